@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/mkseven15/whitelist-server/internal/backup"
+	"github.com/mkseven15/whitelist-server/internal/config"
+	"github.com/mkseven15/whitelist-server/internal/database"
+)
+
+// runCommand runs the subcommand in args instead of serving.
+func runCommand(ctx context.Context, db *sql.DB, dialect database.Dialect, cfg *config.Config, args []string) error {
+	switch args[0] {
+	case "backup", "restore":
+	default:
+		return fmt.Errorf("unknown command %q (want backup or restore)", args[0])
+	}
+	if len(args) != 2 {
+		return fmt.Errorf("usage: %s %s <archive>", os.Args[0], args[0])
+	}
+	if dialect != database.Postgres {
+		return fmt.Errorf("%s needs a Postgres database", args[0])
+	}
+	if cfg.BackupPassphrase == "" {
+		return errors.New("BACKUP_PASSPHRASE is not set")
+	}
+
+	if args[0] == "backup" {
+		// Written next to the target and renamed, so a failed backup never
+		// replaces a good one
+		tmp, err := os.CreateTemp(filepath.Dir(args[1]), ".backup-*")
+		if err != nil {
+			return err
+		}
+		defer os.Remove(tmp.Name())
+		counts, err := backup.Dump(ctx, db, tmp, cfg.BackupPassphrase)
+		if err == nil {
+			err = tmp.Close()
+		} else {
+			tmp.Close()
+		}
+		if err != nil {
+			return err
+		}
+		if err := os.Rename(tmp.Name(), args[1]); err != nil {
+			return err
+		}
+		log.Printf("Backed up %s to %s", formatCounts(counts), args[1])
+		return nil
+	}
+
+	f, err := os.Open(args[1])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	counts, err := backup.Restore(ctx, db, f, cfg.BackupPassphrase)
+	if err != nil {
+		return err
+	}
+	log.Printf("Restored %s from %s", formatCounts(counts), args[1])
+	return nil
+}
+
+func formatCounts(counts backup.Counts) string {
+	var parts []string
+	for table, n := range counts {
+		parts = append(parts, fmt.Sprintf("%d %s", n, table))
+	}
+	sort.Strings(parts)
+	if len(parts) == 0 {
+		return "nothing"
+	}
+	return strings.Join(parts, ", ")
+}
@@ -2,93 +2,506 @@ package main
 
 import (
 	"context"
-	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
 	"log"
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"slices"
+	"strconv"
 	"strings" // Added string manipulation package
+	"syscall"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
-	_ "github.com/lib/pq" // Postgres driver
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"golang.org/x/crypto/acme/autocert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
 
-	pb "github.com/mkseven15/whitelist-server/proto"
+	"github.com/mkseven15/whitelist-server/internal/accesslog"
+	"github.com/mkseven15/whitelist-server/internal/adminip"
+	"github.com/mkseven15/whitelist-server/internal/apidocs"
+	"github.com/mkseven15/whitelist-server/internal/cache"
+	"github.com/mkseven15/whitelist-server/internal/clientip"
+	"github.com/mkseven15/whitelist-server/internal/config"
+	"github.com/mkseven15/whitelist-server/internal/connecthandler"
+	"github.com/mkseven15/whitelist-server/internal/dashboard"
+	"github.com/mkseven15/whitelist-server/internal/database"
+	"github.com/mkseven15/whitelist-server/internal/discordbot"
+	"github.com/mkseven15/whitelist-server/internal/geoip"
+	"github.com/mkseven15/whitelist-server/internal/grpcauth"
+	"github.com/mkseven15/whitelist-server/internal/httpcompress"
+	"github.com/mkseven15/whitelist-server/internal/ipban"
+	"github.com/mkseven15/whitelist-server/internal/metrics"
+	"github.com/mkseven15/whitelist-server/internal/migrations"
+	"github.com/mkseven15/whitelist-server/internal/notify"
+	"github.com/mkseven15/whitelist-server/internal/ratelimit"
+	"github.com/mkseven15/whitelist-server/internal/recovery"
 	"github.com/mkseven15/whitelist-server/internal/service"
+	"github.com/mkseven15/whitelist-server/internal/signing"
+	"github.com/mkseven15/whitelist-server/internal/store"
+	"github.com/mkseven15/whitelist-server/internal/tracing"
+	"github.com/mkseven15/whitelist-server/internal/validation"
+	"github.com/mkseven15/whitelist-server/internal/webhook"
+	pb "github.com/mkseven15/whitelist-server/proto"
+	pbv2 "github.com/mkseven15/whitelist-server/proto/v2"
 )
 
 func main() {
+	configPath := flag.String("config", os.Getenv("CONFIG_FILE"), "path to a YAML config file (env vars take precedence)")
+	migrate := flag.Bool("migrate", false, "apply pending database migrations before serving (or set MIGRATE_ON_START=true)")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] [backup|restore <archive>]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	// Cancelled on SIGINT/SIGTERM (Render sends SIGTERM before restarting)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	// 1. Config
-	dbURL := os.Getenv("DB_URL")
-	if dbURL == "" {
-		log.Fatal("DB_URL environment variable is required")
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	if len(cfg.AdminSecrets()) == 0 && cfg.AdminJWTSecret == "" {
+		log.Println("Neither ADMIN_SECRET nor ADMIN_JWT_SECRET is set; admin endpoints are disabled unless secrets were rotated into the database")
 	}
 
-	// Render provides the PORT variable. Default to 8080 if running locally.
-	httpPort := os.Getenv("PORT")
-	if httpPort == "" {
-		httpPort = "8080"
+	// Tracing (no-op unless OTEL_EXPORTER_OTLP_ENDPOINT is set)
+	shutdownTracing, err := tracing.Setup(context.Background(), "whitelist-server")
+	if err != nil {
+		log.Fatalf("Failed to set up tracing: %v", err)
 	}
-	
-	// Internal gRPC port (not exposed to public internet directly on Render)
-	grpcPort := "50051"
 
 	// 2. Database Connection
-	db, err := sql.Open("postgres", dbURL)
+	db, dialect, err := database.Open(cfg.DBURL)
 	if err != nil {
 		log.Fatalf("Failed to open db connection: %v", err)
 	}
-	defer db.Close()
+
+	db.SetMaxOpenConns(cfg.DBPool.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.DBPool.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.DBPool.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(cfg.DBPool.ConnMaxIdleTime)
 
 	if err := db.Ping(); err != nil {
 		log.Fatalf("Failed to ping db: %v", err)
 	}
-	log.Println("Connected to Supabase")
+	log.Printf("Connected to %s", dialect)
+
+	if *migrate || cfg.MigrateOnStart {
+		if err := migrations.Up(ctx, db, dialect, []byte(cfg.HashSalt)); err != nil {
+			log.Fatalf("Failed to migrate db: %v", err)
+		}
+		log.Println("Database schema up to date")
+	}
+
+	// backup and restore run against the database and exit
+	if flag.NArg() > 0 {
+		if err := runCommand(ctx, db, dialect, cfg, flag.Args()); err != nil {
+			log.Fatalf("%s failed: %v", flag.Arg(0), err)
+		}
+		return
+	}
+
+	var licenseCache cache.Cache
+	switch cfg.LicenseCache {
+	case "redis":
+		licenseCache, err = cache.NewRedis(ctx, cfg.RedisURL)
+		if err != nil {
+			log.Fatalf("Failed to connect to redis: %v", err)
+		}
+		log.Printf("Caching licenses in Redis for %s", cfg.LicenseCacheTTL)
+	case "memory":
+		licenseCache = cache.NewMemory()
+		log.Printf("Caching licenses in memory for %s", cfg.LicenseCacheTTL)
+	}
+
+	var tokens store.TokenStore
+	if cfg.TokenStore == "redis" {
+		tokens, err = store.NewRedisTokens(ctx, cfg.RedisURL)
+		if err != nil {
+			log.Fatalf("Failed to connect to redis: %v", err)
+		}
+		log.Printf("Keeping access tokens in Redis")
+	}
+
+	var endpoints []webhook.Endpoint
+	for _, w := range cfg.Webhooks {
+		endpoints = append(endpoints, webhook.Endpoint{URL: w.URL, Secret: w.Secret, Events: w.Events})
+	}
+	hooks := webhook.New(endpoints)
+	alerts := notify.NewTelegram(cfg.Telegram.BotToken, cfg.Telegram.Chats, cfg.Telegram.Routes)
+
+	var geo *geoip.DB
+	if cfg.GeoIPDatabase != "" {
+		geo, err = geoip.Open(cfg.GeoIPDatabase)
+		if err != nil {
+			log.Fatalf("Failed to open GeoIP database: %v", err)
+		}
+		defer geo.Close()
+		log.Printf("Resolving client countries with %s", cfg.GeoIPDatabase)
+	}
 
 	// 3. Start gRPC Server (Internal)
-	lis, err := net.Listen("tcp", ":"+grpcPort)
+	lis, err := listenGRPC(cfg)
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	s := grpc.NewServer()
-	whitelistService := service.NewWhitelistService(db)
+	// Rate limits for the public endpoints (requests/second per client, 0 disables)
+	limitByIP := ratelimit.New(cfg.RateLimit.IPRPS, cfg.RateLimit.IPBurst)
+	limitByKey := ratelimit.New(cfg.RateLimit.KeyRPS, cfg.RateLimit.KeyBurst)
+
+	publicMethods := []string{
+		pb.WhitelistService_GetAuthToken_FullMethodName,
+		pb.WhitelistService_ValidateLicense_FullMethodName,
+		pb.WhitelistService_GetChallenge_FullMethodName,
+		pb.WhitelistService_GetServerTime_FullMethodName,
+		pb.WhitelistService_GetPublicKey_FullMethodName,
+		pb.WhitelistService_GetSigningKeys_FullMethodName,
+		pb.WhitelistService_ValidateLicenses_FullMethodName,
+		pb.WhitelistService_StartSession_FullMethodName,
+		pb.WhitelistService_Heartbeat_FullMethodName,
+		pb.WhitelistService_CheckoutLicense_FullMethodName,
+		pb.WhitelistService_ConsumeCredits_FullMethodName,
+		pb.WhitelistService_GetLatestVersion_FullMethodName,
+		pb.WhitelistService_CreateTrialLicense_FullMethodName,
+		pb.WhitelistService_ListMyDevices_FullMethodName,
+		pb.WhitelistService_DeactivateDevice_FullMethodName,
+		pb.WhitelistService_ResellerGenerateLicense_FullMethodName,
+		pb.WhitelistService_ResellerExtendLicense_FullMethodName,
+		pb.WhitelistService_AdminLogin_FullMethodName,
+		pbv2.WhitelistService_GetAuthToken_FullMethodName,
+		pbv2.WhitelistService_ValidateLicense_FullMethodName,
+		pbv2.WhitelistService_GetChallenge_FullMethodName,
+		pbv2.WhitelistService_GetServerTime_FullMethodName,
+		pbv2.WhitelistService_GetPublicKey_FullMethodName,
+		pbv2.WhitelistService_GetSigningKeys_FullMethodName,
+	}
+	// Banned addresses are turned away before they count against rate limits
+	whitelistService := service.NewWhitelistService(db, cfg, licenseCache, tokens, hooks, alerts, geo)
+	whitelistServiceV2 := whitelistService.V2()
+	// Everything but the client RPCs is an admin call (see adminip)
+	clientMethods := slices.Concat(publicMethods, []string{
+		pb.WhitelistService_EndSession_FullMethodName,
+		pb.WhitelistService_CheckinLicense_FullMethodName,
+		pb.WhitelistService_WatchLicense_FullMethodName,
+	})
+	adminNetworks, _ := cfg.AdminAllowedNetworks() // checked by Validate
+	// Field rules from the (validate.rules) options in the .proto files
+	validator, err := validation.New(
+		pb.File_proto_whitelist_proto.Services().ByName("WhitelistService"),
+		pbv2.File_proto_v2_whitelist_proto.Services().ByName("WhitelistService"),
+	)
+	if err != nil {
+		log.Fatalf("Failed to load request validation rules: %v", err)
+	}
+	publicUnary := []grpc.UnaryServerInterceptor{
+		accesslog.UnaryServerInterceptor(cfg.AccessLog.Sample, cfg.AccessLog.Errors),
+		// A panicking call fails on its own rather than crashing the process
+		recovery.UnaryServerInterceptor(),
+		adminip.UnaryServerInterceptor(adminNetworks, clientMethods...),
+		ipban.UnaryServerInterceptor(whitelistService.IPBans(), publicMethods...),
+		ratelimit.UnaryServerInterceptor(limitByIP, limitByKey, publicMethods...),
+		validator.UnaryServerInterceptor(),
+	}
+	publicStream := []grpc.StreamServerInterceptor{
+		recovery.StreamServerInterceptor(),
+		adminip.StreamServerInterceptor(adminNetworks, clientMethods...),
+		ipban.StreamServerInterceptor(whitelistService.IPBans(), pb.WhitelistService_WatchLicense_FullMethodName),
+		validator.StreamServerInterceptor(),
+	}
+
+	// Only trusted clients should talk to the gRPC port (see grpcauth)
+	var unary []grpc.UnaryServerInterceptor
+	var stream []grpc.StreamServerInterceptor
+	if cfg.GRPCAuth.Token != "" {
+		unary = append(unary, grpcauth.UnaryServerInterceptor(cfg.GRPCAuth.Token))
+		stream = append(stream, grpcauth.StreamServerInterceptor(cfg.GRPCAuth.Token))
+	}
+	unary = append(unary, publicUnary...)
+	stream = append(stream, publicStream...)
+
+	serverOpts := []grpc.ServerOption{
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	}
+	if cfg.GRPCAuth.TLS() {
+		serverCreds, err := grpcauth.ServerTLS(cfg.GRPCAuth.TLSCert, cfg.GRPCAuth.TLSKey, cfg.GRPCAuth.TLSCA)
+		if err != nil {
+			log.Fatalf("Failed to load gRPC TLS config: %v", err)
+		}
+		serverOpts = append(serverOpts, grpc.Creds(serverCreds))
+	}
+	if cfg.GRPCSocket == "" && !cfg.GRPCAuth.TLS() && cfg.GRPCAuth.Token == "" {
+		log.Printf("gRPC port %s accepts unauthenticated calls; set GRPC_INTERNAL_TOKEN or GRPC_TLS_* if it is reachable", cfg.GRPCPort)
+	}
+
+	s := grpc.NewServer(serverOpts...)
 	pb.RegisterWhitelistServiceServer(s, whitelistService)
+	pbv2.RegisterWhitelistServiceServer(s, whitelistServiceV2)
 	reflection.Register(s)
 
+	var bot *discordbot.Bot
+	if cfg.Discord.BotToken != "" {
+		bot, err = discordbot.Start(cfg.Discord.BotToken, cfg.Discord.GuildID, cfg.Discord.AdminRoles, cfg.AdminSecrets()[0], whitelistService)
+		if err != nil {
+			log.Fatalf("Failed to start Discord bot: %v", err)
+		}
+		log.Println("Discord bot connected")
+		whitelistService.SetDiscordDM(bot.DirectMessage)
+	}
+
+	// Any server failing brings the whole process down (via shutdown below)
+	serveErr := make(chan error, 4)
+
 	go func() {
 		log.Printf("gRPC server listening internally at %v", lis.Addr())
 		if err := s.Serve(lis); err != nil {
-			log.Fatalf("failed to serve: %v", err)
+			serveErr <- err
 		}
 	}()
 
 	// 4. Start HTTP Gateway (Public)
-	// The gateway connects to the internal gRPC server
-	conn, err := grpc.Dial("localhost:"+grpcPort, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	// Gateway and gRPC-Web calls are served by a second, in-process gRPC
+	// server over an in-memory connection: they come from outside, so it has
+	// the public interceptors, but it needs neither the internal token nor TLS
+	local := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(publicUnary...),
+		grpc.ChainStreamInterceptor(publicStream...),
+	)
+	pb.RegisterWhitelistServiceServer(local, whitelistService)
+	pbv2.RegisterWhitelistServiceServer(local, whitelistServiceV2)
+	localLis := bufconn.Listen(localBufferSize)
+	go func() {
+		if err := local.Serve(clientip.GatewayListener(localLis)); err != nil {
+			serveErr <- err
+		}
+	}()
+	conn, err := grpc.NewClient("passthrough:///in-process",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return localLis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
 	if err != nil {
 		log.Fatalf("did not connect to gRPC: %v", err)
 	}
-	defer conn.Close()
 
 	mux := runtime.NewServeMux(
 		runtime.WithIncomingHeaderMatcher(customMatcher),
+		runtime.WithErrorHandler(gatewayErrorHandler),
+		runtime.WithRoutingErrorHandler(gatewayRoutingErrorHandler),
 	)
 
 	err = pb.RegisterWhitelistServiceHandler(context.Background(), mux, conn)
 	if err != nil {
 		log.Fatalf("Failed to register gateway: %v", err)
 	}
+	err = pbv2.RegisterWhitelistServiceHandler(context.Background(), mux, conn)
+	if err != nil {
+		log.Fatalf("Failed to register v2 gateway: %v", err)
+	}
 
+	if cfg.Stripe.WebhookSecret != "" {
+		err = mux.HandlePath("POST", "/webhooks/stripe", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+			whitelistService.StripeWebhook(w, r)
+		})
+		if err != nil {
+			log.Fatalf("Failed to register Stripe webhook: %v", err)
+		}
+	}
+
+	// The dashboard signs operators in with AdminLogin, so it needs its secret
+	root := http.NewServeMux()
+	root.Handle("/", mux)
+	apidocs.Register(root)
+	if cfg.MetricsToken != "" {
+		root.Handle(metrics.Path, metrics.Handler(cfg.MetricsToken))
+	}
+	if cfg.Dashboard && cfg.AdminJWTSecret != "" {
+		dash := dashboard.New(pb.NewWhitelistServiceClient(conn))
+		root.Handle(dashboard.Prefix, dash)
+		root.Handle(dashboard.Prefix+"/", dash)
+	}
+	// Connect, and gRPC over HTTP/2, with the same interceptors as gRPC-Web
+	if cfg.Connect {
+		root.Handle(connecthandler.New(whitelistService, publicUnary, publicStream))
+		root.Handle(connecthandler.NewV2(whitelistServiceV2, publicUnary, publicStream))
+	}
+
+	handler := signing.Middleware(root)
+	if cfg.GRPCWeb {
+		handler = grpcWebHandler(grpcweb.WrapServer(local), handler)
+	}
+
+	// CORS tells client routes from admin ones by path
+	isPublicRoute := routeMatcher(slices.Concat(publicRoutes, clientMethods))
+	isStreamRoute := routeMatcher(slices.Concat(streamRoutes, []string{
+		pb.WhitelistService_WatchLicense_FullMethodName,
+		pb.WhitelistService_ExportLicenses_FullMethodName,
+		pb.WhitelistService_ExportAuditLog_FullMethodName,
+	}))
+	limits := cfg.HTTPLimits
+	trustedProxies, _ := cfg.TrustedProxyNetworks() // checked by Validate
+	handler = limitMiddleware(limits.MaxBodyBytes, isStreamRoute, handler)
+	if cfg.CompressMinSize > 0 {
+		handler = httpcompress.Middleware(cfg.CompressMinSize, handler)
+	}
 	gwServer := &http.Server{
-		Addr:    ":" + httpPort,
-		Handler: corsMiddleware(mux),
+		Addr: ":" + cfg.HTTPPort,
+		// Everything behind it sees the client's address as RemoteAddr
+		Handler:           clientip.Middleware(trustedProxies, otelhttp.NewHandler(tracing.TraceIDHeader(corsMiddleware(cfg.CORS, isPublicRoute, handler)), "gateway")),
+		ReadHeaderTimeout: limits.ReadHeaderTimeout,
+		ReadTimeout:       limits.ReadTimeout,
+		WriteTimeout:      limits.WriteTimeout,
+		IdleTimeout:       limits.IdleTimeout,
+		MaxHeaderBytes:    limits.MaxHeaderBytes,
+	}
+	if cfg.Connect {
+		// gRPC clients without TLS speak HTTP/2 in cleartext (h2c)
+		gwServer.Protocols = new(http.Protocols)
+		gwServer.Protocols.SetHTTP1(true)
+		gwServer.Protocols.SetHTTP2(true)
+		gwServer.Protocols.SetUnencryptedHTTP2(true)
+	}
+
+	// Optional native TLS for deployments without a proxy in front
+	var acmeServer *http.Server
+	certFile, keyFile := cfg.HTTPTLS.CertFile, cfg.HTTPTLS.KeyFile
+	if domains := cfg.HTTPTLS.AutocertDomains; len(domains) > 0 {
+		m := &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(domains...),
+			Cache:      autocert.DirCache(cfg.HTTPTLS.AutocertCacheDir),
+			Email:      cfg.HTTPTLS.AutocertEmail,
+		}
+		gwServer.TLSConfig = m.TLSConfig()
+		certFile, keyFile = "", ""
+
+		if cfg.HTTPTLS.AutocertHTTPPort != "" {
+			// Answers HTTP-01 challenges and redirects everything else to HTTPS
+			acmeServer = &http.Server{Addr: ":" + cfg.HTTPTLS.AutocertHTTPPort, Handler: m.HTTPHandler(nil)}
+			go func() {
+				if err := acmeServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+					serveErr <- err
+				}
+			}()
+		}
 	}
 
-	log.Printf("HTTP Gateway listening publicly on port %s", httpPort)
-	log.Fatal(gwServer.ListenAndServe())
+	go func() {
+		var err error
+		if cfg.HTTPTLS.Enabled() {
+			log.Printf("HTTPS Gateway listening publicly on port %s", cfg.HTTPPort)
+			err = gwServer.ListenAndServeTLS(certFile, keyFile)
+		} else {
+			log.Printf("HTTP Gateway listening publicly on port %s", cfg.HTTPPort)
+			err = gwServer.ListenAndServe()
+		}
+		if !errors.Is(err, http.ErrServerClosed) {
+			serveErr <- err
+		}
+	}()
+
+	// 5. Wait for a signal (or a server failure), then shut down in order:
+	// stop taking HTTP traffic, drain gRPC, stop background jobs, close the DB.
+	var exitErr error
+	select {
+	case <-ctx.Done():
+		log.Println("Shutdown signal received, draining requests...")
+	case exitErr = <-serveErr:
+		log.Printf("Server error, shutting down: %v", exitErr)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	// WatchLicense streams never finish on their own
+	whitelistService.StopWatches()
+	if err := gwServer.Shutdown(shutdownCtx); err != nil {
+		log.Printf("HTTP shutdown: %v", err)
+	}
+	if acmeServer != nil {
+		acmeServer.Shutdown(shutdownCtx)
+	}
+	conn.Close()
+	local.Stop()
+	stopGRPC(shutdownCtx, s)
+	bot.Close()
+	whitelistService.Close()
+	hooks.Close(shutdownCtx)
+	alerts.Close(shutdownCtx)
+	if licenseCache != nil {
+		licenseCache.Close()
+	}
+	if tokens != nil {
+		tokens.Close()
+	}
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		log.Printf("Tracing shutdown: %v", err)
+	}
+	db.Close()
+
+	if exitErr != nil {
+		log.Fatal(exitErr)
+	}
+	log.Println("Shutdown complete")
+}
+
+// listenGRPC opens the internal gRPC server's listener: the Unix socket at
+// GRPCSocket if one is set, the TCP port otherwise.
+func listenGRPC(cfg *config.Config) (net.Listener, error) {
+	path := cfg.GRPCSocket
+	if path == "" {
+		return net.Listen("tcp", ":"+cfg.GRPCPort)
+	}
+	// A socket left behind by a crash would make Listen fail
+	if fi, err := os.Lstat(path); err == nil && fi.Mode()&os.ModeSocket != 0 {
+		os.Remove(path)
+	}
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	// Only the server's own user may connect
+	if err := os.Chmod(path, 0o600); err != nil {
+		lis.Close()
+		return nil, err
+	}
+	return lis, nil
+}
+
+// stopGRPC waits for in-flight RPCs to finish, forcing the server closed if
+// ctx expires first.
+func stopGRPC(ctx context.Context, s *grpc.Server) {
+	done := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		log.Println("gRPC drain timed out, forcing stop")
+		s.Stop()
+	}
 }
 
 // customMatcher allows specific headers to pass through to the gRPC context
@@ -100,17 +513,119 @@ func customMatcher(key string) (string, bool) {
 		return strings.ToLower(key), true
 	case "x-admin-secret":
 		return strings.ToLower(key), true
+	case "x-admin-actor":
+		return strings.ToLower(key), true
+	case "x-admin-otp":
+		return strings.ToLower(key), true
+	case "x-reseller-key":
+		return strings.ToLower(key), true
+	case signing.TimestampHeader, signing.NonceHeader, signing.SignatureHeader, signing.BodyDigestHeader:
+		// The body digest is always the gateway's own (see signing.Middleware)
+		return strings.ToLower(key), true
+	case "grpc-metadata-" + grpcauth.TokenHeader:
+		// The internal token is for the gRPC port, never passed on
+		return "", false
 	default:
 		return runtime.DefaultHeaderMatcher(key)
 	}
 }
 
-// corsMiddleware adds CORS headers for web compatibility
-func corsMiddleware(h http.Handler) http.Handler {
+// gatewayErrorHandler is runtime.DefaultHTTPErrorHandler, except for errors
+// raised before a call was made (unknown routes, wrong methods, bad
+// headers): those have no server metadata, which the default handler logs
+// as an ERROR every time a client gets a 404 or 405.
+func gatewayErrorHandler(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if _, ok := runtime.ServerMetadataFromContext(ctx); !ok {
+		ctx = runtime.NewServerMetadataContext(ctx, runtime.ServerMetadata{})
+	}
+	runtime.DefaultHTTPErrorHandler(ctx, mux, m, w, r, err)
+}
+
+// gatewayRoutingErrorHandler is runtime.DefaultRoutingErrorHandler, except
+// that a known path with the wrong method gets 405 rather than the 501 its
+// Unimplemented code maps to.
+func gatewayRoutingErrorHandler(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, r *http.Request, httpStatus int) {
+	if httpStatus != http.StatusMethodNotAllowed {
+		runtime.DefaultRoutingErrorHandler(ctx, mux, m, w, r, httpStatus)
+		return
+	}
+	runtime.HTTPError(ctx, mux, m, w, r, &runtime.HTTPStatusError{
+		HTTPStatus: httpStatus,
+		Err:        status.Error(codes.Unimplemented, http.StatusText(httpStatus)),
+	})
+}
+
+// localBufferSize is the buffer of the in-memory connection between the
+// gateway and the in-process gRPC server, in each direction.
+const localBufferSize = 1 << 20
+
+// publicRoutes are the gateway routes of the client RPCs, which get the
+// public CORS policy along with their gRPC-Web and Connect paths.
+var publicRoutes = []string{
+	"/v1/auth/token",
+	"/v1/license/validate",
+	"/v1/challenge",
+	"/v1/time",
+	"/v1/public-key",
+	"/v1/signing-keys",
+	"/v1/license/validate-batch",
+	"/v1/sessions",
+	"/v1/sessions/heartbeat",
+	"/v1/sessions/end",
+	"/v1/leases",
+	"/v1/leases/checkin",
+	"/v1/license/{license_key}/credits/consume",
+	"/v1/products/{product_id}/latest",
+	"/v1/products/{product_id}/trial",
+	"/v1/license/{license_key}/my-devices",
+	"/v1/license/{license_key}/my-devices/deactivate",
+	"/v1/reseller/licenses/generate",
+	"/v1/reseller/licenses/{license_key}/extend",
+	"/v1/admin/login",
+	"/v1/license/{license_key}/watch",
+	"/v2/auth/token",
+	"/v2/license/validate",
+	"/v2/challenge",
+	"/v2/time",
+	"/v2/public-key",
+	"/v2/signing-keys",
+}
+
+// routeMatcher reports whether a path is one of patterns, in which a {name}
+// segment matches any one non-empty segment.
+func routeMatcher(patterns []string) func(path string) bool {
+	split := make([][]string, len(patterns))
+	for i, p := range patterns {
+		split[i] = strings.Split(p, "/")
+	}
+	return func(path string) bool {
+		segs := strings.Split(path, "/")
+	next:
+		for _, p := range split {
+			if len(p) != len(segs) {
+				continue
+			}
+			for i, s := range p {
+				if s != segs[i] && (!strings.HasPrefix(s, "{") || segs[i] == "") {
+					continue next
+				}
+			}
+			return true
+		}
+		return false
+	}
+}
+
+// corsMiddleware adds CORS headers for web compatibility: the public policy
+// on paths isPublic reports, the admin policy everywhere else.
+func corsMiddleware(cors config.CORS, isPublic func(path string) bool, h http.Handler) http.Handler {
+	public, admin := newCORSPolicy(cors.Public), newCORSPolicy(cors.Admin)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, x-access-token, x-admin-secret")
+		p := admin
+		if isPublic(r.URL.Path) {
+			p = public
+		}
+		p.setHeaders(w.Header(), r.Header.Get("Origin"))
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusOK)
 			return
@@ -118,3 +633,99 @@ func corsMiddleware(h http.Handler) http.Handler {
 		h.ServeHTTP(w, r)
 	})
 }
+
+// corsPolicy is a config.CORSPolicy with its headers prepared.
+type corsPolicy struct {
+	anyOrigin   bool
+	origins     map[string]bool
+	methods     string
+	headers     string
+	maxAge      string
+	credentials bool
+}
+
+func newCORSPolicy(c config.CORSPolicy) *corsPolicy {
+	p := &corsPolicy{
+		origins:     map[string]bool{},
+		methods:     strings.Join(c.Methods, ", "),
+		headers:     strings.Join(c.Headers, ", "),
+		credentials: c.Credentials,
+	}
+	for _, o := range c.Origins {
+		p.origins[o] = true
+	}
+	p.anyOrigin = p.origins["*"]
+	if c.MaxAge > 0 {
+		p.maxAge = strconv.Itoa(int(c.MaxAge.Seconds()))
+	}
+	return p
+}
+
+// setHeaders sets the CORS headers for a request from origin. Requests from
+// origins not in the allow list get none.
+func (p *corsPolicy) setHeaders(h http.Header, origin string) {
+	if p.anyOrigin {
+		h.Set("Access-Control-Allow-Origin", "*")
+	} else {
+		h.Add("Vary", "Origin")
+		if !p.origins[origin] {
+			return
+		}
+		h.Set("Access-Control-Allow-Origin", origin)
+	}
+	if p.credentials {
+		h.Set("Access-Control-Allow-Credentials", "true")
+	}
+	if p.methods != "" {
+		h.Set("Access-Control-Allow-Methods", p.methods)
+	}
+	if p.headers != "" {
+		h.Set("Access-Control-Allow-Headers", p.headers)
+	}
+	if p.maxAge != "" {
+		h.Set("Access-Control-Max-Age", p.maxAge)
+	}
+	h.Set("Access-Control-Expose-Headers", "X-Trace-Id, grpc-status, grpc-message")
+}
+
+// streamRoutes are the gateway routes of the streaming RPCs, which may run
+// for longer than the server's read and write timeouts.
+var streamRoutes = []string{
+	"/v1/license/{license_key}/watch",
+	"/v1/licenses/export",
+	"/v1/audit/export",
+}
+
+// limitMiddleware answers requests with bodies over maxBody with 413, and
+// lifts the server's read and write deadlines for routes isStream reports.
+func limitMiddleware(maxBody int, isStream func(path string) bool, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength > int64(maxBody) {
+			http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		if r.Body != nil {
+			r.Body = http.MaxBytesReader(w, r.Body, int64(maxBody))
+		}
+		if isStream(r.URL.Path) {
+			rc := http.NewResponseController(w)
+			rc.SetReadDeadline(time.Time{})
+			rc.SetWriteDeadline(time.Time{})
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// grpcWebHandler sends gRPC-Web requests to web and everything else to h.
+// CORS is left to corsMiddleware. A signed gRPC-Web call signs the request
+// message as direct gRPC calls do, so a client-sent body digest is dropped.
+func grpcWebHandler(web *grpcweb.WrappedGrpcServer, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !web.IsGrpcWebRequest(r) {
+			h.ServeHTTP(w, r)
+			return
+		}
+		r.Header.Del(signing.BodyDigestHeader)
+		web.HandleGrpcWebRequest(w, r)
+	})
+}
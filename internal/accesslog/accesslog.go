@@ -0,0 +1,38 @@
+// Package accesslog logs RPCs as they finish: method, client address,
+// status code and duration, as key=value pairs through log/slog.
+package accesslog
+
+import (
+	"context"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/mkseven15/whitelist-server/internal/clientip"
+)
+
+// UnaryServerInterceptor logs a sample fraction (0 to 1) of successful
+// calls and, with logErrors set, every failed one.
+func UnaryServerInterceptor(sample float64, logErrors bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		if err != nil && logErrors || err == nil && sample > 0 && rand.Float64() < sample {
+			code := status.Code(err)
+			level := slog.LevelInfo
+			if err != nil {
+				level = slog.LevelWarn
+			}
+			slog.Log(ctx, level, "rpc",
+				"method", info.FullMethod,
+				"ip", clientip.FromContext(ctx),
+				"code", code.String(),
+				"duration", time.Since(start).Round(time.Microsecond),
+			)
+		}
+		return resp, err
+	}
+}
@@ -0,0 +1,72 @@
+// Package adminip keeps the admin RPCs to a configured set of client
+// networks, so a leaked admin secret or session token is of no use from
+// anywhere else.
+package adminip
+
+import (
+	"context"
+	"log"
+	"net/netip"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/mkseven15/whitelist-server/internal/clientip"
+	"github.com/mkseven15/whitelist-server/internal/errinfo"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+var errNotAllowed = errinfo.Error(codes.PermissionDenied, pb.Reason_REASON_ADDRESS_NOT_ALLOWED, "admin calls are not allowed from your address")
+
+// UnaryServerInterceptor rejects calls from addresses outside allowed with
+// PermissionDenied (HTTP 403 through the gateway), except calls to the given
+// full method names, the client RPCs. With no networks everyone is let
+// through.
+func UnaryServerInterceptor(allowed []netip.Prefix, except ...string) grpc.UnaryServerInterceptor {
+	exempt := methodSet(except)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !exempt[info.FullMethod] && !check(ctx, allowed, info.FullMethod) {
+			return nil, errNotAllowed
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is UnaryServerInterceptor for streaming methods.
+func StreamServerInterceptor(allowed []netip.Prefix, except ...string) grpc.StreamServerInterceptor {
+	exempt := methodSet(except)
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !exempt[info.FullMethod] && !check(ss.Context(), allowed, info.FullMethod) {
+			return errNotAllowed
+		}
+		return handler(srv, ss)
+	}
+}
+
+// check reports whether the caller's address is in allowed, logging the
+// calls it turns away: from outside, they're likely someone else holding
+// admin credentials.
+func check(ctx context.Context, allowed []netip.Prefix, method string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	ip := clientip.FromContext(ctx)
+	if addr, err := netip.ParseAddr(ip); err == nil {
+		addr = addr.Unmap()
+		for _, p := range allowed {
+			if p.Contains(addr) {
+				return true
+			}
+		}
+	}
+	log.Printf("Refused %s from %q: address not in admin_allowed_ips", method, ip)
+	return false
+}
+
+func methodSet(methods []string) map[string]bool {
+	set := make(map[string]bool, len(methods))
+	for _, m := range methods {
+		set[m] = true
+	}
+	return set
+}
@@ -0,0 +1,27 @@
+// Package apidocs serves the HTTP gateway's OpenAPI description and a
+// Swagger UI page for browsing it, so integrators don't need the proto file.
+package apidocs
+
+import (
+	_ "embed"
+	"net/http"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// The UI's scripts and styles come from a CDN, pinned to one version
+//
+//go:embed swagger.html
+var swaggerPage []byte
+
+// Register adds GET /openapi.json (the spec) and GET /docs (Swagger UI) to mux.
+func Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(pb.OpenAPI)
+	})
+	mux.HandleFunc("GET /docs", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write(swaggerPage)
+	})
+}
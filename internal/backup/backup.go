@@ -0,0 +1,234 @@
+// Package backup copies a deployment's products, customers, API keys and
+// licenses (with their bound devices) into a passphrase-encrypted archive and
+// restores them, e.g. to move to another Supabase project.
+//
+// An archive is a magic string, a scrypt salt and an AES-GCM nonce, followed
+// by the sealed, gzipped NDJSON: a header line, then one line per row.
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/mkseven15/whitelist-server/internal/migrations"
+)
+
+// Tables in restore order, parents before the rows that reference them
+var tables = []string{"products", "customers", "api_keys", "licenses", "license_devices"}
+
+const (
+	magic   = "WLBACKUP1"
+	saltLen = 16
+)
+
+// ErrDecrypt means the passphrase is wrong or the archive was altered.
+var ErrDecrypt = errors.New("can't decrypt archive: wrong passphrase or corrupt file")
+
+// Counts is the number of rows backed up or restored per table.
+type Counts map[string]int
+
+type header struct {
+	// Rows only fit a database migrated to the same version
+	SchemaVersion int64     `json:"schema_version"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type record struct {
+	Table string          `json:"table"`
+	Row   json.RawMessage `json:"row"`
+}
+
+// Dump writes an archive of db to w, from one consistent snapshot.
+func Dump(ctx context.Context, db *sql.DB, w io.Writer, passphrase string) (Counts, error) {
+	if passphrase == "" {
+		return nil, errors.New("no passphrase")
+	}
+	version, err := migrations.Version(ctx, db)
+	if err != nil {
+		return nil, fmt.Errorf("schema version: %w", err)
+	}
+
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	enc := json.NewEncoder(zw)
+	if err := enc.Encode(header{SchemaVersion: version, CreatedAt: time.Now().UTC()}); err != nil {
+		return nil, err
+	}
+
+	counts := Counts{}
+	for _, table := range tables {
+		rows, err := tx.QueryContext(ctx, "SELECT row_to_json(t) FROM "+table+" t")
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", table, err)
+		}
+		for rows.Next() {
+			var row json.RawMessage
+			if err := rows.Scan(&row); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("%s: %w", table, err)
+			}
+			if err := enc.Encode(record{Table: table, Row: row}); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			counts[table]++
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("%s: %w", table, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	sealed, err := seal(passphrase, buf.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(sealed); err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// Restore loads an archive from r into db in one transaction. Rows whose key
+// already exists are left alone, so restoring twice is harmless.
+func Restore(ctx context.Context, db *sql.DB, r io.Reader, passphrase string) (Counts, error) {
+	sealed, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	plain, err := open(passphrase, sealed)
+	if err != nil {
+		return nil, err
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(plain))
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bufio.NewReader(zr))
+
+	var h header
+	if err := dec.Decode(&h); err != nil {
+		return nil, fmt.Errorf("bad archive header: %w", err)
+	}
+	version, err := migrations.Version(ctx, db)
+	if err != nil {
+		return nil, fmt.Errorf("schema version: %w", err)
+	}
+	if h.SchemaVersion != version {
+		return nil, fmt.Errorf("archive is from schema version %d but the database is at %d; migrate both to the same version", h.SchemaVersion, version)
+	}
+
+	known := map[string]bool{}
+	for _, table := range tables {
+		known[table] = true
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	counts := Counts{}
+	for {
+		var rec record
+		if err := dec.Decode(&rec); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("bad archive row: %w", err)
+		}
+		if !known[rec.Table] {
+			return nil, fmt.Errorf("archive has rows for unknown table %q", rec.Table)
+		}
+		res, err := tx.ExecContext(ctx, "INSERT INTO "+rec.Table+" SELECT * FROM json_populate_record(NULL::"+rec.Table+", $1) ON CONFLICT DO NOTHING", string(rec.Row))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", rec.Table, err)
+		}
+		n, _ := res.RowsAffected()
+		counts[rec.Table] += int(n)
+	}
+
+	// Customers keep their ids; move the sequence past them
+	if _, err := tx.ExecContext(ctx, "SELECT setval(pg_get_serial_sequence('customers', 'id'), COALESCE(MAX(id), 0) + 1, false) FROM customers"); err != nil {
+		return nil, fmt.Errorf("customers: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+func seal(passphrase string, plain []byte) ([]byte, error) {
+	salt := make([]byte, saltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	gcm, err := newGCM(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	out := append([]byte(magic), salt...)
+	out = append(out, nonce...)
+	return gcm.Seal(out, nonce, plain, []byte(magic)), nil
+}
+
+func open(passphrase string, sealed []byte) ([]byte, error) {
+	if !bytes.HasPrefix(sealed, []byte(magic)) {
+		return nil, errors.New("not a backup archive")
+	}
+	sealed = sealed[len(magic):]
+	if len(sealed) < saltLen {
+		return nil, ErrDecrypt
+	}
+	gcm, err := newGCM(passphrase, sealed[:saltLen])
+	if err != nil {
+		return nil, err
+	}
+	sealed = sealed[saltLen:]
+	if len(sealed) < gcm.NonceSize() {
+		return nil, ErrDecrypt
+	}
+	plain, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], []byte(magic))
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plain, nil
+}
+
+func newGCM(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, 1<<15, 8, 1, 32)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
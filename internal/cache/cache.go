@@ -0,0 +1,50 @@
+// Package cache keeps recently validated license rows out of Postgres.
+//
+// Only the fields ValidateLicense needs are cached. Entries are dropped by the
+// admin RPCs that change a license, and otherwise expire after a short TTL so
+// edits made directly in the database are picked up eventually.
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// License is the cached subset of a licenses row.
+type License struct {
+	ProductID  string     `json:"product_id"`
+	IsActive   bool       `json:"is_active"`
+	MaxDevices int        `json:"max_devices"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	// Size of the floating pool; 0 binds devices
+	FloatingSeats int `json:"floating_seats,omitempty"`
+	// Shown to clients while suspended
+	SuspendReason string `json:"suspend_reason,omitempty"`
+	// ISO country codes; an empty allow list allows all
+	AllowedCountries []string `json:"allowed_countries,omitempty"`
+	BlockedCountries []string `json:"blocked_countries,omitempty"`
+	// JSON object, returned to clients as is
+	Metadata json.RawMessage `json:"metadata,omitempty"`
+	// Overrides PlanFeatures, which override ProductFeatures
+	Features map[string]bool `json:"features,omitempty"`
+	// From the license's plan, if any
+	PlanFeatures map[string]bool `json:"plan_features,omitempty"`
+
+	// From the product catalog
+	ProductDisabled         bool            `json:"product_disabled,omitempty"`
+	MinVersion              string          `json:"min_version,omitempty"`
+	ProductAllowedCountries []string        `json:"product_allowed_countries,omitempty"`
+	ProductBlockedCountries []string        `json:"product_blocked_countries,omitempty"`
+	RequireChallenge        bool            `json:"require_challenge,omitempty"`
+	ProductFeatures         map[string]bool `json:"product_features,omitempty"`
+}
+
+// Cache stores License entries by license key.
+type Cache interface {
+	// Get returns nil, nil on a miss.
+	Get(ctx context.Context, key string) (*License, error)
+	Set(ctx context.Context, key string, l *License, ttl time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
+	Close() error
+}
@@ -0,0 +1,74 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval controls how often expired entries are dropped from memory.
+const sweepInterval = time.Minute
+
+// Memory is an in-process Cache. Entries live only as long as the process and
+// aren't shared between instances, so keep TTLs short when running several.
+type Memory struct {
+	mu        sync.Mutex
+	entries   map[string]memoryEntry
+	lastSweep time.Time
+}
+
+type memoryEntry struct {
+	license License
+	expires time.Time
+}
+
+func NewMemory() *Memory {
+	return &Memory{entries: make(map[string]memoryEntry), lastSweep: time.Now()}
+}
+
+func (m *Memory) Get(_ context.Context, key string) (*License, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.entries[key]
+	if !ok || time.Now().After(e.expires) {
+		return nil, nil
+	}
+	l := e.license
+	return &l, nil
+}
+
+func (m *Memory) Set(_ context.Context, key string, l *License, ttl time.Duration) error {
+	now := time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if now.Sub(m.lastSweep) > sweepInterval {
+		m.sweep(now)
+	}
+	m.entries[key] = memoryEntry{license: *l, expires: now.Add(ttl)}
+	return nil
+}
+
+func (m *Memory) Delete(_ context.Context, keys ...string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, k := range keys {
+		delete(m.entries, k)
+	}
+	return nil
+}
+
+func (m *Memory) Close() error { return nil }
+
+// sweep forgets expired entries. Caller holds m.mu.
+func (m *Memory) sweep(now time.Time) {
+	for key, e := range m.entries {
+		if now.After(e.expires) {
+			delete(m.entries, key)
+		}
+	}
+	m.lastSweep = now
+}
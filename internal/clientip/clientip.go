@@ -0,0 +1,160 @@
+// Package clientip resolves the address of the caller behind the HTTP gateway.
+//
+// X-Forwarded-For is whatever the client put there plus what each proxy on
+// the way appended, so only the entries added by proxies we run can be
+// believed. Middleware settles the address once, at the HTTP server, from
+// the configured trusted proxies; from then on the caller's address travels
+// as the connection's peer, never as a header a client could have sent.
+package clientip
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// Middleware sets r.RemoteAddr to the client's address and drops
+// X-Forwarded-For. The address is the peer's, unless the peer is one of
+// trusted: then it's the rightmost X-Forwarded-For entry not in trusted
+// (or the leftmost, if all are). With no trusted proxies the header is
+// ignored.
+func Middleware(trusted []netip.Prefix, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host, port, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			host, port = r.RemoteAddr, "0"
+		}
+		client := resolve(host, r.Header.Values("X-Forwarded-For"), trusted)
+		r.Header.Del("X-Forwarded-For")
+		if client != host {
+			r = r.Clone(r.Context())
+			r.RemoteAddr = net.JoinHostPort(client, port)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// resolve returns the client's address given the peer's and the
+// X-Forwarded-For values, as Middleware does.
+func resolve(peerHost string, forwarded []string, trusted []netip.Prefix) string {
+	if !contains(trusted, peerHost) {
+		return peerHost
+	}
+	var hops []string
+	for _, v := range forwarded {
+		for _, hop := range strings.Split(v, ",") {
+			if hop = strings.TrimSpace(hop); hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+	client := peerHost
+	for i := len(hops) - 1; i >= 0; i-- {
+		client = hops[i]
+		if !contains(trusted, client) {
+			break
+		}
+	}
+	return client
+}
+
+func contains(trusted []netip.Prefix, host string) bool {
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, p := range trusted {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewContext returns ctx with remoteAddr (an http.Request's, after
+// Middleware) as the gRPC peer, for HTTP handlers calling the service
+// directly.
+func NewContext(ctx context.Context, remoteAddr string) context.Context {
+	var addr net.Addr = stringAddr(remoteAddr)
+	if ap, err := netip.ParseAddrPort(remoteAddr); err == nil {
+		addr = net.TCPAddrFromAddrPort(ap)
+	}
+	return peer.NewContext(ctx, &peer.Peer{Addr: addr})
+}
+
+type stringAddr string
+
+func (a stringAddr) Network() string { return "tcp" }
+func (a stringAddr) String() string  { return string(a) }
+
+// GatewayListener wraps the listener of the in-process gRPC server behind
+// the gateway. The gateway passes the caller's address (r.RemoteAddr) on as
+// x-forwarded-for, which FromContext believes on these connections only.
+func GatewayListener(l net.Listener) net.Listener {
+	return gatewayListener{l}
+}
+
+type gatewayListener struct {
+	net.Listener
+}
+
+func (l gatewayListener) Accept() (net.Conn, error) {
+	c, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return gatewayConn{c}, nil
+}
+
+type gatewayConn struct {
+	net.Conn
+}
+
+func (gatewayConn) RemoteAddr() net.Addr { return gatewayAddr{} }
+
+type gatewayAddr struct{}
+
+func (gatewayAddr) Network() string { return "gateway" }
+func (gatewayAddr) String() string  { return "gateway" }
+
+// FromGateway reports whether ctx is a call that came in through
+// GatewayListener, whose metadata the gateway itself set.
+func FromGateway(ctx context.Context) bool {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return false
+	}
+	_, ok = p.Addr.(gatewayAddr)
+	return ok
+}
+
+// FromContext returns the original caller's address: the one the gateway
+// passed on for its calls, the peer address for everything else. Direct
+// gRPC and Connect callers can't claim another with x-forwarded-for.
+func FromContext(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	if _, ok := p.Addr.(gatewayAddr); ok {
+		// The gateway appends r.RemoteAddr last
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if fwd := md.Get("x-forwarded-for"); len(fwd) > 0 {
+				hops := strings.Split(fwd[len(fwd)-1], ",")
+				return strings.TrimSpace(hops[len(hops)-1])
+			}
+		}
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
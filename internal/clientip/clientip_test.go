@@ -0,0 +1,90 @@
+package clientip
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestResolve(t *testing.T) {
+	trusted := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("192.0.2.1/32")}
+	tests := []struct {
+		name      string
+		peer      string
+		forwarded []string
+		trusted   []netip.Prefix
+		want      string
+	}{
+		{"no proxies ignores header", "203.0.113.9", []string{"198.51.100.1"}, nil, "203.0.113.9"},
+		{"untrusted peer ignores header", "203.0.113.9", []string{"198.51.100.1"}, trusted, "203.0.113.9"},
+		{"trusted peer", "10.0.0.2", []string{"198.51.100.1"}, trusted, "198.51.100.1"},
+		{"spoofed first entry", "10.0.0.2", []string{"192.0.2.200, 198.51.100.1"}, trusted, "198.51.100.1"},
+		{"proxy chain", "10.0.0.2", []string{"198.51.100.1, 10.1.1.1, 192.0.2.1"}, trusted, "198.51.100.1"},
+		{"several headers", "10.0.0.2", []string{"192.0.2.200", "198.51.100.1"}, trusted, "198.51.100.1"},
+		{"all trusted", "10.0.0.2", []string{"10.0.0.3, 10.0.0.4"}, trusted, "10.0.0.3"},
+		{"no header", "10.0.0.2", nil, trusted, "10.0.0.2"},
+		{"mapped peer", "::ffff:10.0.0.2", []string{"198.51.100.1"}, trusted, "198.51.100.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resolve(tt.peer, tt.forwarded, tt.trusted); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	trusted := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+	var gotAddr, gotHeader string
+	h := Middleware(trusted, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAddr, gotHeader = r.RemoteAddr, r.Header.Get("X-Forwarded-For")
+	}))
+	r := httptest.NewRequest("GET", "/", nil)
+	r.RemoteAddr = "10.0.0.2:5000"
+	r.Header.Set("X-Forwarded-For", "192.0.2.200, 198.51.100.1")
+	h.ServeHTTP(httptest.NewRecorder(), r)
+	if gotAddr != "198.51.100.1:5000" || gotHeader != "" {
+		t.Errorf("got RemoteAddr %q and X-Forwarded-For %q", gotAddr, gotHeader)
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	withPeer := func(addr net.Addr, fwd ...string) context.Context {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+		if len(fwd) > 0 {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", fwd[0]))
+		}
+		return ctx
+	}
+	tcp := &net.TCPAddr{IP: net.ParseIP("203.0.113.9"), Port: 4000}
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{"direct call", withPeer(tcp), "203.0.113.9"},
+		{"direct call claiming an address", withPeer(tcp, "192.0.2.200"), "203.0.113.9"},
+		{"gateway", withPeer(gatewayAddr{}, "198.51.100.1"), "198.51.100.1"},
+		{"gateway appends last", withPeer(gatewayAddr{}, "192.0.2.200, 198.51.100.1"), "198.51.100.1"},
+		{"in-process HTTP handler", NewContext(context.Background(), "198.51.100.1:80"), "198.51.100.1"},
+		{"no peer", metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-forwarded-for", "192.0.2.200")), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromContext(tt.ctx); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+			if got, want := FromGateway(tt.ctx), strings.HasPrefix(tt.name, "gateway"); got != want {
+				t.Errorf("FromGateway: got %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,1129 @@
+// Package config loads server settings from an optional YAML file with
+// environment variable overrides.
+//
+// Precedence, lowest to highest: built-in defaults, the config file, env vars.
+package config
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"math"
+	"net/netip"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/mkseven15/whitelist-server/internal/database"
+	"github.com/mkseven15/whitelist-server/internal/ipban"
+	"github.com/mkseven15/whitelist-server/licensefile"
+)
+
+type Config struct {
+	DBURL     string    `yaml:"db_url"`
+	DBPool    DBPool    `yaml:"db_pool"`
+	DBRetry   DBRetry   `yaml:"db_retry"`
+	DBBreaker DBBreaker `yaml:"db_breaker"`
+	// How long after its last success ValidateLicense keeps accepting a
+	// license and HWID while the database is down; 0 returns errors instead
+	FailOpenWindow time.Duration `yaml:"fail_open_window"`
+	// Non-empty starts the server in maintenance mode, refusing changes
+	// with this message until it's unset again
+	MaintenanceMessage string     `yaml:"maintenance_message"`
+	HTTPPort           string     `yaml:"http_port"`
+	HTTPTLS            HTTPTLS    `yaml:"http_tls"`
+	HTTPLimits         HTTPLimits `yaml:"http_limits"`
+	// Compress gateway responses of at least this many bytes with brotli or
+	// gzip; 0 turns compression off
+	CompressMinSize int `yaml:"compress_min_size"`
+	// Internal gRPC port (not exposed to public internet directly on Render)
+	GRPCPort string `yaml:"grpc_port"`
+	// Path of a Unix socket to serve internal gRPC on instead of GRPCPort
+	GRPCSocket string   `yaml:"grpc_socket"`
+	GRPCAuth   GRPCAuth `yaml:"grpc_auth"`
+
+	// Shared owner-level secret for the x-admin-secret header (optional once
+	// admin accounts exist). Comma-separated to accept several while rotating;
+	// see AdminSecrets.
+	AdminSecret string `yaml:"admin_secret"`
+	// Signs the tokens AdminLogin issues; admin login is off while empty
+	AdminJWTSecret  string        `yaml:"admin_jwt_secret"`
+	AdminSessionTTL time.Duration `yaml:"admin_session_ttl"`
+	// Refuse deletes, bulk changes and exports to admins without a TOTP
+	// enrolled even before any admin has enrolled, when they'd otherwise get
+	// through
+	AdminOTPRequired bool `yaml:"admin_otp_required"`
+	// Addresses and CIDRs the admin RPCs may be called from, by the
+	// client address; empty allows any
+	AdminAllowedIPs []string `yaml:"admin_allowed_ips"`
+	// Addresses and CIDRs of the proxies in front of the HTTP port, whose
+	// X-Forwarded-For entries give the client address; empty ignores the
+	// header (see clientip)
+	TrustedProxies []string `yaml:"trusted_proxies"`
+	HashSalt       string   `yaml:"hash_salt"`
+	// The salt HashSalt replaced, while moving to it: credentials stored
+	// under it are still accepted and re-hashed on use. Nil when unset; ""
+	// is the empty salt older versions allowed.
+	HashSaltPrevious *string `yaml:"hash_salt_previous"`
+
+	TokenTTL        time.Duration `yaml:"token_ttl"`
+	CleanupInterval time.Duration `yaml:"cleanup_interval"`
+	// Each cleanup run waits up to this much (capped at CleanupInterval)
+	// longer than CleanupInterval, so replicas don't all clean up at once
+	CleanupJitter   time.Duration `yaml:"cleanup_jitter"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+
+	// How long expired trial licenses and validation events are kept before
+	// the cleanup job deletes them; 0 keeps them for good
+	TrialRetention           time.Duration `yaml:"trial_retention"`
+	ValidationEventRetention time.Duration `yaml:"validation_event_retention"`
+
+	// Serves Prometheus metrics at /metrics to requests bearing it; the
+	// endpoint is off while empty
+	MetricsToken string `yaml:"metrics_token"`
+
+	// Shape of GetAuthToken tokens: TokenLength characters from TokenCharset
+	TokenLength  int    `yaml:"token_length"`
+	TokenCharset string `yaml:"token_charset"`
+
+	// Lifetime of GetAuthToken refresh tokens; 0 issues none
+	RefreshTokenTTL time.Duration `yaml:"refresh_token_ttl"`
+
+	// A StartSession session ends this long after its last heartbeat
+	LicenseSessionTTL time.Duration `yaml:"license_session_ttl"`
+	// A CheckoutLicense lease frees its floating seat this long after it was
+	// last checked out
+	FloatingLeaseTTL time.Duration `yaml:"floating_lease_ttl"`
+
+	// How far a signed request's timestamp may be from the server's clock
+	SignatureMaxSkew time.Duration `yaml:"signature_max_skew"`
+
+	// How long a GetChallenge challenge can be used
+	ChallengeTTL time.Duration `yaml:"challenge_ttl"`
+
+	// A new HWID whose components match this many of a bound device's is
+	// taken to be that device; 0 only matches HWIDs exactly
+	HwidMatchThreshold int `yaml:"hwid_match_threshold"`
+	// How long after one of a license's devices changed its HWID another
+	// may; 0 allows any number
+	HwidRebindCooldown time.Duration `yaml:"hwid_rebind_cooldown"`
+
+	// CORS headers for browser clients, per route group
+	CORS CORS `yaml:"cors"`
+
+	MigrateOnStart bool `yaml:"migrate_on_start"`
+
+	// Encrypts the archives of the backup and restore commands
+	BackupPassphrase string `yaml:"backup_passphrase"`
+
+	// Serve the web dashboard at /admin (needs AdminJWTSecret)
+	Dashboard bool `yaml:"dashboard"`
+
+	// Accept gRPC-Web calls on the HTTP port alongside the JSON gateway
+	GRPCWeb bool `yaml:"grpc_web"`
+
+	// Accept Connect and gRPC calls on the HTTP port too
+	Connect bool `yaml:"connect"`
+
+	// Optional cache for ValidateLicense's license lookup: "", "memory" or
+	// "redis" (the default when redis_url is set)
+	LicenseCache    string        `yaml:"license_cache"`
+	RedisURL        string        `yaml:"redis_url"`
+	LicenseCacheTTL time.Duration `yaml:"license_cache_ttl"`
+	// Per-product TTL overrides; 0 disables caching for that product
+	LicenseCacheProductTTL map[string]time.Duration `yaml:"license_cache_product_ttl"`
+
+	// Where GetAuthToken's access tokens are kept: "postgres" (the default)
+	// or "redis", which needs redis_url
+	TokenStore string `yaml:"token_store"`
+
+	RateLimit RateLimit `yaml:"rate_limit"`
+	AccessLog AccessLog `yaml:"access_log"`
+
+	Discord Discord `yaml:"discord"`
+
+	Telegram Telegram `yaml:"telegram"`
+
+	Stripe Stripe `yaml:"stripe"`
+
+	LicenseFiles LicenseFiles `yaml:"license_files"`
+
+	Mail Mail `yaml:"mail"`
+
+	ExpiryReminders ExpiryReminders `yaml:"expiry_reminders"`
+
+	AutoBan AutoBan `yaml:"auto_ban"`
+
+	Lockout Lockout `yaml:"lockout"`
+
+	AuthBackoff AuthBackoff `yaml:"auth_backoff"`
+
+	// MaxMind GeoLite2/GeoIP2 Country or City .mmdb file; enables country
+	// restrictions and country logging
+	GeoIPDatabase string `yaml:"geoip_database"`
+
+	Webhooks []Webhook `yaml:"webhooks"`
+	// Consecutive failed validations of one license before a
+	// validation.failure_streak webhook fires
+	FailureStreakThreshold int `yaml:"failure_streak_threshold"`
+}
+
+// Discord configures the optional admin bot; it runs when BotToken is set.
+// AdminRoles are the role IDs allowed to use its commands.
+type Discord struct {
+	BotToken   string   `yaml:"bot_token"`
+	GuildID    string   `yaml:"guild_id"`
+	AdminRoles []string `yaml:"admin_roles"`
+}
+
+// Telegram configures security alerts; they're sent when BotToken is set.
+// Routes maps alert kinds (hwid.mismatch, validation.failure_streak,
+// admin.auth_failed, ip.auto_banned) to chat IDs; other kinds go to Chats.
+type Telegram struct {
+	BotToken string              `yaml:"bot_token"`
+	Chats    []string            `yaml:"chats"`
+	Routes   map[string][]string `yaml:"routes"`
+}
+
+// Stripe configures POST /webhooks/stripe, enabled when WebhookSecret is set.
+// Prices maps Stripe price IDs to the licenses their subscriptions get.
+type Stripe struct {
+	WebhookSecret string                 `yaml:"webhook_secret"`
+	Prices        map[string]StripePrice `yaml:"prices"`
+	// Added to the end of each paid period so renewals have time to land
+	GracePeriod time.Duration `yaml:"grace_period"`
+}
+
+type StripePrice struct {
+	ProductID  string `yaml:"product_id"`
+	MaxDevices int    `yaml:"max_devices"`
+}
+
+// Webhook is an endpoint that receives license events. Events lists the event
+// types to send; empty means all of them.
+type Webhook struct {
+	URL    string   `yaml:"url"`
+	Secret string   `yaml:"secret"`
+	Events []string `yaml:"events"`
+}
+
+// DBPool sizes the database/sql connection pool. Supabase caps connections
+// per project, so MaxOpenConns should stay under that limit across instances.
+type DBPool struct {
+	MaxOpenConns    int           `yaml:"max_open_conns"`
+	MaxIdleConns    int           `yaml:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
+	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time"`
+}
+
+// DBRetry reruns license, token and API key queries, and the busiest
+// transactions whole, that fail with a transient error (a serialization
+// failure, a dropped connection, a failover), waiting Base and then twice as long each time, up to Max.
+// Attempts counts the first try; 1 never retries.
+type DBRetry struct {
+	Attempts int           `yaml:"attempts"`
+	Base     time.Duration `yaml:"base"`
+	Max      time.Duration `yaml:"max"`
+}
+
+// DBBreaker makes license, token and API key queries fail fast for
+// Cooldown after Failures transient errors in a row, then lets one through
+// to see whether the database is back. Failures 0 turns it off.
+type DBBreaker struct {
+	Failures int           `yaml:"failures"`
+	Cooldown time.Duration `yaml:"cooldown"`
+}
+
+// CORS sets the CORS answer separately for the public routes (the client
+// RPCs: tokens, validation, sessions, trials, updates, resellers and admin
+// login) and the admin routes (everything else).
+type CORS struct {
+	Public CORSPolicy `yaml:"public"`
+	Admin  CORSPolicy `yaml:"admin"`
+}
+
+// CORSPolicy is what one route group allows. Requests from origins not in
+// Origins ("*" allows any) get no Access-Control-Allow-Origin header;
+// MaxAge 0 leaves preflight caching to the browser. Credentials lets
+// browsers send cookies and HTTP auth, and needs explicit origins.
+type CORSPolicy struct {
+	Origins     []string      `yaml:"origins"`
+	Methods     []string      `yaml:"methods"`
+	Headers     []string      `yaml:"headers"`
+	MaxAge      time.Duration `yaml:"max_age"`
+	Credentials bool          `yaml:"credentials"`
+}
+
+// HTTPLimits bounds what a single client can hold on the HTTP port: how
+// long it may take to send its headers and request and to read the response,
+// how long an idle keep-alive connection stays open, and how large headers
+// and bodies may be. Streaming calls (WatchLicense, exports) aren't held to
+// ReadTimeout and WriteTimeout. A zero timeout means none.
+type HTTPLimits struct {
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout"`
+	ReadTimeout       time.Duration `yaml:"read_timeout"`
+	WriteTimeout      time.Duration `yaml:"write_timeout"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout"`
+	MaxHeaderBytes    int           `yaml:"max_header_bytes"`
+	MaxBodyBytes      int           `yaml:"max_body_bytes"`
+}
+
+// HTTPTLS makes the gateway serve HTTPS itself, for deployments without a
+// TLS-terminating proxy in front. Use either a certificate pair or autocert
+// (Let's Encrypt) domains.
+type HTTPTLS struct {
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+
+	AutocertDomains  []string `yaml:"autocert_domains"`
+	AutocertEmail    string   `yaml:"autocert_email"`
+	AutocertCacheDir string   `yaml:"autocert_cache_dir"`
+	// Port answering ACME HTTP-01 challenges and redirecting to HTTPS;
+	// empty to rely on TLS-ALPN-01 only
+	AutocertHTTPPort string `yaml:"autocert_http_port"`
+}
+
+// Enabled reports whether the gateway should serve TLS.
+func (t HTTPTLS) Enabled() bool {
+	return t.CertFile != "" || len(t.AutocertDomains) > 0
+}
+
+// GRPCAuth secures the internal gRPC port against callers other than
+// trusted services. Token and mutual TLS can be used separately or together;
+// certificate fields take a file path or inline PEM.
+type GRPCAuth struct {
+	Token   string `yaml:"token"`
+	TLSCert string `yaml:"tls_cert"`
+	TLSKey  string `yaml:"tls_key"`
+	TLSCA   string `yaml:"tls_ca"`
+}
+
+// TLS reports whether mutual TLS is configured.
+func (a GRPCAuth) TLS() bool {
+	return a.TLSCert != ""
+}
+
+// LicenseFiles configures signed offline license files; ExportLicenseFile is
+// off, and validation answers unsigned, while SigningKey is empty.
+type LicenseFiles struct {
+	// Ed25519 private key: inline PEM, base64 seed, or a path to either
+	SigningKey string `yaml:"signing_key"`
+	// Public keys of earlier signing keys, inline or as paths, still listed
+	// by GetSigningKeys so clients keep accepting what they signed
+	PreviousPublicKeys []string `yaml:"previous_public_keys"`
+	// Default offline window of an exported file
+	ValidFor time.Duration `yaml:"valid_for"`
+	// Longest offline window an admin may ask for
+	MaxValidFor time.Duration `yaml:"max_valid_for"`
+}
+
+// Key parses SigningKey, returning nil when it's unset.
+func (l LicenseFiles) Key() (ed25519.PrivateKey, error) {
+	v := strings.TrimSpace(l.SigningKey)
+	if v == "" {
+		return nil, nil
+	}
+	if key, err := licensefile.ParsePrivateKey(v); err == nil {
+		return key, nil
+	}
+	b, err := os.ReadFile(v)
+	if err != nil {
+		return nil, fmt.Errorf("license_files: signing_key is neither a key nor a readable file: %w", err)
+	}
+	return licensefile.ParsePrivateKey(string(b))
+}
+
+// PreviousKeys parses PreviousPublicKeys.
+func (l LicenseFiles) PreviousKeys() ([]ed25519.PublicKey, error) {
+	var keys []ed25519.PublicKey
+	for i, v := range l.PreviousPublicKeys {
+		v = strings.TrimSpace(v)
+		key, err := licensefile.ParsePublicKey(v)
+		if err != nil {
+			b, rerr := os.ReadFile(v)
+			if rerr != nil {
+				return nil, fmt.Errorf("license_files: previous_public_keys[%d] is neither a key nor a readable file: %w", i, rerr)
+			}
+			if key, err = licensefile.ParsePublicKey(string(b)); err != nil {
+				return nil, fmt.Errorf("license_files: previous_public_keys[%d]: %w", i, err)
+			}
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// Mail configures emailing license keys to customers (IssueLicenseToEmail).
+// It's enabled once From and either SendGridAPIKey or SMTPHost are set;
+// SendGrid wins when both are.
+type Mail struct {
+	From           string `yaml:"from"`
+	SendGridAPIKey string `yaml:"sendgrid_api_key"`
+
+	SMTPHost     string `yaml:"smtp_host"`
+	SMTPPort     int    `yaml:"smtp_port"`
+	SMTPUsername string `yaml:"smtp_username"`
+	SMTPPassword string `yaml:"smtp_password"`
+
+	// text/template for the subject line, and a path to one for the body.
+	// Built-in templates are used when empty.
+	Subject      string `yaml:"subject"`
+	BodyTemplate string `yaml:"body_template"`
+}
+
+// Enabled reports whether a mail provider is configured.
+func (m Mail) Enabled() bool {
+	return m.From != "" && (m.SendGridAPIKey != "" || m.SMTPHost != "")
+}
+
+// Templates parses Subject and BodyTemplate, returning nil for either when
+// it's unset.
+func (m Mail) Templates() (subject, body *template.Template, err error) {
+	return parseMailTemplates("mail", m.Subject, m.BodyTemplate)
+}
+
+func parseMailTemplates(section, subjectText, bodyPath string) (subject, body *template.Template, err error) {
+	if subjectText != "" {
+		if subject, err = template.New("subject").Parse(subjectText); err != nil {
+			return nil, nil, fmt.Errorf("%s: subject: %w", section, err)
+		}
+	}
+	if bodyPath != "" {
+		b, err := os.ReadFile(bodyPath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: body_template: %w", section, err)
+		}
+		if body, err = template.New("body").Parse(string(b)); err != nil {
+			return nil, nil, fmt.Errorf("%s: body_template: %w", section, err)
+		}
+	}
+	return subject, body, nil
+}
+
+// ExpiryReminders warns customers before their licenses expire: by email to
+// the license's customer, by Discord DM from the bot to their Discord ID, and
+// with a license.expiring webhook. It's on while Days is set.
+type ExpiryReminders struct {
+	// Days before expiry to remind at, e.g. [7, 1]
+	Days []int `yaml:"days"`
+	// How often to look for licenses to remind about
+	Interval time.Duration `yaml:"interval"`
+
+	// As in Mail, for all products but those in Products
+	Subject      string                      `yaml:"subject"`
+	BodyTemplate string                      `yaml:"body_template"`
+	Products     map[string]ReminderTemplate `yaml:"products"`
+
+	// Get a daily list of the licenses expiring within the longest of Days,
+	// sent at DigestHour (UTC); Telegram's default chats get it as well
+	DigestEmails []string `yaml:"digest_emails"`
+	DigestHour   int      `yaml:"digest_hour"`
+}
+
+// ReminderTemplate overrides the reminder templates for one product.
+type ReminderTemplate struct {
+	Subject      string `yaml:"subject"`
+	BodyTemplate string `yaml:"body_template"`
+}
+
+// MailTemplates is a parsed subject and body; nil for either means the
+// built-in one.
+type MailTemplates struct {
+	Subject *template.Template
+	Body    *template.Template
+}
+
+// Enabled reports whether reminders are sent.
+func (r ExpiryReminders) Enabled() bool {
+	return len(r.Days) > 0
+}
+
+// Templates parses the reminder templates, keyed by product ID with "" for
+// the default.
+func (r ExpiryReminders) Templates() (map[string]MailTemplates, error) {
+	out := map[string]MailTemplates{}
+	subject, body, err := parseMailTemplates("expiry_reminders", r.Subject, r.BodyTemplate)
+	if err != nil {
+		return nil, err
+	}
+	out[""] = MailTemplates{Subject: subject, Body: body}
+	for productID, t := range r.Products {
+		subject, body, err := parseMailTemplates("expiry_reminders: products: "+productID, t.Subject, t.BodyTemplate)
+		if err != nil {
+			return nil, err
+		}
+		out[productID] = MailTemplates{Subject: subject, Body: body}
+	}
+	return out, nil
+}
+
+// checkTokenFormat makes sure access tokens can't be guessed: at least 128
+// random bits, and no longer than keys are generated.
+func (c *Config) checkTokenFormat() error {
+	distinct := map[rune]bool{}
+	for _, r := range c.TokenCharset {
+		distinct[r] = true
+	}
+	if len(distinct) != len([]rune(c.TokenCharset)) || len(distinct) < 2 {
+		return errors.New("token_charset needs at least 2 characters, none repeated")
+	}
+	if c.TokenLength > 128 {
+		return errors.New("token_length must be at most 128")
+	}
+	if bits := float64(c.TokenLength) * math.Log2(float64(len(distinct))); bits < 128 {
+		return fmt.Errorf("token_length %d of %d characters gives %.0f random bits, at least 128 needed", c.TokenLength, len(distinct), bits)
+	}
+	return nil
+}
+
+// AutoBan bans client IPs that fail Failures validations within Window, for
+// Duration (0 bans for good). Failures 0 disables it.
+type AutoBan struct {
+	Failures int           `yaml:"failures"`
+	Window   time.Duration `yaml:"window"`
+	Duration time.Duration `yaml:"duration"`
+}
+
+// Lockout refuses a license key or client IP for Duration after Failures
+// consecutive failed validations. Failures 0 disables it.
+type Lockout struct {
+	Failures int           `yaml:"failures"`
+	Duration time.Duration `yaml:"duration"`
+}
+
+// AuthBackoff blocks a client IP from GetAuthToken after every Failures
+// invalid API keys, for Base and then twice as long each time. Once a block
+// would exceed Max the IP is banned for BanDuration (0 bans for good)
+// instead. Failures 0 disables it.
+type AuthBackoff struct {
+	Failures    int           `yaml:"failures"`
+	Base        time.Duration `yaml:"base"`
+	Max         time.Duration `yaml:"max"`
+	BanDuration time.Duration `yaml:"ban_duration"`
+}
+
+// AccessLog logs finished RPCs: Sample is the fraction of successful calls
+// logged (0 none, 1 all), and Errors logs every failed one.
+type AccessLog struct {
+	Sample float64 `yaml:"sample"`
+	Errors bool    `yaml:"errors"`
+}
+
+// RateLimit configures the public endpoint limits. A rate of 0 disables that limit.
+type RateLimit struct {
+	IPRPS    float64 `yaml:"ip_rps"`
+	IPBurst  int     `yaml:"ip_burst"`
+	KeyRPS   float64 `yaml:"key_rps"`
+	KeyBurst int     `yaml:"key_burst"`
+}
+
+// Default returns the settings used when nothing else is configured.
+func Default() Config {
+	return Config{
+		DBPool: DBPool{
+			MaxOpenConns:    10,
+			MaxIdleConns:    5,
+			ConnMaxLifetime: 30 * time.Minute,
+			ConnMaxIdleTime: 5 * time.Minute,
+		},
+		DBRetry:   DBRetry{Attempts: 3, Base: 50 * time.Millisecond, Max: time.Second},
+		DBBreaker: DBBreaker{Failures: 5, Cooldown: 30 * time.Second},
+		HTTPPort:  "8080",
+		HTTPTLS:   HTTPTLS{AutocertCacheDir: "autocert-cache", AutocertHTTPPort: "80"},
+		HTTPLimits: HTTPLimits{
+			ReadHeaderTimeout: 10 * time.Second,
+			ReadTimeout:       30 * time.Second,
+			WriteTimeout:      time.Minute,
+			IdleTimeout:       2 * time.Minute,
+			MaxHeaderBytes:    64 << 10,
+			MaxBodyBytes:      4 << 20,
+		},
+		CompressMinSize: 1024,
+		GRPCPort:        "50051",
+		TokenTTL:        30 * time.Second,
+		TokenLength:     64,
+		TokenCharset:    "0123456789abcdef",
+		RefreshTokenTTL: 24 * time.Hour,
+		AdminSessionTTL: time.Hour,
+		CleanupInterval: time.Minute,
+		CleanupJitter:   10 * time.Second,
+		TrialRetention:  30 * 24 * time.Hour,
+		// The longest GetStats period
+		ValidationEventRetention: 366 * 24 * time.Hour,
+		ShutdownTimeout:          20 * time.Second,
+		CORS: CORS{
+			Public: CORSPolicy{
+				Origins: []string{"*"},
+				Methods: []string{"GET", "POST", "OPTIONS"},
+				Headers: []string{"Content-Type", "x-access-token", "x-reseller-key", "x-timestamp", "x-nonce", "x-signature", "traceparent", "x-grpc-web", "x-user-agent", "grpc-timeout", "connect-protocol-version", "connect-timeout-ms"},
+			},
+			// Same-origin only: the dashboard needs no CORS
+			Admin: CORSPolicy{
+				Methods: []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+				Headers: []string{"Content-Type", "x-admin-secret", "Authorization", "x-admin-actor", "x-admin-otp", "traceparent", "x-grpc-web", "x-user-agent", "grpc-timeout", "connect-protocol-version", "connect-timeout-ms"},
+			},
+		},
+		Dashboard:       true,
+		GRPCWeb:         true,
+		Connect:         true,
+		LicenseCacheTTL: 30 * time.Second,
+		RateLimit: RateLimit{
+			IPRPS:    5,
+			IPBurst:  20,
+			KeyRPS:   10,
+			KeyBurst: 50,
+		},
+		Stripe:                 Stripe{GracePeriod: 72 * time.Hour},
+		LicenseFiles:           LicenseFiles{ValidFor: 7 * 24 * time.Hour, MaxValidFor: 90 * 24 * time.Hour},
+		FailureStreakThreshold: 5,
+		LicenseSessionTTL:      2 * time.Minute,
+		FloatingLeaseTTL:       time.Hour,
+		SignatureMaxSkew:       5 * time.Minute,
+		ChallengeTTL:           time.Minute,
+		HwidMatchThreshold:     3,
+		HwidRebindCooldown:     7 * 24 * time.Hour,
+		Mail:                   Mail{SMTPPort: 587},
+		ExpiryReminders:        ExpiryReminders{Interval: 15 * time.Minute, DigestHour: 8},
+		AutoBan:                AutoBan{Window: 10 * time.Minute, Duration: 24 * time.Hour},
+		Lockout:                Lockout{Duration: 15 * time.Minute},
+		AuthBackoff:            AuthBackoff{Failures: 10, Base: time.Minute, Max: time.Hour, BanDuration: 24 * time.Hour},
+	}
+}
+
+// Load builds the config from defaults, the YAML file at path (skipped when
+// path is empty) and the environment, then validates it.
+func Load(path string) (*Config, error) {
+	cfg := Default()
+
+	if path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read config: %w", err)
+		}
+		if err := yaml.Unmarshal(b, &cfg); err != nil {
+			return nil, fmt.Errorf("parse %s: %w", path, err)
+		}
+	}
+
+	if err := cfg.applyEnv(); err != nil {
+		return nil, err
+	}
+	if cfg.LicenseCache == "" && cfg.RedisURL != "" {
+		cfg.LicenseCache = "redis"
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// applyEnv overrides fields from environment variables that are set.
+func (c *Config) applyEnv() error {
+	var errs []error
+	str := func(name string, dst *string) {
+		if v, ok := os.LookupEnv(name); ok {
+			*dst = v
+		}
+	}
+	dur := func(name string, dst *time.Duration) {
+		if v, ok := os.LookupEnv(name); ok {
+			d, err := time.ParseDuration(v)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", name, err))
+				return
+			}
+			*dst = d
+		}
+	}
+	// Plain integer seconds, kept for variables that predate this package
+	seconds := func(name string, dst *time.Duration) {
+		if v, ok := os.LookupEnv(name); ok {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", name, err))
+				return
+			}
+			*dst = time.Duration(n) * time.Second
+		}
+	}
+	float := func(name string, dst *float64) {
+		if v, ok := os.LookupEnv(name); ok {
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", name, err))
+				return
+			}
+			*dst = f
+		}
+	}
+	integer := func(name string, dst *int) {
+		if v, ok := os.LookupEnv(name); ok {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", name, err))
+				return
+			}
+			*dst = n
+		}
+	}
+	boolean := func(name string, dst *bool) {
+		if v, ok := os.LookupEnv(name); ok {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", name, err))
+				return
+			}
+			*dst = b
+		}
+	}
+	list := func(name string, dst *[]string) {
+		if v, ok := os.LookupEnv(name); ok {
+			*dst = splitList(v)
+		}
+	}
+
+	str("DB_URL", &c.DBURL)
+	integer("DB_MAX_OPEN_CONNS", &c.DBPool.MaxOpenConns)
+	integer("DB_MAX_IDLE_CONNS", &c.DBPool.MaxIdleConns)
+	dur("DB_CONN_MAX_LIFETIME", &c.DBPool.ConnMaxLifetime)
+	dur("DB_CONN_MAX_IDLE_TIME", &c.DBPool.ConnMaxIdleTime)
+	integer("DB_RETRY_ATTEMPTS", &c.DBRetry.Attempts)
+	dur("DB_RETRY_BASE", &c.DBRetry.Base)
+	dur("DB_RETRY_MAX", &c.DBRetry.Max)
+	integer("DB_BREAKER_FAILURES", &c.DBBreaker.Failures)
+	dur("DB_BREAKER_COOLDOWN", &c.DBBreaker.Cooldown)
+	dur("FAIL_OPEN_WINDOW", &c.FailOpenWindow)
+	str("MAINTENANCE_MESSAGE", &c.MaintenanceMessage)
+	str("PORT", &c.HTTPPort) // Render provides PORT
+	dur("HTTP_READ_HEADER_TIMEOUT", &c.HTTPLimits.ReadHeaderTimeout)
+	dur("HTTP_READ_TIMEOUT", &c.HTTPLimits.ReadTimeout)
+	dur("HTTP_WRITE_TIMEOUT", &c.HTTPLimits.WriteTimeout)
+	dur("HTTP_IDLE_TIMEOUT", &c.HTTPLimits.IdleTimeout)
+	integer("HTTP_MAX_HEADER_BYTES", &c.HTTPLimits.MaxHeaderBytes)
+	integer("HTTP_MAX_BODY_BYTES", &c.HTTPLimits.MaxBodyBytes)
+	integer("COMPRESS_MIN_SIZE", &c.CompressMinSize)
+	str("HTTP_TLS_CERT", &c.HTTPTLS.CertFile)
+	str("HTTP_TLS_KEY", &c.HTTPTLS.KeyFile)
+	list("AUTOCERT_DOMAINS", &c.HTTPTLS.AutocertDomains)
+	str("AUTOCERT_EMAIL", &c.HTTPTLS.AutocertEmail)
+	str("AUTOCERT_CACHE_DIR", &c.HTTPTLS.AutocertCacheDir)
+	str("AUTOCERT_HTTP_PORT", &c.HTTPTLS.AutocertHTTPPort)
+	str("GRPC_PORT", &c.GRPCPort)
+	str("GRPC_SOCKET", &c.GRPCSocket)
+	str("GRPC_INTERNAL_TOKEN", &c.GRPCAuth.Token)
+	str("GRPC_TLS_CERT", &c.GRPCAuth.TLSCert)
+	str("GRPC_TLS_KEY", &c.GRPCAuth.TLSKey)
+	str("GRPC_TLS_CA", &c.GRPCAuth.TLSCA)
+	str("ADMIN_SECRET", &c.AdminSecret)
+	str("ADMIN_JWT_SECRET", &c.AdminJWTSecret)
+	dur("ADMIN_SESSION_TTL", &c.AdminSessionTTL)
+	boolean("ADMIN_OTP_REQUIRED", &c.AdminOTPRequired)
+	list("ADMIN_ALLOWED_IPS", &c.AdminAllowedIPs)
+	list("TRUSTED_PROXIES", &c.TrustedProxies)
+	str("HASH_SALT", &c.HashSalt)
+	if v, ok := os.LookupEnv("HASH_SALT_PREVIOUS"); ok {
+		c.HashSaltPrevious = &v
+	}
+	str("LICENSE_SIGNING_KEY", &c.LicenseFiles.SigningKey)
+	list("LICENSE_PREVIOUS_PUBLIC_KEYS", &c.LicenseFiles.PreviousPublicKeys)
+	dur("LICENSE_FILE_VALID_FOR", &c.LicenseFiles.ValidFor)
+	str("MAIL_FROM", &c.Mail.From)
+	str("SENDGRID_API_KEY", &c.Mail.SendGridAPIKey)
+	str("SMTP_HOST", &c.Mail.SMTPHost)
+	integer("SMTP_PORT", &c.Mail.SMTPPort)
+	str("SMTP_USERNAME", &c.Mail.SMTPUsername)
+	str("SMTP_PASSWORD", &c.Mail.SMTPPassword)
+	str("MAIL_SUBJECT", &c.Mail.Subject)
+	str("MAIL_BODY_TEMPLATE", &c.Mail.BodyTemplate)
+	dur("TOKEN_TTL", &c.TokenTTL)
+	integer("TOKEN_LENGTH", &c.TokenLength)
+	str("TOKEN_CHARSET", &c.TokenCharset)
+	dur("REFRESH_TOKEN_TTL", &c.RefreshTokenTTL)
+	dur("LICENSE_SESSION_TTL", &c.LicenseSessionTTL)
+	dur("FLOATING_LEASE_TTL", &c.FloatingLeaseTTL)
+	dur("SIGNATURE_MAX_SKEW", &c.SignatureMaxSkew)
+	dur("CHALLENGE_TTL", &c.ChallengeTTL)
+	integer("HWID_MATCH_THRESHOLD", &c.HwidMatchThreshold)
+	dur("HWID_REBIND_COOLDOWN", &c.HwidRebindCooldown)
+	dur("CLEANUP_INTERVAL", &c.CleanupInterval)
+	dur("CLEANUP_JITTER", &c.CleanupJitter)
+	dur("TRIAL_RETENTION", &c.TrialRetention)
+	dur("VALIDATION_EVENT_RETENTION", &c.ValidationEventRetention)
+	str("METRICS_TOKEN", &c.MetricsToken)
+	seconds("SHUTDOWN_TIMEOUT_SECONDS", &c.ShutdownTimeout)
+	list("CORS_ORIGINS", &c.CORS.Public.Origins)
+	list("CORS_METHODS", &c.CORS.Public.Methods)
+	list("CORS_HEADERS", &c.CORS.Public.Headers)
+	dur("CORS_MAX_AGE", &c.CORS.Public.MaxAge)
+	boolean("CORS_CREDENTIALS", &c.CORS.Public.Credentials)
+	list("CORS_ADMIN_ORIGINS", &c.CORS.Admin.Origins)
+	list("CORS_ADMIN_METHODS", &c.CORS.Admin.Methods)
+	list("CORS_ADMIN_HEADERS", &c.CORS.Admin.Headers)
+	dur("CORS_ADMIN_MAX_AGE", &c.CORS.Admin.MaxAge)
+	boolean("CORS_ADMIN_CREDENTIALS", &c.CORS.Admin.Credentials)
+	boolean("MIGRATE_ON_START", &c.MigrateOnStart)
+	str("BACKUP_PASSPHRASE", &c.BackupPassphrase)
+	boolean("DASHBOARD", &c.Dashboard)
+	boolean("GRPC_WEB", &c.GRPCWeb)
+	boolean("CONNECT", &c.Connect)
+	str("LICENSE_CACHE", &c.LicenseCache)
+	str("TOKEN_STORE", &c.TokenStore)
+	str("REDIS_URL", &c.RedisURL)
+	dur("LICENSE_CACHE_TTL", &c.LicenseCacheTTL)
+	float("RATE_LIMIT_IP_RPS", &c.RateLimit.IPRPS)
+	integer("RATE_LIMIT_IP_BURST", &c.RateLimit.IPBurst)
+	float("RATE_LIMIT_KEY_RPS", &c.RateLimit.KeyRPS)
+	integer("RATE_LIMIT_KEY_BURST", &c.RateLimit.KeyBurst)
+	float("ACCESS_LOG_SAMPLE", &c.AccessLog.Sample)
+	boolean("ACCESS_LOG_ERRORS", &c.AccessLog.Errors)
+	str("DISCORD_BOT_TOKEN", &c.Discord.BotToken)
+	str("DISCORD_GUILD_ID", &c.Discord.GuildID)
+	list("DISCORD_ADMIN_ROLES", &c.Discord.AdminRoles)
+	str("TELEGRAM_BOT_TOKEN", &c.Telegram.BotToken)
+	list("TELEGRAM_CHAT_IDS", &c.Telegram.Chats)
+	str("STRIPE_WEBHOOK_SECRET", &c.Stripe.WebhookSecret)
+	dur("STRIPE_GRACE_PERIOD", &c.Stripe.GracePeriod)
+	// price_id=product_id pairs, comma-separated
+	if v, ok := os.LookupEnv("STRIPE_PRICES"); ok {
+		c.Stripe.Prices = map[string]StripePrice{}
+		for _, pair := range splitList(v) {
+			price, product, found := strings.Cut(pair, "=")
+			if !found {
+				errs = append(errs, fmt.Errorf("STRIPE_PRICES: expected price_id=product_id, got %q", pair))
+				continue
+			}
+			c.Stripe.Prices[strings.TrimSpace(price)] = StripePrice{ProductID: strings.TrimSpace(product)}
+		}
+	}
+	if v, ok := os.LookupEnv("EXPIRY_REMINDER_DAYS"); ok {
+		c.ExpiryReminders.Days = nil
+		for _, d := range splitList(v) {
+			n, err := strconv.Atoi(d)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("EXPIRY_REMINDER_DAYS: %w", err))
+				continue
+			}
+			c.ExpiryReminders.Days = append(c.ExpiryReminders.Days, n)
+		}
+	}
+	dur("EXPIRY_REMINDER_INTERVAL", &c.ExpiryReminders.Interval)
+	list("EXPIRY_DIGEST_EMAILS", &c.ExpiryReminders.DigestEmails)
+	integer("EXPIRY_DIGEST_HOUR", &c.ExpiryReminders.DigestHour)
+	integer("WEBHOOK_FAILURE_STREAK", &c.FailureStreakThreshold)
+	integer("AUTO_BAN_FAILURES", &c.AutoBan.Failures)
+	dur("AUTO_BAN_WINDOW", &c.AutoBan.Window)
+	dur("AUTO_BAN_DURATION", &c.AutoBan.Duration)
+	integer("LOCKOUT_FAILURES", &c.Lockout.Failures)
+	dur("LOCKOUT_DURATION", &c.Lockout.Duration)
+	integer("AUTH_BACKOFF_FAILURES", &c.AuthBackoff.Failures)
+	dur("AUTH_BACKOFF_BASE", &c.AuthBackoff.Base)
+	dur("AUTH_BACKOFF_MAX", &c.AuthBackoff.Max)
+	dur("AUTH_BACKOFF_BAN_DURATION", &c.AuthBackoff.BanDuration)
+	str("GEOIP_DATABASE", &c.GeoIPDatabase)
+
+	// A single endpoint can be set from the environment, on top of any in the file
+	if v, ok := os.LookupEnv("WEBHOOK_URL"); ok && v != "" {
+		c.Webhooks = append(c.Webhooks, Webhook{
+			URL:    v,
+			Secret: os.Getenv("WEBHOOK_SECRET"),
+			Events: splitList(os.Getenv("WEBHOOK_EVENTS")),
+		})
+	}
+
+	return errors.Join(errs...)
+}
+
+// Validate reports every invalid setting at once.
+func (c *Config) Validate() error {
+	var errs []error
+	if c.DBURL == "" {
+		errs = append(errs, errors.New("db_url (DB_URL) is required"))
+	} else if _, _, err := database.Parse(c.DBURL); err != nil {
+		errs = append(errs, fmt.Errorf("db_url: %w", err))
+	}
+	if c.DBPool.MaxOpenConns < 0 || c.DBPool.MaxIdleConns < 0 {
+		errs = append(errs, errors.New("db_pool: connection counts must not be negative"))
+	}
+	if c.DBPool.MaxOpenConns > 0 && c.DBPool.MaxIdleConns > c.DBPool.MaxOpenConns {
+		errs = append(errs, errors.New("db_pool: max_idle_conns must not exceed max_open_conns"))
+	}
+	if c.DBPool.ConnMaxLifetime < 0 || c.DBPool.ConnMaxIdleTime < 0 {
+		errs = append(errs, errors.New("db_pool: durations must not be negative"))
+	}
+	if c.DBRetry.Attempts < 1 {
+		errs = append(errs, errors.New("db_retry: attempts must be at least 1"))
+	} else if c.DBRetry.Attempts > 1 && (c.DBRetry.Base <= 0 || c.DBRetry.Max < c.DBRetry.Base) {
+		errs = append(errs, errors.New("db_retry: base must be positive and max at least base"))
+	}
+	if c.DBBreaker.Failures < 0 {
+		errs = append(errs, errors.New("db_breaker: failures must not be negative"))
+	} else if c.DBBreaker.Failures > 0 && c.DBBreaker.Cooldown <= 0 {
+		errs = append(errs, errors.New("db_breaker: cooldown must be positive"))
+	}
+	if c.FailOpenWindow < 0 {
+		errs = append(errs, errors.New("fail_open_window must not be negative"))
+	} else if c.FailOpenWindow > 0 && c.DBBreaker.Failures == 0 {
+		errs = append(errs, errors.New("fail_open_window needs db_breaker.failures set"))
+	}
+	for name, port := range map[string]string{"http_port": c.HTTPPort, "grpc_port": c.GRPCPort} {
+		if n, err := strconv.Atoi(port); err != nil || n <= 0 || n > 65535 {
+			errs = append(errs, fmt.Errorf("%s: invalid port %q", name, port))
+		}
+	}
+	for name, p := range map[string]CORSPolicy{"cors.public": c.CORS.Public, "cors.admin": c.CORS.Admin} {
+		if p.MaxAge < 0 {
+			errs = append(errs, fmt.Errorf("%s: max_age must not be negative", name))
+		}
+		if p.Credentials && slices.Contains(p.Origins, "*") {
+			errs = append(errs, fmt.Errorf("%s: credentials need explicit origins, not \"*\"", name))
+		}
+	}
+	if l := c.HTTPLimits; l.ReadHeaderTimeout < 0 || l.ReadTimeout < 0 || l.WriteTimeout < 0 || l.IdleTimeout < 0 {
+		errs = append(errs, errors.New("http_limits: timeouts must not be negative"))
+	}
+	if l := c.HTTPLimits; l.MaxHeaderBytes < 4<<10 || l.MaxBodyBytes < 64<<10 {
+		errs = append(errs, errors.New("http_limits: max_header_bytes must be at least 4KiB and max_body_bytes at least 64KiB"))
+	}
+	if c.AccessLog.Sample < 0 || c.AccessLog.Sample > 1 {
+		errs = append(errs, errors.New("access_log: sample must be between 0 and 1"))
+	}
+	if c.CompressMinSize < 0 {
+		errs = append(errs, errors.New("compress_min_size must not be negative"))
+	}
+	if t := c.HTTPTLS; (t.CertFile == "") != (t.KeyFile == "") {
+		errs = append(errs, errors.New("http_tls: cert_file and key_file must be set together"))
+	} else if t.CertFile != "" && len(t.AutocertDomains) > 0 {
+		errs = append(errs, errors.New("http_tls: use either a certificate or autocert_domains, not both"))
+	}
+	if a := c.GRPCAuth; (a.TLSCert != "" || a.TLSKey != "" || a.TLSCA != "") && (a.TLSCert == "" || a.TLSKey == "" || a.TLSCA == "") {
+		errs = append(errs, errors.New("grpc_auth: tls_cert, tls_key and tls_ca must be set together"))
+	}
+	if c.GRPCAuth.Token != "" && len(c.GRPCAuth.Token) < 16 {
+		errs = append(errs, errors.New("grpc_auth: token must be at least 16 characters"))
+	}
+	if c.TokenTTL < time.Second {
+		errs = append(errs, errors.New("token_ttl must be at least 1s"))
+	}
+	if err := c.checkTokenFormat(); err != nil {
+		errs = append(errs, err)
+	}
+	if c.RefreshTokenTTL < 0 {
+		errs = append(errs, errors.New("refresh_token_ttl must not be negative"))
+	}
+	if c.LicenseSessionTTL < 10*time.Second {
+		errs = append(errs, errors.New("license_session_ttl must be at least 10s"))
+	}
+	if c.FloatingLeaseTTL < time.Minute {
+		errs = append(errs, errors.New("floating_lease_ttl must be at least 1m"))
+	}
+	if c.SignatureMaxSkew < time.Second {
+		errs = append(errs, errors.New("signature_max_skew must be at least 1s"))
+	}
+	if c.ChallengeTTL < time.Second {
+		errs = append(errs, errors.New("challenge_ttl must be at least 1s"))
+	}
+	if c.HwidMatchThreshold < 0 || c.HwidMatchThreshold > 4 {
+		errs = append(errs, errors.New("hwid_match_threshold must be between 0 and 4"))
+	}
+	if c.HwidRebindCooldown < 0 {
+		errs = append(errs, errors.New("hwid_rebind_cooldown must not be negative"))
+	}
+	// Every stored credential hash is keyed with it
+	if len(c.HashSalt) < 16 {
+		errs = append(errs, errors.New("hash_salt (HASH_SALT) is required, at least 16 characters; to keep credentials stored under an older salt, set that as hash_salt_previous (HASH_SALT_PREVIOUS)"))
+	} else if c.HashSaltPrevious != nil && *c.HashSaltPrevious == c.HashSalt {
+		errs = append(errs, errors.New("hash_salt_previous must differ from hash_salt"))
+	}
+	if c.AdminJWTSecret != "" && len(c.AdminJWTSecret) < 32 {
+		errs = append(errs, errors.New("admin_jwt_secret must be at least 32 characters"))
+	}
+	if c.AdminSessionTTL <= 0 {
+		errs = append(errs, errors.New("admin_session_ttl must be positive"))
+	}
+	if c.CleanupInterval <= 0 {
+		errs = append(errs, errors.New("cleanup_interval must be positive"))
+	}
+	if c.CleanupJitter < 0 {
+		errs = append(errs, errors.New("cleanup_jitter must not be negative"))
+	}
+	if c.TrialRetention < 0 || c.ValidationEventRetention < 0 {
+		errs = append(errs, errors.New("trial_retention and validation_event_retention must not be negative"))
+	}
+	if c.MetricsToken != "" && len(c.MetricsToken) < 16 {
+		errs = append(errs, errors.New("metrics_token must be at least 16 characters"))
+	}
+	if _, err := c.LicenseFiles.Key(); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := c.LicenseFiles.PreviousKeys(); err != nil {
+		errs = append(errs, err)
+	}
+	if c.LicenseFiles.ValidFor <= 0 || c.LicenseFiles.MaxValidFor < c.LicenseFiles.ValidFor {
+		errs = append(errs, errors.New("license_files: valid_for must be positive and at most max_valid_for"))
+	}
+	if _, _, err := c.Mail.Templates(); err != nil {
+		errs = append(errs, err)
+	}
+	if r := c.ExpiryReminders; r.Enabled() {
+		for _, d := range r.Days {
+			if d < 1 || d > 365 {
+				errs = append(errs, fmt.Errorf("expiry_reminders: days must be between 1 and 365, got %d", d))
+			}
+		}
+		if r.Interval <= 0 || r.Interval > time.Hour {
+			errs = append(errs, errors.New("expiry_reminders: interval must be positive and at most 1h"))
+		}
+		if r.DigestHour < 0 || r.DigestHour > 23 {
+			errs = append(errs, errors.New("expiry_reminders: digest_hour must be between 0 and 23"))
+		}
+		if len(r.DigestEmails) > 0 && !c.Mail.Enabled() {
+			errs = append(errs, errors.New("expiry_reminders: digest_emails needs mail to be configured"))
+		}
+		if _, err := r.Templates(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if c.Mail.SMTPHost != "" && (c.Mail.SMTPPort <= 0 || c.Mail.SMTPPort > 65535) {
+		errs = append(errs, fmt.Errorf("mail: invalid smtp_port %d", c.Mail.SMTPPort))
+	}
+	if (c.Mail.SendGridAPIKey != "" || c.Mail.SMTPHost != "") && !strings.Contains(c.Mail.From, "@") {
+		errs = append(errs, errors.New("mail: from must be an email address"))
+	}
+	if c.AutoBan.Failures < 0 || c.AutoBan.Duration < 0 {
+		errs = append(errs, errors.New("auto_ban: failures and duration must not be negative"))
+	}
+	if c.AutoBan.Failures > 0 && c.AutoBan.Window <= 0 {
+		errs = append(errs, errors.New("auto_ban: window must be positive"))
+	}
+	if c.Lockout.Failures < 0 {
+		errs = append(errs, errors.New("lockout: failures must not be negative"))
+	}
+	if c.Lockout.Failures > 0 && c.Lockout.Duration <= 0 {
+		errs = append(errs, errors.New("lockout: duration must be positive"))
+	}
+	if c.AuthBackoff.Failures < 0 || c.AuthBackoff.BanDuration < 0 {
+		errs = append(errs, errors.New("auth_backoff: failures and ban_duration must not be negative"))
+	}
+	if c.AuthBackoff.Failures > 0 && (c.AuthBackoff.Base <= 0 || c.AuthBackoff.Max < c.AuthBackoff.Base) {
+		errs = append(errs, errors.New("auth_backoff: base must be positive and max at least base"))
+	}
+	switch c.LicenseCache {
+	case "", "none", "memory":
+	case "redis":
+		if c.RedisURL == "" {
+			errs = append(errs, errors.New("license_cache redis needs redis_url (REDIS_URL)"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("license_cache: unknown backend %q", c.LicenseCache))
+	}
+	switch c.TokenStore {
+	case "", "postgres":
+	case "redis":
+		if c.RedisURL == "" {
+			errs = append(errs, errors.New("token_store redis needs redis_url (REDIS_URL)"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("token_store: unknown backend %q", c.TokenStore))
+	}
+	if c.LicenseCacheTTL <= 0 {
+		errs = append(errs, errors.New("license_cache_ttl must be positive"))
+	}
+	for product, ttl := range c.LicenseCacheProductTTL {
+		if ttl < 0 {
+			errs = append(errs, fmt.Errorf("license_cache_product_ttl[%s] must not be negative", product))
+		}
+	}
+	if c.ShutdownTimeout < 0 {
+		errs = append(errs, errors.New("shutdown_timeout must not be negative"))
+	}
+	if c.RateLimit.IPRPS < 0 || c.RateLimit.KeyRPS < 0 {
+		errs = append(errs, errors.New("rate_limit: rps must not be negative"))
+	}
+	if c.Discord.BotToken != "" {
+		if len(c.Discord.AdminRoles) == 0 {
+			errs = append(errs, errors.New("discord: admin_roles is required when the bot is enabled"))
+		}
+		if len(c.AdminSecrets()) == 0 {
+			errs = append(errs, errors.New("discord: the bot needs admin_secret to call admin endpoints"))
+		}
+	}
+	if c.Telegram.BotToken != "" && len(c.Telegram.Chats) == 0 && len(c.Telegram.Routes) == 0 {
+		errs = append(errs, errors.New("telegram: chats or routes is required when bot_token is set"))
+	}
+	if c.Stripe.WebhookSecret != "" && len(c.Stripe.Prices) == 0 {
+		errs = append(errs, errors.New("stripe: prices is required when webhook_secret is set"))
+	}
+	for price, p := range c.Stripe.Prices {
+		if p.ProductID == "" {
+			errs = append(errs, fmt.Errorf("stripe.prices[%s]: product_id is required", price))
+		}
+	}
+	if c.Stripe.GracePeriod < 0 {
+		errs = append(errs, errors.New("stripe.grace_period must not be negative"))
+	}
+	for i, w := range c.Webhooks {
+		if u, err := url.Parse(w.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, fmt.Errorf("webhooks[%d]: invalid url %q", i, w.URL))
+		}
+		if w.Secret == "" {
+			errs = append(errs, fmt.Errorf("webhooks[%d]: secret is required", i))
+		}
+	}
+	if c.FailureStreakThreshold < 0 {
+		errs = append(errs, errors.New("failure_streak_threshold must not be negative"))
+	}
+	if _, err := c.AdminAllowedNetworks(); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := c.TrustedProxyNetworks(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// AdminAllowedNetworks parses AdminAllowedIPs.
+func (c *Config) AdminAllowedNetworks() ([]netip.Prefix, error) {
+	var out []netip.Prefix
+	for _, s := range c.AdminAllowedIPs {
+		p, err := ipban.ParsePrefix(s)
+		if err != nil {
+			return nil, fmt.Errorf("admin_allowed_ips: %w", err)
+		}
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+// TrustedProxyNetworks parses TrustedProxies.
+func (c *Config) TrustedProxyNetworks() ([]netip.Prefix, error) {
+	var out []netip.Prefix
+	for _, s := range c.TrustedProxies {
+		p, err := ipban.ParsePrefix(s)
+		if err != nil {
+			return nil, fmt.Errorf("trusted_proxies: %w", err)
+		}
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+// AdminSecrets returns the secrets listed in AdminSecret, in order.
+func (c *Config) AdminSecrets() []string {
+	return splitList(c.AdminSecret)
+}
+
+func splitList(v string) []string {
+	var out []string
+	for _, item := range strings.Split(v, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
@@ -0,0 +1,249 @@
+// Package connecthandler serves the whitelist service with connect-go, which
+// answers Connect, gRPC and gRPC-Web calls, so clients can use the public
+// HTTP port for all three instead of the gateway's JSON API.
+package connecthandler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/mkseven15/whitelist-server/internal/clientip"
+	"github.com/mkseven15/whitelist-server/internal/service"
+	"github.com/mkseven15/whitelist-server/internal/signing"
+	pb "github.com/mkseven15/whitelist-server/proto"
+	"github.com/mkseven15/whitelist-server/proto/protoconnect"
+	"github.com/mkseven15/whitelist-server/proto/v2/whitelistv2connect"
+)
+
+// New returns the path to mount the handler on and the handler. Calls go
+// through the given gRPC interceptors and see their request headers as
+// incoming gRPC metadata, just as they would on the gRPC port.
+func New(svc *service.WhitelistService, unary []grpc.UnaryServerInterceptor, stream []grpc.StreamServerInterceptor) (string, http.Handler) {
+	path, h := protoconnect.NewWhitelistServiceHandler(&handler{svc},
+		connect.WithInterceptors(&interceptor{unary: unary, stream: stream}),
+	)
+	return path, bodyDigest(h)
+}
+
+// NewV2 is New for the whitelist.v2 API.
+func NewV2(svc *service.WhitelistServiceV2, unary []grpc.UnaryServerInterceptor, stream []grpc.StreamServerInterceptor) (string, http.Handler) {
+	path, h := whitelistv2connect.NewWhitelistServiceHandler(svc,
+		connect.WithInterceptors(&interceptor{unary: unary, stream: stream}),
+	)
+	return path, bodyDigest(h)
+}
+
+// handler adapts the service's streaming methods; its unary methods already
+// have the signatures connect-go expects.
+type handler struct {
+	*service.WhitelistService
+}
+
+func (h *handler) ExportLicenses(ctx context.Context, req *pb.ExportLicensesRequest, stream *connect.ServerStream[httpbody.HttpBody]) error {
+	return h.WhitelistService.ExportLicenses(req, newServerStream(ctx, stream))
+}
+
+func (h *handler) WatchLicense(ctx context.Context, req *pb.WatchLicenseRequest, stream *connect.ServerStream[pb.LicenseStatusEvent]) error {
+	return h.WhitelistService.WatchLicense(req, newServerStream(ctx, stream))
+}
+
+func (h *handler) ExportAuditLog(ctx context.Context, req *pb.ExportAuditLogRequest, stream *connect.ServerStream[httpbody.HttpBody]) error {
+	return h.WhitelistService.ExportAuditLog(req, newServerStream(ctx, stream))
+}
+
+// interceptor runs the gRPC interceptors around each call and turns the
+// service's gRPC status errors into Connect errors with the same code.
+type interceptor struct {
+	unary  []grpc.UnaryServerInterceptor
+	stream []grpc.StreamServerInterceptor
+}
+
+func (i *interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ts := &transportStream{method: req.Spec().Procedure, header: http.Header{}, trailer: http.Header{}}
+		ctx = incomingContext(ctx, ts, req.Header(), req.Peer())
+
+		var resp connect.AnyResponse
+		call := func(ctx context.Context, _ interface{}) (interface{}, error) {
+			var err error
+			resp, err = next(ctx, req)
+			return resp, err
+		}
+		info := &grpc.UnaryServerInfo{FullMethod: ts.method}
+		for n := len(i.unary) - 1; n >= 0; n-- {
+			interceptor, handler := i.unary[n], call
+			call = func(ctx context.Context, msg interface{}) (interface{}, error) {
+				return interceptor(ctx, msg, info, handler)
+			}
+		}
+		if _, err := call(ctx, req.Any()); err != nil {
+			return nil, connectError(err)
+		}
+		copyHeader(resp.Header(), ts.header)
+		copyHeader(resp.Trailer(), ts.trailer)
+		return resp, nil
+	}
+}
+
+func (i *interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ts := &transportStream{method: conn.Spec().Procedure, header: conn.ResponseHeader(), trailer: conn.ResponseTrailer()}
+		ctx = incomingContext(ctx, ts, conn.RequestHeader(), conn.Peer())
+
+		call := func(_ interface{}, ss grpc.ServerStream) error {
+			return next(ss.Context(), &handlerConn{StreamingHandlerConn: conn, ss: ss})
+		}
+		info := &grpc.StreamServerInfo{FullMethod: ts.method, IsServerStream: true}
+		for n := len(i.stream) - 1; n >= 0; n-- {
+			interceptor, handler := i.stream[n], call
+			call = func(srv interface{}, ss grpc.ServerStream) error {
+				return interceptor(srv, ss, info, handler)
+			}
+		}
+		return connectError(call(nil, &serverStream{ctx: ctx, conn: conn}))
+	}
+}
+
+// incomingContext gives ctx what a gRPC server would: the request headers as
+// incoming metadata, the caller's address as the peer and the method name.
+func incomingContext(ctx context.Context, ts *transportStream, header http.Header, peer connect.Peer) context.Context {
+	md := metadata.MD{}
+	for key, values := range header {
+		md.Append(strings.ToLower(key), values...)
+	}
+	ctx = clientip.NewContext(metadata.NewIncomingContext(ctx, md), peer.Addr)
+	return grpc.NewContextWithServerTransportStream(ctx, ts)
+}
+
+// connectError converts a gRPC status error; connect-go would otherwise
+// report it as Unknown.
+func connectError(err error) error {
+	var connectErr *connect.Error
+	if err == nil || errors.As(err, &connectErr) {
+		return err
+	}
+	if st, ok := status.FromError(err); ok {
+		connectErr = connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+		// ErrorInfo and BadRequest, as the other protocols send them
+		for _, d := range st.Proto().GetDetails() {
+			if detail, err := connect.NewErrorDetail(d); err == nil {
+				connectErr.AddDetail(detail)
+			}
+		}
+		return connectErr
+	}
+	return err
+}
+
+// bodyDigest hands signing.Middleware's body digest to the service in the
+// request context, for unary Connect calls only, whose body is the request
+// message as the client signed it. Other protocols frame the message, so the
+// service signs the message itself as it does for calls to the gRPC port.
+func bodyDigest(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		digest := r.Header.Get(signing.BodyDigestHeader)
+		r.Header.Del(signing.BodyDigestHeader)
+		contentType, _, _ := strings.Cut(r.Header.Get("Content-Type"), ";")
+		switch strings.TrimSpace(contentType) {
+		case "application/json", "application/proto":
+			if digest != "" {
+				r = r.WithContext(signing.NewContext(r.Context(), digest))
+			}
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// transportStream lets grpc.Method and grpc.SetHeader work inside handlers.
+type transportStream struct {
+	method  string
+	header  http.Header
+	trailer http.Header
+}
+
+func (t *transportStream) Method() string { return t.method }
+
+func (t *transportStream) SetHeader(md metadata.MD) error {
+	addMetadata(t.header, md)
+	return nil
+}
+
+func (t *transportStream) SendHeader(md metadata.MD) error {
+	return t.SetHeader(md)
+}
+
+func (t *transportStream) SetTrailer(md metadata.MD) error {
+	addMetadata(t.trailer, md)
+	return nil
+}
+
+// serverStream presents a Connect stream as a gRPC one.
+type serverStream struct {
+	ctx  context.Context
+	conn connect.StreamingHandlerConn
+}
+
+func (s *serverStream) Context() context.Context { return s.ctx }
+
+func (s *serverStream) SetHeader(md metadata.MD) error {
+	addMetadata(s.conn.ResponseHeader(), md)
+	return nil
+}
+
+func (s *serverStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *serverStream) SetTrailer(md metadata.MD) {
+	addMetadata(s.conn.ResponseTrailer(), md)
+}
+
+func (s *serverStream) SendMsg(m interface{}) error { return s.conn.Send(m) }
+
+func (s *serverStream) RecvMsg(m interface{}) error { return s.conn.Receive(m) }
+
+// handlerConn receives through the stream the interceptors wrapped, so the
+// ones that look at incoming messages (validation) see the request.
+type handlerConn struct {
+	connect.StreamingHandlerConn
+	ss grpc.ServerStream
+}
+
+func (c *handlerConn) Receive(m interface{}) error { return c.ss.RecvMsg(m) }
+
+// typedStream is serverStream for the service's streaming methods.
+type typedStream[T any] struct {
+	*serverStream
+}
+
+func newServerStream[T any](ctx context.Context, stream *connect.ServerStream[T]) grpc.ServerStreamingServer[T] {
+	return typedStream[T]{&serverStream{ctx: ctx, conn: stream.Conn()}}
+}
+
+func (s typedStream[T]) Send(m *T) error { return s.conn.Send(m) }
+
+func addMetadata(h http.Header, md metadata.MD) {
+	for key, values := range md {
+		for _, v := range values {
+			h.Add(key, v)
+		}
+	}
+}
+
+func copyHeader(dst, src http.Header) {
+	for key, values := range src {
+		dst[key] = append(dst[key], values...)
+	}
+}
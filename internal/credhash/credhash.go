@@ -0,0 +1,17 @@
+// Package credhash derives the digests stored in place of API keys and
+// access tokens.
+package credhash
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Sum returns the hex HMAC-SHA256 of secret keyed with salt (the server's
+// HASH_SALT).
+func Sum(salt []byte, secret string) string {
+	mac := hmac.New(sha256.New, salt)
+	mac.Write([]byte(secret))
+	return hex.EncodeToString(mac.Sum(nil))
+}
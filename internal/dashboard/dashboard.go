@@ -0,0 +1,338 @@
+// Package dashboard serves a small web UI at /admin for operators: searching
+// licenses, resetting HWIDs and reading recent validations. Pages call the
+// service over the gateway's in-process connection with the operator's admin
+// login token, so they go through the same interceptors (admin allowlist, IP
+// bans, rate limits), roles and audit log as the HTTP API.
+package dashboard
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"embed"
+	"encoding/hex"
+	"html/template"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+const (
+	// Where the dashboard is mounted
+	Prefix = "/admin"
+
+	cookieName   = "whitelist_admin"
+	pageSize     = 50
+	recentEvents = 20
+)
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+var funcs = template.FuncMap{
+	"time": func(t *timestamppb.Timestamp) string {
+		if t == nil {
+			return ""
+		}
+		return t.AsTime().UTC().Format("2006-01-02 15:04:05")
+	},
+	"expired": func(t *timestamppb.Timestamp) bool {
+		return t != nil && t.AsTime().Before(time.Now())
+	},
+}
+
+// Handler serves the dashboard pages under Prefix.
+type Handler struct {
+	svc   pb.WhitelistServiceClient
+	mux   *http.ServeMux
+	pages map[string]*template.Template
+}
+
+// New returns the dashboard calling svc, a client on the gateway's connection
+// (see clientip.GatewayListener). Operators sign in with their admin account,
+// so it needs ADMIN_JWT_SECRET; the shared secret isn't accepted.
+func New(svc pb.WhitelistServiceClient) *Handler {
+	h := &Handler{svc: svc, mux: http.NewServeMux(), pages: map[string]*template.Template{}}
+	for _, name := range []string{"login", "licenses", "license", "validations"} {
+		h.pages[name] = template.Must(template.New("layout.html").Funcs(funcs).ParseFS(templateFS, "templates/layout.html", "templates/"+name+".html"))
+	}
+
+	h.mux.HandleFunc("GET "+Prefix+"/login", h.loginPage)
+	h.mux.HandleFunc("POST "+Prefix+"/login", h.login)
+	h.mux.HandleFunc("POST "+Prefix+"/logout", h.logout)
+	h.mux.HandleFunc("GET "+Prefix+"/{$}", h.licenses)
+	h.mux.HandleFunc("GET "+Prefix+"/licenses/{key}", h.license)
+	h.mux.HandleFunc("POST "+Prefix+"/licenses/{key}/reset-hwid", h.resetHwid)
+	h.mux.HandleFunc("GET "+Prefix+"/validations", h.validations)
+	h.mux.Handle("GET "+Prefix, http.RedirectHandler(Prefix+"/", http.StatusMovedPermanently))
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Nothing here runs scripts or belongs in a frame
+	w.Header().Set("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'; form-action 'self'; frame-ancestors 'none'")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Referrer-Policy", "same-origin")
+	h.mux.ServeHTTP(w, r)
+}
+
+// page is what every template gets: the page's own data plus the form token
+// and an error or notice to show.
+type page struct {
+	CSRF   string
+	Error  string
+	Notice string
+	Data   interface{}
+}
+
+func (h *Handler) render(w http.ResponseWriter, name string, code int, p page) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(code)
+	if err := h.pages[name].Execute(w, p); err != nil {
+		log.Printf("dashboard: render %s: %v", name, err)
+	}
+}
+
+func (h *Handler) loginPage(w http.ResponseWriter, r *http.Request) {
+	h.render(w, "login", http.StatusOK, page{})
+}
+
+func (h *Handler) login(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.svc.AdminLogin(outgoing(r), &pb.AdminLoginRequest{Username: r.PostFormValue("username"), Password: r.PostFormValue("password")})
+	if err != nil {
+		h.render(w, "login", httpStatus(err), page{Error: status.Convert(err).Message()})
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     cookieName,
+		Value:    resp.Token,
+		Path:     Prefix,
+		Expires:  resp.ExpiresAt.AsTime(),
+		HttpOnly: true,
+		Secure:   secure(r),
+		SameSite: http.SameSiteStrictMode,
+	})
+	http.Redirect(w, r, Prefix+"/", http.StatusSeeOther)
+}
+
+func (h *Handler) logout(w http.ResponseWriter, r *http.Request) {
+	ctx, token, ok := h.session(w, r)
+	if !ok {
+		return
+	}
+	if !h.checkCSRF(w, r, token) {
+		return
+	}
+	if _, err := h.svc.AdminLogout(ctx, &emptypb.Empty{}); err != nil {
+		log.Printf("dashboard: logout: %v", err)
+	}
+	h.clearCookie(w, r)
+	http.Redirect(w, r, Prefix+"/login", http.StatusSeeOther)
+}
+
+type licensesData struct {
+	Search    string
+	ProductID string
+	Licenses  []*pb.License
+	NextPage  string
+}
+
+func (h *Handler) licenses(w http.ResponseWriter, r *http.Request) {
+	ctx, token, ok := h.session(w, r)
+	if !ok {
+		return
+	}
+	q := r.URL.Query()
+	data := licensesData{Search: strings.TrimSpace(q.Get("q")), ProductID: q.Get("product")}
+	resp, err := h.svc.ListLicenses(ctx, &pb.ListLicensesRequest{Search: data.Search, ProductId: data.ProductID, PageSize: pageSize, PageToken: q.Get("page")})
+	if h.failed(w, r, "licenses", token, err, data) {
+		return
+	}
+	data.Licenses = resp.Licenses
+	if resp.NextPageToken != "" {
+		next := url.Values{"q": {data.Search}, "product": {data.ProductID}, "page": {resp.NextPageToken}}
+		data.NextPage = Prefix + "/?" + next.Encode()
+	}
+	h.render(w, "licenses", http.StatusOK, page{CSRF: csrfToken(token), Data: data})
+}
+
+type licenseData struct {
+	License *pb.License
+	Events  []*pb.ValidationEvent
+}
+
+func (h *Handler) license(w http.ResponseWriter, r *http.Request) {
+	ctx, token, ok := h.session(w, r)
+	if !ok {
+		return
+	}
+	h.showLicense(ctx, w, r, token, r.PathValue("key"), http.StatusOK, page{})
+}
+
+// showLicense renders a license with its recent validations, and the outcome
+// of an action on it in p.
+func (h *Handler) showLicense(ctx context.Context, w http.ResponseWriter, r *http.Request, token, key string, code int, p page) {
+	var data licenseData
+	l, err := h.svc.GetLicense(ctx, &pb.GetLicenseRequest{LicenseKey: key})
+	if h.failed(w, r, "license", token, err, data) {
+		return
+	}
+	data.License = l
+	events, err := h.svc.ListValidationEvents(ctx, &pb.ListValidationEventsRequest{LicenseKey: key, PageSize: recentEvents})
+	if h.failed(w, r, "license", token, err, data) {
+		return
+	}
+	data.Events = events.Events
+	p.CSRF, p.Data = csrfToken(token), data
+	h.render(w, "license", code, p)
+}
+
+func (h *Handler) resetHwid(w http.ResponseWriter, r *http.Request) {
+	ctx, token, ok := h.session(w, r)
+	if !ok {
+		return
+	}
+	if !h.checkCSRF(w, r, token) {
+		return
+	}
+	key, hwid := r.PathValue("key"), r.PostFormValue("hwid")
+	_, err := h.svc.ResetHwid(ctx, &pb.ResetHwidRequest{LicenseKey: key, Hwid: hwid})
+	switch {
+	case status.Code(err) == codes.Unauthenticated:
+		h.failed(w, r, "license", token, err, licenseData{})
+	case err != nil:
+		h.showLicense(ctx, w, r, token, key, httpStatus(err), page{Error: status.Convert(err).Message()})
+	case hwid != "":
+		h.showLicense(ctx, w, r, token, key, http.StatusOK, page{Notice: "Unbound " + hwid + "."})
+	default:
+		h.showLicense(ctx, w, r, token, key, http.StatusOK, page{Notice: "Unbound all devices."})
+	}
+}
+
+type validationsData struct {
+	Filter   string
+	Events   []*pb.ValidationEvent
+	NextPage string
+}
+
+func (h *Handler) validations(w http.ResponseWriter, r *http.Request) {
+	ctx, token, ok := h.session(w, r)
+	if !ok {
+		return
+	}
+	q := r.URL.Query()
+	data := validationsData{Filter: q.Get("filter")}
+	req := &pb.ListValidationEventsRequest{ProductId: q.Get("product"), PageSize: pageSize, PageToken: q.Get("page")}
+	switch data.Filter {
+	case "valid":
+		req.Valid = boolPtr(true)
+	case "failed":
+		req.Valid = boolPtr(false)
+	}
+	resp, err := h.svc.ListValidationEvents(ctx, req)
+	if h.failed(w, r, "validations", token, err, data) {
+		return
+	}
+	data.Events = resp.Events
+	if resp.NextPageToken != "" {
+		next := url.Values{"filter": {data.Filter}, "product": {req.ProductId}, "page": {resp.NextPageToken}}
+		data.NextPage = Prefix + "/validations?" + next.Encode()
+	}
+	h.render(w, "validations", http.StatusOK, page{CSRF: csrfToken(token), Data: data})
+}
+
+func boolPtr(b bool) *bool { return &b }
+
+// session returns a context carrying the operator's token for service calls,
+// or sends them to the login page.
+func (h *Handler) session(w http.ResponseWriter, r *http.Request) (context.Context, string, bool) {
+	c, err := r.Cookie(cookieName)
+	if err != nil || c.Value == "" {
+		http.Redirect(w, r, Prefix+"/login", http.StatusSeeOther)
+		return nil, "", false
+	}
+	return metadata.AppendToOutgoingContext(outgoing(r), "authorization", "Bearer "+c.Value), c.Value, true
+}
+
+// outgoing returns the context for a call made on behalf of r, passing the
+// operator's address on as the gateway does.
+func outgoing(r *http.Request) context.Context {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return metadata.AppendToOutgoingContext(r.Context(), "x-forwarded-for", host)
+}
+
+// failed shows err, if any, on the named page. A rejected token means the
+// session ended, so the operator is sent to sign in again.
+func (h *Handler) failed(w http.ResponseWriter, r *http.Request, name, token string, err error, data interface{}) bool {
+	if err == nil {
+		return false
+	}
+	if status.Code(err) == codes.Unauthenticated {
+		h.clearCookie(w, r)
+		http.Redirect(w, r, Prefix+"/login", http.StatusSeeOther)
+		return true
+	}
+	h.render(w, name, httpStatus(err), page{CSRF: csrfToken(token), Error: status.Convert(err).Message(), Data: data})
+	return true
+}
+
+// checkCSRF makes sure a form post came from a dashboard page. The strict
+// cookie already keeps other sites out in current browsers; this is for the
+// rest.
+func (h *Handler) checkCSRF(w http.ResponseWriter, r *http.Request, token string) bool {
+	if subtle.ConstantTimeCompare([]byte(r.PostFormValue("csrf")), []byte(csrfToken(token))) == 1 {
+		return true
+	}
+	http.Error(w, "invalid form token, reload the page", http.StatusForbidden)
+	return false
+}
+
+// csrfToken derives the form token from the session token, which other sites
+// can't read.
+func csrfToken(token string) string {
+	sum := sha256.Sum256([]byte("csrf:" + token))
+	return hex.EncodeToString(sum[:16])
+}
+
+func (h *Handler) clearCookie(w http.ResponseWriter, r *http.Request) {
+	http.SetCookie(w, &http.Cookie{Name: cookieName, Path: Prefix, MaxAge: -1, HttpOnly: true, Secure: secure(r), SameSite: http.SameSiteStrictMode})
+}
+
+// secure reports whether the browser reached us over HTTPS, directly or
+// through a proxy.
+func secure(r *http.Request) bool {
+	return r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https"
+}
+
+func httpStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
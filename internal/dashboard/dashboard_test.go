@@ -0,0 +1,107 @@
+package dashboard
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mkseven15/whitelist-server/internal/clientip"
+	"github.com/mkseven15/whitelist-server/internal/ipban"
+	"github.com/mkseven15/whitelist-server/internal/ratelimit"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+type fakeService struct {
+	pb.UnimplementedWhitelistServiceServer
+	logins int
+}
+
+func (f *fakeService) AdminLogin(ctx context.Context, req *pb.AdminLoginRequest) (*pb.AdminLoginResponse, error) {
+	f.logins++
+	return &pb.AdminLoginResponse{Token: "token", ExpiresAt: timestamppb.New(time.Now().Add(time.Hour))}, nil
+}
+
+// newTestHandler serves the dashboard through a gateway connection to a
+// server with the IP ban and rate limit interceptors, as main does.
+func newTestHandler(t *testing.T, bans *ipban.List, limit *ratelimit.Limiter) (*Handler, *fakeService) {
+	t.Helper()
+	svc := &fakeService{}
+	methods := []string{pb.WhitelistService_AdminLogin_FullMethodName}
+	s := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		ipban.UnaryServerInterceptor(bans, methods...),
+		ratelimit.UnaryServerInterceptor(limit, nil, methods...),
+	))
+	pb.RegisterWhitelistServiceServer(s, svc)
+	lis := bufconn.Listen(1 << 16)
+	go s.Serve(clientip.GatewayListener(lis))
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///in-process",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return New(pb.NewWhitelistServiceClient(conn)), svc
+}
+
+func postLogin(h *Handler, remoteAddr string) int {
+	form := url.Values{"username": {"admin"}, "password": {"password"}}
+	r := httptest.NewRequest(http.MethodPost, Prefix+"/login", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.RemoteAddr = remoteAddr
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w.Code
+}
+
+func TestLoginBannedAddress(t *testing.T) {
+	bans := ipban.NewList()
+	bans.Set([]netip.Prefix{netip.MustParsePrefix("203.0.113.0/24")})
+	h, svc := newTestHandler(t, bans, nil)
+
+	if code := postLogin(h, "203.0.113.7:40000"); code != http.StatusForbidden {
+		t.Errorf("banned address: got %d, want %d", code, http.StatusForbidden)
+	}
+	if code := postLogin(h, "198.51.100.7:40000"); code != http.StatusSeeOther {
+		t.Errorf("other address: got %d, want %d", code, http.StatusSeeOther)
+	}
+	if svc.logins != 1 {
+		t.Errorf("service saw %d logins, want 1", svc.logins)
+	}
+}
+
+func TestLoginRateLimited(t *testing.T) {
+	h, svc := newTestHandler(t, ipban.NewList(), ratelimit.New(0.001, 2))
+
+	for i := 0; i < 2; i++ {
+		if code := postLogin(h, "198.51.100.7:40000"); code != http.StatusSeeOther {
+			t.Fatalf("login %d: got %d, want %d", i+1, code, http.StatusSeeOther)
+		}
+	}
+	if code := postLogin(h, "198.51.100.7:40001"); code != http.StatusTooManyRequests {
+		t.Errorf("over the limit: got %d, want %d", code, http.StatusTooManyRequests)
+	}
+	// The limit is per address, not shared by everyone behind the dashboard
+	if code := postLogin(h, "198.51.100.8:40000"); code != http.StatusSeeOther {
+		t.Errorf("other address: got %d, want %d", code, http.StatusSeeOther)
+	}
+	if svc.logins != 3 {
+		t.Errorf("service saw %d logins, want 3", svc.logins)
+	}
+}
@@ -0,0 +1,114 @@
+// Package database opens the server's database, picking the backend from
+// the scheme of DB_URL: Postgres for postgres:// URLs and key=value
+// connection strings, SQLite for sqlite: paths and MariaDB for mysql:// and
+// mariadb:// URLs.
+//
+// The service's queries are written for Postgres. On SQLite and MariaDB
+// they go through a driver that rewrites the few Postgres-only constructs
+// they use, so the server runs on either without a Postgres instance.
+package database
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/XSAM/otelsql"
+	_ "github.com/lib/pq" // Postgres driver
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Dialect is the kind of database a DB_URL points at.
+type Dialect string
+
+const (
+	Postgres Dialect = "postgres"
+	SQLite   Dialect = "sqlite"
+	// MySQL is MariaDB 10.6 or later; MySQL itself lacks the RETURNING
+	// clauses the queries rely on.
+	MySQL Dialect = "mysql"
+)
+
+// TimeArg is a timestamp query argument in the text Postgres casts: RFC
+// 3339, "infinity" or "-infinity", as page cursors hold. Postgres gets the
+// text as is; the other backends convert it to their own time values. Plain
+// strings are never converted, however much they look like a time.
+type TimeArg string
+
+// Parse returns the dialect of dbURL and the data source name for its
+// driver.
+func Parse(dbURL string) (Dialect, string, error) {
+	scheme, rest, ok := strings.Cut(dbURL, ":")
+	if !ok || strings.ContainsAny(scheme, " =") {
+		// key=value connection strings have no scheme
+		return Postgres, dbURL, nil
+	}
+	switch strings.ToLower(scheme) {
+	case "postgres", "postgresql":
+		return Postgres, dbURL, nil
+	case "sqlite", "sqlite3":
+		dsn, err := sqliteDSN(rest)
+		if err != nil {
+			return "", "", err
+		}
+		return SQLite, dsn, nil
+	case "mysql", "mariadb":
+		dsn, err := mysqlDSN(dbURL)
+		if err != nil {
+			return "", "", err
+		}
+		return MySQL, dsn, nil
+	}
+	return "", "", fmt.Errorf("unsupported database scheme %q (want postgres, sqlite or mysql)", scheme)
+}
+
+// Open opens dbURL with tracing. It doesn't connect; Ping to check the
+// database is reachable.
+func Open(dbURL string) (*sql.DB, Dialect, error) {
+	dialect, dsn, err := Parse(dbURL)
+	if err != nil {
+		return nil, "", err
+	}
+	driverName, system := "postgres", "postgresql"
+	switch dialect {
+	case SQLite:
+		driverName, system = sqliteDriverName, "sqlite"
+	case MySQL:
+		driverName, system = mysqlDriverName, "mariadb"
+	}
+	db, err := otelsql.Open(driverName, dsn, otelsql.WithAttributes(attribute.String("db.system", system)))
+	if err != nil {
+		return nil, "", err
+	}
+	return db, dialect, nil
+}
+
+// sqliteDSN turns the part of a sqlite: URL after the scheme into a
+// data source for the SQLite driver. sqlite:whitelist.db is relative to the
+// working directory and sqlite:///var/lib/whitelist.db absolute; query
+// parameters are passed on to the driver.
+func sqliteDSN(rest string) (string, error) {
+	path, query, _ := strings.Cut(strings.TrimPrefix(rest, "//"), "?")
+	if path == "" {
+		return "", errors.New("sqlite url has no database path")
+	}
+	if path == ":memory:" {
+		// Every pooled connection would get a database of its own
+		return "", errors.New("sqlite in-memory databases aren't supported, use a file")
+	}
+	params, err := url.ParseQuery(query)
+	if err != nil {
+		return "", fmt.Errorf("sqlite url: %w", err)
+	}
+	params.Add("_pragma", "foreign_keys(1)")
+	params.Add("_pragma", "journal_mode(WAL)")
+	params.Add("_pragma", "busy_timeout(5000)")
+	// Transactions take the write lock up front, standing in for the
+	// SELECT ... FOR UPDATE row locks the queries rely on
+	if !params.Has("_txlock") {
+		params.Set("_txlock", "immediate")
+	}
+	return "file:" + path + "?" + params.Encode(), nil
+}
@@ -0,0 +1,255 @@
+package database
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// A rewriter translates the service's Postgres queries for another
+// database. Its steps cover the Postgres syntax the queries actually use,
+// not Postgres in general; a query still using Postgres-only syntax
+// afterwards (see postgresOnly) fails with an error naming it, rather than
+// running as something else. Results are cached, since the service runs the
+// same few hundred queries over and over.
+type rewriter struct {
+	name  string
+	steps []func(string) string
+	// Syntax the database doesn't take beyond postgresOnly
+	leftovers []leftover
+	cache     sync.Map
+}
+
+type rewritten struct {
+	query string
+	err   error
+}
+
+func newRewriter(name string, leftovers []leftover, steps ...func(string) string) *rewriter {
+	return &rewriter{name: name, steps: steps, leftovers: leftovers}
+}
+
+func (w *rewriter) rewrite(query string) (string, error) {
+	if r, ok := w.cache.Load(query); ok {
+		return r.(rewritten).query, r.(rewritten).err
+	}
+	q := query
+	for _, step := range w.steps {
+		q = step(q)
+	}
+	var err error
+	if what := w.leftover(q); what != "" {
+		err = fmt.Errorf("database: %s can't run %s, which the query still has after rewriting: %s", w.name, what, query)
+		q = ""
+	}
+	w.cache.Store(query, rewritten{q, err})
+	return q, err
+}
+
+// leftover returns what Postgres-only syntax q has, "" if none.
+func (w *rewriter) leftover(q string) string {
+	unquoted := stripLiterals(q)
+	for _, l := range slices.Concat(postgresOnly, w.leftovers) {
+		text := unquoted
+		if l.literal {
+			text = q
+		}
+		if l.re.MatchString(text) {
+			return l.what
+		}
+	}
+	return ""
+}
+
+// A leftover is syntax a rewriter's steps should have translated.
+type leftover struct {
+	re   *regexp.Regexp
+	what string
+	// Whether re matches string literals too; otherwise it only sees the
+	// query outside them
+	literal bool
+}
+
+func pgOnly(pattern, what string) leftover {
+	return leftover{re: regexp.MustCompile(`(?i)` + pattern), what: what}
+}
+
+// postgresOnly is the syntax the queries use that neither SQLite nor
+// MariaDB understand, or understand differently.
+var postgresOnly = []leftover{
+	pgOnly(`::`, "a :: cast"),
+	pgOnly(`\bANY\s*\(`, "ANY()"),
+	pgOnly(`@>`, "@>"),
+	pgOnly(`\bILIKE\b`, "ILIKE"),
+	pgOnly(`\bINTERVAL\s*'`, "an interval literal"),
+	pgOnly(`\bARRAY\s*[(\[]`, "an array constructor"),
+	pgOnly(`\bAT\s+TIME\s+ZONE\b`, "AT TIME ZONE"),
+	pgOnly(`\bDISTINCT\s+ON\b`, "DISTINCT ON"),
+	pgOnly(`\b(?:to_char|unnest|date_trunc|generate_series|array_agg|string_agg|jsonb_\w+)\s*\(`, "a Postgres function"),
+	// Casts are stripped, so one the steps missed would compare as text
+	{re: regexp.MustCompile(`'-?infinity'`), what: "an infinite timestamp", literal: true},
+}
+
+// stripLiterals returns q with the contents of its string literals
+// removed, as the quotes around them mark where they were. An unterminated
+// literal runs to the end.
+func stripLiterals(q string) string {
+	var b strings.Builder
+	quoted := false
+	for i := 0; i < len(q); i++ {
+		if q[i] == '\'' {
+			quoted = !quoted
+			b.WriteByte(q[i])
+		} else if !quoted {
+			b.WriteByte(q[i])
+		}
+	}
+	return b.String()
+}
+
+// replace is a step replacing every match of pattern with repl.
+func replace(pattern, repl string) func(string) string {
+	re := regexp.MustCompile(pattern)
+	return func(q string) string {
+		return re.ReplaceAllString(q, repl)
+	}
+}
+
+// replaceFunc is a step replacing every match of pattern with what fn
+// returns for its submatches.
+func replaceFunc(pattern string, fn func(m []string) string) func(string) string {
+	re := regexp.MustCompile(pattern)
+	return func(q string) string {
+		return re.ReplaceAllStringFunc(q, func(s string) string {
+			return fn(re.FindStringSubmatch(s))
+		})
+	}
+}
+
+// outsideQuotes is step applied only to the parts of a query outside
+// string literals, so a '::' or keyword in a literal stays as written.
+func outsideQuotes(step func(string) string) func(string) string {
+	return func(q string) string {
+		var b strings.Builder
+		start, quoted := 0, false
+		for i := 0; i < len(q); i++ {
+			if q[i] != '\'' {
+				continue
+			}
+			if quoted {
+				b.WriteString(q[start : i+1])
+				start = i + 1
+			} else {
+				b.WriteString(step(q[start:i]))
+				start = i
+			}
+			quoted = !quoted
+		}
+		if quoted {
+			b.WriteString(q[start:])
+		} else {
+			b.WriteString(step(q[start:]))
+		}
+		return b.String()
+	}
+}
+
+var deleteAliasRe = regexp.MustCompile(`DELETE FROM (\w+) (\w+) WHERE\b`)
+
+// dropDeleteAlias replaces a DELETE's table alias by the table name, for
+// databases that don't take one (or whose RETURNING can't see it).
+func dropDeleteAlias(q string) string {
+	m := deleteAliasRe.FindStringSubmatch(q)
+	if m == nil {
+		return q
+	}
+	table, alias := m[1], m[2]
+	q = strings.Replace(q, m[0], "DELETE FROM "+table+" WHERE", 1)
+	return regexp.MustCompile(`\b`+alias+`\.`).ReplaceAllString(q, table+".")
+}
+
+var arrayRe = regexp.MustCompile(`ARRAY\(\s*SELECT\s+(DISTINCT\s+)?`)
+
+// rewriteArrays returns a step turning ARRAY(SELECT [DISTINCT] expr FROM ...
+// [ORDER BY ...]) into what agg returns: an aggregate over the same rows
+// producing the same array literal. rest starts with FROM and order, if
+// any, with ORDER BY.
+func rewriteArrays(agg func(distinct, expr, rest, order string) string) func(string) string {
+	return func(q string) string {
+		for {
+			loc := arrayRe.FindStringSubmatchIndex(q)
+			if loc == nil {
+				return q
+			}
+			open := loc[0] + len("ARRAY")
+			end := closingParen(q, open)
+			if end < 0 {
+				return q
+			}
+			distinct := ""
+			if loc[2] >= 0 {
+				distinct = "DISTINCT "
+			}
+			body := q[loc[1]:end]
+			from := topLevelIndex(body, `\sFROM\s`)
+			if from < 0 {
+				return q
+			}
+			expr, rest := strings.TrimSpace(body[:from]), body[from:]
+			order := ""
+			if i := topLevelIndex(rest, `\sORDER BY\s`); i >= 0 {
+				rest, order = rest[:i], strings.TrimSpace(rest[i:])
+			}
+			q = q[:loc[0]] + agg(distinct, expr, rest, order) + q[end+1:]
+		}
+	}
+}
+
+// closingParen returns the index of the parenthesis closing the one at
+// open, or -1.
+func closingParen(s string, open int) int {
+	depth := 0
+	quoted := false
+	for i := open; i < len(s); i++ {
+		switch {
+		case s[i] == '\'':
+			quoted = !quoted
+		case quoted:
+		case s[i] == '(':
+			depth++
+		case s[i] == ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// topLevelIndex returns where pattern first matches s outside parentheses
+// and quotes, or -1.
+func topLevelIndex(s, pattern string) int {
+	re := regexp.MustCompile(pattern)
+	for _, m := range re.FindAllStringIndex(s, -1) {
+		depth := 0
+		quoted := false
+		for i := 0; i < m[0]; i++ {
+			switch {
+			case s[i] == '\'':
+				quoted = !quoted
+			case quoted:
+			case s[i] == '(':
+				depth++
+			case s[i] == ')':
+				depth--
+			}
+		}
+		if depth == 0 && !quoted {
+			return m[0]
+		}
+	}
+	return -1
+}
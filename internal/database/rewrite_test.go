@@ -0,0 +1,329 @@
+package database
+
+import (
+	"database/sql/driver"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOutsideQuotes(t *testing.T) {
+	strip := outsideQuotes(replace(`::\w+`, ""))
+	tests := []struct {
+		name, in, want string
+	}{
+		{"no literals", "SELECT $1::text", "SELECT $1"},
+		{"cast after literal", "SELECT '{}'::text", "SELECT '{}'"},
+		{"cast inside literal", "SELECT 'a::b'::text, $1::int", "SELECT 'a::b', $1"},
+		{"escaped quote", "SELECT 'it''s::x'::text", "SELECT 'it''s::x'"},
+		{"unterminated literal", "SELECT $1::int, 'a::b", "SELECT $1, 'a::b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := strip(tt.in); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDropDeleteAlias(t *testing.T) {
+	tests := []struct {
+		name, in, want string
+	}{
+		{"alias", "DELETE FROM licenses l WHERE l.license_key = $1 RETURNING l.hwid", "DELETE FROM licenses WHERE licenses.license_key = $1 RETURNING licenses.hwid"},
+		{"no alias", "DELETE FROM licenses WHERE license_key = $1", "DELETE FROM licenses WHERE license_key = $1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dropDeleteAlias(tt.in); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRewriteArrays(t *testing.T) {
+	agg := rewriteArrays(func(distinct, expr, rest, order string) string {
+		return "AGG[" + distinct + "|" + expr + "|" + rest + "|" + order + "]"
+	})
+	tests := []struct {
+		name, in, want string
+	}{
+		{"plain", "SELECT ARRAY(SELECT hwid FROM d WHERE k = $1)", "SELECT AGG[|hwid| FROM d WHERE k = $1|]"},
+		{"distinct and order", "ARRAY(SELECT DISTINCT n FROM t ORDER BY n)", "AGG[DISTINCT |n| FROM t|ORDER BY n]"},
+		{"nested parentheses", "ARRAY(SELECT lower(x) FROM t WHERE y IN (SELECT y FROM u))", "AGG[|lower(x)| FROM t WHERE y IN (SELECT y FROM u)|]"},
+		{"parenthesis in literal", "ARRAY(SELECT x FROM t WHERE y = ')')", "AGG[|x| FROM t WHERE y = ')'|]"},
+		{"two arrays", "ARRAY(SELECT a FROM t), ARRAY(SELECT b FROM u)", "AGG[|a| FROM t|], AGG[|b| FROM u|]"},
+		{"no array", "SELECT 1", "SELECT 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := agg(tt.in); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSQLiteRewriter(t *testing.T) {
+	tests := []struct {
+		name, in, want string
+	}{
+		{"array", "SELECT ARRAY(SELECT hwid FROM d ORDER BY hwid)", "SELECT COALESCE((SELECT pg_array_agg(hwid ORDER BY hwid) FROM d), '{}')"},
+		{"interval", "WHERE t < NOW() - INTERVAL '2 hours'", "WHERE t < NOW(-7200)"},
+		{"infinity", "COALESCE(e, 'infinity'::timestamptz)", "COALESCE(e, '" + maxTime + "')"},
+		{"-infinity", "COALESCE(e, '-infinity'::timestamptz)", "COALESCE(e, '" + minTime + "')"},
+		{"to_char", "to_char(v.created_at AT TIME ZONE 'UTC', 'YYYY-MM-DD')", "substr(v.created_at, 1, 10)"},
+		{"to_char in UTC", "to_char(created_at, 'YYYY-MM-DD')", "substr(created_at, 1, 10)"},
+		{"today", "(NOW() AT TIME ZONE 'UTC')::date", "substr(NOW(), 1, 10)"},
+		{"date param", "WHERE d >= $1::date", "WHERE d >= substr($1, 1, 10)"},
+		{"unnest", "FROM unnest($1::text[]) n", "FROM (SELECT value AS n FROM json_each(pg_array_json($1))) n"},
+		{"any", "WHERE l.hwid = ANY($2)", "WHERE pg_array_contains($2, l.hwid)"},
+		{"jsonb contains", "WHERE metadata @> $1::jsonb", "WHERE pg_jsonb_contains(metadata, $1)"},
+		{"casts", "SELECT $1::text, $2::int[]", "SELECT $1, $2"},
+		{"cast in literal", "SET note = 'a::b' WHERE k = $1::text", "SET note = 'a::b' WHERE k = $1"},
+		{"ilike", "WHERE email ILIKE $3 ESCAPE '\\'", "WHERE pg_lower(email) LIKE pg_lower($3) ESCAPE '\\'"},
+		{"for update", "SELECT 1 FROM t WHERE k = $1 FOR UPDATE", "SELECT 1 FROM t WHERE k = $1"},
+		{"delete alias", "DELETE FROM t x WHERE x.k = $1", "DELETE FROM t WHERE t.k = $1"},
+		{"upsert", "INSERT INTO t (k, v) VALUES ($1, $2) ON CONFLICT (k) DO UPDATE SET v = EXCLUDED.v RETURNING k", "INSERT INTO t (k, v) VALUES ($1, $2) ON CONFLICT (k) DO UPDATE SET v = EXCLUDED.v RETURNING k"},
+		{"advisory lock", "SELECT pg_try_advisory_lock($1)", "SELECT pg_try_advisory_lock($1)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := sqliteRewriter.rewrite(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMySQLRewriter(t *testing.T) {
+	tests := []struct {
+		name, in, want string
+	}{
+		{"array", "SELECT ARRAY(SELECT hwid FROM d)", `SELECT COALESCE((SELECT CONCAT('{', GROUP_CONCAT(CONCAT('"', REPLACE(REPLACE(hwid, '\\', '\\\\'), '"', '\\"'), '"') SEPARATOR ','), '}') FROM d), '{}')`},
+		{"infinity", "COALESCE(e, 'infinity'::timestamptz)", "COALESCE(e, CAST('9999-12-31 23:59:59.999999' AS DATETIME(6)))"},
+		{"-infinity", "COALESCE(e, '-infinity'::timestamptz)", "COALESCE(e, CAST('1000-01-01 00:00:00' AS DATETIME(6)))"},
+		{"to_char", "to_char(created_at, 'YYYY-MM-DD')", "DATE_FORMAT(created_at, '%Y-%m-%d')"},
+		{"today", "(NOW() AT TIME ZONE 'UTC')::date", "UTC_DATE()"},
+		{"date param", "WHERE d >= $1::date", "WHERE d >= DATE($1)"},
+		{"interval", "WHERE t < NOW() - INTERVAL '5 minutes'", "WHERE t < UTC_TIMESTAMP(6) - INTERVAL 5 minute"},
+		{"now", "SET t = NOW()", "SET t = UTC_TIMESTAMP(6)"},
+		{"unnest", "FROM unnest($1::text[]) n", "FROM JSON_TABLE(CONCAT('[', SUBSTRING($1, 2, CHAR_LENGTH($1) - 2), ']'), '$[*]' COLUMNS (n VARCHAR(255) PATH '$')) n"},
+		{"any", "WHERE hwid = ANY($2)", `WHERE LOCATE(CONCAT(',', CONCAT('"', REPLACE(REPLACE(hwid, '\\', '\\\\'), '"', '\\"'), '"'), ','), CONCAT(',', SUBSTRING($2, 2, CHAR_LENGTH($2) - 2), ',')) > 0`},
+		{"jsonb contains", "WHERE metadata @> $1::jsonb", "WHERE JSON_CONTAINS(metadata, $1)"},
+		{"casts", "SELECT $1::text", "SELECT $1"},
+		{"cast in literal", "SET note = 'a::b' WHERE k = $1::text", "SET note = 'a::b' WHERE k = $1"},
+		{"ilike", "WHERE email ILIKE $3 ESCAPE '\\'", "WHERE LOWER(email) LIKE LOWER($3)"},
+		{"count filter", "COUNT(*) FILTER (WHERE valid)", "COALESCE(SUM(CASE WHEN valid THEN 1 ELSE 0 END), 0)"},
+		{"advisory lock", "SELECT pg_try_advisory_lock($1)", "SELECT GET_LOCK(CONCAT('whitelist-', $1), 0)"},
+		{"advisory unlock", "SELECT pg_advisory_unlock($1)", "SELECT RELEASE_LOCK(CONCAT('whitelist-', $1))"},
+		{"delete limit", "DELETE FROM e WHERE id IN (SELECT id FROM e WHERE t < $1 LIMIT $2)", "DELETE FROM e WHERE t < $1 LIMIT $2"},
+		{"delete alias", "DELETE FROM t x WHERE x.k = $1", "DELETE FROM t WHERE t.k = $1"},
+		{"do nothing", "INSERT INTO t (k) VALUES ($1) ON CONFLICT (k) DO NOTHING", "INSERT IGNORE INTO t (k) VALUES ($1)"},
+		{"do nothing on any conflict", "INSERT INTO t (k) VALUES ($1) ON CONFLICT DO NOTHING RETURNING id", "INSERT IGNORE INTO t (k) VALUES ($1) RETURNING id"},
+		{"do update", "INSERT INTO t (k, v, n) VALUES ($1, $2, 1) ON CONFLICT (k) DO UPDATE SET v = EXCLUDED.v, n = t.n + 1", "INSERT INTO t (k, v, n) VALUES ($1, $2, 1) ON DUPLICATE KEY UPDATE v = VALUES(v), n = t.n + 1"},
+		{"do update where", "INSERT INTO t (k, v) VALUES ($1, $2) ON CONFLICT (k) DO UPDATE SET v = EXCLUDED.v WHERE t.v < EXCLUDED.v", "INSERT INTO t (k, v) VALUES ($1, $2) ON DUPLICATE KEY UPDATE v = IF(t.v < VALUES(v), VALUES(v), v)"},
+		{"do update returning", "INSERT INTO t (k) VALUES ($1) ON CONFLICT (k) DO UPDATE SET n = COALESCE(t.n, 0) + 1 RETURNING n", "INSERT INTO t (k) VALUES ($1) ON DUPLICATE KEY UPDATE n = COALESCE(t.n, 0) + 1 RETURNING n"},
+		{"to_char at UTC", "to_char(created_at AT TIME ZONE 'UTC', 'YYYY-MM-DD')", "DATE_FORMAT(created_at, '%Y-%m-%d')"},
+		{"delete returning", "DELETE FROM t WHERE k = $1 RETURNING v", "DELETE FROM t WHERE k = $1 RETURNING v"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mysqlRewriter.rewrite(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRewriterLeftovers(t *testing.T) {
+	tests := []struct {
+		name     string
+		w        *rewriter
+		in, what string
+	}{
+		{"any of a literal array", sqliteRewriter, "WHERE k = ANY(ARRAY['a', 'b'])", "ANY()"},
+		{"ilike of a column", sqliteRewriter, "WHERE a ILIKE b", "ILIKE"},
+		{"interval from a column", sqliteRewriter, "WHERE t < created_at - INTERVAL '1 day'", "an interval literal"},
+		{"interval in weeks", mysqlRewriter, "WHERE t < NOW() - INTERVAL '2 weeks'", "an interval literal"},
+		{"jsonb function", sqliteRewriter, "SELECT jsonb_typeof(metadata) FROM licenses", "a Postgres function"},
+		{"distinct on", mysqlRewriter, "SELECT DISTINCT ON (k) k, v FROM t", "DISTINCT ON"},
+		{"infinite timestamp", sqliteRewriter, "COALESCE(e, 'infinity'::timestamp)", "an infinite timestamp"},
+		{"contains of a column", mysqlRewriter, "WHERE a @> b", "@>"},
+		{"array literal", mysqlRewriter, "SELECT ARRAY['a']", "an array constructor"},
+		{"row lock", sqliteRewriter, "SELECT 1 FROM t FOR SHARE", "a row lock"},
+		{"lowercase lock", sqliteRewriter, "SELECT 1 FROM t for update", "a row lock"},
+		{"greatest", sqliteRewriter, "SELECT GREATEST(a, b) FROM t", "GREATEST() or LEAST()"},
+		{"conflict without columns", mysqlRewriter, "INSERT INTO t (k) VALUES ($1) ON CONFLICT DO UPDATE SET k = $1", "ON CONFLICT"},
+		{"filter of a sum", mysqlRewriter, "SELECT SUM(n) FILTER (WHERE ok) FROM t", "an aggregate's FILTER"},
+		{"concatenation", mysqlRewriter, "SELECT 'a' || k FROM t", "|| (which is OR)"},
+		{"postgres function", mysqlRewriter, "SELECT pg_sleep(1)", "a Postgres function"},
+		{"update returning", mysqlRewriter, "UPDATE t SET n = n + 1 WHERE k = $1 RETURNING n", "UPDATE ... RETURNING"},
+		{"nulls last", mysqlRewriter, "SELECT k FROM t ORDER BY e NULLS LAST", "NULLS FIRST or LAST"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.w.rewrite(tt.in)
+			if err == nil {
+				t.Fatalf("rewrote to %q, want an error", got)
+			}
+			if !strings.Contains(err.Error(), tt.what) || !strings.Contains(err.Error(), tt.in) {
+				t.Errorf("error %q doesn't name %s and the query", err, tt.what)
+			}
+			// The failure is cached too
+			if _, err := tt.w.rewrite(tt.in); err == nil {
+				t.Error("second rewrite succeeded")
+			}
+		})
+	}
+
+	// Inside a string literal, it's data
+	if _, err := mysqlRewriter.rewrite("SET note = 'a || b, ANY(x), ON CONFLICT' WHERE k = $1"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPrepareMySQL(t *testing.T) {
+	q, err := prepareMySQL("UPDATE t SET a = $2, b = '$1' WHERE k = $1 AND j = $2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "UPDATE t SET a = ?, b = '$1' WHERE k = ? AND j = ?"; q.text != want {
+		t.Errorf("got %q, want %q", q.text, want)
+	}
+	bound, err := q.bind([]driver.NamedValue{{Ordinal: 1, Value: "k"}, {Ordinal: 2, Value: "v"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var values []driver.Value
+	for _, nv := range bound {
+		values = append(values, nv.Value)
+	}
+	if want := []driver.Value{"v", "k", "v"}; !slices.Equal(values, want) {
+		t.Errorf("bound %v, want %v", values, want)
+	}
+	if _, err := q.bind([]driver.NamedValue{{Ordinal: 1, Value: "k"}}); err == nil {
+		t.Error("bound a missing argument")
+	}
+	if _, err := prepareMySQL("UPDATE t SET n = n + 1 RETURNING n"); err == nil {
+		t.Error("prepared a query MariaDB can't run")
+	}
+}
+
+// TestRewriteStoreQueries runs every query literal in the store and the
+// background jobs' leader election through both rewriters, so a query using
+// syntax they don't handle fails here rather than on a SQLite or MariaDB
+// server. Queries built up from pieces are checked piece by piece.
+func TestRewriteStoreQueries(t *testing.T) {
+	files, err := filepath.Glob("../store/*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files = append(files, "../jobs/leader.go")
+	isQuery := regexp.MustCompile(`(?i)^\s*(SELECT|INSERT|UPDATE|DELETE|WITH)\s`)
+	var queries int
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), file, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ast.Inspect(f, func(n ast.Node) bool {
+			lit, ok := n.(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				return true
+			}
+			query, err := strconv.Unquote(lit.Value)
+			if err != nil || !isQuery.MatchString(query) {
+				return true
+			}
+			queries++
+			for _, w := range []*rewriter{sqliteRewriter, mysqlRewriter} {
+				if _, err := w.rewrite(query); err != nil {
+					t.Errorf("%s: %v", filepath.Base(file), err)
+				}
+			}
+			return true
+		})
+	}
+	if queries == 0 {
+		t.Fatal("found no queries in the store")
+	}
+}
+
+func TestSQLiteCheckNamedValue(t *testing.T) {
+	at := time.Date(2026, 1, 2, 3, 4, 5, 6000000, time.UTC)
+	tests := []struct {
+		name string
+		in   interface{}
+		want driver.Value
+	}{
+		// Plain strings stay as they are, whatever they look like
+		{"timestamp-like string", "2026-01-02T03:04:05Z", "2026-01-02T03:04:05Z"},
+		{"infinity string", "infinity", "infinity"},
+		{"time", at, "2026-01-02 03:04:05.006+00:00"},
+		{"time arg", TimeArg("2026-01-02T03:04:05.006Z"), "2026-01-02 03:04:05.006+00:00"},
+		{"infinity arg", TimeArg("infinity"), maxTime},
+		{"-infinity arg", TimeArg("-infinity"), minTime},
+		{"bytes", []byte(`{"a":1}`), `{"a":1}`},
+		{"nil bytes", []byte(nil), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nv := &driver.NamedValue{Value: tt.in}
+			if err := (&sqliteConn{}).CheckNamedValue(nv); err != nil {
+				t.Fatal(err)
+			}
+			if nv.Value != tt.want {
+				t.Errorf("got %#v, want %#v", nv.Value, tt.want)
+			}
+		})
+	}
+	if err := (&sqliteConn{}).CheckNamedValue(&driver.NamedValue{Value: TimeArg("yesterday")}); err == nil {
+		t.Error("a malformed TimeArg was accepted")
+	}
+}
+
+func TestMySQLCheckNamedValue(t *testing.T) {
+	tests := []struct {
+		name string
+		in   interface{}
+		want driver.Value
+	}{
+		{"timestamp-like string", "2026-01-02T03:04:05Z", "2026-01-02T03:04:05Z"},
+		{"infinity string", "infinity", "infinity"},
+		{"time arg", TimeArg("2026-01-02T03:04:05Z"), time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{"infinity arg", TimeArg("infinity"), mysqlMaxTime},
+		{"-infinity arg", TimeArg("-infinity"), mysqlMinTime},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nv := &driver.NamedValue{Value: tt.in}
+			if err := (&mysqlConn{}).CheckNamedValue(nv); err != nil {
+				t.Fatal(err)
+			}
+			if nv.Value != tt.want {
+				t.Errorf("got %#v, want %#v", nv.Value, tt.want)
+			}
+		})
+	}
+}
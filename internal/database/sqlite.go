@@ -0,0 +1,423 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"modernc.org/sqlite"
+
+	"github.com/mkseven15/whitelist-server/internal/hwid"
+)
+
+const sqliteDriverName = "whitelist-sqlite"
+
+// SQLite has no time type, so times are stored as UTC text in this layout:
+// fixed width, so comparing and sorting the text orders the times. Column
+// defaults write the same layout with strftime('%Y-%m-%d %H:%M:%f+00:00').
+const timeLayout = "2006-01-02 15:04:05.000+00:00"
+
+// Stand-ins for Postgres' 'infinity' and '-infinity' timestamps
+const (
+	maxTime = "9999-12-31 23:59:59.999+00:00"
+	minTime = "0001-01-01 00:00:00.000+00:00"
+)
+
+var timeText = regexp.MustCompile(`^\d{4}-\d\d-\d\d \d\d:\d\d:\d\d\.\d{3}\+00:00$`)
+
+var intervalUnits = map[string]int{"second": 1, "minute": 60, "hour": 3600, "day": 86400}
+
+// sqliteLeftovers is the Postgres syntax SQLite lacks beyond postgresOnly.
+var sqliteLeftovers = []leftover{
+	pgOnly(`\bFOR\s+(?:NO\s+KEY\s+)?(?:UPDATE|SHARE)\b|\bSKIP\s+LOCKED\b|\bNOWAIT\b`, "a row lock"),
+	pgOnly(`\b(?:GREATEST|LEAST)\s*\(`, "GREATEST() or LEAST()"),
+}
+
+// sqliteRewriter translates queries for SQLite, which runs the functions
+// registered below in place of Postgres' operators.
+var sqliteRewriter = newRewriter("SQLite", sqliteLeftovers,
+	rewriteArrays(func(distinct, expr, rest, order string) string {
+		if order != "" {
+			order = " " + order
+		}
+		return "COALESCE((SELECT pg_array_agg(" + distinct + expr + order + ")" + rest + "), '{}')"
+	}),
+	replaceFunc(`NOW\(\) - INTERVAL '(\d+) (second|minute|hour|day)s?'`, func(m []string) string {
+		n, _ := strconv.Atoi(m[1])
+		return "NOW(" + strconv.Itoa(-n*intervalUnits[m[2]]) + ")"
+	}),
+	replace(`'-infinity'::timestamptz`, "'"+minTime+"'"),
+	replace(`'infinity'::timestamptz`, "'"+maxTime+"'"),
+	// Times are stored in UTC, so the date is the text's first 10 characters
+	replace(`to_char\(([\w.]+)(?: AT TIME ZONE 'UTC')?, 'YYYY-MM-DD'\)`, "substr($1, 1, 10)"),
+	replace(`\(NOW\(\) AT TIME ZONE 'UTC'\)::date`, "substr(NOW(), 1, 10)"),
+	replace(`(\$\d+)::date`, "substr($1, 1, 10)"),
+	replace(`unnest\((\$\d+)::text\[\]\) (\w+)`, "(SELECT value AS $2 FROM json_each(pg_array_json($1))) $2"),
+	replace(`([\w.]+) = ANY\(([\w.$]+)\)`, "pg_array_contains($2, $1)"),
+	replace(`([\w.]+) @> (\$\d+)(?:::jsonb)?`, "pg_jsonb_contains($1, $2)"),
+	outsideQuotes(replace(`::\w+(\[\])?`, "")),
+	// SQLite's LIKE and LOWER only fold ASCII letters
+	replace(`([\w.]+) ILIKE (\$\d+)`, "pg_lower($1) LIKE pg_lower($2)"),
+	// Transactions lock the whole database for writing instead
+	replace(`\s+FOR UPDATE\b`, ""),
+	// SQLite's RETURNING can't see a DELETE's table alias
+	dropDeleteAlias,
+)
+
+func init() {
+	sql.Register(sqliteDriverName, sqliteDriver{})
+
+	sqlite.MustRegisterFunction("now", &sqlite.FunctionImpl{
+		// NOW(n) is n seconds from now, for NOW() - INTERVAL
+		NArgs: -1,
+		Scalar: func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+			now := time.Now()
+			if len(args) == 1 {
+				secs, _ := args[0].(int64)
+				now = now.Add(time.Duration(secs) * time.Second)
+			}
+			return formatTime(now), nil
+		},
+	})
+	sqlite.MustRegisterDeterministicScalarFunction("reverse", 1, func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		s, ok := args[0].(string)
+		if !ok {
+			return args[0], nil
+		}
+		r := []rune(s)
+		for i, j := 0, len(r)-1; i < j; i, j = i+1, j-1 {
+			r[i], r[j] = r[j], r[i]
+		}
+		return string(r), nil
+	})
+	sqlite.MustRegisterFunction("pg_array_agg", &sqlite.FunctionImpl{
+		NArgs: 1,
+		MakeAggregate: func(sqlite.FunctionContext) (sqlite.AggregateFunction, error) {
+			return &arrayAgg{}, nil
+		},
+	})
+	sqlite.MustRegisterDeterministicScalarFunction("pg_array_contains", 2, func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		arr, err := scanArray(args[0])
+		if err != nil || args[1] == nil {
+			return nil, err
+		}
+		want := fmt.Sprint(args[1])
+		for _, v := range arr {
+			if v == want {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	sqlite.MustRegisterDeterministicScalarFunction("pg_array_json", 1, func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		arr, err := scanArray(args[0])
+		if err != nil {
+			return nil, err
+		}
+		if arr == nil {
+			arr = []string{}
+		}
+		b, err := json.Marshal(arr)
+		return string(b), err
+	})
+	sqlite.MustRegisterDeterministicScalarFunction("pg_jsonb_contains", 2, func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		if args[0] == nil || args[1] == nil {
+			return nil, nil
+		}
+		var doc, pattern interface{}
+		if err := json.Unmarshal([]byte(fmt.Sprint(args[0])), &doc); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(fmt.Sprint(args[1])), &pattern); err != nil {
+			return nil, err
+		}
+		return jsonContains(doc, pattern), nil
+	})
+	sqlite.MustRegisterDeterministicScalarFunction("pg_lower", 1, func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		s, ok := args[0].(string)
+		if !ok {
+			return args[0], nil
+		}
+		return strings.ToLower(s), nil
+	})
+	// For the migration hashing stored HWIDs
+	sqlite.MustRegisterDeterministicScalarFunction("hwid_hash", 1, func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		s, ok := args[0].(string)
+		if !ok {
+			return args[0], nil
+		}
+		return hwid.Hash(s), nil
+	})
+	// A SQLite database belongs to one server, so it always leads the
+	// background jobs
+	for _, name := range []string{"pg_try_advisory_lock", "pg_advisory_unlock"} {
+		sqlite.MustRegisterScalarFunction(name, 1, func(*sqlite.FunctionContext, []driver.Value) (driver.Value, error) {
+			return true, nil
+		})
+	}
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(timeLayout)
+}
+
+// sqliteDriver opens SQLite connections whose queries are rewritten from
+// Postgres (see sqliteRewriter) and whose arguments and results are converted to
+// and from the text times are stored as.
+type sqliteDriver struct{}
+
+// sqliteBase is modernc.org/sqlite's registered driver, the one holding
+// the functions registered above; a new sqlite.Driver has none.
+var sqliteBase = func() driver.Driver {
+	db, _ := sql.Open("sqlite", "")
+	defer db.Close()
+	return db.Driver()
+}()
+
+func (sqliteDriver) Open(dsn string) (driver.Conn, error) {
+	c, err := sqliteBase.Open(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &sqliteConn{c}, nil
+}
+
+// The interfaces modernc.org/sqlite connections implement, which
+// sqliteConn passes through.
+type sqliteInnerConn interface {
+	driver.Conn
+	driver.ConnBeginTx
+	driver.ConnPrepareContext
+	driver.ExecerContext
+	driver.QueryerContext
+	driver.Pinger
+	driver.SessionResetter
+	driver.Validator
+}
+
+type sqliteConn struct {
+	driver.Conn
+}
+
+func (c *sqliteConn) inner() sqliteInnerConn {
+	return c.Conn.(sqliteInnerConn)
+}
+
+func (c *sqliteConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return c.inner().BeginTx(ctx, opts)
+}
+
+func (c *sqliteConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	query, err := sqliteRewriter.rewrite(query)
+	if err != nil {
+		return nil, err
+	}
+	stmt, err := c.inner().PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &sqliteStmt{stmt}, nil
+}
+
+func (c *sqliteConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	query, err := sqliteRewriter.rewrite(query)
+	if err != nil {
+		return nil, err
+	}
+	return c.inner().ExecContext(ctx, query, args)
+}
+
+func (c *sqliteConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	query, err := sqliteRewriter.rewrite(query)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := c.inner().QueryContext(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+	return newSQLiteRows(rows), nil
+}
+
+func (c *sqliteConn) Ping(ctx context.Context) error {
+	return c.inner().Ping(ctx)
+}
+
+func (c *sqliteConn) ResetSession(ctx context.Context) error {
+	return c.inner().ResetSession(ctx)
+}
+
+func (c *sqliteConn) IsValid() bool {
+	return c.inner().IsValid()
+}
+
+// CheckNamedValue converts arguments the way lib/pq would send them, then
+// into what SQLite stores: times (and TimeArgs) as timeLayout text and bytes
+// (JSON) as text.
+func (c *sqliteConn) CheckNamedValue(nv *driver.NamedValue) error {
+	if t, ok := nv.Value.(TimeArg); ok {
+		switch t {
+		case "infinity":
+			nv.Value = maxTime
+		case "-infinity":
+			nv.Value = minTime
+		default:
+			v, err := time.Parse(time.RFC3339Nano, string(t))
+			if err != nil {
+				return err
+			}
+			nv.Value = formatTime(v)
+		}
+		return nil
+	}
+	v, err := driver.DefaultParameterConverter.ConvertValue(nv.Value)
+	if err != nil {
+		return err
+	}
+	switch v := v.(type) {
+	case time.Time:
+		nv.Value = formatTime(v)
+	case []byte:
+		if v == nil {
+			nv.Value = nil
+		} else {
+			nv.Value = string(v)
+		}
+	default:
+		nv.Value = v
+	}
+	return nil
+}
+
+type sqliteStmt struct {
+	driver.Stmt
+}
+
+func (s *sqliteStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	return s.Stmt.(driver.StmtExecContext).ExecContext(ctx, args)
+}
+
+func (s *sqliteStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	rows, err := s.Stmt.(driver.StmtQueryContext).QueryContext(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return newSQLiteRows(rows), nil
+}
+
+// sqliteRows turns time text back into times. The driver already does so
+// for columns declared as timestamps; this covers expressions and
+// RETURNING clauses, which have no declared type.
+type sqliteRows struct {
+	driver.Rows
+	untyped []bool
+}
+
+func newSQLiteRows(rows driver.Rows) *sqliteRows {
+	r := &sqliteRows{Rows: rows, untyped: make([]bool, len(rows.Columns()))}
+	if typed, ok := rows.(driver.RowsColumnTypeDatabaseTypeName); ok {
+		for i := range r.untyped {
+			r.untyped[i] = typed.ColumnTypeDatabaseTypeName(i) == ""
+		}
+	}
+	return r
+}
+
+func (r *sqliteRows) Next(dest []driver.Value) error {
+	if err := r.Rows.Next(dest); err != nil {
+		return err
+	}
+	for i, v := range dest {
+		if s, ok := v.(string); ok && r.untyped[i] && timeText.MatchString(s) {
+			if t, err := time.Parse(timeLayout, s); err == nil {
+				dest[i] = t
+			}
+		}
+	}
+	return nil
+}
+
+// arrayAgg builds a Postgres array literal, standing in for ARRAY(SELECT ...).
+type arrayAgg struct {
+	values []string
+}
+
+func (a *arrayAgg) Step(_ *sqlite.FunctionContext, args []driver.Value) error {
+	if args[0] != nil {
+		a.values = append(a.values, fmt.Sprint(args[0]))
+	}
+	return nil
+}
+
+func (a *arrayAgg) WindowInverse(*sqlite.FunctionContext, []driver.Value) error {
+	return errors.New("pg_array_agg can't be used as a window function")
+}
+
+func (a *arrayAgg) WindowValue(*sqlite.FunctionContext) (driver.Value, error) {
+	if a.values == nil {
+		return "{}", nil
+	}
+	return pq.StringArray(a.values).Value()
+}
+
+func (a *arrayAgg) Final(*sqlite.FunctionContext) {}
+
+// scanArray parses a stored Postgres array literal.
+func scanArray(v driver.Value) ([]string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var arr pq.StringArray
+	if err := arr.Scan(fmt.Sprint(v)); err != nil {
+		return nil, err
+	}
+	return arr, nil
+}
+
+// jsonContains reports whether doc contains pattern the way Postgres' jsonb
+// @> does: objects match on every key of pattern, arrays when each element
+// of pattern is contained in some element of doc, scalars when equal.
+func jsonContains(doc, pattern interface{}) bool {
+	switch p := pattern.(type) {
+	case map[string]interface{}:
+		d, ok := doc.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, pv := range p {
+			dv, ok := d[k]
+			if !ok || !jsonContains(dv, pv) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		d, ok := doc.([]interface{})
+		if !ok {
+			return false
+		}
+		for _, pv := range p {
+			found := false
+			for _, dv := range d {
+				if jsonContains(dv, pv) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	default:
+		return doc == pattern
+	}
+}
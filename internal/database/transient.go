@@ -0,0 +1,74 @@
+package database
+
+import (
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net"
+	"syscall"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	"modernc.org/sqlite"
+)
+
+// Transient reports whether err is a failure worth retrying: a
+// serialization failure or deadlock, a dropped connection or a failover in
+// progress. unapplied reports whether the statement is also known not to
+// have taken effect, so that running it again is safe even if it isn't
+// idempotent; a connection dropped mid-statement may have committed it.
+func Transient(err error) (transient, unapplied bool) {
+	if err == nil {
+		return false, false
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case "40001", "40P01": // serialization_failure, deadlock_detected
+			return true, true
+		case "57P03", "08001", "08004": // cannot_connect_now, refused connections
+			return true, true
+		case "25006": // read_only_sql_transaction: hit the old primary after a failover
+			return true, true
+		case "57P01", "57P02": // admin_shutdown, crash_shutdown
+			return true, false
+		}
+		return pqErr.Code.Class() == "08", false // connection_exception
+	}
+
+	var liteErr *sqlite.Error
+	if errors.As(err, &liteErr) {
+		switch liteErr.Code() & 0xff {
+		case 5, 6: // SQLITE_BUSY, SQLITE_LOCKED
+			return true, true
+		}
+		return false, false
+	}
+
+	var myErr *mysql.MySQLError
+	if errors.As(err, &myErr) {
+		switch myErr.Number {
+		case 1205, 1213: // lock wait timeout, deadlock
+			return true, true
+		case 1290, 1836: // read-only server, read-only mode
+			return true, true
+		}
+		return false, false
+	}
+
+	// Connections refused never reached the database; anything else on the
+	// wire may have happened after it ran the statement.
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		return true, true
+	}
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, mysql.ErrInvalidConn) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET) {
+		return true, false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true, false
+	}
+	return false, false
+}
@@ -0,0 +1,272 @@
+// Package discordbot exposes a few license admin tasks as Discord slash
+// commands. Commands call the service in-process with admin credentials, so
+// they go through the same validation and audit log as the HTTP API.
+package discordbot
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+const (
+	commandName    = "license"
+	commandTimeout = 30 * time.Second
+)
+
+// Bot is a connected Discord session serving the /license command.
+type Bot struct {
+	session     *discordgo.Session
+	svc         pb.WhitelistServiceServer
+	adminSecret string
+	guildID     string
+	roles       map[string]bool
+}
+
+// Start connects to Discord and registers the slash commands. When guildID is
+// set they're registered for that server only (they show up immediately);
+// otherwise globally. Only members holding one of adminRoles (role IDs) may
+// use them.
+func Start(token, guildID string, adminRoles []string, adminSecret string, svc pb.WhitelistServiceServer) (*Bot, error) {
+	session, err := discordgo.New("Bot " + token)
+	if err != nil {
+		return nil, err
+	}
+	b := &Bot{
+		session:     session,
+		svc:         svc,
+		adminSecret: adminSecret,
+		guildID:     guildID,
+		roles:       make(map[string]bool),
+	}
+	for _, r := range adminRoles {
+		b.roles[r] = true
+	}
+
+	session.AddHandler(b.onInteraction)
+	if err := session.Open(); err != nil {
+		return nil, fmt.Errorf("open discord session: %w", err)
+	}
+	if _, err := session.ApplicationCommandBulkOverwrite(session.State.User.ID, guildID, commands); err != nil {
+		session.Close()
+		return nil, fmt.Errorf("register commands: %w", err)
+	}
+	return b, nil
+}
+
+// DirectMessage sends text to a user by DM. It fails if the user doesn't share
+// a server with the bot or has DMs from it turned off.
+func (b *Bot) DirectMessage(userID, text string) error {
+	channel, err := b.session.UserChannelCreate(userID)
+	if err != nil {
+		return err
+	}
+	_, err = b.session.ChannelMessageSend(channel.ID, text)
+	return err
+}
+
+// Close disconnects from Discord. The registered commands are left in place.
+func (b *Bot) Close() error {
+	if b == nil {
+		return nil
+	}
+	return b.session.Close()
+}
+
+var commands = []*discordgo.ApplicationCommand{{
+	Name:        commandName,
+	Description: "Manage licenses",
+	Options: []*discordgo.ApplicationCommandOption{
+		{
+			Type:        discordgo.ApplicationCommandOptionSubCommand,
+			Name:        "create",
+			Description: "Generate a new license key",
+			Options: []*discordgo.ApplicationCommandOption{
+				{Type: discordgo.ApplicationCommandOptionString, Name: "product", Description: "Product ID", Required: true},
+				{Type: discordgo.ApplicationCommandOptionInteger, Name: "days", Description: "Days until expiry (default never)", MinValue: floatPtr(1)},
+				{Type: discordgo.ApplicationCommandOptionInteger, Name: "max_devices", Description: "Devices per key (default 1)", MinValue: floatPtr(1)},
+			},
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionSubCommand,
+			Name:        "reset-hwid",
+			Description: "Unbind devices from a license",
+			Options: []*discordgo.ApplicationCommandOption{
+				{Type: discordgo.ApplicationCommandOptionString, Name: "key", Description: "License key", Required: true},
+				{Type: discordgo.ApplicationCommandOptionString, Name: "hwid", Description: "Only unbind this device (default all)"},
+			},
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionSubCommand,
+			Name:        "lookup",
+			Description: "Show a license's status",
+			Options: []*discordgo.ApplicationCommandOption{
+				{Type: discordgo.ApplicationCommandOptionString, Name: "key", Description: "License key", Required: true},
+			},
+		},
+	},
+}}
+
+func floatPtr(f float64) *float64 { return &f }
+
+func (b *Bot) onInteraction(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if i.Type != discordgo.InteractionApplicationCommand || i.ApplicationCommandData().Name != commandName {
+		return
+	}
+	// Member is nil for commands used in DMs, where roles can't be checked
+	if i.Member == nil || !b.authorized(i.Member) {
+		b.reply(i, "You don't have permission to manage licenses.")
+		return
+	}
+
+	// Acknowledge first; Discord drops interactions not answered within 3s
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral},
+	})
+	if err != nil {
+		log.Printf("discord: defer response: %v", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+	actor := "discord:" + i.Member.User.Username
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-admin-secret", b.adminSecret, "x-admin-actor", actor))
+
+	sub := i.ApplicationCommandData().Options[0]
+	opts := map[string]*discordgo.ApplicationCommandInteractionDataOption{}
+	for _, o := range sub.Options {
+		opts[o.Name] = o
+	}
+
+	var edit *discordgo.WebhookEdit
+	switch sub.Name {
+	case "create":
+		edit = b.create(ctx, opts)
+	case "reset-hwid":
+		edit = b.resetHwid(ctx, actor, opts)
+	case "lookup":
+		edit = b.lookup(ctx, opts)
+	default:
+		edit = text("Unknown command.")
+	}
+	if _, err := s.InteractionResponseEdit(i.Interaction, edit); err != nil {
+		log.Printf("discord: edit response: %v", err)
+	}
+}
+
+func (b *Bot) authorized(m *discordgo.Member) bool {
+	for _, r := range m.Roles {
+		if b.roles[r] {
+			return true
+		}
+	}
+	return false
+}
+
+// create generates a single key: more at once need a TOTP code, which the
+// bot's shared admin secret can't have.
+func (b *Bot) create(ctx context.Context, opts map[string]*discordgo.ApplicationCommandInteractionDataOption) *discordgo.WebhookEdit {
+	req := &pb.GenerateLicensesRequest{ProductId: opts["product"].StringValue(), Count: 1, IsActive: true}
+	if o, ok := opts["days"]; ok {
+		req.ExpiresAt = timestamppb.New(time.Now().AddDate(0, 0, int(o.IntValue())))
+	}
+	if o, ok := opts["max_devices"]; ok {
+		req.MaxDevices = int32(o.IntValue())
+	}
+
+	resp, err := b.svc.GenerateLicenses(ctx, req)
+	if err != nil {
+		return failure(err)
+	}
+	return text(fmt.Sprintf("Created a key for `%s`.\n```\n%s\n```", req.ProductId, strings.Join(resp.LicenseKeys, "\n")))
+}
+
+func (b *Bot) resetHwid(ctx context.Context, actor string, opts map[string]*discordgo.ApplicationCommandInteractionDataOption) *discordgo.WebhookEdit {
+	req := &pb.ResetHwidRequest{LicenseKey: opts["key"].StringValue(), Actor: actor}
+	if o, ok := opts["hwid"]; ok {
+		req.Hwid = o.StringValue()
+	}
+	if _, err := b.svc.ResetHwid(ctx, req); err != nil {
+		return failure(err)
+	}
+	if req.Hwid != "" {
+		return text(fmt.Sprintf("Unbound `%s` from `%s`.", req.Hwid, req.LicenseKey))
+	}
+	return text(fmt.Sprintf("Unbound all devices from `%s`.", req.LicenseKey))
+}
+
+func (b *Bot) lookup(ctx context.Context, opts map[string]*discordgo.ApplicationCommandInteractionDataOption) *discordgo.WebhookEdit {
+	l, err := b.svc.GetLicense(ctx, &pb.GetLicenseRequest{LicenseKey: opts["key"].StringValue()})
+	if err != nil {
+		return failure(err)
+	}
+
+	state := "active"
+	if !l.IsActive {
+		state = "suspended"
+		if l.SuspendReason != "" {
+			state += " (" + l.SuspendReason + ")"
+		}
+	} else if l.ExpiresAt != nil && l.ExpiresAt.AsTime().Before(time.Now()) {
+		state = "expired"
+	}
+	expires := "never"
+	if l.ExpiresAt != nil {
+		expires = fmt.Sprintf("<t:%d:R>", l.ExpiresAt.AsTime().Unix())
+	}
+	lastSeen := "never"
+	if l.LastValidatedAt != nil {
+		lastSeen = fmt.Sprintf("<t:%d:R>", l.LastValidatedAt.AsTime().Unix())
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "**`%s`** (%s)\n", l.LicenseKey, l.ProductId)
+	fmt.Fprintf(&sb, "Status: %s\nExpires: %s\nLast validated: %s\n", state, expires, lastSeen)
+	if l.Plan != "" {
+		fmt.Fprintf(&sb, "Plan: %s\n", l.Plan)
+	}
+	if l.MaxSessions > 0 {
+		fmt.Fprintf(&sb, "Sessions: %d/%d\n", l.ActiveSessions, l.MaxSessions)
+	}
+	if l.FloatingSeats > 0 {
+		fmt.Fprintf(&sb, "Floating seats: %d/%d\n", l.ActiveLeases, l.FloatingSeats)
+	}
+	if l.Credits > 0 {
+		fmt.Fprintf(&sb, "Credits: %d\n", l.Credits)
+	}
+	fmt.Fprintf(&sb, "Devices: %d/%d", len(l.Hwids), l.MaxDevices)
+	for _, h := range l.Hwids {
+		fmt.Fprintf(&sb, "\n- `%s`", h)
+	}
+	return text(sb.String())
+}
+
+// reply answers an interaction immediately with an ephemeral message.
+func (b *Bot) reply(i *discordgo.InteractionCreate, msg string) {
+	err := b.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Content: msg, Flags: discordgo.MessageFlagsEphemeral},
+	})
+	if err != nil {
+		log.Printf("discord: respond: %v", err)
+	}
+}
+
+func text(msg string) *discordgo.WebhookEdit {
+	return &discordgo.WebhookEdit{Content: &msg}
+}
+
+func failure(err error) *discordgo.WebhookEdit {
+	return text("Failed: " + status.Convert(err).Message())
+}
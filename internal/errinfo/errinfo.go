@@ -0,0 +1,36 @@
+// Package errinfo gives the errors clients can run into a
+// google.rpc.ErrorInfo detail naming a whitelist.Reason, so they can tell
+// an expired token from a rate limit without matching on the message.
+package errinfo
+
+import (
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// Domain is the ErrorInfo domain of the server's errors.
+const Domain = "whitelist"
+
+// Error returns a status error with code and msg, carrying reason.
+func Error(code codes.Code, reason pb.Reason, msg string) error {
+	return Status(code, reason, msg).Err()
+}
+
+// Errorf is Error with a formatted message.
+func Errorf(code codes.Code, reason pb.Reason, format string, args ...interface{}) error {
+	return Error(code, reason, fmt.Sprintf(format, args...))
+}
+
+// Status returns the status Error would, for callers adding more details.
+func Status(code codes.Code, reason pb.Reason, msg string) *status.Status {
+	st := status.New(code, msg)
+	if withInfo, err := st.WithDetails(&errdetails.ErrorInfo{Reason: reason.String(), Domain: Domain}); err == nil {
+		st = withInfo
+	}
+	return st
+}
@@ -0,0 +1,47 @@
+// Package geoip resolves client addresses to countries with a MaxMind
+// GeoLite2 (or GeoIP2) Country or City database.
+package geoip
+
+import (
+	"net"
+
+	"github.com/oschwald/geoip2-golang"
+)
+
+// DB looks up countries. A nil *DB knows no countries.
+type DB struct {
+	reader *geoip2.Reader
+}
+
+// Open memory-maps the .mmdb file at path.
+func Open(path string) (*DB, error) {
+	r, err := geoip2.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return &DB{reader: r}, nil
+}
+
+// Country returns the ISO 3166-1 alpha-2 code of ip's country, or "" when
+// it's unknown (private ranges, unparsable addresses, no database).
+func (db *DB) Country(ip string) string {
+	if db == nil {
+		return ""
+	}
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return ""
+	}
+	rec, err := db.reader.Country(addr)
+	if err != nil {
+		return ""
+	}
+	return rec.Country.IsoCode
+}
+
+func (db *DB) Close() error {
+	if db == nil {
+		return nil
+	}
+	return db.reader.Close()
+}
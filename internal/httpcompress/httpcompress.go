@@ -0,0 +1,184 @@
+// Package httpcompress compresses the gateway's responses with brotli or
+// gzip, whichever the client prefers.
+//
+// gRPC, gRPC-Web and Connect calls are left alone: those protocols negotiate
+// compression themselves.
+package httpcompress
+
+import (
+	"compress/gzip"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+)
+
+// Middleware compresses responses of at least minSize bytes whose content
+// type is worth compressing (JSON, text, JavaScript, CSV, SVG). Smaller ones
+// are sent as they are. A response flushed before reaching minSize, like a
+// WatchLicense stream, stays uncompressed.
+func Middleware(minSize int, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		encoding := negotiate(r.Header.Get("Accept-Encoding"))
+		if encoding == "" || ownCompression(r) {
+			h.ServeHTTP(w, r)
+			return
+		}
+		cw := &writer{ResponseWriter: w, encoding: encoding, minSize: minSize, status: http.StatusOK}
+		defer cw.close()
+		h.ServeHTTP(cw, r)
+	})
+}
+
+// negotiate returns "br", "gzip" or, when the client accepts neither, "".
+func negotiate(accept string) string {
+	var br, gz bool
+	for _, part := range strings.Split(accept, ",") {
+		name, params, _ := strings.Cut(part, ";")
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if v, err := strconv.ParseFloat(q, 64); err == nil && v == 0 {
+				continue
+			}
+		}
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "br":
+			br = true
+		case "gzip", "*":
+			gz = true
+		}
+	}
+	switch {
+	case br:
+		return "br"
+	case gz:
+		return "gzip"
+	}
+	return ""
+}
+
+// ownCompression reports whether r is a call in a protocol that compresses
+// on its own.
+func ownCompression(r *http.Request) bool {
+	ct := r.Header.Get("Content-Type")
+	return strings.HasPrefix(ct, "application/grpc") ||
+		strings.HasPrefix(ct, "application/connect") ||
+		r.Header.Get("Connect-Protocol-Version") != ""
+}
+
+func compressible(contentType string) bool {
+	mt, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	switch {
+	case strings.HasPrefix(mt, "text/"),
+		mt == "application/json",
+		strings.HasSuffix(mt, "+json"),
+		mt == "application/javascript",
+		mt == "image/svg+xml":
+		return true
+	}
+	return false
+}
+
+// encoder is the part of gzip.Writer and brotli.Writer the writer uses.
+type encoder interface {
+	io.WriteCloser
+	Flush() error
+}
+
+// writer holds back the start of a response until it knows whether to
+// compress it: once minSize bytes are written, or when the response is
+// flushed or finished.
+type writer struct {
+	http.ResponseWriter
+	encoding string
+	minSize  int
+	status   int
+	buf      []byte
+	decided  bool
+	enc      encoder
+}
+
+func (w *writer) WriteHeader(status int) {
+	if !w.decided {
+		w.status = status
+	}
+}
+
+func (w *writer) Write(p []byte) (int, error) {
+	if !w.decided {
+		w.buf = append(w.buf, p...)
+		if len(w.buf) < w.minSize {
+			return len(p), nil
+		}
+		if err := w.decide(); err != nil {
+			return 0, err
+		}
+		return len(p), nil
+	}
+	if w.enc != nil {
+		return w.enc.Write(p)
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+// decide sends the header, compressing the body if it's large and
+// compressible enough, then whatever was held back.
+func (w *writer) decide() error {
+	w.decided = true
+	h := w.Header()
+	if compressible(h.Get("Content-Type")) {
+		h.Add("Vary", "Accept-Encoding")
+		if len(w.buf) >= w.minSize && h.Get("Content-Encoding") == "" && h.Get("Content-Range") == "" &&
+			w.status != http.StatusNoContent && w.status != http.StatusNotModified {
+			h.Del("Content-Length")
+			h.Set("Content-Encoding", w.encoding)
+			if w.encoding == "br" {
+				w.enc = brotli.NewWriter(w.ResponseWriter)
+			} else {
+				w.enc = gzip.NewWriter(w.ResponseWriter)
+			}
+		}
+	}
+	w.ResponseWriter.WriteHeader(w.status)
+	buf := w.buf
+	w.buf = nil
+	if len(buf) == 0 {
+		return nil
+	}
+	var err error
+	if w.enc != nil {
+		_, err = w.enc.Write(buf)
+	} else {
+		_, err = w.ResponseWriter.Write(buf)
+	}
+	return err
+}
+
+func (w *writer) Flush() {
+	if !w.decided {
+		w.decide()
+	}
+	if w.enc != nil {
+		w.enc.Flush()
+	}
+	http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+func (w *writer) close() {
+	if !w.decided {
+		w.decide()
+	}
+	if w.enc != nil {
+		w.enc.Close()
+	}
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (w *writer) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
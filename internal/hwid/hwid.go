@@ -0,0 +1,35 @@
+// Package hwid turns the hardware ids clients send into the form the server
+// stores and compares: trimmed, lowercased and hashed, so "ABC-123 " and
+// "abc-123" are the same device and the database holds no raw ids.
+package hwid
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// Prefix starts every stored HWID.
+const Prefix = "sha256:"
+
+// Hash returns the stored form of id: Prefix and the hex SHA-256 of id
+// without surrounding space, lowercased. A blank id stays "", and one
+// already in stored form is returned as is, so hashing twice is harmless and
+// admins can pass on the HWIDs they see.
+func Hash(id string) string {
+	id = strings.ToLower(strings.TrimSpace(id))
+	if id == "" || isHashed(id) {
+		return id
+	}
+	sum := sha256.Sum256([]byte(id))
+	return Prefix + hex.EncodeToString(sum[:])
+}
+
+func isHashed(id string) bool {
+	digest, ok := strings.CutPrefix(id, Prefix)
+	if !ok || len(digest) != 2*sha256.Size {
+		return false
+	}
+	_, err := hex.DecodeString(digest)
+	return err == nil
+}
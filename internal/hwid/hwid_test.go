@@ -0,0 +1,30 @@
+package hwid
+
+import "testing"
+
+func TestHash(t *testing.T) {
+	// SHA-256 of "abc"
+	const abc = Prefix + "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"
+	tests := []struct {
+		name, in, want string
+	}{
+		{"raw", "abc", abc},
+		{"case and space", " ABC\t", abc},
+		{"empty", "", ""},
+		{"blank", " \n", ""},
+		{"already hashed", abc, abc},
+		{"hashed, uppercase", " SHA256:BA7816BF8F01CFEA414140DE5DAE2223B00361A396177A9CB410FF61F20015AD", abc},
+		// Only a full digest counts as hashed
+		{"prefix only", "sha256:abc", Prefix + "67e9bc3cfd2163c2978358dfe00d2f912cd4ee0c99f077c3583b39b48aebb124"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Hash(tt.in); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+	if Hash(Hash("abc")) != Hash("abc") {
+		t.Error("hashing twice changed the HWID")
+	}
+}
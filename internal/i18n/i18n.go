@@ -0,0 +1,114 @@
+// Package i18n holds the translations of the messages clients get with a
+// validation or session answer, one JSON file per locale in messages/ keyed
+// by Reason name. en.json is the text the server has always sent.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// DefaultLocale is used for requests without a locale, or with one that
+// has no messages.
+const DefaultLocale = "en"
+
+//go:embed messages/*.json
+var messageFiles embed.FS
+
+// Catalog holds messages by locale and reason.
+type Catalog map[string]map[pb.Reason]string
+
+var builtin = mustLoad()
+
+// Builtin returns the bundled catalog. It must not be modified.
+func Builtin() Catalog {
+	return builtin
+}
+
+func mustLoad() Catalog {
+	entries, err := messageFiles.ReadDir("messages")
+	if err != nil {
+		panic(err)
+	}
+	c := Catalog{}
+	for _, e := range entries {
+		data, err := messageFiles.ReadFile(path.Join("messages", e.Name()))
+		if err != nil {
+			panic(err)
+		}
+		var byName map[string]string
+		if err := json.Unmarshal(data, &byName); err != nil {
+			panic(fmt.Sprintf("i18n: %s: %v", e.Name(), err))
+		}
+		locale := strings.TrimSuffix(e.Name(), ".json")
+		c[locale] = make(map[pb.Reason]string, len(byName))
+		for name, text := range byName {
+			reason, ok := pb.Reason_value[name]
+			if !ok {
+				panic(fmt.Sprintf("i18n: %s: unknown reason %q", e.Name(), name))
+			}
+			c[locale][pb.Reason(reason)] = text
+		}
+	}
+	return c
+}
+
+// Has reports whether the catalog has a message for reason in any locale,
+// i.e. whether it's a reason with a translatable message at all.
+func (c Catalog) Has(reason pb.Reason) bool {
+	_, ok := c[DefaultLocale][reason]
+	return ok
+}
+
+// Message returns c's text for reason in the locale closest to the
+// requested one, and whether there was one.
+func (c Catalog) Message(locale string, reason pb.Reason) (string, bool) {
+	if len(c) == 0 {
+		return "", false
+	}
+	locales := make([]string, 0, len(c))
+	for l := range c {
+		locales = append(locales, l)
+	}
+	slices.Sort(locales)
+	match := Match(locale, locales)
+	if match == "" {
+		return "", false
+	}
+	text, ok := c[match][reason]
+	return text, ok
+}
+
+// Match returns the locale in available that best fits requested: the same
+// tag ignoring case (and "_" for "-"), else the bare language, else the
+// first with the same language. "" when none does.
+func Match(requested string, available []string) string {
+	want := normalize(requested)
+	if want == "" {
+		return ""
+	}
+	lang, _, _ := strings.Cut(want, "-")
+	var sameLang string
+	for _, l := range available {
+		have := normalize(l)
+		if have == want {
+			return l
+		}
+		if have == lang {
+			sameLang = l
+		} else if haveLang, _, _ := strings.Cut(have, "-"); haveLang == lang && sameLang == "" {
+			sameLang = l
+		}
+	}
+	return sameLang
+}
+
+func normalize(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
@@ -0,0 +1,113 @@
+// Package ipban turns away calls from banned client addresses before they
+// reach the public RPCs.
+//
+// The List itself is only a snapshot; its owner reloads it from the database
+// whenever bans change.
+package ipban
+
+import (
+	"context"
+	"net/netip"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/mkseven15/whitelist-server/internal/clientip"
+	"github.com/mkseven15/whitelist-server/internal/errinfo"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// List is a set of banned networks, safe for concurrent use.
+type List struct {
+	mu       sync.RWMutex
+	prefixes []netip.Prefix
+}
+
+func NewList() *List {
+	return &List{}
+}
+
+// Set replaces the banned networks.
+func (l *List) Set(prefixes []netip.Prefix) {
+	l.mu.Lock()
+	l.prefixes = prefixes
+	l.mu.Unlock()
+}
+
+// Contains reports whether ip falls in a banned network. Addresses that don't
+// parse are never banned.
+func (l *List) Contains(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	for _, p := range l.prefixes {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParsePrefix accepts a CIDR ("203.0.113.0/24") or a single address, which
+// becomes a /32 or /128. Host bits are cleared.
+func ParsePrefix(s string) (netip.Prefix, error) {
+	s = strings.TrimSpace(s)
+	if !strings.Contains(s, "/") {
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		addr = addr.Unmap()
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+	p, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	// Clients are matched by their unmapped address, so ::ffff:a.b.c.d/104
+	// has to become a.b.c.d/8
+	if p.Addr().Is4In6() && p.Bits() >= 96 {
+		p = netip.PrefixFrom(p.Addr().Unmap(), p.Bits()-96)
+	}
+	return p.Masked(), nil
+}
+
+var errBanned = errinfo.Error(codes.PermissionDenied, pb.Reason_REASON_ADDRESS_BANNED, "your address is banned")
+
+// UnaryServerInterceptor rejects calls to the given full method names from
+// banned addresses with PermissionDenied (HTTP 403 through the gateway).
+func UnaryServerInterceptor(l *List, methods ...string) grpc.UnaryServerInterceptor {
+	guarded := methodSet(methods)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if guarded[info.FullMethod] && l.Contains(clientip.FromContext(ctx)) {
+			return nil, errBanned
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is UnaryServerInterceptor for streaming methods.
+func StreamServerInterceptor(l *List, methods ...string) grpc.StreamServerInterceptor {
+	guarded := methodSet(methods)
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if guarded[info.FullMethod] && l.Contains(clientip.FromContext(ss.Context())) {
+			return errBanned
+		}
+		return handler(srv, ss)
+	}
+}
+
+func methodSet(methods []string) map[string]bool {
+	set := make(map[string]bool, len(methods))
+	for _, m := range methods {
+		set[m] = true
+	}
+	return set
+}
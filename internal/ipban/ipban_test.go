@@ -0,0 +1,72 @@
+package ipban
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestParsePrefix(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"203.0.113.7", "203.0.113.7/32"},
+		{"203.0.113.7/24", "203.0.113.0/24"},
+		{"2001:db8::1", "2001:db8::1/128"},
+		{"::ffff:203.0.113.7", "203.0.113.7/32"},
+		{"::ffff:203.0.113.0/120", "203.0.113.0/24"},
+	}
+	for _, tt := range tests {
+		p, err := ParsePrefix(tt.in)
+		if err != nil || p.String() != tt.want {
+			t.Errorf("ParsePrefix(%q) = %v, %v; want %s", tt.in, p, err, tt.want)
+		}
+	}
+	if _, err := ParsePrefix("not an address"); err == nil {
+		t.Error("ParsePrefix accepted garbage")
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	l := NewList()
+	l.Set([]netip.Prefix{netip.MustParsePrefix("203.0.113.0/24")})
+	intercept := UnaryServerInterceptor(l, "/svc/Guarded")
+	call := func(ctx context.Context, method string) error {
+		_, err := intercept(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		return err
+	}
+	from := func(ip string, fwd ...string) context.Context {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 1234}})
+		if len(fwd) > 0 {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", fwd[0]))
+		}
+		return ctx
+	}
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		banned bool
+	}{
+		{"banned", from("203.0.113.9"), "/svc/Guarded", true},
+		{"not banned", from("198.51.100.1"), "/svc/Guarded", false},
+		{"other method", from("203.0.113.9"), "/svc/Other", false},
+		// The header is no way around a ban, nor a way to get someone banned
+		{"banned claiming another address", from("203.0.113.9", "198.51.100.1"), "/svc/Guarded", true},
+		{"claiming a banned address", from("198.51.100.1", "203.0.113.9"), "/svc/Guarded", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := call(tt.ctx, tt.method); (err != nil) != tt.banned {
+				t.Errorf("got %v, want banned %v", err, tt.banned)
+			}
+		})
+	}
+}
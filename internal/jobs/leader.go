@@ -0,0 +1,90 @@
+// Package jobs picks one replica to run the background jobs (cleanup,
+// expiry reminders) with a Postgres advisory lock, so the others don't
+// repeat its deletes and notifications.
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"hash/fnv"
+	"log"
+	"sync"
+
+	"github.com/mkseven15/whitelist-server/internal/metrics"
+)
+
+var leaderGauge = metrics.NewGauge("whitelist_jobs_leader", "1 while this replica runs the background jobs.", "lock")
+
+// Leader holds a session-level advisory lock on a connection of its own
+// while this replica is the leader. If that connection dies, Postgres drops
+// the lock and another replica takes over on its next check.
+type Leader struct {
+	db   *sql.DB
+	name string
+	key  int64
+
+	mu   sync.Mutex
+	conn *sql.Conn
+}
+
+// NewLeader returns a Leader for the lock named name; replicas using the
+// same name elect one leader between them.
+func NewLeader(db *sql.DB, name string) *Leader {
+	h := fnv.New64a()
+	h.Write([]byte(name))
+	return &Leader{db: db, name: name, key: int64(h.Sum64())}
+}
+
+// IsLeader reports whether this replica is the leader, taking the lock if
+// it's free and checking it still holds it otherwise. Jobs call it before
+// every run.
+func (l *Leader) IsLeader(ctx context.Context) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn != nil {
+		err := l.conn.PingContext(ctx)
+		if err == nil {
+			return true
+		}
+		log.Printf("Lost %s leadership: %v", l.name, err)
+		l.conn.Close()
+		l.conn = nil
+		leaderGauge.Set(0, l.name)
+		return false
+	}
+
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		log.Printf("Error electing %s leader: %v", l.name, err)
+		return false
+	}
+	var locked bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", l.key).Scan(&locked); err != nil || !locked {
+		if err != nil {
+			log.Printf("Error electing %s leader: %v", l.name, err)
+		}
+		conn.Close()
+		return false
+	}
+	log.Printf("This replica now runs %s", l.name)
+	l.conn = conn
+	leaderGauge.Set(1, l.name)
+	return true
+}
+
+// Release gives up the lock, if held, so another replica can take over
+// right away.
+func (l *Leader) Release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.conn == nil {
+		return
+	}
+	if _, err := l.conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", l.key); err != nil {
+		log.Printf("Error releasing %s leadership: %v", l.name, err)
+	}
+	l.conn.Close()
+	l.conn = nil
+	leaderGauge.Set(0, l.name)
+}
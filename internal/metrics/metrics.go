@@ -0,0 +1,112 @@
+// Package metrics keeps the server's counters and gauges in memory and
+// serves them in the Prometheus text format.
+package metrics
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Path is where Handler is served.
+const Path = "/metrics"
+
+var (
+	mu       sync.Mutex
+	families []*Vec
+)
+
+// Vec is a metric with a value per combination of label values.
+type Vec struct {
+	name, help, kind string
+	labels           []string
+
+	mu     sync.Mutex
+	values map[string]float64
+}
+
+// NewCounter registers a counter, which only goes up.
+func NewCounter(name, help string, labels ...string) *Vec {
+	return register(name, help, "counter", labels)
+}
+
+// NewGauge registers a gauge, which is set to the latest value.
+func NewGauge(name, help string, labels ...string) *Vec {
+	return register(name, help, "gauge", labels)
+}
+
+func register(name, help, kind string, labels []string) *Vec {
+	v := &Vec{name: name, help: help, kind: kind, labels: labels, values: map[string]float64{}}
+	mu.Lock()
+	families = append(families, v)
+	mu.Unlock()
+	return v
+}
+
+// Add adds n to the value for labelValues, given in the order the labels
+// were registered.
+func (v *Vec) Add(n float64, labelValues ...string) {
+	key := v.key(labelValues)
+	v.mu.Lock()
+	v.values[key] += n
+	v.mu.Unlock()
+}
+
+// Set replaces the value for labelValues.
+func (v *Vec) Set(n float64, labelValues ...string) {
+	key := v.key(labelValues)
+	v.mu.Lock()
+	v.values[key] = n
+	v.mu.Unlock()
+}
+
+// key renders the label set as it appears in the output, e.g.
+// {table="access_tokens"}.
+func (v *Vec) key(labelValues []string) string {
+	if len(labelValues) != len(v.labels) {
+		panic(fmt.Sprintf("metrics: %s takes %d label values, got %d", v.name, len(v.labels), len(labelValues)))
+	}
+	if len(v.labels) == 0 {
+		return ""
+	}
+	pairs := make([]string, len(v.labels))
+	for i, l := range v.labels {
+		pairs[i] = l + "=" + strconv.Quote(labelValues[i])
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+// Handler serves every registered metric. With a token, requests must carry
+// it as "Authorization: Bearer <token>".
+func Handler(token string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if token != "" && subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte("Bearer "+token)) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+
+		mu.Lock()
+		all := append([]*Vec(nil), families...)
+		mu.Unlock()
+		var b strings.Builder
+		for _, v := range all {
+			fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s %s\n", v.name, v.help, v.name, v.kind)
+			v.mu.Lock()
+			keys := make([]string, 0, len(v.values))
+			for k := range v.values {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				fmt.Fprintf(&b, "%s%s %s\n", v.name, k, strconv.FormatFloat(v.values[k], 'g', -1, 64))
+			}
+			v.mu.Unlock()
+		}
+		w.Write([]byte(b.String()))
+	})
+}
@@ -0,0 +1,19 @@
+-- Schema as originally created by hand in Supabase.
+
+-- +goose Up
+CREATE TABLE IF NOT EXISTS api_keys (
+    key        TEXT PRIMARY KEY,
+    expires_at TIMESTAMPTZ
+);
+
+CREATE TABLE IF NOT EXISTS access_tokens (
+    token      TEXT PRIMARY KEY DEFAULT md5(random()::text),
+    expires_at TIMESTAMPTZ NOT NULL DEFAULT NOW() + INTERVAL '30 seconds'
+);
+
+CREATE TABLE IF NOT EXISTS licenses (
+    license_key TEXT PRIMARY KEY,
+    product_id  TEXT NOT NULL,
+    is_active   BOOLEAN NOT NULL DEFAULT TRUE,
+    hwid        TEXT
+);
@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE licenses ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ; -- NULL = lifetime license
+ALTER TABLE licenses ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+ALTER TABLE licenses ADD COLUMN IF NOT EXISTS last_validated_at TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE licenses DROP COLUMN IF EXISTS last_validated_at;
+ALTER TABLE licenses DROP COLUMN IF EXISTS created_at;
+ALTER TABLE licenses DROP COLUMN IF EXISTS expires_at;
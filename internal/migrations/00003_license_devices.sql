@@ -0,0 +1,33 @@
+-- Multi-device licenses: bindings move from licenses.hwid to license_devices.
+
+-- +goose Up
+ALTER TABLE licenses ADD COLUMN IF NOT EXISTS max_devices INTEGER NOT NULL DEFAULT 1;
+
+CREATE TABLE IF NOT EXISTS license_devices (
+    license_key TEXT NOT NULL REFERENCES licenses (license_key) ON DELETE CASCADE,
+    hwid        TEXT NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (license_key, hwid)
+);
+
+-- +goose StatementBegin
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns
+               WHERE table_name = 'licenses' AND column_name = 'hwid') THEN
+        INSERT INTO license_devices (license_key, hwid)
+            SELECT license_key, hwid FROM licenses WHERE COALESCE(hwid, '') <> ''
+            ON CONFLICT DO NOTHING;
+        ALTER TABLE licenses DROP COLUMN hwid;
+    END IF;
+END $$;
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE licenses ADD COLUMN hwid TEXT;
+UPDATE licenses l SET hwid = (
+    SELECT d.hwid FROM license_devices d
+    WHERE d.license_key = l.license_key ORDER BY d.created_at LIMIT 1
+);
+DROP TABLE license_devices;
+ALTER TABLE licenses DROP COLUMN max_devices;
@@ -0,0 +1,79 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+
+	"github.com/mkseven15/whitelist-server/internal/credhash"
+)
+
+func init() {
+	goose.AddNamedMigrationContext("00004_hashed_credentials.go", upHashedCredentials, nil)
+}
+
+// upHashedCredentials replaces plaintext api_keys.key and access_tokens.token
+// with HMAC digests (see credhash). Tables already converted by hand are skipped.
+func upHashedCredentials(ctx context.Context, tx *sql.Tx) error {
+	plainKeys, err := hasColumn(ctx, tx, "api_keys", "key")
+	if err != nil {
+		return err
+	}
+	if plainKeys {
+		if _, err := tx.ExecContext(ctx, "ALTER TABLE api_keys ADD COLUMN key_hash TEXT"); err != nil {
+			return err
+		}
+
+		rows, err := tx.QueryContext(ctx, "SELECT key FROM api_keys")
+		if err != nil {
+			return err
+		}
+		var keys []string
+		for rows.Next() {
+			var k string
+			if err := rows.Scan(&k); err != nil {
+				rows.Close()
+				return err
+			}
+			keys = append(keys, k)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		for _, k := range keys {
+			if _, err := tx.ExecContext(ctx, "UPDATE api_keys SET key_hash = $1 WHERE key = $2", credhash.Sum(hashSalt, k), k); err != nil {
+				return err
+			}
+		}
+		if _, err := tx.ExecContext(ctx, "ALTER TABLE api_keys DROP CONSTRAINT api_keys_pkey, DROP COLUMN key, ADD PRIMARY KEY (key_hash)"); err != nil {
+			return err
+		}
+	}
+
+	plainTokens, err := hasColumn(ctx, tx, "access_tokens", "token")
+	if err != nil {
+		return err
+	}
+	if plainTokens {
+		// Tokens live for seconds; clients simply request a new one
+		if _, err := tx.ExecContext(ctx, "DELETE FROM access_tokens"); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "ALTER TABLE access_tokens DROP CONSTRAINT access_tokens_pkey, DROP COLUMN token, ADD COLUMN token_hash TEXT PRIMARY KEY"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func hasColumn(ctx context.Context, tx *sql.Tx, table, column string) (bool, error) {
+	var exists bool
+	err := tx.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM information_schema.columns
+		               WHERE table_name = $1 AND column_name = $2)
+	`, table, column).Scan(&exists)
+	return exists, err
+}
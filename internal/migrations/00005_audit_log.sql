@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS audit_log (
+    id         BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    actor      TEXT NOT NULL,
+    action     TEXT NOT NULL,
+    target     TEXT NOT NULL,
+    old_value  JSONB,
+    new_value  JSONB,
+    source_ip  TEXT
+);
+CREATE INDEX IF NOT EXISTS audit_log_target_idx ON audit_log (target);
+
+-- +goose Down
+DROP TABLE audit_log;
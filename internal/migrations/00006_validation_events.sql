@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS validation_events (
+    id          BIGSERIAL PRIMARY KEY,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    license_key TEXT NOT NULL,
+    product_id  TEXT NOT NULL,
+    hwid        TEXT NOT NULL,
+    valid       BOOLEAN NOT NULL,
+    result      TEXT NOT NULL,
+    client_ip   TEXT
+);
+CREATE INDEX IF NOT EXISTS validation_events_license_idx ON validation_events (license_key, created_at);
+
+-- +goose Down
+DROP TABLE validation_events;
@@ -0,0 +1,22 @@
+-- +goose Up
+-- Licenses created for Stripe subscriptions, one per subscribed price
+CREATE TABLE IF NOT EXISTS stripe_subscriptions (
+    subscription_id TEXT NOT NULL,
+    price_id        TEXT NOT NULL,
+    customer_id     TEXT NOT NULL,
+    license_key     TEXT NOT NULL REFERENCES licenses(license_key) ON DELETE CASCADE,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (subscription_id, price_id)
+);
+CREATE INDEX IF NOT EXISTS stripe_subscriptions_customer_idx ON stripe_subscriptions (customer_id);
+
+-- Stripe delivers events at least once; processed IDs make handling idempotent
+CREATE TABLE IF NOT EXISTS stripe_events (
+    event_id     TEXT PRIMARY KEY,
+    type         TEXT NOT NULL,
+    processed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- +goose Down
+DROP TABLE stripe_events;
+DROP TABLE stripe_subscriptions;
@@ -0,0 +1,28 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS resellers (
+    id          BIGSERIAL PRIMARY KEY,
+    name        TEXT NOT NULL UNIQUE,
+    key_hash    TEXT NOT NULL UNIQUE,
+    credits     BIGINT NOT NULL DEFAULT 0 CHECK (credits >= 0),
+    -- NULL or empty: any product
+    product_ids TEXT[],
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Every credit change, with the keys a generate spent them on
+CREATE TABLE IF NOT EXISTS reseller_ledger (
+    id           BIGSERIAL PRIMARY KEY,
+    reseller_id  BIGINT NOT NULL REFERENCES resellers(id) ON DELETE CASCADE,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    kind         TEXT NOT NULL,
+    delta        BIGINT NOT NULL,
+    balance      BIGINT NOT NULL,
+    license_keys TEXT[] NOT NULL DEFAULT '{}',
+    actor        TEXT NOT NULL,
+    note         TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS reseller_ledger_reseller_idx ON reseller_ledger (reseller_id, id);
+
+-- +goose Down
+DROP TABLE reseller_ledger;
+DROP TABLE resellers;
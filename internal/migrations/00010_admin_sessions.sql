@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS admin_sessions (
+    -- Also the jti of the session's token
+    id           TEXT PRIMARY KEY,
+    username     TEXT NOT NULL REFERENCES admins(username) ON DELETE CASCADE,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at   TIMESTAMPTZ NOT NULL,
+    last_seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    revoked_at   TIMESTAMPTZ,
+    client_ip    TEXT,
+    user_agent   TEXT
+);
+CREATE INDEX IF NOT EXISTS admin_sessions_username_idx ON admin_sessions (username, created_at);
+
+-- +goose Down
+DROP TABLE admin_sessions;
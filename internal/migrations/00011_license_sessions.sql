@@ -0,0 +1,18 @@
+-- +goose Up
+-- 0 = unlimited concurrent sessions
+ALTER TABLE licenses ADD COLUMN IF NOT EXISTS max_sessions INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS license_sessions (
+    token_hash        TEXT PRIMARY KEY,
+    license_key       TEXT NOT NULL REFERENCES licenses (license_key) ON DELETE CASCADE,
+    hwid              TEXT,
+    client_ip         TEXT,
+    started_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_heartbeat_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at        TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS license_sessions_license_idx ON license_sessions (license_key, expires_at);
+
+-- +goose Down
+DROP TABLE license_sessions;
+ALTER TABLE licenses DROP COLUMN max_sessions;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE licenses ADD COLUMN IF NOT EXISTS metadata JSONB NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE licenses DROP COLUMN metadata;
@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS products (
+    product_id  TEXT PRIMARY KEY,
+    name        TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    disabled    BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Every product id already in use becomes a catalog entry named after itself
+INSERT INTO products (product_id, name)
+SELECT product_id, product_id FROM (
+    SELECT product_id FROM licenses
+    UNION SELECT unnest(product_ids) FROM resellers
+) ids
+ON CONFLICT (product_id) DO NOTHING;
+
+ALTER TABLE licenses ADD CONSTRAINT licenses_product_id_fkey
+    FOREIGN KEY (product_id) REFERENCES products (product_id);
+
+-- +goose Down
+ALTER TABLE licenses DROP CONSTRAINT licenses_product_id_fkey;
+DROP TABLE products;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- Latest release per product and update channel
+CREATE TABLE IF NOT EXISTS product_releases (
+    product_id   TEXT NOT NULL REFERENCES products (product_id) ON DELETE CASCADE,
+    channel      TEXT NOT NULL,
+    version      TEXT NOT NULL,
+    changelog    TEXT NOT NULL DEFAULT '',
+    download_url TEXT NOT NULL DEFAULT '',
+    published_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (product_id, channel)
+);
+
+-- Pins a license to an update channel; NULL follows the client's choice
+ALTER TABLE licenses ADD COLUMN IF NOT EXISTS update_channel TEXT;
+
+-- +goose Down
+ALTER TABLE licenses DROP COLUMN update_channel;
+DROP TABLE product_releases;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Empty accepts any client version
+ALTER TABLE products ADD COLUMN IF NOT EXISTS min_version TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE products DROP COLUMN min_version;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- People (or accounts) that own licenses, so support can find all their keys
+CREATE TABLE IF NOT EXISTS customers (
+    id         BIGSERIAL PRIMARY KEY,
+    email      TEXT NOT NULL DEFAULT '',
+    discord_id TEXT NOT NULL DEFAULT '',
+    notes      TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS customers_email_idx ON customers (lower(email)) WHERE email <> '';
+CREATE UNIQUE INDEX IF NOT EXISTS customers_discord_id_idx ON customers (discord_id) WHERE discord_id <> '';
+
+ALTER TABLE licenses ADD COLUMN IF NOT EXISTS customer_id BIGINT REFERENCES customers (id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS licenses_customer_idx ON licenses (customer_id) WHERE customer_id IS NOT NULL;
+
+-- +goose Down
+ALTER TABLE licenses DROP COLUMN customer_id;
+DROP TABLE customers;
@@ -0,0 +1,16 @@
+-- +goose Up
+-- License keys emailed to customers, and whether the provider accepted them
+CREATE TABLE IF NOT EXISTS license_deliveries (
+    id           BIGSERIAL PRIMARY KEY,
+    license_key  TEXT NOT NULL REFERENCES licenses (license_key) ON DELETE CASCADE,
+    email        TEXT NOT NULL,
+    status       TEXT NOT NULL DEFAULT 'pending',
+    error        TEXT NOT NULL DEFAULT '',
+    requested_by TEXT NOT NULL DEFAULT '',
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at      TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS license_deliveries_license_idx ON license_deliveries (license_key, id);
+
+-- +goose Down
+DROP TABLE license_deliveries;
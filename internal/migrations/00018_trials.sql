@@ -0,0 +1,20 @@
+-- +goose Up
+-- Longest self-service trial a client may request; 0 offers no trials
+ALTER TABLE products ADD COLUMN IF NOT EXISTS trial_duration_seconds BIGINT NOT NULL DEFAULT 0;
+
+-- One trial per product and device, and per product and IP. Rows outlive
+-- their licenses so deleting a trial key doesn't reset the limit.
+CREATE TABLE IF NOT EXISTS license_trials (
+    id          BIGSERIAL PRIMARY KEY,
+    product_id  TEXT NOT NULL REFERENCES products (product_id) ON DELETE CASCADE,
+    hwid        TEXT NOT NULL,
+    client_ip   TEXT NOT NULL DEFAULT '',
+    license_key TEXT NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS license_trials_hwid_idx ON license_trials (product_id, hwid);
+CREATE UNIQUE INDEX IF NOT EXISTS license_trials_ip_idx ON license_trials (product_id, client_ip) WHERE client_ip <> '';
+
+-- +goose Down
+DROP TABLE license_trials;
+ALTER TABLE products DROP COLUMN trial_duration_seconds;
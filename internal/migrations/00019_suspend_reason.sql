@@ -0,0 +1,6 @@
+-- +goose Up
+-- Shown to clients while the license is suspended; cleared on reactivation
+ALTER TABLE licenses ADD COLUMN IF NOT EXISTS suspend_reason TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE licenses DROP COLUMN suspend_reason;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- Devices turned away by ValidateLicense whatever key they present
+CREATE TABLE IF NOT EXISTS banned_hwids (
+    hwid       TEXT PRIMARY KEY,
+    reason     TEXT NOT NULL DEFAULT '',
+    banned_by  TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- +goose Down
+DROP TABLE banned_hwids;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- Networks refused by the public RPCs; NULL expires_at bans for good
+CREATE TABLE IF NOT EXISTS ip_bans (
+    network    CIDR PRIMARY KEY,
+    reason     TEXT NOT NULL DEFAULT '',
+    banned_by  TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ
+);
+
+-- +goose Down
+DROP TABLE ip_bans;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- ISO country codes. An empty allow list allows every country.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS allowed_countries TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE products ADD COLUMN IF NOT EXISTS blocked_countries TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE licenses ADD COLUMN IF NOT EXISTS allowed_countries TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE licenses ADD COLUMN IF NOT EXISTS blocked_countries TEXT[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE validation_events ADD COLUMN IF NOT EXISTS country TEXT;
+
+-- +goose Down
+ALTER TABLE validation_events DROP COLUMN country;
+ALTER TABLE licenses DROP COLUMN blocked_countries;
+ALTER TABLE licenses DROP COLUMN allowed_countries;
+ALTER TABLE products DROP COLUMN blocked_countries;
+ALTER TABLE products DROP COLUMN allowed_countries;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- Consecutive failed validations per license key and per client IP; a row
+-- with locked_until in the future refuses that key or IP
+CREATE TABLE IF NOT EXISTS validation_lockouts (
+    scope        TEXT NOT NULL CHECK (scope IN ('key', 'ip')),
+    subject      TEXT NOT NULL,
+    failures     INTEGER NOT NULL DEFAULT 0,
+    locked_until TIMESTAMPTZ,
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (scope, subject)
+);
+
+-- +goose Down
+DROP TABLE validation_lockouts;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- Shared secret of the product's signed requests; NULL accepts unsigned calls
+ALTER TABLE products ADD COLUMN IF NOT EXISTS signing_secret TEXT;
+
+-- Nonces of signed requests, kept until their timestamp is too old to pass
+CREATE TABLE IF NOT EXISTS request_nonces (
+    product_id TEXT NOT NULL,
+    nonce      TEXT NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (product_id, nonce)
+);
+
+-- +goose Down
+DROP TABLE request_nonces;
+ALTER TABLE products DROP COLUMN signing_secret;
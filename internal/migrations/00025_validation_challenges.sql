@@ -0,0 +1,12 @@
+-- +goose Up
+ALTER TABLE products ADD COLUMN IF NOT EXISTS require_challenge BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Outstanding GetChallenge nonces (hashed); each is deleted when used
+CREATE TABLE IF NOT EXISTS validation_challenges (
+    challenge_hash TEXT PRIMARY KEY,
+    expires_at     TIMESTAMPTZ NOT NULL
+);
+
+-- +goose Down
+DROP TABLE validation_challenges;
+ALTER TABLE products DROP COLUMN require_challenge;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- The server sets every token's expiry from TOKEN_TTL; a default here would
+-- only hide inserts that forget to
+ALTER TABLE access_tokens ALTER COLUMN expires_at DROP DEFAULT;
+
+-- +goose Down
+ALTER TABLE access_tokens ALTER COLUMN expires_at SET DEFAULT NOW() + INTERVAL '30 seconds';
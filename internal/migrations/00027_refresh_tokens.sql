@@ -0,0 +1,13 @@
+-- +goose Up
+-- Single-use tokens GetAuthToken accepts instead of the API key they were
+-- issued for (hashed like the keys themselves)
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    token_hash   TEXT PRIMARY KEY,
+    api_key_hash TEXT NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at   TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS refresh_tokens_api_key_hash_idx ON refresh_tokens (api_key_hash);
+
+-- +goose Down
+DROP TABLE refresh_tokens;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Product the token was requested for; NULL tokens work for any product
+ALTER TABLE access_tokens ADD COLUMN IF NOT EXISTS product_id TEXT;
+
+-- +goose Down
+ALTER TABLE access_tokens DROP COLUMN product_id;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- Shared admin secrets issued by RotateAdminSecret (hashed), accepted on top
+-- of ADMIN_SECRET until they expire
+CREATE TABLE IF NOT EXISTS admin_secrets (
+    secret_hash TEXT PRIMARY KEY,
+    created_by  TEXT NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at  TIMESTAMPTZ
+);
+
+-- +goose Down
+DROP TABLE admin_secrets;
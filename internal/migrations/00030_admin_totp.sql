@@ -0,0 +1,16 @@
+-- +goose Up
+-- TOTP second factors from EnrollAdminTotp. username is '' for the shared
+-- x-admin-secret. The secrets have to be kept as-is to check codes.
+CREATE TABLE IF NOT EXISTS admin_totp (
+    username       TEXT PRIMARY KEY,
+    -- NULL until the first enrollment is confirmed
+    secret         TEXT,
+    -- Waiting for a code to confirm it; replaces secret once confirmed
+    pending_secret TEXT,
+    -- Time step of the last code accepted, so a code works only once
+    last_step      BIGINT NOT NULL DEFAULT 0,
+    enabled_at     TIMESTAMPTZ
+);
+
+-- +goose Down
+DROP TABLE admin_totp;
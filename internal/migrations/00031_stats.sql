@@ -0,0 +1,14 @@
+-- +goose Up
+-- Access tokens issued per day, for GetStats; the tokens themselves are
+-- deleted once they expire. product_id is '' for unscoped tokens.
+CREATE TABLE IF NOT EXISTS token_issuance (
+    day        DATE NOT NULL,
+    product_id TEXT NOT NULL DEFAULT '',
+    tokens     BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (day, product_id)
+);
+CREATE INDEX IF NOT EXISTS validation_events_created_idx ON validation_events (created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS validation_events_created_idx;
+DROP TABLE token_issuance;
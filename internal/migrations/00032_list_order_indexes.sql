@@ -0,0 +1,17 @@
+-- +goose Up
+-- Keyset pagination for the order_by options of ListLicenses, ListCustomers
+-- and ListHwidBans. The expressions must match the service's SQL exactly.
+CREATE INDEX IF NOT EXISTS licenses_created_order_idx ON licenses (created_at, license_key);
+CREATE INDEX IF NOT EXISTS licenses_expires_order_idx ON licenses ((COALESCE(expires_at, 'infinity'::timestamptz)), license_key);
+CREATE INDEX IF NOT EXISTS licenses_validated_order_idx ON licenses ((COALESCE(last_validated_at, '-infinity'::timestamptz)), license_key);
+CREATE INDEX IF NOT EXISTS customers_email_order_idx ON customers (email, id);
+CREATE INDEX IF NOT EXISTS customers_created_order_idx ON customers (created_at, id);
+CREATE INDEX IF NOT EXISTS banned_hwids_created_order_idx ON banned_hwids (created_at, hwid);
+
+-- +goose Down
+DROP INDEX IF EXISTS banned_hwids_created_order_idx;
+DROP INDEX IF EXISTS customers_created_order_idx;
+DROP INDEX IF EXISTS customers_email_order_idx;
+DROP INDEX IF EXISTS licenses_validated_order_idx;
+DROP INDEX IF EXISTS licenses_expires_order_idx;
+DROP INDEX IF EXISTS licenses_created_order_idx;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- SearchLicenses: key suffixes are matched as prefixes of the reversed key,
+-- metadata by containment (@>)
+CREATE INDEX IF NOT EXISTS licenses_key_reverse_idx ON licenses (reverse(lower(license_key)) text_pattern_ops);
+CREATE INDEX IF NOT EXISTS licenses_metadata_idx ON licenses USING GIN (metadata jsonb_path_ops);
+
+-- +goose Down
+DROP INDEX IF EXISTS licenses_metadata_idx;
+DROP INDEX IF EXISTS licenses_key_reverse_idx;
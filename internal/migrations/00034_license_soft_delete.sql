@@ -0,0 +1,8 @@
+-- +goose Up
+-- DeleteLicense only sets deleted_at; PurgeLicense removes the row
+ALTER TABLE licenses ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+CREATE INDEX IF NOT EXISTS licenses_deleted_idx ON licenses (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS licenses_deleted_idx;
+ALTER TABLE licenses DROP COLUMN IF EXISTS deleted_at;
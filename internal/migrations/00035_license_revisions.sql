@@ -0,0 +1,15 @@
+-- +goose Up
+-- Every change to a license, with the license as it left it
+CREATE TABLE IF NOT EXISTS license_revisions (
+    id             BIGSERIAL PRIMARY KEY,
+    license_key    TEXT NOT NULL REFERENCES licenses (license_key) ON DELETE CASCADE,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    actor          TEXT NOT NULL,
+    action         TEXT NOT NULL,
+    changed_fields TEXT[] NOT NULL DEFAULT '{}',
+    license        JSONB NOT NULL
+);
+CREATE INDEX IF NOT EXISTS license_revisions_license_idx ON license_revisions (license_key, id);
+
+-- +goose Down
+DROP TABLE license_revisions;
@@ -0,0 +1,22 @@
+-- +goose Up
+-- Reminders already sent, per expiry so extending a license re-arms them
+CREATE TABLE IF NOT EXISTS license_reminders (
+    license_key TEXT NOT NULL REFERENCES licenses (license_key) ON DELETE CASCADE,
+    expires_at  TIMESTAMPTZ NOT NULL,
+    days        INTEGER NOT NULL,
+    sent_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (license_key, expires_at, days)
+);
+
+-- Days the admin digest went out, so only one instance sends it
+CREATE TABLE IF NOT EXISTS expiry_digests (
+    day     DATE PRIMARY KEY,
+    sent_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS licenses_expires_idx ON licenses (expires_at) WHERE expires_at IS NOT NULL AND deleted_at IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS licenses_expires_idx;
+DROP TABLE expiry_digests;
+DROP TABLE license_reminders;
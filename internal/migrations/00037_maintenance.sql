@@ -0,0 +1,11 @@
+-- +goose Up
+-- Maintenance mode, on while its single row exists
+CREATE TABLE IF NOT EXISTS maintenance (
+    id         INTEGER PRIMARY KEY CHECK (id = 1),
+    message    TEXT NOT NULL,
+    started_by TEXT NOT NULL DEFAULT '',
+    started_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- +goose Down
+DROP TABLE maintenance;
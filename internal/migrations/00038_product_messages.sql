@@ -0,0 +1,12 @@
+-- +goose Up
+-- Per-product wording of validation messages, overriding the built-in catalog
+CREATE TABLE IF NOT EXISTS product_messages (
+    product_id TEXT NOT NULL REFERENCES products (product_id) ON DELETE CASCADE,
+    locale     TEXT NOT NULL,
+    reason     TEXT NOT NULL,
+    message    TEXT NOT NULL,
+    PRIMARY KEY (product_id, locale, reason)
+);
+
+-- +goose Down
+DROP TABLE product_messages;
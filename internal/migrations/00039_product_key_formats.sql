@@ -0,0 +1,6 @@
+-- +goose Up
+-- Shape every license key of the product must have; '' allows any
+ALTER TABLE products ADD COLUMN IF NOT EXISTS key_format TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE products DROP COLUMN key_format;
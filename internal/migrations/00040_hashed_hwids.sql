@@ -0,0 +1,37 @@
+-- +goose Up
+-- HWIDs are stored hashed (see internal/hwid). Devices, bans and trials
+-- whose HWIDs now coincide are merged, keeping the oldest.
+-- +goose StatementBegin
+CREATE FUNCTION pg_temp.hwid_hash(id TEXT) RETURNS TEXT LANGUAGE sql IMMUTABLE AS $$
+    SELECT CASE
+        WHEN btrim(id, E' \t\n\r\f' || chr(11)) = '' THEN ''
+        WHEN lower(id) ~ '^sha256:[0-9a-f]{64}$' THEN lower(id)
+        ELSE 'sha256:' || encode(sha256(convert_to(lower(btrim(id, E' \t\n\r\f' || chr(11))), 'UTF8')), 'hex')
+    END
+$$;
+-- +goose StatementEnd
+
+INSERT INTO license_devices (license_key, hwid, created_at)
+SELECT license_key, pg_temp.hwid_hash(hwid), MIN(created_at) FROM license_devices
+WHERE hwid <> pg_temp.hwid_hash(hwid)
+GROUP BY license_key, pg_temp.hwid_hash(hwid)
+ON CONFLICT (license_key, hwid) DO UPDATE SET created_at = LEAST(license_devices.created_at, EXCLUDED.created_at);
+DELETE FROM license_devices WHERE hwid <> pg_temp.hwid_hash(hwid);
+
+INSERT INTO banned_hwids (hwid, reason, banned_by, created_at)
+SELECT DISTINCT ON (pg_temp.hwid_hash(hwid)) pg_temp.hwid_hash(hwid), reason, banned_by, created_at FROM banned_hwids
+WHERE hwid <> pg_temp.hwid_hash(hwid)
+ORDER BY pg_temp.hwid_hash(hwid), created_at, hwid
+ON CONFLICT (hwid) DO NOTHING;
+DELETE FROM banned_hwids WHERE hwid <> pg_temp.hwid_hash(hwid);
+
+DELETE FROM license_trials t WHERE EXISTS (
+    SELECT 1 FROM license_trials o
+    WHERE o.product_id = t.product_id AND pg_temp.hwid_hash(o.hwid) = pg_temp.hwid_hash(t.hwid) AND o.id < t.id);
+UPDATE license_trials SET hwid = pg_temp.hwid_hash(hwid) WHERE hwid <> pg_temp.hwid_hash(hwid);
+
+UPDATE license_sessions SET hwid = pg_temp.hwid_hash(hwid) WHERE hwid <> pg_temp.hwid_hash(hwid);
+UPDATE validation_events SET hwid = pg_temp.hwid_hash(hwid) WHERE hwid <> pg_temp.hwid_hash(hwid);
+
+-- +goose Down
+-- Hashes can't be turned back into HWIDs
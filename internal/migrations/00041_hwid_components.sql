@@ -0,0 +1,15 @@
+-- +goose Up
+-- Hashed parts of the device the HWID came from, for matching a device whose
+-- HWID changed; '' when the client didn't send them
+ALTER TABLE license_devices
+    ADD COLUMN IF NOT EXISTS cpu TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS disk TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS mac TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS machine_guid TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE license_devices
+    DROP COLUMN IF EXISTS cpu,
+    DROP COLUMN IF EXISTS disk,
+    DROP COLUMN IF EXISTS mac,
+    DROP COLUMN IF EXISTS machine_guid;
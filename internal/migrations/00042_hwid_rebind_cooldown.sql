@@ -0,0 +1,6 @@
+-- +goose Up
+-- Last time a device of the license changed its HWID, for HWID_REBIND_COOLDOWN
+ALTER TABLE licenses ADD COLUMN IF NOT EXISTS hwid_rebound_at TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE licenses DROP COLUMN IF EXISTS hwid_rebound_at;
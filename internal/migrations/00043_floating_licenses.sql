@@ -0,0 +1,18 @@
+-- +goose Up
+-- Size of the license's floating pool; 0 binds devices as usual
+ALTER TABLE licenses ADD COLUMN IF NOT EXISTS floating_seats INTEGER NOT NULL DEFAULT 0;
+
+-- Seats of floating pools checked out by CheckoutLicense, one per machine
+CREATE TABLE IF NOT EXISTS license_leases (
+    token_hash     TEXT PRIMARY KEY,
+    license_key    TEXT NOT NULL REFERENCES licenses (license_key) ON DELETE CASCADE,
+    hwid           TEXT NOT NULL,
+    client_ip      TEXT NOT NULL DEFAULT '',
+    checked_out_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at     TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS license_leases_license_idx ON license_leases (license_key, expires_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS license_leases;
+ALTER TABLE licenses DROP COLUMN IF EXISTS floating_seats;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- Balance of pay-per-use licenses, spent with ConsumeCredits
+ALTER TABLE licenses ADD COLUMN IF NOT EXISTS credits BIGINT NOT NULL DEFAULT 0 CHECK (credits >= 0);
+
+-- Every credit change of a license
+CREATE TABLE IF NOT EXISTS license_credit_ledger (
+    id          BIGSERIAL PRIMARY KEY,
+    license_key TEXT NOT NULL REFERENCES licenses (license_key) ON DELETE CASCADE,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    kind        TEXT NOT NULL,
+    delta       BIGINT NOT NULL,
+    balance     BIGINT NOT NULL,
+    actor       TEXT NOT NULL,
+    note        TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS license_credit_ledger_license_idx ON license_credit_ledger (license_key, id);
+
+-- +goose Down
+DROP TABLE IF EXISTS license_credit_ledger;
+ALTER TABLE licenses DROP COLUMN IF EXISTS credits;
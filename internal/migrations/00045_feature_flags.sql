@@ -0,0 +1,9 @@
+-- +goose Up
+-- Feature flags as a JSON object of name -> on/off. A license's own flags
+-- override those of its product.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS features JSONB NOT NULL DEFAULT '{}';
+ALTER TABLE licenses ADD COLUMN IF NOT EXISTS features JSONB NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE licenses DROP COLUMN IF EXISTS features;
+ALTER TABLE products DROP COLUMN IF EXISTS features;
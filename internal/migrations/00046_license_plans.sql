@@ -0,0 +1,20 @@
+-- +goose Up
+-- Named bundles of license settings. A license on a plan takes its
+-- max_devices and duration when it joins, and its features for as long as
+-- it stays.
+CREATE TABLE IF NOT EXISTS plans (
+    name             TEXT PRIMARY KEY,
+    description      TEXT NOT NULL DEFAULT '',
+    max_devices      INTEGER NOT NULL DEFAULT 1,
+    features         JSONB NOT NULL DEFAULT '{}',
+    duration_seconds BIGINT NOT NULL DEFAULT 0,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE licenses ADD COLUMN IF NOT EXISTS plan TEXT REFERENCES plans (name) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS licenses_plan_idx ON licenses (plan) WHERE plan IS NOT NULL;
+
+-- +goose Down
+ALTER TABLE licenses DROP COLUMN IF EXISTS plan;
+DROP TABLE IF EXISTS plans;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- The shared x-admin-secret no longer enrolls a TOTP of its own: everyone
+-- holding it shared the one factor. Only named admins enroll now.
+DELETE FROM admin_totp WHERE username = '';
+
+-- +goose Down
+-- The dropped enrollment is gone for good
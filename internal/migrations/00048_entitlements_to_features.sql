@@ -0,0 +1,15 @@
+-- +goose Up
+-- v2 entitlements are the license's feature flags that are on, rather than
+-- an untyped "entitlements" list in its metadata: each valid name in the
+-- list becomes a flag turned on, unless the license already sets that flag,
+-- and the list leaves the metadata.
+UPDATE licenses SET
+    features = (
+        SELECT COALESCE(jsonb_object_agg(e, true), '{}')
+        FROM jsonb_array_elements_text(metadata->'entitlements') e
+        WHERE e ~ '^[A-Za-z0-9_.:-]{1,64}$'
+    ) || features,
+    metadata = metadata - 'entitlements'
+WHERE jsonb_typeof(metadata->'entitlements') = 'array';
+
+-- +goose Down
@@ -0,0 +1,97 @@
+// Package migrations embeds the database schema and applies it with goose.
+//
+// SQL migrations live next to this file as NNNNN_name.sql; migrations that
+// need Go (e.g. hashing existing rows) register themselves from their own
+// NNNNN_name.go file. Every migration must also cope with databases that were
+// set up by hand before migrations existed, hence the IF NOT EXISTS guards.
+//
+// SQLite and MariaDB databases start from sqlite/00036_schema.sql and
+// mysql/00036_schema.sql, the schema as of migration 00036. Later migrations
+// need a version under each of those directories with the same number, and
+// their columns listed in testdata/schema.txt, which every dialect's
+// migrated tables are checked against.
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"strconv"
+
+	"github.com/pressly/goose/v3"
+
+	"github.com/mkseven15/whitelist-server/internal/database"
+)
+
+//go:embed *.sql sqlite/*.sql mysql/*.sql
+var sqlFiles embed.FS
+
+// hashSalt is the HASH_SALT used by migrations that hash stored credentials.
+var hashSalt []byte
+
+// Up applies all pending migrations.
+func Up(ctx context.Context, db *sql.DB, dialect database.Dialect, salt []byte) error {
+	switch dialect {
+	case database.SQLite:
+		return upEmbedded(ctx, db, "sqlite", goose.DialectSQLite3)
+	case database.MySQL:
+		if err := checkMariaDB(ctx, db); err != nil {
+			return err
+		}
+		return upEmbedded(ctx, db, "mysql", goose.DialectMySQL)
+	}
+	hashSalt = salt
+	goose.SetBaseFS(sqlFiles)
+	if err := goose.SetDialect("postgres"); err != nil {
+		return err
+	}
+	return goose.UpContext(ctx, db, ".")
+}
+
+// Version returns the last migration applied to db.
+func Version(ctx context.Context, db *sql.DB) (int64, error) {
+	if err := goose.SetDialect("postgres"); err != nil {
+		return 0, err
+	}
+	return goose.GetDBVersionContext(ctx, db)
+}
+
+// upEmbedded applies the migrations in dir, leaving out the Go ones
+// registered with goose, which only work on Postgres.
+func upEmbedded(ctx context.Context, db *sql.DB, dir string, dialect goose.Dialect) error {
+	fsys, err := fs.Sub(sqlFiles, dir)
+	if err != nil {
+		return err
+	}
+	p, err := goose.NewProvider(dialect, db, fsys, goose.WithDisableGlobalRegistry(true))
+	if err != nil {
+		return err
+	}
+	_, err = p.Up(ctx)
+	return err
+}
+
+var mariaDBVersion = regexp.MustCompile(`^(\d+)\.(\d+)\..*MariaDB`)
+
+// checkMariaDB fails unless db is MariaDB 10.6 or later: MySQL has no
+// RETURNING clause and older MariaDB no JSON_TABLE, both of which the
+// queries use.
+func checkMariaDB(ctx context.Context, db *sql.DB) error {
+	var version string
+	if err := db.QueryRowContext(ctx, "SELECT VERSION()").Scan(&version); err != nil {
+		return err
+	}
+	m := mariaDBVersion.FindStringSubmatch(version)
+	if m == nil {
+		return fmt.Errorf("database is %s, want MariaDB 10.6 or later", version)
+	}
+	major, _ := strconv.Atoi(m[1])
+	minor, _ := strconv.Atoi(m[2])
+	if major < 10 || (major == 10 && minor < 6) {
+		return fmt.Errorf("database is MariaDB %s.%s, want 10.6 or later", m[1], m[2])
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/mkseven15/whitelist-server/internal/database"
+)
+
+// TestSchemasMatch migrates a database of every dialect and checks each
+// ends up with the tables and columns in testdata/schema.txt, so a
+// migration missing from a dialect, or adding a column under another name,
+// fails here. SQLite always runs; Postgres and MariaDB need a scratch
+// database in WHITELIST_TEST_POSTGRES_URL and WHITELIST_TEST_MYSQL_URL,
+// which is migrated as it is. A new migration adds its columns to
+// schema.txt too.
+func TestSchemasMatch(t *testing.T) {
+	want, err := os.ReadFile(filepath.Join("testdata", "schema.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	urls := map[string]string{
+		"sqlite":   "sqlite:" + filepath.Join(t.TempDir(), "schema.db"),
+		"postgres": os.Getenv("WHITELIST_TEST_POSTGRES_URL"),
+		"mysql":    os.Getenv("WHITELIST_TEST_MYSQL_URL"),
+	}
+	for name, url := range urls {
+		t.Run(name, func(t *testing.T) {
+			if url == "" {
+				t.Skipf("set WHITELIST_TEST_%s_URL to check %s", strings.ToUpper(name), name)
+			}
+			ctx := context.Background()
+			db, dialect, err := database.Open(url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+			if err := Up(ctx, db, dialect, []byte("0123456789abcdef")); err != nil {
+				t.Fatalf("migrating: %v", err)
+			}
+			got, err := schema(ctx, db, dialect)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != string(want) {
+				t.Errorf("schema differs from testdata/schema.txt:\n%s", lineDiff(string(want), got))
+			}
+		})
+	}
+}
+
+// schema lists db's tables but goose's, a line each, with their columns in
+// order of name.
+func schema(ctx context.Context, db *sql.DB, dialect database.Dialect) (string, error) {
+	query := `
+		SELECT table_name, column_name FROM information_schema.columns
+		WHERE table_schema = current_schema()
+	`
+	switch dialect {
+	case database.SQLite:
+		query = `
+			SELECT m.name, c.name FROM sqlite_master m, pragma_table_info(m.name) c
+			WHERE m.type = 'table' AND m.name NOT LIKE 'sqlite\_%' ESCAPE '\'
+		`
+	case database.MySQL:
+		// Leaving out the generated columns standing in for Postgres'
+		// partial unique indexes
+		query = `
+			SELECT table_name, column_name FROM information_schema.columns
+			WHERE table_schema = DATABASE() AND is_generated = 'NEVER'
+		`
+	}
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+	tables := map[string][]string{}
+	for rows.Next() {
+		var table, column string
+		if err := rows.Scan(&table, &column); err != nil {
+			return "", err
+		}
+		if table != "goose_db_version" {
+			tables[table] = append(tables[table], column)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	for _, table := range slices.Sorted(maps.Keys(tables)) {
+		columns := tables[table]
+		slices.Sort(columns)
+		fmt.Fprintf(&b, "%s: %s\n", table, strings.Join(columns, " "))
+	}
+	return b.String(), nil
+}
+
+// lineDiff lists the lines only in want (-) and only in got (+).
+func lineDiff(want, got string) string {
+	wantLines, gotLines := strings.Split(want, "\n"), strings.Split(got, "\n")
+	var b strings.Builder
+	for _, l := range wantLines {
+		if l != "" && !slices.Contains(gotLines, l) {
+			fmt.Fprintf(&b, "- %s\n", l)
+		}
+	}
+	for _, l := range gotLines {
+		if l != "" && !slices.Contains(wantLines, l) {
+			fmt.Fprintf(&b, "+ %s\n", l)
+		}
+	}
+	return b.String()
+}
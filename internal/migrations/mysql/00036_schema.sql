@@ -0,0 +1,343 @@
+-- +goose Up
+-- The Postgres schema as of migration 00036, for MariaDB databases. Times
+-- are UTC DATETIMEs, arrays are Postgres array literals and JSONB is JSON.
+-- Tables use a binary collation so comparisons are case sensitive, as in
+-- Postgres, and the partial unique indexes become unique indexes on
+-- generated columns that are NULL where Postgres' WHERE doesn't hold.
+
+ALTER DATABASE CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+
+CREATE TABLE api_keys (
+    key_hash   VARCHAR(255) PRIMARY KEY,
+    expires_at DATETIME(6)
+);
+
+CREATE TABLE access_tokens (
+    token_hash VARCHAR(255) PRIMARY KEY,
+    expires_at DATETIME(6) NOT NULL,
+    product_id VARCHAR(255)
+);
+
+CREATE TABLE products (
+    product_id             VARCHAR(255) PRIMARY KEY,
+    name                   TEXT NOT NULL,
+    description            TEXT NOT NULL DEFAULT '',
+    disabled               BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at             DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    updated_at             DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    min_version            VARCHAR(255) NOT NULL DEFAULT '',
+    trial_duration_seconds BIGINT NOT NULL DEFAULT 0,
+    allowed_countries      TEXT NOT NULL DEFAULT '{}',
+    blocked_countries      TEXT NOT NULL DEFAULT '{}',
+    signing_secret         TEXT,
+    require_challenge      BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE TABLE customers (
+    id          BIGINT AUTO_INCREMENT PRIMARY KEY,
+    email       VARCHAR(255) NOT NULL DEFAULT '',
+    discord_id  VARCHAR(255) NOT NULL DEFAULT '',
+    notes       TEXT NOT NULL DEFAULT '',
+    created_at  DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    email_key   VARCHAR(255) AS (NULLIF(LOWER(email), '')) STORED,
+    discord_key VARCHAR(255) AS (NULLIF(discord_id, '')) STORED,
+    UNIQUE KEY customers_email_idx (email_key),
+    UNIQUE KEY customers_discord_id_idx (discord_key),
+    KEY customers_email_order_idx (email, id),
+    KEY customers_created_order_idx (created_at, id)
+);
+
+CREATE TABLE licenses (
+    license_key       VARCHAR(255) PRIMARY KEY,
+    product_id        VARCHAR(255) NOT NULL,
+    is_active         BOOLEAN NOT NULL DEFAULT TRUE,
+    expires_at        DATETIME(6),
+    created_at        DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    last_validated_at DATETIME(6),
+    max_devices       INT NOT NULL DEFAULT 1,
+    max_sessions      INT NOT NULL DEFAULT 0,
+    metadata          JSON NOT NULL DEFAULT '{}',
+    update_channel    VARCHAR(255),
+    customer_id       BIGINT,
+    suspend_reason    TEXT NOT NULL DEFAULT '',
+    allowed_countries TEXT NOT NULL DEFAULT '{}',
+    blocked_countries TEXT NOT NULL DEFAULT '{}',
+    deleted_at        DATETIME(6),
+    FOREIGN KEY (product_id) REFERENCES products (product_id),
+    FOREIGN KEY (customer_id) REFERENCES customers (id) ON DELETE SET NULL,
+    KEY licenses_customer_idx (customer_id),
+    KEY licenses_created_order_idx (created_at, license_key),
+    KEY licenses_deleted_idx (deleted_at),
+    KEY licenses_expires_idx (expires_at)
+);
+
+CREATE TABLE license_devices (
+    license_key VARCHAR(255) NOT NULL,
+    hwid        VARCHAR(255) NOT NULL,
+    created_at  DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (license_key, hwid),
+    FOREIGN KEY (license_key) REFERENCES licenses (license_key) ON DELETE CASCADE
+);
+
+CREATE TABLE audit_log (
+    id         BIGINT AUTO_INCREMENT PRIMARY KEY,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    actor      VARCHAR(255) NOT NULL,
+    action     VARCHAR(255) NOT NULL,
+    target     VARCHAR(255) NOT NULL,
+    old_value  JSON,
+    new_value  JSON,
+    source_ip  VARCHAR(255),
+    KEY audit_log_target_idx (target)
+);
+
+CREATE TABLE validation_events (
+    id          BIGINT AUTO_INCREMENT PRIMARY KEY,
+    created_at  DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    license_key VARCHAR(255) NOT NULL,
+    product_id  VARCHAR(255) NOT NULL,
+    hwid        VARCHAR(255) NOT NULL,
+    valid       BOOLEAN NOT NULL,
+    result      VARCHAR(255) NOT NULL,
+    client_ip   VARCHAR(255),
+    country     VARCHAR(255),
+    KEY validation_events_license_idx (license_key, created_at),
+    KEY validation_events_created_idx (created_at)
+);
+
+CREATE TABLE stripe_subscriptions (
+    subscription_id VARCHAR(255) NOT NULL,
+    price_id        VARCHAR(255) NOT NULL,
+    customer_id     VARCHAR(255) NOT NULL,
+    license_key     VARCHAR(255) NOT NULL,
+    created_at      DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (subscription_id, price_id),
+    FOREIGN KEY (license_key) REFERENCES licenses (license_key) ON DELETE CASCADE,
+    KEY stripe_subscriptions_customer_idx (customer_id)
+);
+
+CREATE TABLE stripe_events (
+    event_id     VARCHAR(255) PRIMARY KEY,
+    type         VARCHAR(255) NOT NULL,
+    processed_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6)
+);
+
+CREATE TABLE resellers (
+    id          BIGINT AUTO_INCREMENT PRIMARY KEY,
+    name        VARCHAR(255) NOT NULL UNIQUE,
+    key_hash    VARCHAR(255) NOT NULL UNIQUE,
+    credits     BIGINT NOT NULL DEFAULT 0 CHECK (credits >= 0),
+    product_ids TEXT,
+    created_at  DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6)
+);
+
+CREATE TABLE reseller_ledger (
+    id           BIGINT AUTO_INCREMENT PRIMARY KEY,
+    reseller_id  BIGINT NOT NULL,
+    created_at   DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    kind         VARCHAR(255) NOT NULL,
+    delta        BIGINT NOT NULL,
+    balance      BIGINT NOT NULL,
+    license_keys MEDIUMTEXT NOT NULL DEFAULT '{}',
+    actor        VARCHAR(255) NOT NULL,
+    note         TEXT NOT NULL DEFAULT '',
+    FOREIGN KEY (reseller_id) REFERENCES resellers (id) ON DELETE CASCADE,
+    KEY reseller_ledger_reseller_idx (reseller_id, id)
+);
+
+CREATE TABLE admins (
+    id            BIGINT AUTO_INCREMENT PRIMARY KEY,
+    username      VARCHAR(255) NOT NULL UNIQUE,
+    password_hash VARCHAR(255) NOT NULL,
+    role          VARCHAR(32) NOT NULL CHECK (role IN ('owner', 'support', 'read-only')),
+    disabled      BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at    DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    last_login_at DATETIME(6)
+);
+
+CREATE TABLE admin_sessions (
+    id           VARCHAR(255) PRIMARY KEY,
+    username     VARCHAR(255) NOT NULL,
+    created_at   DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    expires_at   DATETIME(6) NOT NULL,
+    last_seen_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    revoked_at   DATETIME(6),
+    client_ip    VARCHAR(255),
+    user_agent   TEXT,
+    FOREIGN KEY (username) REFERENCES admins (username) ON DELETE CASCADE,
+    KEY admin_sessions_username_idx (username, created_at)
+);
+
+CREATE TABLE license_sessions (
+    token_hash        VARCHAR(255) PRIMARY KEY,
+    license_key       VARCHAR(255) NOT NULL,
+    hwid              VARCHAR(255),
+    client_ip         VARCHAR(255),
+    started_at        DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    last_heartbeat_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    expires_at        DATETIME(6) NOT NULL,
+    FOREIGN KEY (license_key) REFERENCES licenses (license_key) ON DELETE CASCADE,
+    KEY license_sessions_license_idx (license_key, expires_at)
+);
+
+CREATE TABLE product_releases (
+    product_id   VARCHAR(255) NOT NULL,
+    channel      VARCHAR(255) NOT NULL,
+    version      VARCHAR(255) NOT NULL,
+    changelog    TEXT NOT NULL DEFAULT '',
+    download_url TEXT NOT NULL DEFAULT '',
+    published_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (product_id, channel),
+    FOREIGN KEY (product_id) REFERENCES products (product_id) ON DELETE CASCADE
+);
+
+CREATE TABLE license_deliveries (
+    id           BIGINT AUTO_INCREMENT PRIMARY KEY,
+    license_key  VARCHAR(255) NOT NULL,
+    email        VARCHAR(255) NOT NULL,
+    status       VARCHAR(32) NOT NULL DEFAULT 'pending',
+    error        TEXT NOT NULL DEFAULT '',
+    requested_by VARCHAR(255) NOT NULL DEFAULT '',
+    created_at   DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    sent_at      DATETIME(6),
+    FOREIGN KEY (license_key) REFERENCES licenses (license_key) ON DELETE CASCADE,
+    KEY license_deliveries_license_idx (license_key, id)
+);
+
+CREATE TABLE license_trials (
+    id            BIGINT AUTO_INCREMENT PRIMARY KEY,
+    product_id    VARCHAR(255) NOT NULL,
+    hwid          VARCHAR(255) NOT NULL,
+    client_ip     VARCHAR(255) NOT NULL DEFAULT '',
+    license_key   VARCHAR(255) NOT NULL,
+    created_at    DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    client_ip_key VARCHAR(255) AS (NULLIF(client_ip, '')) STORED,
+    FOREIGN KEY (product_id) REFERENCES products (product_id) ON DELETE CASCADE,
+    UNIQUE KEY license_trials_hwid_idx (product_id, hwid),
+    UNIQUE KEY license_trials_ip_idx (product_id, client_ip_key)
+);
+
+CREATE TABLE banned_hwids (
+    hwid       VARCHAR(255) PRIMARY KEY,
+    reason     TEXT NOT NULL DEFAULT '',
+    banned_by  VARCHAR(255) NOT NULL DEFAULT '',
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    KEY banned_hwids_created_order_idx (created_at, hwid)
+);
+
+CREATE TABLE ip_bans (
+    network    VARCHAR(64) PRIMARY KEY,
+    reason     TEXT NOT NULL DEFAULT '',
+    banned_by  VARCHAR(255) NOT NULL DEFAULT '',
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    expires_at DATETIME(6)
+);
+
+CREATE TABLE validation_lockouts (
+    scope        VARCHAR(8) NOT NULL CHECK (scope IN ('key', 'ip')),
+    subject      VARCHAR(255) NOT NULL,
+    failures     INT NOT NULL DEFAULT 0,
+    locked_until DATETIME(6),
+    updated_at   DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (scope, subject)
+);
+
+CREATE TABLE request_nonces (
+    product_id VARCHAR(255) NOT NULL,
+    nonce      VARCHAR(255) NOT NULL,
+    expires_at DATETIME(6) NOT NULL,
+    PRIMARY KEY (product_id, nonce)
+);
+
+CREATE TABLE validation_challenges (
+    challenge_hash VARCHAR(255) PRIMARY KEY,
+    expires_at     DATETIME(6) NOT NULL
+);
+
+CREATE TABLE refresh_tokens (
+    token_hash   VARCHAR(255) PRIMARY KEY,
+    api_key_hash VARCHAR(255) NOT NULL,
+    created_at   DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    expires_at   DATETIME(6) NOT NULL,
+    KEY refresh_tokens_api_key_hash_idx (api_key_hash)
+);
+
+CREATE TABLE admin_secrets (
+    secret_hash VARCHAR(255) PRIMARY KEY,
+    created_by  VARCHAR(255) NOT NULL DEFAULT '',
+    created_at  DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    expires_at  DATETIME(6)
+);
+
+CREATE TABLE admin_totp (
+    username       VARCHAR(255) PRIMARY KEY,
+    secret         VARCHAR(255),
+    pending_secret VARCHAR(255),
+    last_step      BIGINT NOT NULL DEFAULT 0,
+    enabled_at     DATETIME(6)
+);
+
+CREATE TABLE token_issuance (
+    day        DATE NOT NULL,
+    product_id VARCHAR(255) NOT NULL DEFAULT '',
+    tokens     BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (day, product_id)
+);
+
+CREATE TABLE license_revisions (
+    id             BIGINT AUTO_INCREMENT PRIMARY KEY,
+    license_key    VARCHAR(255) NOT NULL,
+    created_at     DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    actor          VARCHAR(255) NOT NULL,
+    action         VARCHAR(255) NOT NULL,
+    changed_fields TEXT NOT NULL DEFAULT '{}',
+    license        JSON NOT NULL,
+    FOREIGN KEY (license_key) REFERENCES licenses (license_key) ON DELETE CASCADE,
+    KEY license_revisions_license_idx (license_key, id)
+);
+
+CREATE TABLE license_reminders (
+    license_key VARCHAR(255) NOT NULL,
+    expires_at  DATETIME(6) NOT NULL,
+    days        INT NOT NULL,
+    sent_at     DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (license_key, expires_at, days),
+    FOREIGN KEY (license_key) REFERENCES licenses (license_key) ON DELETE CASCADE
+);
+
+CREATE TABLE expiry_digests (
+    day     DATE PRIMARY KEY,
+    sent_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6)
+);
+
+-- +goose Down
+DROP TABLE expiry_digests;
+DROP TABLE license_reminders;
+DROP TABLE license_revisions;
+DROP TABLE token_issuance;
+DROP TABLE admin_totp;
+DROP TABLE admin_secrets;
+DROP TABLE refresh_tokens;
+DROP TABLE validation_challenges;
+DROP TABLE request_nonces;
+DROP TABLE validation_lockouts;
+DROP TABLE ip_bans;
+DROP TABLE banned_hwids;
+DROP TABLE license_trials;
+DROP TABLE license_deliveries;
+DROP TABLE product_releases;
+DROP TABLE license_sessions;
+DROP TABLE admin_sessions;
+DROP TABLE admins;
+DROP TABLE reseller_ledger;
+DROP TABLE resellers;
+DROP TABLE stripe_events;
+DROP TABLE stripe_subscriptions;
+DROP TABLE validation_events;
+DROP TABLE audit_log;
+DROP TABLE license_devices;
+DROP TABLE licenses;
+DROP TABLE customers;
+DROP TABLE products;
+DROP TABLE access_tokens;
+DROP TABLE api_keys;
@@ -0,0 +1,10 @@
+-- +goose Up
+CREATE TABLE maintenance (
+    id         INT PRIMARY KEY CHECK (id = 1),
+    message    TEXT NOT NULL,
+    started_by VARCHAR(255) NOT NULL DEFAULT '',
+    started_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6)
+);
+
+-- +goose Down
+DROP TABLE maintenance;
@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE product_messages (
+    product_id VARCHAR(255) NOT NULL,
+    locale     VARCHAR(35) NOT NULL,
+    reason     VARCHAR(64) NOT NULL,
+    message    TEXT NOT NULL,
+    PRIMARY KEY (product_id, locale, reason),
+    FOREIGN KEY (product_id) REFERENCES products (product_id) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE product_messages;
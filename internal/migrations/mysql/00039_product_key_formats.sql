@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE products ADD COLUMN key_format VARCHAR(255) NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE products DROP COLUMN key_format;
@@ -0,0 +1,29 @@
+-- +goose Up
+-- Approximates hwid.Hash, which also trims Unicode spaces
+INSERT INTO license_devices (license_key, hwid, created_at)
+SELECT license_key, CONCAT('sha256:', SHA2(LOWER(REGEXP_REPLACE(hwid, '^[[:space:]]+|[[:space:]]+$', '')), 256)) AS hashed, MIN(created_at)
+FROM license_devices WHERE hwid NOT LIKE 'sha256:%'
+GROUP BY license_key, hashed
+ON DUPLICATE KEY UPDATE created_at = LEAST(license_devices.created_at, VALUES(created_at));
+DELETE FROM license_devices WHERE hwid NOT LIKE 'sha256:%';
+
+INSERT IGNORE INTO banned_hwids (hwid, reason, banned_by, created_at)
+SELECT CONCAT('sha256:', SHA2(LOWER(REGEXP_REPLACE(hwid, '^[[:space:]]+|[[:space:]]+$', '')), 256)), reason, banned_by, created_at
+FROM banned_hwids WHERE hwid NOT LIKE 'sha256:%'
+ORDER BY created_at, hwid;
+DELETE FROM banned_hwids WHERE hwid NOT LIKE 'sha256:%';
+
+DELETE t FROM license_trials t JOIN license_trials o
+    ON o.product_id = t.product_id AND o.id < t.id
+    AND LOWER(REGEXP_REPLACE(o.hwid, '^[[:space:]]+|[[:space:]]+$', '')) = LOWER(REGEXP_REPLACE(t.hwid, '^[[:space:]]+|[[:space:]]+$', ''))
+WHERE t.hwid NOT LIKE 'sha256:%';
+UPDATE license_trials SET hwid = CONCAT('sha256:', SHA2(LOWER(REGEXP_REPLACE(hwid, '^[[:space:]]+|[[:space:]]+$', '')), 256))
+WHERE hwid NOT LIKE 'sha256:%';
+
+UPDATE license_sessions SET hwid = CONCAT('sha256:', SHA2(LOWER(REGEXP_REPLACE(hwid, '^[[:space:]]+|[[:space:]]+$', '')), 256))
+WHERE hwid <> '' AND hwid NOT LIKE 'sha256:%';
+UPDATE validation_events SET hwid = CONCAT('sha256:', SHA2(LOWER(REGEXP_REPLACE(hwid, '^[[:space:]]+|[[:space:]]+$', '')), 256))
+WHERE hwid <> '' AND hwid NOT LIKE 'sha256:%';
+
+-- +goose Down
+-- Hashes can't be turned back into HWIDs
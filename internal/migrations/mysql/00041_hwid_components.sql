@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE license_devices
+    ADD COLUMN cpu VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN disk VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN mac VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN machine_guid VARCHAR(255) NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE license_devices
+    DROP COLUMN cpu,
+    DROP COLUMN disk,
+    DROP COLUMN mac,
+    DROP COLUMN machine_guid;
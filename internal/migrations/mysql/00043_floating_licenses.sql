@@ -0,0 +1,17 @@
+-- +goose Up
+ALTER TABLE licenses ADD COLUMN floating_seats INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE license_leases (
+    token_hash     VARCHAR(255) PRIMARY KEY,
+    license_key    VARCHAR(255) NOT NULL,
+    hwid           VARCHAR(255) NOT NULL,
+    client_ip      VARCHAR(255) NOT NULL DEFAULT '',
+    checked_out_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    expires_at     DATETIME(6) NOT NULL,
+    FOREIGN KEY (license_key) REFERENCES licenses (license_key) ON DELETE CASCADE,
+    KEY license_leases_license_idx (license_key, expires_at)
+);
+
+-- +goose Down
+DROP TABLE license_leases;
+ALTER TABLE licenses DROP COLUMN floating_seats;
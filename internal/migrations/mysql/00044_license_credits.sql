@@ -0,0 +1,19 @@
+-- +goose Up
+ALTER TABLE licenses ADD COLUMN credits BIGINT NOT NULL DEFAULT 0 CHECK (credits >= 0);
+
+CREATE TABLE license_credit_ledger (
+    id          BIGINT AUTO_INCREMENT PRIMARY KEY,
+    license_key VARCHAR(255) NOT NULL,
+    created_at  DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    kind        VARCHAR(255) NOT NULL,
+    delta       BIGINT NOT NULL,
+    balance     BIGINT NOT NULL,
+    actor       VARCHAR(255) NOT NULL,
+    note        TEXT NOT NULL DEFAULT '',
+    FOREIGN KEY (license_key) REFERENCES licenses (license_key) ON DELETE CASCADE,
+    KEY license_credit_ledger_license_idx (license_key, id)
+);
+
+-- +goose Down
+DROP TABLE license_credit_ledger;
+ALTER TABLE licenses DROP COLUMN credits;
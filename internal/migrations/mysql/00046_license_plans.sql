@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE plans (
+    name             VARCHAR(255) PRIMARY KEY,
+    description      TEXT NOT NULL DEFAULT '',
+    max_devices      INTEGER NOT NULL DEFAULT 1,
+    features         JSON NOT NULL DEFAULT '{}',
+    duration_seconds BIGINT NOT NULL DEFAULT 0,
+    created_at       DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    updated_at       DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6)
+);
+
+ALTER TABLE licenses ADD COLUMN plan VARCHAR(255),
+    ADD CONSTRAINT licenses_plan_fk FOREIGN KEY (plan) REFERENCES plans (name) ON DELETE SET NULL;
+
+-- +goose Down
+ALTER TABLE licenses DROP FOREIGN KEY licenses_plan_fk, DROP COLUMN plan;
+DROP TABLE plans;
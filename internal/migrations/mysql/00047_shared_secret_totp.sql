@@ -0,0 +1,4 @@
+-- +goose Up
+DELETE FROM admin_totp WHERE username = '';
+
+-- +goose Down
@@ -0,0 +1,11 @@
+-- +goose Up
+UPDATE licenses SET
+    features = JSON_MERGE_PATCH(COALESCE((
+        SELECT CONCAT('{', GROUP_CONCAT(JSON_QUOTE(e.name), ':true'), '}')
+        FROM JSON_TABLE(licenses.metadata, '$.entitlements[*]' COLUMNS (name VARCHAR(255) PATH '$')) e
+        WHERE e.name REGEXP '^[A-Za-z0-9_.:-]{1,64}$'
+    ), '{}'), features),
+    metadata = JSON_REMOVE(metadata, '$.entitlements')
+WHERE JSON_TYPE(JSON_EXTRACT(metadata, '$.entitlements')) = 'ARRAY';
+
+-- +goose Down
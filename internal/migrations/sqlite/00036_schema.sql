@@ -0,0 +1,324 @@
+-- +goose Up
+-- The Postgres schema as of migration 00036, for SQLite databases. Times are
+-- UTC text in the layout the sqlite driver writes, so they sort and compare
+-- as text; arrays are Postgres array literals and JSONB is JSON text.
+
+CREATE TABLE api_keys (
+    key_hash   TEXT PRIMARY KEY,
+    expires_at TIMESTAMP
+);
+
+CREATE TABLE access_tokens (
+    token_hash TEXT PRIMARY KEY,
+    expires_at TIMESTAMP NOT NULL,
+    product_id TEXT
+);
+
+CREATE TABLE products (
+    product_id             TEXT PRIMARY KEY,
+    name                   TEXT NOT NULL,
+    description            TEXT NOT NULL DEFAULT '',
+    disabled               BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at             TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at             TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    min_version            TEXT NOT NULL DEFAULT '',
+    trial_duration_seconds BIGINT NOT NULL DEFAULT 0,
+    allowed_countries      TEXT NOT NULL DEFAULT '{}',
+    blocked_countries      TEXT NOT NULL DEFAULT '{}',
+    signing_secret         TEXT,
+    require_challenge      BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE TABLE customers (
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    email      TEXT NOT NULL DEFAULT '',
+    discord_id TEXT NOT NULL DEFAULT '',
+    notes      TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+CREATE UNIQUE INDEX customers_email_idx ON customers (lower(email)) WHERE email <> '';
+CREATE UNIQUE INDEX customers_discord_id_idx ON customers (discord_id) WHERE discord_id <> '';
+CREATE INDEX customers_email_order_idx ON customers (email, id);
+CREATE INDEX customers_created_order_idx ON customers (created_at, id);
+
+CREATE TABLE licenses (
+    license_key       TEXT PRIMARY KEY,
+    product_id        TEXT NOT NULL REFERENCES products (product_id),
+    is_active         BOOLEAN NOT NULL DEFAULT TRUE,
+    expires_at        TIMESTAMP,
+    created_at        TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    last_validated_at TIMESTAMP,
+    max_devices       INTEGER NOT NULL DEFAULT 1,
+    max_sessions      INTEGER NOT NULL DEFAULT 0,
+    metadata          TEXT NOT NULL DEFAULT '{}',
+    update_channel    TEXT,
+    customer_id       INTEGER REFERENCES customers (id) ON DELETE SET NULL,
+    suspend_reason    TEXT NOT NULL DEFAULT '',
+    allowed_countries TEXT NOT NULL DEFAULT '{}',
+    blocked_countries TEXT NOT NULL DEFAULT '{}',
+    deleted_at        TIMESTAMP
+);
+CREATE INDEX licenses_customer_idx ON licenses (customer_id) WHERE customer_id IS NOT NULL;
+CREATE INDEX licenses_created_order_idx ON licenses (created_at, license_key);
+CREATE INDEX licenses_deleted_idx ON licenses (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX licenses_expires_idx ON licenses (expires_at) WHERE expires_at IS NOT NULL AND deleted_at IS NULL;
+
+CREATE TABLE license_devices (
+    license_key TEXT NOT NULL REFERENCES licenses (license_key) ON DELETE CASCADE,
+    hwid        TEXT NOT NULL,
+    created_at  TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    PRIMARY KEY (license_key, hwid)
+);
+
+CREATE TABLE audit_log (
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    created_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    actor      TEXT NOT NULL,
+    action     TEXT NOT NULL,
+    target     TEXT NOT NULL,
+    old_value  TEXT,
+    new_value  TEXT,
+    source_ip  TEXT
+);
+CREATE INDEX audit_log_target_idx ON audit_log (target);
+
+CREATE TABLE validation_events (
+    id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    created_at  TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    license_key TEXT NOT NULL,
+    product_id  TEXT NOT NULL,
+    hwid        TEXT NOT NULL,
+    valid       BOOLEAN NOT NULL,
+    result      TEXT NOT NULL,
+    client_ip   TEXT,
+    country     TEXT
+);
+CREATE INDEX validation_events_license_idx ON validation_events (license_key, created_at);
+CREATE INDEX validation_events_created_idx ON validation_events (created_at);
+
+CREATE TABLE stripe_subscriptions (
+    subscription_id TEXT NOT NULL,
+    price_id        TEXT NOT NULL,
+    customer_id     TEXT NOT NULL,
+    license_key     TEXT NOT NULL REFERENCES licenses (license_key) ON DELETE CASCADE,
+    created_at      TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    PRIMARY KEY (subscription_id, price_id)
+);
+CREATE INDEX stripe_subscriptions_customer_idx ON stripe_subscriptions (customer_id);
+
+CREATE TABLE stripe_events (
+    event_id     TEXT PRIMARY KEY,
+    type         TEXT NOT NULL,
+    processed_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+CREATE TABLE resellers (
+    id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    name        TEXT NOT NULL UNIQUE,
+    key_hash    TEXT NOT NULL UNIQUE,
+    credits     BIGINT NOT NULL DEFAULT 0 CHECK (credits >= 0),
+    product_ids TEXT,
+    created_at  TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+CREATE TABLE reseller_ledger (
+    id           INTEGER PRIMARY KEY AUTOINCREMENT,
+    reseller_id  INTEGER NOT NULL REFERENCES resellers (id) ON DELETE CASCADE,
+    created_at   TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    kind         TEXT NOT NULL,
+    delta        BIGINT NOT NULL,
+    balance      BIGINT NOT NULL,
+    license_keys TEXT NOT NULL DEFAULT '{}',
+    actor        TEXT NOT NULL,
+    note         TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX reseller_ledger_reseller_idx ON reseller_ledger (reseller_id, id);
+
+CREATE TABLE admins (
+    id            INTEGER PRIMARY KEY AUTOINCREMENT,
+    username      TEXT NOT NULL UNIQUE,
+    password_hash TEXT NOT NULL,
+    role          TEXT NOT NULL CHECK (role IN ('owner', 'support', 'read-only')),
+    disabled      BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at    TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    last_login_at TIMESTAMP
+);
+
+CREATE TABLE admin_sessions (
+    id           TEXT PRIMARY KEY,
+    username     TEXT NOT NULL REFERENCES admins (username) ON DELETE CASCADE,
+    created_at   TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    expires_at   TIMESTAMP NOT NULL,
+    last_seen_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    revoked_at   TIMESTAMP,
+    client_ip    TEXT,
+    user_agent   TEXT
+);
+CREATE INDEX admin_sessions_username_idx ON admin_sessions (username, created_at);
+
+CREATE TABLE license_sessions (
+    token_hash        TEXT PRIMARY KEY,
+    license_key       TEXT NOT NULL REFERENCES licenses (license_key) ON DELETE CASCADE,
+    hwid              TEXT,
+    client_ip         TEXT,
+    started_at        TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    last_heartbeat_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    expires_at        TIMESTAMP NOT NULL
+);
+CREATE INDEX license_sessions_license_idx ON license_sessions (license_key, expires_at);
+
+CREATE TABLE product_releases (
+    product_id   TEXT NOT NULL REFERENCES products (product_id) ON DELETE CASCADE,
+    channel      TEXT NOT NULL,
+    version      TEXT NOT NULL,
+    changelog    TEXT NOT NULL DEFAULT '',
+    download_url TEXT NOT NULL DEFAULT '',
+    published_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    PRIMARY KEY (product_id, channel)
+);
+
+CREATE TABLE license_deliveries (
+    id           INTEGER PRIMARY KEY AUTOINCREMENT,
+    license_key  TEXT NOT NULL REFERENCES licenses (license_key) ON DELETE CASCADE,
+    email        TEXT NOT NULL,
+    status       TEXT NOT NULL DEFAULT 'pending',
+    error        TEXT NOT NULL DEFAULT '',
+    requested_by TEXT NOT NULL DEFAULT '',
+    created_at   TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    sent_at      TIMESTAMP
+);
+CREATE INDEX license_deliveries_license_idx ON license_deliveries (license_key, id);
+
+CREATE TABLE license_trials (
+    id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    product_id  TEXT NOT NULL REFERENCES products (product_id) ON DELETE CASCADE,
+    hwid        TEXT NOT NULL,
+    client_ip   TEXT NOT NULL DEFAULT '',
+    license_key TEXT NOT NULL,
+    created_at  TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+CREATE UNIQUE INDEX license_trials_hwid_idx ON license_trials (product_id, hwid);
+CREATE UNIQUE INDEX license_trials_ip_idx ON license_trials (product_id, client_ip) WHERE client_ip <> '';
+
+CREATE TABLE banned_hwids (
+    hwid       TEXT PRIMARY KEY,
+    reason     TEXT NOT NULL DEFAULT '',
+    banned_by  TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+CREATE INDEX banned_hwids_created_order_idx ON banned_hwids (created_at, hwid);
+
+CREATE TABLE ip_bans (
+    network    TEXT PRIMARY KEY,
+    reason     TEXT NOT NULL DEFAULT '',
+    banned_by  TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    expires_at TIMESTAMP
+);
+
+CREATE TABLE validation_lockouts (
+    scope        TEXT NOT NULL CHECK (scope IN ('key', 'ip')),
+    subject      TEXT NOT NULL,
+    failures     INTEGER NOT NULL DEFAULT 0,
+    locked_until TIMESTAMP,
+    updated_at   TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    PRIMARY KEY (scope, subject)
+);
+
+CREATE TABLE request_nonces (
+    product_id TEXT NOT NULL,
+    nonce      TEXT NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (product_id, nonce)
+);
+
+CREATE TABLE validation_challenges (
+    challenge_hash TEXT PRIMARY KEY,
+    expires_at     TIMESTAMP NOT NULL
+);
+
+CREATE TABLE refresh_tokens (
+    token_hash   TEXT PRIMARY KEY,
+    api_key_hash TEXT NOT NULL,
+    created_at   TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    expires_at   TIMESTAMP NOT NULL
+);
+CREATE INDEX refresh_tokens_api_key_hash_idx ON refresh_tokens (api_key_hash);
+
+CREATE TABLE admin_secrets (
+    secret_hash TEXT PRIMARY KEY,
+    created_by  TEXT NOT NULL DEFAULT '',
+    created_at  TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    expires_at  TIMESTAMP
+);
+
+CREATE TABLE admin_totp (
+    username       TEXT PRIMARY KEY,
+    secret         TEXT,
+    pending_secret TEXT,
+    last_step      BIGINT NOT NULL DEFAULT 0,
+    enabled_at     TIMESTAMP
+);
+
+CREATE TABLE token_issuance (
+    day        DATE NOT NULL,
+    product_id TEXT NOT NULL DEFAULT '',
+    tokens     BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (day, product_id)
+);
+
+CREATE TABLE license_revisions (
+    id             INTEGER PRIMARY KEY AUTOINCREMENT,
+    license_key    TEXT NOT NULL REFERENCES licenses (license_key) ON DELETE CASCADE,
+    created_at     TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    actor          TEXT NOT NULL,
+    action         TEXT NOT NULL,
+    changed_fields TEXT NOT NULL DEFAULT '{}',
+    license        TEXT NOT NULL
+);
+CREATE INDEX license_revisions_license_idx ON license_revisions (license_key, id);
+
+CREATE TABLE license_reminders (
+    license_key TEXT NOT NULL REFERENCES licenses (license_key) ON DELETE CASCADE,
+    expires_at  TIMESTAMP NOT NULL,
+    days        INTEGER NOT NULL,
+    sent_at     TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    PRIMARY KEY (license_key, expires_at, days)
+);
+
+CREATE TABLE expiry_digests (
+    day     DATE PRIMARY KEY,
+    sent_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+-- +goose Down
+DROP TABLE expiry_digests;
+DROP TABLE license_reminders;
+DROP TABLE license_revisions;
+DROP TABLE token_issuance;
+DROP TABLE admin_totp;
+DROP TABLE admin_secrets;
+DROP TABLE refresh_tokens;
+DROP TABLE validation_challenges;
+DROP TABLE request_nonces;
+DROP TABLE validation_lockouts;
+DROP TABLE ip_bans;
+DROP TABLE banned_hwids;
+DROP TABLE license_trials;
+DROP TABLE license_deliveries;
+DROP TABLE product_releases;
+DROP TABLE license_sessions;
+DROP TABLE admin_sessions;
+DROP TABLE admins;
+DROP TABLE reseller_ledger;
+DROP TABLE resellers;
+DROP TABLE stripe_events;
+DROP TABLE stripe_subscriptions;
+DROP TABLE validation_events;
+DROP TABLE audit_log;
+DROP TABLE license_devices;
+DROP TABLE licenses;
+DROP TABLE customers;
+DROP TABLE products;
+DROP TABLE access_tokens;
+DROP TABLE api_keys;
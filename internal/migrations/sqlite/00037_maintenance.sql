@@ -0,0 +1,10 @@
+-- +goose Up
+CREATE TABLE maintenance (
+    id         INTEGER PRIMARY KEY CHECK (id = 1),
+    message    TEXT NOT NULL,
+    started_by TEXT NOT NULL DEFAULT '',
+    started_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+-- +goose Down
+DROP TABLE maintenance;
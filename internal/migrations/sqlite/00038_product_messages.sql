@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE product_messages (
+    product_id TEXT NOT NULL REFERENCES products (product_id) ON DELETE CASCADE,
+    locale     TEXT NOT NULL,
+    reason     TEXT NOT NULL,
+    message    TEXT NOT NULL,
+    PRIMARY KEY (product_id, locale, reason)
+);
+
+-- +goose Down
+DROP TABLE product_messages;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE products ADD COLUMN key_format TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE products DROP COLUMN key_format;
@@ -0,0 +1,27 @@
+-- +goose Up
+-- hwid_hash is hwid.Hash, registered by the database package
+INSERT INTO license_devices (license_key, hwid, created_at)
+SELECT license_key, hwid_hash(hwid), MIN(created_at) FROM license_devices
+WHERE hwid <> hwid_hash(hwid)
+GROUP BY license_key, hwid_hash(hwid)
+ON CONFLICT (license_key, hwid) DO UPDATE SET created_at = MIN(license_devices.created_at, excluded.created_at);
+DELETE FROM license_devices WHERE hwid <> hwid_hash(hwid);
+
+INSERT INTO banned_hwids (hwid, reason, banned_by, created_at)
+SELECT hwid_hash(b.hwid), b.reason, b.banned_by, b.created_at FROM banned_hwids b
+WHERE b.hwid <> hwid_hash(b.hwid) AND NOT EXISTS (
+    SELECT 1 FROM banned_hwids o
+    WHERE hwid_hash(o.hwid) = hwid_hash(b.hwid) AND (o.created_at, o.hwid) < (b.created_at, b.hwid))
+ON CONFLICT (hwid) DO NOTHING;
+DELETE FROM banned_hwids WHERE hwid <> hwid_hash(hwid);
+
+DELETE FROM license_trials WHERE EXISTS (
+    SELECT 1 FROM license_trials o
+    WHERE o.product_id = license_trials.product_id AND hwid_hash(o.hwid) = hwid_hash(license_trials.hwid) AND o.id < license_trials.id);
+UPDATE license_trials SET hwid = hwid_hash(hwid) WHERE hwid <> hwid_hash(hwid);
+
+UPDATE license_sessions SET hwid = hwid_hash(hwid) WHERE hwid <> hwid_hash(hwid);
+UPDATE validation_events SET hwid = hwid_hash(hwid) WHERE hwid <> hwid_hash(hwid);
+
+-- +goose Down
+-- Hashes can't be turned back into HWIDs
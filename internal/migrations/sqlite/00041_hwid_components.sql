@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE license_devices ADD COLUMN cpu TEXT NOT NULL DEFAULT '';
+ALTER TABLE license_devices ADD COLUMN disk TEXT NOT NULL DEFAULT '';
+ALTER TABLE license_devices ADD COLUMN mac TEXT NOT NULL DEFAULT '';
+ALTER TABLE license_devices ADD COLUMN machine_guid TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE license_devices DROP COLUMN machine_guid;
+ALTER TABLE license_devices DROP COLUMN mac;
+ALTER TABLE license_devices DROP COLUMN disk;
+ALTER TABLE license_devices DROP COLUMN cpu;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE licenses ADD COLUMN hwid_rebound_at TIMESTAMP;
+
+-- +goose Down
+ALTER TABLE licenses DROP COLUMN hwid_rebound_at;
@@ -0,0 +1,16 @@
+-- +goose Up
+ALTER TABLE licenses ADD COLUMN floating_seats INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE license_leases (
+    token_hash     TEXT PRIMARY KEY,
+    license_key    TEXT NOT NULL REFERENCES licenses (license_key) ON DELETE CASCADE,
+    hwid           TEXT NOT NULL,
+    client_ip      TEXT NOT NULL DEFAULT '',
+    checked_out_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    expires_at     TIMESTAMP NOT NULL
+);
+CREATE INDEX license_leases_license_idx ON license_leases (license_key, expires_at);
+
+-- +goose Down
+DROP TABLE license_leases;
+ALTER TABLE licenses DROP COLUMN floating_seats;
@@ -0,0 +1,18 @@
+-- +goose Up
+ALTER TABLE licenses ADD COLUMN credits BIGINT NOT NULL DEFAULT 0 CHECK (credits >= 0);
+
+CREATE TABLE license_credit_ledger (
+    id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    license_key TEXT NOT NULL REFERENCES licenses (license_key) ON DELETE CASCADE,
+    created_at  TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    kind        TEXT NOT NULL,
+    delta       BIGINT NOT NULL,
+    balance     BIGINT NOT NULL,
+    actor       TEXT NOT NULL,
+    note        TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX license_credit_ledger_license_idx ON license_credit_ledger (license_key, id);
+
+-- +goose Down
+DROP TABLE license_credit_ledger;
+ALTER TABLE licenses DROP COLUMN credits;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE products ADD COLUMN features TEXT NOT NULL DEFAULT '{}';
+ALTER TABLE licenses ADD COLUMN features TEXT NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE licenses DROP COLUMN features;
+ALTER TABLE products DROP COLUMN features;
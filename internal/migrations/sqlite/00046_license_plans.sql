@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE plans (
+    name             TEXT PRIMARY KEY,
+    description      TEXT NOT NULL DEFAULT '',
+    max_devices      INTEGER NOT NULL DEFAULT 1,
+    features         TEXT NOT NULL DEFAULT '{}',
+    duration_seconds BIGINT NOT NULL DEFAULT 0,
+    created_at       TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at       TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+ALTER TABLE licenses ADD COLUMN plan TEXT REFERENCES plans (name) ON DELETE SET NULL;
+CREATE INDEX licenses_plan_idx ON licenses (plan) WHERE plan IS NOT NULL;
+
+-- +goose Down
+DROP INDEX licenses_plan_idx;
+ALTER TABLE licenses DROP COLUMN plan;
+DROP TABLE plans;
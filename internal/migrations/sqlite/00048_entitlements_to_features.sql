@@ -0,0 +1,12 @@
+-- +goose Up
+UPDATE licenses SET
+    features = json_patch((
+        SELECT json_group_object(e.value, json('true'))
+        FROM json_each(licenses.metadata, '$.entitlements') e
+        WHERE e.type = 'text' AND length(e.value) BETWEEN 1 AND 64
+            AND e.value NOT GLOB '*[^A-Za-z0-9_.:-]*'
+    ), features),
+    metadata = json_remove(metadata, '$.entitlements')
+WHERE json_type(metadata, '$.entitlements') = 'array';
+
+-- +goose Down
@@ -0,0 +1,173 @@
+// Package notify sends security alerts to Telegram chats.
+//
+// Alerts are routed by kind to the chats configured for it (or the default
+// chats), sent in the background, and throttled so a burst of identical
+// alerts (say, one IP hammering the admin API) produces a single message.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// Alert kinds
+const (
+	HwidMismatch            = "hwid.mismatch"
+	ValidationFailureStreak = "validation.failure_streak"
+	AdminAuthFailed         = "admin.auth_failed"
+	IPAutoBanned            = "ip.auto_banned"
+	ExpiryDigest            = "expiry.digest"
+)
+
+const (
+	telegramAPI    = "https://api.telegram.org"
+	queueSize      = 100
+	throttleWindow = time.Minute
+)
+
+// Alert is one message. Alerts with the same Kind and Key are sent at most
+// once per throttle window.
+type Alert struct {
+	Kind string
+	Key  string
+	Text string
+}
+
+type message struct {
+	chatID string
+	text   string
+}
+
+// Telegram posts alerts through a bot. A nil *Telegram drops everything.
+type Telegram struct {
+	token        string
+	defaultChats []string
+	routes       map[string][]string
+	client       *http.Client
+
+	mu       sync.Mutex
+	lastSent map[string]time.Time
+
+	queue chan message
+	done  chan struct{}
+}
+
+// NewTelegram starts a notifier for the bot token, or returns nil when token
+// is empty. routes maps alert kinds to chat IDs; kinds without a route go to
+// defaultChats.
+func NewTelegram(token string, defaultChats []string, routes map[string][]string) *Telegram {
+	if token == "" {
+		return nil
+	}
+	t := &Telegram{
+		token:        token,
+		defaultChats: defaultChats,
+		routes:       routes,
+		client:       &http.Client{Timeout: 10 * time.Second},
+		lastSent:     make(map[string]time.Time),
+		queue:        make(chan message, queueSize),
+		done:         make(chan struct{}),
+	}
+	go t.run()
+	return t
+}
+
+// Send queues a for its chats without blocking.
+func (t *Telegram) Send(a Alert) {
+	if t == nil || !t.allow(a) {
+		return
+	}
+	chats, ok := t.routes[a.Kind]
+	if !ok {
+		chats = t.defaultChats
+	}
+	for _, chat := range chats {
+		select {
+		case t.queue <- message{chatID: chat, text: a.Text}:
+		default:
+			log.Printf("notify: queue full, dropping %s alert", a.Kind)
+		}
+	}
+}
+
+// allow reports whether a is outside its throttle window, recording it if so.
+func (t *Telegram) allow(a Alert) bool {
+	now := time.Now()
+	key := a.Kind + "\x00" + a.Key
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if last, ok := t.lastSent[key]; ok && now.Sub(last) < throttleWindow {
+		return false
+	}
+	t.lastSent[key] = now
+	// Keep the map from growing with every distinct key ever seen
+	if len(t.lastSent) > 10000 {
+		for k, last := range t.lastSent {
+			if now.Sub(last) >= throttleWindow {
+				delete(t.lastSent, k)
+			}
+		}
+	}
+	return true
+}
+
+// Close stops accepting alerts and waits for queued ones until ctx expires.
+// Send must not be called after Close.
+func (t *Telegram) Close(ctx context.Context) {
+	if t == nil {
+		return
+	}
+	close(t.queue)
+	select {
+	case <-t.done:
+	case <-ctx.Done():
+	}
+}
+
+func (t *Telegram) run() {
+	defer close(t.done)
+	for m := range t.queue {
+		if err := t.post(m); err != nil {
+			log.Printf("notify: telegram chat %s: %v", m.chatID, err)
+		}
+	}
+}
+
+func (t *Telegram) post(m message) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"chat_id":                  m.chatID,
+		"text":                     m.text,
+		"disable_web_page_preview": true,
+	})
+	if err != nil {
+		return err
+	}
+	resp, err := t.client.Post(fmt.Sprintf("%s/bot%s/sendMessage", telegramAPI, t.token), "application/json", bytes.NewReader(body))
+	if err != nil {
+		// The URL contains the token; don't let it reach the logs
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Description string `json:"description"`
+		}
+		json.NewDecoder(resp.Body).Decode(&apiErr)
+		return fmt.Errorf("status %d: %s", resp.StatusCode, apiErr.Description)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package ratelimit
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/mkseven15/whitelist-server/internal/clientip"
+	"github.com/mkseven15/whitelist-server/internal/errinfo"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// UnaryServerInterceptor limits calls to the given full method names per
+// client IP and, for requests carrying an api_key, per API key. Either
+// limiter may be nil to disable it. Rejected calls get ResourceExhausted,
+// which the gateway turns into HTTP 429.
+func UnaryServerInterceptor(byIP, byAPIKey *Limiter, methods ...string) grpc.UnaryServerInterceptor {
+	limited := make(map[string]bool, len(methods))
+	for _, m := range methods {
+		limited[m] = true
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !limited[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		if !byIP.Allow(clientip.FromContext(ctx)) {
+			return nil, errinfo.Error(codes.ResourceExhausted, pb.Reason_REASON_RATE_LIMITED, "rate limit exceeded, slow down")
+		}
+		if r, ok := req.(interface{ GetApiKey() string }); ok && r.GetApiKey() != "" {
+			if !byAPIKey.Allow(r.GetApiKey()) {
+				return nil, errinfo.Error(codes.ResourceExhausted, pb.Reason_REASON_RATE_LIMITED, "rate limit exceeded for this API key")
+			}
+		}
+		return handler(ctx, req)
+	}
+}
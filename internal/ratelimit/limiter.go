@@ -0,0 +1,91 @@
+// Package ratelimit provides keyed token-bucket rate limiting for gRPC calls.
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// sweepInterval controls how often idle buckets are dropped from memory.
+const sweepInterval = time.Minute
+
+// Limiter is a set of token buckets, one per key (client IP, API key, ...).
+// Each bucket refills at rate tokens per second up to burst.
+type Limiter struct {
+	rate  float64
+	burst float64
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// New returns a Limiter allowing rate requests per second per key with the
+// given burst. A rate <= 0 returns nil, which allows everything.
+func New(rate float64, burst int) *Limiter {
+	if rate <= 0 {
+		return nil
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &Limiter{
+		rate:      rate,
+		burst:     float64(burst),
+		buckets:   make(map[string]*bucket),
+		lastSweep: time.Now(),
+	}
+}
+
+// Allow takes one token from key's bucket, reporting whether one was available.
+func (l *Limiter) Allow(key string) bool {
+	if l == nil {
+		return true
+	}
+	return l.allow(key, time.Now())
+}
+
+// allow is Allow at time now.
+func (l *Limiter) allow(key string, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastSweep) > sweepInterval {
+		l.sweep(now)
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	} else {
+		b.tokens += now.Sub(b.last).Seconds() * l.rate
+		if b.tokens > l.burst {
+			b.tokens = l.burst
+		}
+		b.last = now
+	}
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// sweep forgets buckets that would have refilled completely; they behave
+// exactly like a fresh bucket. Caller holds l.mu.
+func (l *Limiter) sweep(now time.Time) {
+	full := time.Duration(l.burst / l.rate * float64(time.Second))
+	for key, b := range l.buckets {
+		if now.Sub(b.last) > full {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastSweep = now
+}
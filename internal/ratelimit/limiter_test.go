@@ -0,0 +1,121 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+func TestLimiterRefill(t *testing.T) {
+	l := New(2, 3) // 2 per second, burst 3
+	start := time.Now()
+	tests := []struct {
+		name  string
+		after time.Duration
+		want  bool
+	}{
+		{"burst 1", 0, true},
+		{"burst 2", 0, true},
+		{"burst 3", 0, true},
+		{"empty", 0, false},
+		{"not yet a token", 400 * time.Millisecond, false},
+		{"one token back", 500 * time.Millisecond, true},
+		{"spent", 500 * time.Millisecond, false},
+		// A long wait refills up to the burst, no further
+		{"refilled 1", time.Hour, true},
+		{"refilled 2", time.Hour, true},
+		{"refilled 3", time.Hour, true},
+		{"capped at burst", time.Hour, false},
+	}
+	for _, tt := range tests {
+		if got := l.allow("k", start.Add(tt.after)); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestLimiterKeys(t *testing.T) {
+	l := New(1, 1)
+	now := time.Now()
+	if !l.allow("a", now) || l.allow("a", now) {
+		t.Fatal("a: want one call allowed, then none")
+	}
+	// Another key has its own bucket
+	if !l.allow("b", now) {
+		t.Error("b was limited by a's calls")
+	}
+	if l.allow("a", now) {
+		t.Error("a's bucket refilled by b's call")
+	}
+}
+
+func TestLimiterSweep(t *testing.T) {
+	l := New(1, 2)
+	now := time.Now()
+	l.allow("idle", now)
+	l.allow("busy", now)
+	// Past sweepInterval "idle" has refilled and is forgotten; "busy" has
+	// just been used
+	later := now.Add(sweepInterval + time.Second)
+	l.buckets["busy"].last = later
+	l.allow("other", later)
+	if _, ok := l.buckets["idle"]; ok {
+		t.Error("idle bucket kept")
+	}
+	if _, ok := l.buckets["busy"]; !ok {
+		t.Error("busy bucket dropped")
+	}
+}
+
+func TestNilLimiter(t *testing.T) {
+	l := New(0, 10)
+	if l != nil {
+		t.Fatal("New(0, ...) returned a limiter")
+	}
+	if !l.Allow("k") {
+		t.Error("a nil limiter refused a call")
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	byIP, byKey := New(1, 2), New(1, 1)
+	intercept := UnaryServerInterceptor(byIP, byKey, "/svc/Limited")
+	from := func(ip string) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 1234}})
+	}
+	call := func(ctx context.Context, method string, req interface{}) codes.Code {
+		_, err := intercept(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		return status.Code(err)
+	}
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		req    interface{}
+		want   codes.Code
+	}{
+		{"unlimited method", from("203.0.113.1"), "/svc/Other", nil, codes.OK},
+		{"first key call", from("203.0.113.1"), "/svc/Limited", &pb.GetTokenRequest{ApiKey: "k1"}, codes.OK},
+		{"key spent", from("203.0.113.2"), "/svc/Limited", &pb.GetTokenRequest{ApiKey: "k1"}, codes.ResourceExhausted},
+		{"other key", from("203.0.113.3"), "/svc/Limited", &pb.GetTokenRequest{ApiKey: "k2"}, codes.OK},
+		{"ip burst", from("203.0.113.1"), "/svc/Limited", nil, codes.OK},
+		{"ip spent", from("203.0.113.1"), "/svc/Limited", nil, codes.ResourceExhausted},
+		{"ip spent, other methods pass", from("203.0.113.1"), "/svc/Other", nil, codes.OK},
+		{"other ip", from("198.51.100.1"), "/svc/Limited", nil, codes.OK},
+	}
+	for _, tt := range tests {
+		if got := call(tt.ctx, tt.method, tt.req); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
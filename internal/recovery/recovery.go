@@ -0,0 +1,48 @@
+// Package recovery turns a panicking RPC into a failed call, so one bad
+// request can't take down the process and with it the gateway.
+package recovery
+
+import (
+	"context"
+	"log"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mkseven15/whitelist-server/internal/metrics"
+)
+
+var panics = metrics.NewCounter("whitelist_panics_total", "RPCs that panicked.", "method")
+
+// UnaryServerInterceptor answers calls whose handler (or a later
+// interceptor) panics with Internal, logging the panic with its stack.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				err = recovered(info.FullMethod, p)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is UnaryServerInterceptor for streaming methods.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				err = recovered(info.FullMethod, p)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+func recovered(method string, p interface{}) error {
+	panics.Add(1, method)
+	log.Printf("Panic in %s: %v\n%s", method, p, debug.Stack())
+	return status.Error(codes.Internal, "internal error")
+}
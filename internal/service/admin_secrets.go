@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mkseven15/whitelist-server/internal/store"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+const auditAdminSecretRotate = "admin.secret_rotate"
+
+// How long replaced secrets keep working unless the caller says otherwise
+const defaultSecretOverlap = 24 * time.Hour
+
+// 60. RotateAdminSecret (Owner)
+func (s *WhitelistService) RotateAdminSecret(ctx context.Context, req *pb.RotateAdminSecretRequest) (*pb.RotateAdminSecretResponse, error) {
+	if err := s.requireWrite(ctx, roleOwner); err != nil {
+		return nil, err
+	}
+	if req.OverlapSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "overlap_seconds must not be negative")
+	}
+	overlap := defaultSecretOverlap
+	if req.OverlapSeconds > 0 {
+		overlap = time.Duration(req.OverlapSeconds) * time.Second
+	}
+
+	secret, err := newAccessToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate secret: %v", err)
+	}
+	expireAt := time.Now().Add(overlap)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	// Secrets already due to expire sooner keep their date
+	if err := s.admins.ExpireSecrets(ctx, tx, expireAt); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.admins.AddSecret(ctx, tx, s.hashSecret(secret), adminActor(ctx)); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	resp := &pb.RotateAdminSecretResponse{Secret: secret, PreviousExpireAt: timestamppb.New(expireAt)}
+	// Logged without the secret
+	if err := s.recordAudit(ctx, tx, adminActor(ctx), auditAdminSecretRotate, "admin_secret", nil, &pb.RotateAdminSecretResponse{PreviousExpireAt: resp.PreviousExpireAt}); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	return resp, nil
+}
+
+// checkAdminSecret reports whether the x-admin-secret values hold one of the
+// configured secrets or a live rotated one. No secret at all locks this path
+// rather than opening it.
+func (s *WhitelistService) checkAdminSecret(ctx context.Context, values []string) (bool, error) {
+	if len(values) == 0 || values[0] == "" {
+		return false, nil
+	}
+	for _, secret := range s.adminSecrets {
+		if subtle.ConstantTimeCompare([]byte(values[0]), []byte(secret)) == 1 {
+			return true, nil
+		}
+	}
+	err := s.withPreviousSalt(values[0], func(hash string) error {
+		ok, err := s.admins.SecretLive(ctx, s.db, hash)
+		if err == nil && !ok {
+			return sql.ErrNoRows
+		}
+		return err
+	}, func(old, current string) error {
+		return s.admins.RehashSecret(ctx, s.db, old, current)
+	})
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// haveAdminSecret reports whether any shared secret currently works.
+func (s *WhitelistService) haveAdminSecret(ctx context.Context, q store.Querier) (bool, error) {
+	if len(s.adminSecrets) > 0 {
+		return true, nil
+	}
+	return s.admins.AnySecretLive(ctx, q)
+}
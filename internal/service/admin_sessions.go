@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// Audit actions for admin sessions
+const (
+	auditAdminLogout        = "admin.logout"
+	auditAdminSessionRevoke = "admin.session_revoke"
+)
+
+// How long ended sessions stay listable before the cleaner deletes them
+const adminSessionRetention = 30 * 24 * time.Hour
+
+// createAdminSession records a new session for username, returning its ID.
+func (s *WhitelistService) createAdminSession(ctx context.Context, username string, expiresAt time.Time) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	id := hex.EncodeToString(b)
+	err := s.admins.CreateSession(ctx, s.db, &pb.AdminSession{
+		Id: id, Username: username, ExpiresAt: timestamppb.New(expiresAt), ClientIp: clientIP(ctx), UserAgent: userAgent(ctx),
+	})
+	return id, err
+}
+
+// checkAdminSession resolves a verified token to the live session behind it.
+func (s *WhitelistService) checkAdminSession(ctx context.Context, claims *jwt.RegisteredClaims) (*adminIdentity, error) {
+	sess, err := s.admins.Session(ctx, s.db, claims.ID, claims.Subject)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if sess == nil || sess.Ended {
+		return nil, status.Error(codes.Unauthenticated, "admin session has ended, log in again")
+	}
+	if sess.Disabled {
+		return nil, status.Error(codes.PermissionDenied, "admin account disabled")
+	}
+
+	// Coarse activity tracking; once a minute is plenty for ListAdminSessions
+	_ = s.admins.SeeSession(ctx, s.db, claims.ID)
+
+	role, _ := parseRole(sess.Role)
+	return &adminIdentity{username: claims.Subject, sessionID: claims.ID, role: role}, nil
+}
+
+// 21. AdminLogout
+func (s *WhitelistService) AdminLogout(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	id, err := s.authAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if id.sessionID == "" {
+		return nil, status.Error(codes.FailedPrecondition, "not logged in with a session token")
+	}
+
+	if _, err := s.admins.RevokeSession(ctx, s.db, id.sessionID); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.recordAudit(ctx, s.db, id.username, auditAdminLogout, id.username, nil, nil); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// 22. ListAdminSessions
+func (s *WhitelistService) ListAdminSessions(ctx context.Context, req *pb.ListAdminSessionsRequest) (*pb.ListAdminSessionsResponse, error) {
+	id, err := s.authAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	username := req.Username
+	if username == "" {
+		username = id.username
+	}
+	if username != id.username && id.role < roleOwner {
+		return nil, status.Error(codes.PermissionDenied, "only owners can list other admins' sessions")
+	}
+
+	// A shared-secret caller without a filter gets everyone's sessions
+	sessions, err := s.admins.ListSessions(ctx, s.db, username, req.IncludeEnded)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	return &pb.ListAdminSessionsResponse{Sessions: sessions}, nil
+}
+
+// 23. RevokeAdminSession
+func (s *WhitelistService) RevokeAdminSession(ctx context.Context, req *pb.RevokeAdminSessionRequest) (*emptypb.Empty, error) {
+	id, err := s.authAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := s.admins.SessionOwner(ctx, s.db, req.SessionId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if owner == "" {
+		return nil, status.Error(codes.NotFound, "session not found")
+	}
+	if owner != id.username && id.role < roleOwner {
+		return nil, status.Error(codes.PermissionDenied, "only owners can revoke other admins' sessions")
+	}
+
+	if _, err := s.admins.RevokeSession(ctx, s.db, req.SessionId); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.recordAudit(ctx, s.db, adminActor(ctx), auditAdminSessionRevoke, owner, nil, nil); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// userAgent returns the caller's User-Agent; the gateway forwards it with
+// its grpcgateway- prefix.
+func userAgent(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, key := range []string{"grpcgateway-user-agent", "user-agent"} {
+		if v := md.Get(key); len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ""
+}
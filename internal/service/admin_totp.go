@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/mkseven15/whitelist-server/internal/totp"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// Logged when an enrollment is confirmed; resets show up as admin.update
+const auditAdminTotpEnroll = "admin.totp_enroll"
+
+// The header holding the caller's current TOTP code
+const adminOTPHeader = "x-admin-otp"
+
+// 61. EnrollAdminTotp (Read-only)
+func (s *WhitelistService) EnrollAdminTotp(ctx context.Context, req *pb.EnrollAdminTotpRequest) (*pb.EnrollAdminTotpResponse, error) {
+	if err := s.requireWrite(ctx, roleReadOnly); err != nil {
+		return nil, err
+	}
+	id, err := s.authAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Everyone holding the shared secret would share the one factor
+	if id.username == "" {
+		return nil, status.Error(codes.FailedPrecondition, "the shared admin secret can't enroll a TOTP; log in as a named admin")
+	}
+
+	if req.Code == "" {
+		// Replacing a working factor takes a code from it, or a stolen
+		// credential could swap in its own
+		if err := s.checkOTP(ctx, id, false); err != nil {
+			return nil, err
+		}
+		secret, err := totp.NewSecret()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate secret: %v", err)
+		}
+		if err := s.admins.StartOTP(ctx, s.db, id.username, secret); err != nil {
+			return nil, status.Errorf(codes.Internal, "db error: %v", err)
+		}
+		return &pb.EnrollAdminTotpResponse{Secret: secret, OtpauthUrl: totp.URL(adminTokenIssuer, id.username, secret)}, nil
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	pending, err := s.admins.PendingOTP(ctx, tx, id.username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if !pending.Valid {
+		return nil, status.Error(codes.FailedPrecondition, "no enrollment in progress; call without a code first")
+	}
+	step, ok := totp.Verify(pending.String, req.Code, time.Now())
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid code")
+	}
+	if err := s.admins.ConfirmOTP(ctx, tx, id.username, step); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.recordAudit(ctx, tx, adminActor(ctx), auditAdminTotpEnroll, id.username, nil, nil); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	return &pb.EnrollAdminTotpResponse{Enabled: true}, nil
+}
+
+// requireOTP checks the caller's x-admin-otp code, for calls that delete
+// licenses, change many at once or export keys. The shared secret can't have
+// a code, so it's turned away. Named admins who haven't enrolled get through
+// until any admin has, or ADMIN_OTP_REQUIRED is set.
+func (s *WhitelistService) requireOTP(ctx context.Context) error {
+	id, err := s.authAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	if id.username == "" {
+		return status.Error(codes.FailedPrecondition, "this call needs a TOTP code, which the shared admin secret can't have; log in as a named admin")
+	}
+	required := s.adminOTPRequired
+	if !required {
+		if required, err = s.admins.AnyOTP(ctx, s.db); err != nil {
+			return status.Errorf(codes.Internal, "db error: %v", err)
+		}
+	}
+	return s.checkOTP(ctx, id, required)
+}
+
+// checkOTP checks the x-admin-otp code against the named admin id's enrolled
+// secret and uses it up. With no secret enrolled it fails only when required
+// is set.
+func (s *WhitelistService) checkOTP(ctx context.Context, id *adminIdentity, required bool) error {
+	otp, err := s.admins.OTP(ctx, s.db, id.username)
+	if err != nil {
+		return status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if otp == nil || !otp.Secret.Valid {
+		if required {
+			return status.Error(codes.FailedPrecondition, "this call needs a TOTP code; enroll with EnrollAdminTotp first")
+		}
+		return nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(adminOTPHeader)
+	if len(values) == 0 || values[0] == "" {
+		return status.Errorf(codes.Unauthenticated, "%s required", adminOTPHeader)
+	}
+	step, ok := totp.Verify(otp.Secret.String, values[0], time.Now())
+	if !ok || step <= otp.LastStep {
+		s.alertAdminAuthFailed(ctx, "rejected admin OTP")
+		return status.Error(codes.Unauthenticated, "invalid or already used OTP code")
+	}
+	// Another call may have used the same code in the meantime
+	if ok, err = s.admins.UseOTP(ctx, s.db, id.username, step); err != nil {
+		return status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if !ok {
+		return status.Error(codes.Unauthenticated, "invalid or already used OTP code")
+	}
+	return nil
+}
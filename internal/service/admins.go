@@ -0,0 +1,354 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mkseven15/whitelist-server/internal/notify"
+	"github.com/mkseven15/whitelist-server/internal/store"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// adminRole orders the admin roles; each role can do everything the ones
+// below it can.
+type adminRole int
+
+const (
+	roleReadOnly adminRole = iota + 1 // read licenses, audit log and reseller activity
+	roleSupport                       // plus create/edit licenses and reset HWIDs
+	roleOwner                         // plus deletes, bulk changes, resellers and admin accounts
+)
+
+var roleNames = map[adminRole]string{
+	roleReadOnly: "read-only",
+	roleSupport:  "support",
+	roleOwner:    "owner",
+}
+
+func parseRole(name string) (adminRole, bool) {
+	for r, n := range roleNames {
+		if n == name {
+			return r, true
+		}
+	}
+	return 0, false
+}
+
+const (
+	adminTokenIssuer  = "whitelist-server"
+	minPasswordLength = 12
+)
+
+// Audit actions for admin accounts
+const (
+	auditAdminLogin  = "admin.login"
+	auditAdminCreate = "admin.create"
+	auditAdminUpdate = "admin.update"
+)
+
+// Compared against when the username doesn't exist, so unknown and known
+// users take the same time to reject
+var dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte("not a real password"), bcrypt.DefaultCost)
+
+// adminIdentity is the caller of an admin RPC. Callers using the shared
+// secret have no username or session.
+type adminIdentity struct {
+	username  string
+	sessionID string
+	role      adminRole
+}
+
+// requireRole authenticates the admin behind ctx and checks they hold at
+// least role need.
+func (s *WhitelistService) requireRole(ctx context.Context, need adminRole) error {
+	id, err := s.authAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	if id.role < need {
+		return status.Errorf(codes.PermissionDenied, "requires %s role", roleNames[need])
+	}
+	return nil
+}
+
+// authAdmin identifies the caller from "authorization: Bearer <token>" issued
+// by AdminLogin, or from the shared x-admin-secret, which acts as an owner.
+// The session and account are re-read on every call so revoking a session or
+// disabling an account takes effect at once.
+func (s *WhitelistService) authAdmin(ctx context.Context) (*adminIdentity, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "metadata missing")
+	}
+
+	if token, ok := bearerToken(md); ok {
+		claims, err := s.verifyAdminToken(token)
+		if err != nil {
+			s.alertAdminAuthFailed(ctx, "invalid admin token")
+			return nil, status.Error(codes.Unauthenticated, "invalid or expired admin token")
+		}
+		return s.checkAdminSession(ctx, claims)
+	}
+
+	values := md.Get("x-admin-secret")
+	ok, err := s.checkAdminSecret(ctx, values)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if !ok {
+		if len(values) > 0 {
+			s.alertAdminAuthFailed(ctx, "rejected admin secret")
+		}
+		return nil, status.Error(codes.PermissionDenied, "invalid admin secret")
+	}
+	return &adminIdentity{role: roleOwner}, nil
+}
+
+func (s *WhitelistService) alertAdminAuthFailed(ctx context.Context, what string) {
+	method, _ := grpc.Method(ctx)
+	ip := clientIP(ctx)
+	s.alerts.Send(notify.Alert{
+		Kind: notify.AdminAuthFailed,
+		Key:  ip,
+		Text: fmt.Sprintf("%s from %s calling %s", strings.ToUpper(what[:1])+what[1:], ip, method),
+	})
+}
+
+func bearerToken(md metadata.MD) (string, bool) {
+	for _, v := range md.Get("authorization") {
+		if token, ok := strings.CutPrefix(v, "Bearer "); ok && token != "" {
+			return token, true
+		}
+	}
+	return "", false
+}
+
+// issueAdminToken signs a token for the session, expiring with it.
+func (s *WhitelistService) issueAdminToken(username, sessionID string, now, expiresAt time.Time) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Issuer:    adminTokenIssuer,
+		Subject:   username,
+		ID:        sessionID,
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	})
+	return token.SignedString(s.adminJWTSecret)
+}
+
+// verifyAdminToken checks the signature and expiry. Whether the session is
+// still live is up to checkAdminSession.
+func (s *WhitelistService) verifyAdminToken(token string) (*jwt.RegisteredClaims, error) {
+	if len(s.adminJWTSecret) == 0 {
+		return nil, fmt.Errorf("admin tokens disabled")
+	}
+	var claims jwt.RegisteredClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (interface{}, error) {
+		return s.adminJWTSecret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithIssuer(adminTokenIssuer), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, err
+	}
+	if claims.ID == "" {
+		return nil, fmt.Errorf("token has no session")
+	}
+	return &claims, nil
+}
+
+// 17. AdminLogin
+func (s *WhitelistService) AdminLogin(ctx context.Context, req *pb.AdminLoginRequest) (*pb.AdminLoginResponse, error) {
+	if len(s.adminJWTSecret) == 0 {
+		return nil, status.Error(codes.Unavailable, "admin login is not configured")
+	}
+
+	login, err := s.admins.Login(ctx, s.db, req.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if login == nil {
+		bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(req.Password))
+		s.alertAdminAuthFailed(ctx, "failed admin login")
+		return nil, status.Error(codes.Unauthenticated, "invalid username or password")
+	}
+	if bcrypt.CompareHashAndPassword(login.PasswordHash, []byte(req.Password)) != nil {
+		s.alertAdminAuthFailed(ctx, "failed admin login")
+		return nil, status.Error(codes.Unauthenticated, "invalid username or password")
+	}
+	if login.Disabled {
+		return nil, status.Error(codes.PermissionDenied, "admin account disabled")
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(s.adminSessionTTL)
+	sessionID, err := s.createAdminSession(ctx, req.Username, expiresAt)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	token, err := s.issueAdminToken(req.Username, sessionID, now, expiresAt)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sign token: %v", err)
+	}
+
+	if err := s.admins.TouchLogin(ctx, s.db, req.Username); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.recordAudit(ctx, s.db, req.Username, auditAdminLogin, req.Username, nil, nil); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+
+	return &pb.AdminLoginResponse{Token: token, ExpiresAt: timestamppb.New(expiresAt), Role: login.Role, SessionId: sessionID}, nil
+}
+
+// 18. CreateAdmin (Owner)
+func (s *WhitelistService) CreateAdmin(ctx context.Context, req *pb.CreateAdminRequest) (*pb.Admin, error) {
+	if err := s.requireWrite(ctx, roleOwner); err != nil {
+		return nil, err
+	}
+
+	if _, ok := parseRole(req.Role); !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown role %q", req.Role)
+	}
+	hash, err := hashAdminPassword(req.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	ok, err := s.admins.Create(ctx, tx, req.Username, hash, req.Role)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if !ok {
+		return nil, status.Errorf(codes.AlreadyExists, "admin %q already exists", req.Username)
+	}
+
+	created, err := s.admins.Get(ctx, tx, req.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.recordAudit(ctx, tx, adminActor(ctx), auditAdminCreate, req.Username, nil, created); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	return created, nil
+}
+
+// 19. UpdateAdmin (Owner)
+func (s *WhitelistService) UpdateAdmin(ctx context.Context, req *pb.UpdateAdminRequest) (*pb.Admin, error) {
+	if err := s.requireWrite(ctx, roleOwner); err != nil {
+		return nil, err
+	}
+	if req.ResetTotp {
+		if err := s.requireOTP(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	old, err := s.admins.Get(ctx, tx, req.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if old == nil {
+		return nil, status.Error(codes.NotFound, "admin not found")
+	}
+
+	u := store.AdminUpdate{Role: req.Role, Disabled: req.Disabled}
+	if req.Role != "" {
+		if _, ok := parseRole(req.Role); !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown role %q", req.Role)
+		}
+	}
+	if req.Password != "" {
+		if u.PasswordHash, err = hashAdminPassword(req.Password); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.admins.Update(ctx, tx, req.Username, u); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	// A new password logs the account out everywhere
+	if req.Password != "" {
+		if err := s.admins.RevokeSessions(ctx, tx, req.Username); err != nil {
+			return nil, status.Errorf(codes.Internal, "db error: %v", err)
+		}
+	}
+	if req.ResetTotp {
+		if err := s.admins.ResetOTP(ctx, tx, req.Username); err != nil {
+			return nil, status.Errorf(codes.Internal, "db error: %v", err)
+		}
+	}
+
+	// Don't let the last owner account lock itself out, unless a shared
+	// secret is still there as a way back in
+	haveSecret, err := s.haveAdminSecret(ctx, tx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if !haveSecret {
+		owners, err := s.admins.EnabledOwners(ctx, tx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "db error: %v", err)
+		}
+		if owners == 0 {
+			return nil, status.Error(codes.FailedPrecondition, "at least one enabled owner is required")
+		}
+	}
+
+	updated, err := s.admins.Get(ctx, tx, req.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.recordAudit(ctx, tx, adminActor(ctx), auditAdminUpdate, req.Username, old, updated); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	return updated, nil
+}
+
+// 20. ListAdmins (Owner)
+func (s *WhitelistService) ListAdmins(ctx context.Context, req *pb.ListAdminsRequest) (*pb.ListAdminsResponse, error) {
+	if err := s.requireRole(ctx, roleOwner); err != nil {
+		return nil, err
+	}
+
+	admins, err := s.admins.List(ctx, s.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	return &pb.ListAdminsResponse{Admins: admins}, nil
+}
+
+func hashAdminPassword(password string) ([]byte, error) {
+	if len(password) < minPasswordLength {
+		return nil, status.Errorf(codes.InvalidArgument, "password must be at least %d characters", minPasswordLength)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid password: %v", err)
+	}
+	return hash, nil
+}
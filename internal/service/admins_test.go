@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mkseven15/whitelist-server/internal/store"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// fakeAdmins keeps accounts and sessions in memory. Methods AdminLogin
+// doesn't call aren't implemented.
+type fakeAdmins struct {
+	store.AdminStore
+	logins   map[string]*store.AdminLogin
+	sessions []*pb.AdminSession
+	touched  []string
+}
+
+func (f *fakeAdmins) Login(_ context.Context, _ store.Querier, username string) (*store.AdminLogin, error) {
+	return f.logins[username], nil
+}
+
+func (f *fakeAdmins) CreateSession(_ context.Context, _ store.Querier, s *pb.AdminSession) error {
+	f.sessions = append(f.sessions, s)
+	return nil
+}
+
+func (f *fakeAdmins) TouchLogin(_ context.Context, _ store.Querier, username string) error {
+	f.touched = append(f.touched, username)
+	return nil
+}
+
+type fakeAudit struct {
+	store.AuditStore
+	entries []*store.AuditEntry
+}
+
+func (f *fakeAudit) Record(_ context.Context, _ store.Querier, e *store.AuditEntry) error {
+	f.entries = append(f.entries, e)
+	return nil
+}
+
+func TestAdminLogin(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("hunter22"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name, username, password string
+		code                     codes.Code
+	}{
+		{"right password", "alice", "hunter22", codes.OK},
+		{"wrong password", "alice", "hunter23", codes.Unauthenticated},
+		{"unknown admin", "mallory", "hunter22", codes.Unauthenticated},
+		{"disabled", "bob", "hunter22", codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			admins := &fakeAdmins{logins: map[string]*store.AdminLogin{
+				"alice": {PasswordHash: hash, Role: "owner"},
+				"bob":   {PasswordHash: hash, Role: "support", Disabled: true},
+			}}
+			audit := &fakeAudit{}
+			s := &WhitelistService{admins: admins, audit: audit, adminJWTSecret: []byte("jwt-secret"), adminSessionTTL: time.Hour}
+
+			resp, err := s.AdminLogin(context.Background(), &pb.AdminLoginRequest{Username: tt.username, Password: tt.password})
+			if status.Code(err) != tt.code {
+				t.Fatalf("got %v, want %v", err, tt.code)
+			}
+			if tt.code != codes.OK {
+				if len(admins.sessions) > 0 || len(admins.touched) > 0 || len(audit.entries) > 0 {
+					t.Errorf("failed login left sessions %v, touched %v, audit %v", admins.sessions, admins.touched, audit.entries)
+				}
+				return
+			}
+
+			if len(admins.sessions) != 1 || admins.sessions[0].Id != resp.SessionId || admins.sessions[0].Username != tt.username {
+				t.Fatalf("sessions %v, want one for %s with id %s", admins.sessions, tt.username, resp.SessionId)
+			}
+			if resp.Role != "owner" {
+				t.Errorf("role %q, want owner", resp.Role)
+			}
+			claims, err := s.verifyAdminToken(resp.Token)
+			if err != nil {
+				t.Fatalf("token doesn't verify: %v", err)
+			}
+			if claims.Subject != tt.username || claims.ID != resp.SessionId {
+				t.Errorf("token for %s session %s, want %s session %s", claims.Subject, claims.ID, tt.username, resp.SessionId)
+			}
+			if len(admins.touched) != 1 || len(audit.entries) != 1 || audit.entries[0].Action != auditAdminLogin {
+				t.Errorf("touched %v, audit %v; want the login recorded once", admins.touched, audit.entries)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"strconv"
+	"time"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mkseven15/whitelist-server/internal/store"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+var auditCSVHeader = []string{"id", "created_at", "actor", "action", "target", "old_value", "new_value", "source_ip"}
+
+const ndjsonContentType = "application/x-ndjson"
+
+// Audit actions
+const (
+	auditLicenseCreate    = "license.create"
+	auditLicenseUpdate    = "license.update"
+	auditLicenseDelete    = "license.delete"
+	auditLicenseResetHwid = "license.reset_hwid"
+)
+
+// recordAudit writes one audit log entry. Pass the transaction doing the
+// mutation so the change and its audit entry commit (or roll back) together.
+// oldValue/newValue may be nil.
+func (s *WhitelistService) recordAudit(ctx context.Context, q store.Querier, actor, action, target string, oldValue, newValue proto.Message) error {
+	oldJSON, err := auditJSON(oldValue)
+	if err != nil {
+		return err
+	}
+	newJSON, err := auditJSON(newValue)
+	if err != nil {
+		return err
+	}
+	return s.audit.Record(ctx, q, &store.AuditEntry{
+		Actor: actor, Action: action, Target: target, OldValue: oldJSON, NewValue: newJSON, SourceIP: clientIP(ctx),
+	})
+}
+
+func auditJSON(m proto.Message) (sql.NullString, error) {
+	// A typed nil pointer still satisfies proto.Message; treat it as absent
+	if m == nil || !m.ProtoReflect().IsValid() {
+		return sql.NullString{}, nil
+	}
+	b, err := protojson.Marshal(m)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: string(b), Valid: true}, nil
+}
+
+// 12. ListAuditEvents (Admin)
+func (s *WhitelistService) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	if err := s.requireRole(ctx, roleReadOnly); err != nil {
+		return nil, err
+	}
+
+	page, err := newPage(store.NewestFirst, req.PageSize, req.PageToken, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.audit.List(ctx, s.db, auditFilter(req.Actor, req.Action, req.Target, req.Since, req.Until), page.Page)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	resp := &pb.ListAuditEventsResponse{}
+	for _, entry := range entries {
+		e, err := auditEvent(entry)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "bad audit payload: %v", err)
+		}
+		resp.Events = append(resp.Events, e)
+	}
+
+	if len(resp.Events) > page.Size {
+		resp.Events = resp.Events[:page.Size]
+		resp.NextPageToken = page.next("", strconv.FormatInt(resp.Events[page.Size-1].Id, 10))
+	}
+	return resp, nil
+}
+
+// auditFilter holds the optional filters ListAuditEvents and ExportAuditLog
+// share.
+func auditFilter(actor, action, target string, since, until *timestamppb.Timestamp) store.AuditFilter {
+	f := store.AuditFilter{Actor: actor, Action: action, Target: target}
+	if since != nil {
+		f.Since = since.AsTime()
+	}
+	if until != nil {
+		f.Until = until.AsTime()
+	}
+	return f
+}
+
+// auditEvent converts an audit log entry, parsing its payloads.
+func auditEvent(entry *store.AuditEntry) (*pb.AuditEvent, error) {
+	e := &pb.AuditEvent{
+		Id: entry.ID, CreatedAt: timestamppb.New(entry.CreatedAt), Actor: entry.Actor, Action: entry.Action,
+		Target: entry.Target, SourceIp: entry.SourceIP,
+	}
+	var err error
+	if e.OldValue, err = jsonStruct(entry.OldValue); err != nil {
+		return nil, err
+	}
+	if e.NewValue, err = jsonStruct(entry.NewValue); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// 62. ExportAuditLog (Admin)
+func (s *WhitelistService) ExportAuditLog(req *pb.ExportAuditLogRequest, stream grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	ctx := stream.Context()
+	if err := s.requireRole(ctx, roleReadOnly); err != nil {
+		return err
+	}
+
+	format := req.Format
+	if format == "" {
+		format = "ndjson"
+	}
+	if format != "ndjson" && format != "csv" {
+		return status.Errorf(codes.InvalidArgument, "unknown format %q", req.Format)
+	}
+
+	var buf bytes.Buffer
+	contentType := ndjsonContentType
+	w := csv.NewWriter(&buf)
+	if format == "csv" {
+		contentType = csvContentType
+		w.Write(auditCSVHeader)
+	}
+
+	flush := func() error {
+		w.Flush()
+		if buf.Len() == 0 {
+			return nil
+		}
+		chunk := &httpbody.HttpBody{ContentType: contentType, Data: append([]byte(nil), buf.Bytes()...)}
+		buf.Reset()
+		return stream.Send(chunk)
+	}
+
+	// Oldest first, the way the events happened
+	n := 0
+	err := s.audit.Export(ctx, s.db, auditFilter(req.Actor, req.Action, req.Target, req.Since, req.Until), func(entry *store.AuditEntry) error {
+		if format == "csv" {
+			// The JSON payloads go in as they were stored
+			w.Write([]string{strconv.FormatInt(entry.ID, 10), entry.CreatedAt.UTC().Format(time.RFC3339Nano), entry.Actor, entry.Action, entry.Target,
+				entry.OldValue.String, entry.NewValue.String, entry.SourceIP})
+		} else {
+			e, err := auditEvent(entry)
+			if err != nil {
+				return status.Errorf(codes.Internal, "bad audit payload: %v", err)
+			}
+			line, err := protojson.Marshal(e)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to encode event: %v", err)
+			}
+			buf.Write(line)
+			buf.WriteByte('\n')
+		}
+
+		if n++; n%exportChunkRows == 0 {
+			return flush()
+		}
+		return nil
+	})
+	if _, ok := status.FromError(err); !ok {
+		return status.Errorf(codes.Internal, "db error: %v", err)
+	} else if err != nil {
+		return err
+	}
+	return flush()
+}
+
+// jsonStruct converts a nullable JSONB column into a Struct (nil for NULL).
+func jsonStruct(v sql.NullString) (*structpb.Struct, error) {
+	if !v.Valid {
+		return nil, nil
+	}
+	var st structpb.Struct
+	if err := protojson.Unmarshal([]byte(v.String), &st); err != nil {
+		return nil, err
+	}
+	return &st, nil
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"sync"
+	"time"
+)
+
+// authBackoff slows down clients that submit invalid API keys to
+// GetAuthToken. Every run of threshold failures blocks the address for twice
+// as long as the previous one, starting at base; once that would exceed max,
+// the address is banned instead (see GetAuthToken). State is per instance.
+type authBackoff struct {
+	threshold int
+	base      time.Duration
+	max       time.Duration
+
+	mu      sync.Mutex
+	clients map[string]*authBackoffState
+}
+
+type authBackoffState struct {
+	failures     int
+	strikes      int
+	blockedUntil time.Time
+	lastFailure  time.Time
+}
+
+// idle reports whether the address has been quiet, and unblocked, for d.
+func (c *authBackoffState) idle(now time.Time, d time.Duration) bool {
+	since := c.lastFailure
+	if c.blockedUntil.After(since) {
+		since = c.blockedUntil
+	}
+	return now.Sub(since) >= d
+}
+
+func newAuthBackoff(threshold int, base, max time.Duration) *authBackoff {
+	return &authBackoff{threshold: threshold, base: base, max: max, clients: make(map[string]*authBackoffState)}
+}
+
+// blocked returns how long ip still has to wait, 0 if it may try.
+func (b *authBackoff) blocked(ip string, now time.Time) time.Duration {
+	if b.threshold <= 0 || ip == "" {
+		return 0
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c, ok := b.clients[ip]
+	if !ok || !now.Before(c.blockedUntil) {
+		return 0
+	}
+	return c.blockedUntil.Sub(now)
+}
+
+// fail notes an invalid key from ip. It reports the block that just started,
+// if any, and whether the address is past the longest block and should be
+// banned; the address starts over clean in that case.
+func (b *authBackoff) fail(ip string, now time.Time) (time.Duration, bool) {
+	if b.threshold <= 0 || ip == "" {
+		return 0, false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c, ok := b.clients[ip]
+	// A quiet spell as long as the longest block forgives earlier strikes
+	if !ok || c.idle(now, b.max) {
+		c = &authBackoffState{}
+		b.clients[ip] = c
+	}
+	c.lastFailure = now
+	c.failures++
+	b.prune(now)
+	if c.failures < b.threshold {
+		return 0, false
+	}
+
+	c.failures = 0
+	c.strikes++
+	block := b.base
+	for i := 1; i < c.strikes && block <= b.max; i++ {
+		block *= 2
+	}
+	if block > b.max {
+		delete(b.clients, ip)
+		return 0, true
+	}
+	c.blockedUntil = now.Add(block)
+	return block, false
+}
+
+// succeed forgets ip's failures.
+func (b *authBackoff) succeed(ip string) {
+	if b.threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	delete(b.clients, ip)
+	b.mu.Unlock()
+}
+
+// prune keeps the map from growing with every address ever seen. Callers
+// hold b.mu.
+func (b *authBackoff) prune(now time.Time) {
+	if len(b.clients) <= 10000 {
+		return
+	}
+	for ip, c := range b.clients {
+		if c.idle(now, b.max) {
+			delete(b.clients, ip)
+		}
+	}
+}
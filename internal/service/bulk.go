@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mkseven15/whitelist-server/internal/store"
+	"github.com/mkseven15/whitelist-server/internal/webhook"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// Bulk operations change a product's licenses one by one in a single
+// transaction, so each still gets its audit entry, history and webhook.
+
+// 70. BulkSuspendByProduct (Admin)
+func (s *WhitelistService) BulkSuspendByProduct(ctx context.Context, req *pb.BulkSuspendByProductRequest) (*pb.BulkOperationResponse, error) {
+	if err := s.requireWrite(ctx, roleOwner); err != nil {
+		return nil, err
+	}
+	if err := s.requireOTP(ctx); err != nil {
+		return nil, err
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if utf8.RuneCountInString(reason) > maxSuspendReason {
+		return nil, status.Errorf(codes.InvalidArgument, "reason must be at most %d characters", maxSuspendReason)
+	}
+	active := true
+	return s.bulkByProduct(ctx, req.ProductId, store.LicenseFilter{IsActive: &active}, func(tx *sql.Tx, key string) (webhook.Event, bool, error) {
+		updated, changed, err := s.suspendInTx(ctx, tx, key, false, reason, auditLicenseSuspend)
+		if err != nil || !changed {
+			return webhook.Event{}, false, err
+		}
+		return licenseEvent(webhook.LicenseUpdated, updated), true, nil
+	})
+}
+
+// 71. BulkDeleteByProduct (Admin)
+func (s *WhitelistService) BulkDeleteByProduct(ctx context.Context, req *pb.BulkDeleteByProductRequest) (*pb.BulkOperationResponse, error) {
+	if err := s.requireWrite(ctx, roleOwner); err != nil {
+		return nil, err
+	}
+	if err := s.requireOTP(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.bulkByProduct(ctx, req.ProductId, store.LicenseFilter{}, func(tx *sql.Tx, key string) (webhook.Event, bool, error) {
+		old, err := s.deleteLicense(ctx, tx, key)
+		if err != nil || old == nil {
+			return webhook.Event{}, false, err
+		}
+		return licenseEvent(webhook.LicenseDeleted, old), true, nil
+	})
+}
+
+// 72. BulkExtendByProduct (Admin)
+func (s *WhitelistService) BulkExtendByProduct(ctx context.Context, req *pb.BulkExtendByProductRequest) (*pb.BulkOperationResponse, error) {
+	if err := s.requireWrite(ctx, roleOwner); err != nil {
+		return nil, err
+	}
+	if err := s.requireOTP(ctx); err != nil {
+		return nil, err
+	}
+
+	if d := time.Duration(req.DurationSeconds) * time.Second; req.DurationSeconds <= 0 || d > maxExtension {
+		return nil, status.Errorf(codes.InvalidArgument, "duration_seconds must be between 1 and %d", int64(maxExtension/time.Second))
+	}
+	filter := store.LicenseFilter{Expires: true, Unexpired: !req.IncludeExpired}
+	actor := adminActor(ctx)
+	return s.bulkByProduct(ctx, req.ProductId, filter, func(tx *sql.Tx, key string) (webhook.Event, bool, error) {
+		_, event, err := s.extendLicense(ctx, tx, actor, &pb.ExtendLicenseRequest{LicenseKey: key, DurationSeconds: req.DurationSeconds})
+		return event, err == nil, err
+	})
+}
+
+// bulkByProduct calls apply in one transaction for each of the product's
+// licenses that aren't deleted and match f, and
+// sends the webhooks for those it changed once it commits.
+func (s *WhitelistService) bulkByProduct(ctx context.Context, productID string, f store.LicenseFilter, apply func(tx *sql.Tx, key string) (webhook.Event, bool, error)) (*pb.BulkOperationResponse, error) {
+	if productID == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id required")
+	}
+	missing, err := s.products.Unknown(ctx, s.db, []string{productID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if len(missing) > 0 {
+		return nil, status.Error(codes.NotFound, "product not found")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	f.ProductID, f.Live = productID, true
+	keys, err := s.licenseLists.LockKeys(ctx, tx, f)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	var changed []string
+	var events []webhook.Event
+	for _, key := range keys {
+		event, ok, err := apply(tx, key)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			changed = append(changed, key)
+			events = append(events, event)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+
+	s.invalidateLicenses(ctx, changed...)
+	s.notify(events...)
+	return &pb.BulkOperationResponse{Affected: int32(len(changed))}, nil
+}
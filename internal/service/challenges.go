@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// Answer to unknown, expired, reused or wrongly answered challenges
+const invalidChallengeMessage = "Invalid challenge"
+
+// 58. GetChallenge
+func (s *WhitelistService) GetChallenge(ctx context.Context, req *pb.GetChallengeRequest) (*pb.GetChallengeResponse, error) {
+	challenge, err := newAccessToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate challenge: %v", err)
+	}
+	if err := s.challenges.Issue(ctx, s.db, s.hashSecret(challenge), time.Now().Add(s.challengeTTL)); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	return &pb.GetChallengeResponse{
+		Challenge:        challenge,
+		ExpiresInSeconds: int64(s.challengeTTL / time.Second),
+	}, nil
+}
+
+// redeemChallenge uses up the request's challenge, if it has one, and
+// reports whether it was outstanding and, when the client answered it,
+// answered with the right key.
+func (s *WhitelistService) redeemChallenge(ctx context.Context, req *pb.ValidateRequest) (bool, error) {
+	if req.Challenge == "" {
+		return true, nil
+	}
+	redeemed, err := s.challenges.Redeem(ctx, s.db, s.hashSecret(req.Challenge))
+	if err != nil || !redeemed {
+		return false, err
+	}
+	return challengeAnswered(req), nil
+}
+
+// challengeAnswered reports whether the client's answer to its challenge,
+// if it gave one, was made with the right key.
+func challengeAnswered(req *pb.ValidateRequest) bool {
+	if req.ChallengeResponse == "" {
+		return true
+	}
+	want, _ := hex.DecodeString(challengeMAC(req.LicenseKey, req.Challenge))
+	got, err := hex.DecodeString(req.ChallengeResponse)
+	return err == nil && hmac.Equal(got, want)
+}
+
+// answerChallenge is the server's side of the handshake: it binds the
+// verdict to the client's challenge so a recorded answer can't be played
+// back to it.
+func answerChallenge(key, challenge string, valid bool) string {
+	if challenge == "" {
+		return ""
+	}
+	verdict := "invalid"
+	if valid {
+		verdict = "valid"
+	}
+	return challengeMAC(key, challenge+"\n"+verdict)
+}
+
+func challengeMAC(key, msg string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	io.WriteString(mac, msg)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"log"
+	"math/rand/v2"
+	"time"
+
+	"github.com/mkseven15/whitelist-server/internal/metrics"
+)
+
+// Validation events are deleted this many at a time, so a large backlog
+// doesn't hold one long lock
+const validationEventBatch = 10000
+
+var (
+	cleanupDeleted = metrics.NewCounter("whitelist_cleanup_deleted_rows_total", "Rows deleted by the cleanup job.", "table")
+	cleanupErrors  = metrics.NewCounter("whitelist_cleanup_errors_total", "Failed cleanup deletes.", "table")
+	cleanupLastRun = metrics.NewGauge("whitelist_cleanup_last_run_timestamp_seconds", "When the cleanup job last finished.")
+	cleanupSeconds = metrics.NewGauge("whitelist_cleanup_duration_seconds", "How long the last cleanup run took.")
+)
+
+// cleanupStep deletes one kind of stale row and reports how many went.
+type cleanupStep struct {
+	table string
+	run   func(ctx context.Context, now time.Time) (int64, error)
+}
+
+// runCleanup deletes stale rows every cleanup interval, plus up to the
+// jitter so replicas started together don't clean up in lockstep.
+func (s *WhitelistService) runCleanup() {
+	defer s.wg.Done()
+
+	s.reloadIPBans(context.Background())
+	s.reloadProductMessages(context.Background())
+	s.reloadKeyFormats(context.Background())
+
+	for {
+		wait := s.cleanupInterval
+		if s.cleanupJitter > 0 {
+			wait += rand.N(s.cleanupJitter)
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-s.stop:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		// Picks up bans, product messages and key formats set on other
+		// instances, which every replica needs; the deletes only need doing once
+		ctx := context.Background()
+		s.reloadIPBans(ctx)
+		s.reloadProductMessages(ctx)
+		s.reloadKeyFormats(ctx)
+		if s.leader.IsLeader(ctx) {
+			s.cleanup(ctx, time.Now())
+		}
+	}
+}
+
+// cleanup runs every step once, logging and counting failures.
+func (s *WhitelistService) cleanup(ctx context.Context, now time.Time) {
+	for _, step := range s.cleanupSteps() {
+		n, err := step.run(ctx, now)
+		if err != nil {
+			log.Printf("Error cleaning up %s: %v", step.table, err)
+			cleanupErrors.Add(1, step.table)
+			continue
+		}
+		cleanupDeleted.Add(float64(n), step.table)
+	}
+	cleanupLastRun.Set(float64(time.Now().Unix()))
+	cleanupSeconds.Set(time.Since(now).Seconds())
+}
+
+func (s *WhitelistService) cleanupSteps() []cleanupStep {
+	expired := func(table string) cleanupStep {
+		return cleanupStep{table, func(ctx context.Context, now time.Time) (int64, error) {
+			return s.cleaner.DeleteExpired(ctx, s.db, table, now)
+		}}
+	}
+	steps := []cleanupStep{
+		expired("ip_bans"),
+		{"validation_lockouts", func(ctx context.Context, now time.Time) (int64, error) {
+			return s.deleteStaleLockouts(ctx, now)
+		}},
+		expired("access_tokens"),
+		expired("refresh_tokens"),
+		expired("admin_secrets"),
+		expired("request_nonces"),
+		expired("validation_challenges"),
+		// Sessions whose client stopped sending heartbeats
+		expired("license_sessions"),
+		// Floating leases that weren't checked in or renewed
+		expired("license_leases"),
+		// Ended admin sessions are kept a while so they can still be listed
+		{"admin_sessions", func(ctx context.Context, now time.Time) (int64, error) {
+			return s.admins.DeleteEndedSessions(ctx, s.db, now.Add(-adminSessionRetention))
+		}},
+	}
+	if s.trialRetention > 0 {
+		// The license_trials rows stay, so the device still can't start
+		// another trial
+		steps = append(steps, cleanupStep{"trial_licenses", func(ctx context.Context, now time.Time) (int64, error) {
+			return s.trials.DeleteLicenses(ctx, s.db, now.Add(-s.trialRetention))
+		}})
+	}
+	if s.validationEventRetention > 0 {
+		steps = append(steps, cleanupStep{"validation_events", func(ctx context.Context, now time.Time) (int64, error) {
+			var total int64
+			for {
+				n, err := s.events.DeleteBefore(ctx, s.db, now.Add(-s.validationEventRetention), validationEventBatch)
+				total += n
+				if err != nil || n < validationEventBatch {
+					return total, err
+				}
+				select {
+				case <-s.stop:
+					return total, nil
+				default:
+				}
+			}
+		}})
+	}
+	return steps
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mkseven15/whitelist-server/internal/store"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// Pay-per-use licenses carry a credit balance that clients spend with
+// ConsumeCredits, next to (or instead of) an expiry date. Every change is
+// kept in license_credit_ledger, as reseller credits are in reseller_ledger.
+
+// License credit ledger entry kinds
+const (
+	creditTopUp   = "topup"
+	creditConsume = "consume"
+)
+
+const auditLicenseTopUpCredits = "license.topup_credits"
+
+// 82. ConsumeCredits
+func (s *WhitelistService) ConsumeCredits(ctx context.Context, req *pb.ConsumeCreditsRequest) (*pb.ConsumeCreditsResponse, error) {
+	resp, err := s.consumeCredits(ctx, req)
+	if resp != nil {
+		// Answers for the spending, not the validation inside it
+		resp.ChallengeResponse = answerChallenge(req.LicenseKey, req.Challenge, resp.Valid)
+		resp.Message = s.localMessage(req.ProductId, req.Locale, resp.Reason, resp.Message)
+	}
+	return resp, err
+}
+
+func (s *WhitelistService) consumeCredits(ctx context.Context, req *pb.ConsumeCreditsRequest) (*pb.ConsumeCreditsResponse, error) {
+	// Same checks (and access token, signature and challenge) as a plain validation
+	valid, err := s.ValidateLicense(ctx, &pb.ValidateRequest{
+		LicenseKey: req.LicenseKey, ProductId: req.ProductId, Hwid: req.Hwid, ClientVersion: req.ClientVersion,
+		Challenge: req.Challenge, ChallengeResponse: req.ChallengeResponse, Locale: req.Locale,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !valid.Valid {
+		return &pb.ConsumeCreditsResponse{Valid: false, Reason: valid.Reason, Message: valid.Message, RequiredVersion: valid.RequiredVersion,
+			SuspendReason: valid.SuspendReason, RetryAfterSeconds: valid.RetryAfterSeconds}, nil
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	// Lock the balance so concurrent requests can't both spend the same credits
+	l, err := s.licenses.Lock(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if l == nil {
+		return &pb.ConsumeCreditsResponse{Valid: false, Reason: pb.Reason_REASON_LICENSE_NOT_FOUND, Message: "License not found"}, nil
+	}
+	if l.Credits < req.Amount {
+		return &pb.ConsumeCreditsResponse{Valid: false, Reason: pb.Reason_REASON_INSUFFICIENT_CREDITS, Message: "Insufficient credits", RemainingCredits: l.Credits}, nil
+	}
+
+	balance, err := s.credits.Add(ctx, tx, req.LicenseKey, -req.Amount, creditConsume, customerActor, req.Note)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	return &pb.ConsumeCreditsResponse{Valid: true, Reason: valid.Reason, Message: valid.Message, RemainingCredits: balance}, nil
+}
+
+// 83. TopUpLicenseCredits (Admin)
+func (s *WhitelistService) TopUpLicenseCredits(ctx context.Context, req *pb.TopUpLicenseCreditsRequest) (*pb.License, error) {
+	if err := s.requireWrite(ctx, roleSupport); err != nil {
+		return nil, err
+	}
+
+	if req.Credits == 0 {
+		return nil, status.Error(codes.InvalidArgument, "credits must not be zero")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	old, err := s.licenses.Lock(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if old == nil || old.DeletedAt != nil {
+		return nil, status.Error(codes.NotFound, "license not found")
+	}
+	if old.Credits+req.Credits < 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "license only has %d credits", old.Credits)
+	}
+
+	if _, err := s.credits.Add(ctx, tx, req.LicenseKey, req.Credits, creditTopUp, adminActor(ctx), req.Note); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	updated, err := s.licenses.Get(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.recordLicenseChange(ctx, tx, adminActor(ctx), auditLicenseTopUpCredits, req.LicenseKey, old, updated); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	return updated, nil
+}
+
+// 84. ListLicenseCreditActivity (Admin)
+func (s *WhitelistService) ListLicenseCreditActivity(ctx context.Context, req *pb.ListLicenseCreditActivityRequest) (*pb.ListLicenseCreditActivityResponse, error) {
+	if err := s.requireRole(ctx, roleReadOnly); err != nil {
+		return nil, err
+	}
+
+	page, err := newPage(store.NewestFirst, req.PageSize, req.PageToken, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	activity, err := s.credits.Activity(ctx, s.db, req.LicenseKey, page.Page)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	resp := &pb.ListLicenseCreditActivityResponse{Activity: activity}
+	if len(resp.Activity) > page.Size {
+		resp.Activity = resp.Activity[:page.Size]
+		resp.NextPageToken = page.next("", strconv.FormatInt(resp.Activity[page.Size-1].Id, 10))
+	}
+	return resp, nil
+}
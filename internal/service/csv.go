@@ -0,0 +1,328 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mkseven15/whitelist-server/internal/store"
+	"github.com/mkseven15/whitelist-server/internal/webhook"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+var csvHeader = []string{"license_key", "product_id", "is_active", "expires_at", "max_devices", "max_sessions"}
+
+const (
+	csvContentType  = "text/csv"
+	exportChunkRows = 500
+	maxImportRows   = 10000
+)
+
+// 10. ExportLicenses (Admin)
+func (s *WhitelistService) ExportLicenses(req *pb.ExportLicensesRequest, stream grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	ctx := stream.Context()
+	if err := s.requireRole(ctx, roleReadOnly); err != nil {
+		return err
+	}
+	if err := s.requireOTP(ctx); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write(csvHeader)
+
+	// Send in chunks so large exports don't sit in memory
+	flush := func() error {
+		w.Flush()
+		if buf.Len() == 0 {
+			return nil
+		}
+		chunk := &httpbody.HttpBody{ContentType: csvContentType, Data: append([]byte(nil), buf.Bytes()...)}
+		buf.Reset()
+		return stream.Send(chunk)
+	}
+
+	n := 0
+	var sendErr error
+	err := s.licenseLists.Export(ctx, s.db, store.LicenseFilter{ProductID: req.ProductId, Live: true}, func(l *pb.UpdateLicenseRequest) error {
+		expiry := ""
+		if l.ExpiresAt != nil {
+			expiry = l.ExpiresAt.AsTime().UTC().Format(time.RFC3339)
+		}
+		w.Write([]string{l.LicenseKey, l.ProductId, strconv.FormatBool(l.IsActive), expiry, strconv.Itoa(int(l.MaxDevices)), strconv.Itoa(int(l.MaxSessions))})
+
+		if n++; n%exportChunkRows == 0 {
+			sendErr = flush()
+		}
+		return sendErr
+	})
+	if sendErr != nil {
+		return sendErr
+	} else if err != nil {
+		return status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	return flush()
+}
+
+// 11. ImportLicenses (Admin)
+func (s *WhitelistService) ImportLicenses(ctx context.Context, req *pb.ImportLicensesRequest) (*pb.ImportLicensesResponse, error) {
+	if err := s.requireWrite(ctx, roleOwner); err != nil {
+		return nil, err
+	}
+	// A dry run changes nothing, so it doesn't use up a code
+	if !req.DryRun {
+		if err := s.requireOTP(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	licenses, lines, parseErrs, err := parseLicenseCSV(req.Csv)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid csv: %v", err)
+	}
+	resp := &pb.ImportLicensesResponse{DryRun: req.DryRun, Errors: parseErrs}
+	if len(parseErrs) > 0 {
+		return resp, nil
+	}
+	return s.importLicenses(ctx, resp, licenses, lines, nil)
+}
+
+// importLinks are what an import binds to a license besides its settings.
+type importLinks struct {
+	hwids []string
+	// Customer to attach the license to, created if no customer has the email
+	email string
+}
+
+// importLicenses saves parsed licenses unless resp is a dry run, filling in
+// resp. links are keyed by license key.
+func (s *WhitelistService) importLicenses(ctx context.Context, resp *pb.ImportLicensesResponse, licenses []*pb.UpdateLicenseRequest, lines []int, links map[string]importLinks) (*pb.ImportLicensesResponse, error) {
+	productIDs := make([]string, len(licenses))
+	for i, l := range licenses {
+		productIDs[i] = l.ProductId
+	}
+	missing, err := s.products.Unknown(ctx, s.db, productIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if len(missing) > 0 {
+		resp.Errors = productErrors(missing, licenses, lines)
+		return resp, nil
+	}
+	formatErrs, err := s.keyFormatErrors(ctx, s.db, licenses...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	for i, err := range formatErrs {
+		if err != nil {
+			resp.Errors = append(resp.Errors, fmt.Sprintf("line %d: %v", lines[i], err))
+		}
+	}
+	if len(resp.Errors) > 0 {
+		return resp, nil
+	}
+
+	// Diff against what's stored to report create/update/unchanged per row
+	keys := make([]string, len(licenses))
+	for i, l := range licenses {
+		keys[i] = l.LicenseKey
+	}
+	existing, err := s.loadImportState(ctx, keys)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	var changed []*pb.UpdateLicenseRequest
+	for i, l := range licenses {
+		action := "create"
+		if cur, ok := existing[l.LicenseKey]; ok {
+			action = "update"
+			if sameLicenseSettings(cur, l) {
+				action = "unchanged"
+			}
+		}
+		switch action {
+		case "create":
+			resp.Created++
+		case "update":
+			resp.Updated++
+		default:
+			resp.Unchanged++
+		}
+		if action != "unchanged" {
+			changed = append(changed, l)
+		}
+		resp.Rows = append(resp.Rows, &pb.ImportLicenseRow{Line: int32(lines[i]), LicenseKey: l.LicenseKey, Action: action})
+	}
+
+	if resp.DryRun || (len(changed) == 0 && len(links) == 0) {
+		return resp, nil
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+	events := make([]webhook.Event, len(changed))
+	for i, l := range changed {
+		event, err := s.saveLicense(ctx, tx, l)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "upsert %s failed: %v", l.LicenseKey, err)
+		}
+		events[i] = event
+	}
+	for key, link := range links {
+		if err := s.linkImportedLicense(ctx, tx, key, link); err != nil {
+			return nil, status.Errorf(codes.Internal, "import %s failed: %v", key, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+
+	changedKeys := make([]string, 0, len(changed)+len(links))
+	for _, l := range changed {
+		changedKeys = append(changedKeys, l.LicenseKey)
+	}
+	for key := range links {
+		changedKeys = append(changedKeys, key)
+	}
+	s.invalidateLicenses(ctx, changedKeys...)
+	s.notify(events...)
+	return resp, nil
+}
+
+// parseLicenseCSV turns CSV text into upsert requests. Row-level problems are
+// collected as messages (with line numbers) rather than failing the whole file;
+// the returned error is reserved for a missing or unusable header.
+func parseLicenseCSV(text string) ([]*pb.UpdateLicenseRequest, []int, []string, error) {
+	r := csv.NewReader(strings.NewReader(text))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil, nil, nil, fmt.Errorf("empty file")
+	} else if err != nil {
+		return nil, nil, nil, err
+	}
+	col := map[string]int{}
+	for i, h := range header {
+		col[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	for _, required := range []string{"license_key", "product_id"} {
+		if _, ok := col[required]; !ok {
+			return nil, nil, nil, fmt.Errorf("missing %s column", required)
+		}
+	}
+	field := func(rec []string, name string) string {
+		if i, ok := col[name]; ok && i < len(rec) {
+			return strings.TrimSpace(rec[i])
+		}
+		return ""
+	}
+
+	var licenses []*pb.UpdateLicenseRequest
+	var lines []int
+	var errs []string
+	seen := map[string]int{}
+	for {
+		rec, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		line, _ := r.FieldPos(0)
+		if len(licenses) >= maxImportRows {
+			return nil, nil, nil, fmt.Errorf("more than %d rows", maxImportRows)
+		}
+
+		l := &pb.UpdateLicenseRequest{
+			LicenseKey: field(rec, "license_key"),
+			ProductId:  field(rec, "product_id"),
+			IsActive:   true,
+		}
+		if l.LicenseKey == "" || l.ProductId == "" {
+			errs = append(errs, fmt.Sprintf("line %d: license_key and product_id required", line))
+			continue
+		}
+		if prev, dup := seen[l.LicenseKey]; dup {
+			errs = append(errs, fmt.Sprintf("line %d: duplicate license_key (first seen on line %d)", line, prev))
+			continue
+		}
+		seen[l.LicenseKey] = line
+
+		if v := field(rec, "is_active"); v != "" {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("line %d: invalid is_active %q", line, v))
+				continue
+			}
+			l.IsActive = b
+		}
+		if v := field(rec, "expires_at"); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("line %d: invalid expires_at %q", line, v))
+				continue
+			}
+			l.ExpiresAt = timestamppb.New(t)
+		}
+		if v := field(rec, "max_devices"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 {
+				errs = append(errs, fmt.Sprintf("line %d: invalid max_devices %q", line, v))
+				continue
+			}
+			l.MaxDevices = int32(n)
+		}
+		if v := field(rec, "max_sessions"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 0 {
+				errs = append(errs, fmt.Sprintf("line %d: invalid max_sessions %q", line, v))
+				continue
+			}
+			l.MaxSessions = int32(n)
+		}
+
+		licenses = append(licenses, l)
+		lines = append(lines, line)
+	}
+	return licenses, lines, errs, nil
+}
+
+// loadImportState fetches the current settings of the given keys, keyed by license_key.
+func (s *WhitelistService) loadImportState(ctx context.Context, keys []string) (map[string]*pb.UpdateLicenseRequest, error) {
+	return s.licenseLists.Settings(ctx, s.db, keys)
+}
+
+// sameLicenseSettings reports whether applying b over a would be a no-op.
+func sameLicenseSettings(a, b *pb.UpdateLicenseRequest) bool {
+	maxDevices := b.MaxDevices
+	if maxDevices <= 0 {
+		maxDevices = 1
+	}
+	if a.ProductId != b.ProductId || a.IsActive != b.IsActive || a.MaxDevices != maxDevices || a.MaxSessions != b.MaxSessions {
+		return false
+	}
+	if (a.ExpiresAt == nil) != (b.ExpiresAt == nil) {
+		return false
+	}
+	return a.ExpiresAt == nil || a.ExpiresAt.AsTime().Equal(b.ExpiresAt.AsTime())
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mkseven15/whitelist-server/internal/store"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// Audit actions for customers
+const (
+	auditCustomerCreate = "customer.create"
+	auditLicenseAttach  = "license.attach"
+	auditLicenseDetach  = "license.detach"
+)
+
+// 37. CreateCustomer (Admin)
+func (s *WhitelistService) CreateCustomer(ctx context.Context, req *pb.CreateCustomerRequest) (*pb.Customer, error) {
+	if err := s.requireWrite(ctx, roleSupport); err != nil {
+		return nil, err
+	}
+
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	discordID := strings.TrimSpace(req.DiscordId)
+	if email == "" && discordID == "" {
+		return nil, status.Error(codes.InvalidArgument, "email or discord_id required")
+	}
+	if email != "" && !strings.Contains(email, "@") {
+		return nil, status.Error(codes.InvalidArgument, "invalid email")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	id, err := s.customers.Create(ctx, tx, email, discordID, req.Notes)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if id == 0 {
+		return nil, status.Error(codes.AlreadyExists, "a customer with this email or discord_id already exists")
+	}
+
+	c, err := s.customers.Get(ctx, tx, id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.recordAudit(ctx, tx, adminActor(ctx), auditCustomerCreate, strconv.FormatInt(id, 10), nil, c); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	return c, nil
+}
+
+// 38. ListCustomers (Admin)
+func (s *WhitelistService) ListCustomers(ctx context.Context, req *pb.ListCustomersRequest) (*pb.ListCustomersResponse, error) {
+	if err := s.requireRole(ctx, roleReadOnly); err != nil {
+		return nil, err
+	}
+
+	page, err := newPage(store.CustomerOrder, req.PageSize, req.PageToken, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	customers, err := s.customers.List(ctx, s.db, store.CustomerFilter{
+		Email:      strings.ToLower(strings.TrimSpace(req.Email)),
+		DiscordID:  strings.TrimSpace(req.DiscordId),
+		LicenseKey: req.LicenseKey,
+	}, page.Page)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	resp := &pb.ListCustomersResponse{Customers: customers}
+	if len(resp.Customers) > page.Size {
+		resp.Customers = resp.Customers[:page.Size]
+		last := resp.Customers[page.Size-1]
+		var value string
+		switch page.Field {
+		case "email":
+			value = last.Email
+		case "created_at":
+			value = timeCursor(last.CreatedAt, "")
+		}
+		resp.NextPageToken = page.next(value, strconv.FormatInt(last.Id, 10))
+	}
+	return resp, nil
+}
+
+// 39. AttachLicense (Admin)
+func (s *WhitelistService) AttachLicense(ctx context.Context, req *pb.AttachLicenseRequest) (*pb.License, error) {
+	if err := s.requireWrite(ctx, roleSupport); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	if c, err := s.customers.Get(ctx, tx, req.CustomerId); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	} else if c == nil {
+		return nil, status.Error(codes.NotFound, "customer not found")
+	}
+	old, err := s.licenses.Get(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if old == nil {
+		return nil, status.Error(codes.NotFound, "license not found")
+	}
+	if old.CustomerId == req.CustomerId {
+		return old, nil
+	}
+	// Moving a key between customers takes an explicit detach first
+	if old.CustomerId != 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "license belongs to customer %d", old.CustomerId)
+	}
+
+	if err := s.licenses.Change(ctx, tx, req.LicenseKey, store.LicenseChange{CustomerID: &req.CustomerId}); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	updated, err := s.licenses.Get(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.recordLicenseChange(ctx, tx, adminActor(ctx), auditLicenseAttach, req.LicenseKey, old, updated); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	return updated, nil
+}
+
+// 40. DetachLicense (Admin)
+func (s *WhitelistService) DetachLicense(ctx context.Context, req *pb.DetachLicenseRequest) (*pb.License, error) {
+	if err := s.requireWrite(ctx, roleSupport); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	old, err := s.licenses.Get(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if old == nil || old.CustomerId != req.CustomerId {
+		return nil, status.Error(codes.NotFound, "license not attached to this customer")
+	}
+
+	var none int64
+	if err := s.licenses.Change(ctx, tx, req.LicenseKey, store.LicenseChange{CustomerID: &none}); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	updated, err := s.licenses.Get(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.recordLicenseChange(ctx, tx, adminActor(ctx), auditLicenseDetach, req.LicenseKey, old, updated); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	return updated, nil
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/mkseven15/whitelist-server/internal/errinfo"
+	"github.com/mkseven15/whitelist-server/internal/metrics"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+var failOpenAnswers = metrics.NewCounter("whitelist_fail_open_validations_total", "Validations answered from memory while the database was down.")
+
+// failOpen remembers the license, product and HWID of successful
+// validations, so ValidateLicense can keep accepting the same ones for up
+// to window after their last success while the store breaker is open.
+// State is per instance: each replica answers only for the validations it
+// served itself, and knows only the nonces it saw.
+type failOpen struct {
+	window time.Duration
+
+	mu    sync.Mutex
+	valid map[failOpenKey]failOpenEntry
+	// Nonces of signed requests answered from memory, until they expire
+	nonces map[failOpenNonce]time.Time
+}
+
+type failOpenNonce struct {
+	product, nonce string
+}
+
+type failOpenKey struct {
+	license, product, hwid string
+}
+
+type failOpenEntry struct {
+	at time.Time
+	// Zero for lifetime licenses
+	expiresAt time.Time
+	metadata  *structpb.Struct
+	features  map[string]bool
+	// The product's signing secret, "" if its requests weren't signed
+	signingSecret string
+	// Whether the validation carried a challenge, which the next must then too
+	challenged bool
+}
+
+func newFailOpen(window time.Duration) *failOpen {
+	return &failOpen{window: window, valid: make(map[failOpenKey]failOpenEntry), nonces: make(map[failOpenNonce]time.Time)}
+}
+
+func failOpenKeyOf(req *pb.ValidateRequest) failOpenKey {
+	return failOpenKey{license: req.LicenseKey, product: req.ProductId, hwid: req.Hwid}
+}
+
+// remember notes a validation's answer, keeping it if it was a success and
+// forgetting the pair otherwise. signingSecret is the product's, if any.
+func (f *failOpen) remember(req *pb.ValidateRequest, resp *pb.ValidateResponse, signingSecret string, now time.Time) {
+	if f.window <= 0 || resp == nil {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := failOpenKeyOf(req)
+	if !resp.Valid {
+		delete(f.valid, key)
+		return
+	}
+	e := failOpenEntry{at: now, metadata: resp.Metadata, features: resp.Features, signingSecret: signingSecret, challenged: req.Challenge != ""}
+	if resp.ExpiresInSeconds > 0 {
+		e.expiresAt = now.Add(time.Duration(resp.ExpiresInSeconds) * time.Second)
+	}
+	f.valid[key] = e
+
+	// Keep the map from growing with every pair ever seen
+	if len(f.valid) > 100000 {
+		for k, old := range f.valid {
+			if now.Sub(old.at) >= f.window {
+				delete(f.valid, k)
+			}
+		}
+	}
+}
+
+// lookup returns what was remembered for req, if the same license, product
+// and HWID validated within the window and the license hasn't expired since.
+func (f *failOpen) lookup(req *pb.ValidateRequest, now time.Time) (failOpenEntry, bool) {
+	if f.window <= 0 {
+		return failOpenEntry{}, false
+	}
+	f.mu.Lock()
+	e, ok := f.valid[failOpenKeyOf(req)]
+	f.mu.Unlock()
+	if !ok || now.Sub(e.at) >= f.window {
+		return failOpenEntry{}, false
+	}
+	if !e.expiresAt.IsZero() && !e.expiresAt.After(now) {
+		return failOpenEntry{}, false
+	}
+	return e, true
+}
+
+// answer returns the success to repeat for e, marked as degraded.
+func (f *failOpen) answer(e failOpenEntry, now time.Time) *pb.ValidateResponse {
+	var expiresIn int64
+	if !e.expiresAt.IsZero() {
+		expiresIn = int64(e.expiresAt.Sub(now).Seconds())
+	}
+	return &pb.ValidateResponse{Valid: true, Reason: pb.Reason_REASON_OK, Message: "Authenticated", ExpiresInSeconds: expiresIn, Metadata: e.metadata, Features: e.features, Degraded: true}
+}
+
+// useNonce records a signed request's nonce until expires, reporting false
+// if this instance has seen it before.
+func (f *failOpen) useNonce(product, nonce string, expires, now time.Time) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := failOpenNonce{product, nonce}
+	if until, ok := f.nonces[key]; ok && until.After(now) {
+		return false
+	}
+	f.nonces[key] = expires
+	if len(f.nonces) > 100000 {
+		for k, until := range f.nonces {
+			if !until.After(now) {
+				delete(f.nonces, k)
+			}
+		}
+	}
+	return true
+}
+
+// failOpenAnswer answers req from memory while the database is down, nil if
+// it can't. It first makes the checks that need no database: an access
+// token must be present (it can't be used up), the request must be signed
+// if the product's requests were, with a nonce this instance hasn't seen,
+// and it must carry a challenge if the last validation did, answered right
+// if at all. The challenge itself can't be redeemed, so it isn't answered
+// either.
+func (s *WhitelistService) failOpenAnswer(ctx context.Context, req, signed *pb.ValidateRequest) (*pb.ValidateResponse, error) {
+	now := time.Now()
+	e, ok := s.failOpen.lookup(req, now)
+	if !ok {
+		return nil, nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if len(md.Get("x-access-token")) == 0 {
+		return nil, errinfo.Error(codes.Unauthenticated, pb.Reason_REASON_TOKEN_MISSING, "missing x-access-token header")
+	}
+	if e.signingSecret != "" {
+		signedAt, nonce, err := s.verifySignature(ctx, signed, map[string]string{req.ProductId: e.signingSecret})
+		if err != nil {
+			return nil, err
+		}
+		if !s.failOpen.useNonce(req.ProductId, nonce, signedAt.Add(s.signatureMaxSkew), now) {
+			return nil, errNonceReused
+		}
+	}
+	if e.challenged && req.Challenge == "" {
+		return nil, nil
+	}
+	if req.Challenge != "" && !challengeAnswered(req) {
+		return &pb.ValidateResponse{Valid: false, Reason: pb.Reason_REASON_INVALID_CHALLENGE, Message: invalidChallengeMessage, Degraded: true}, nil
+	}
+	return s.failOpen.answer(e, now), nil
+}
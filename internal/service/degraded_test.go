@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mkseven15/whitelist-server/internal/signing"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+func TestFailOpenLookup(t *testing.T) {
+	f := newFailOpen(time.Minute)
+	now := time.Now()
+	req := &pb.ValidateRequest{LicenseKey: "KEY", ProductId: "prod", Hwid: "hwid"}
+	f.remember(req, &pb.ValidateResponse{Valid: true, ExpiresInSeconds: 3600}, "", now)
+
+	if _, ok := f.lookup(req, now.Add(time.Minute)); ok {
+		t.Error("answered after the window")
+	}
+	e, ok := f.lookup(req, now.Add(30*time.Second))
+	if !ok {
+		t.Fatal("no answer within the window")
+	}
+	if r := f.answer(e, now.Add(30*time.Second)); !r.Valid || !r.Degraded || r.ExpiresInSeconds != 3570 {
+		t.Errorf("got %v", r)
+	}
+	other := &pb.ValidateRequest{LicenseKey: "KEY", ProductId: "prod", Hwid: "other"}
+	if _, ok := f.lookup(other, now); ok {
+		t.Error("answered for another HWID")
+	}
+
+	f.remember(req, &pb.ValidateResponse{Valid: false}, "", now)
+	if _, ok := f.lookup(req, now); ok {
+		t.Error("answered after a failed validation")
+	}
+}
+
+func TestFailOpenAnswer(t *testing.T) {
+	const secret = "signing-secret"
+	s := &WhitelistService{failOpen: newFailOpen(time.Minute), signatureMaxSkew: 5 * time.Minute}
+	now := time.Now()
+	plain := &pb.ValidateRequest{LicenseKey: "KEY", ProductId: "plain", Hwid: "hwid"}
+	signedReq := &pb.ValidateRequest{LicenseKey: "KEY", ProductId: "signed", Hwid: "hwid"}
+	challenged := &pb.ValidateRequest{LicenseKey: "KEY", ProductId: "challenged", Hwid: "hwid", Challenge: "c1"}
+	s.failOpen.remember(plain, &pb.ValidateResponse{Valid: true}, "", now)
+	s.failOpen.remember(signedReq, &pb.ValidateResponse{Valid: true}, secret, now)
+	s.failOpen.remember(challenged, &pb.ValidateResponse{Valid: true}, "", now)
+
+	withToken := func(kv ...string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(append([]string{"x-access-token", "token"}, kv...)...))
+	}
+	sign := func(req *pb.ValidateRequest, nonce string) context.Context {
+		body, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		timestamp := strconv.FormatInt(now.Unix(), 10)
+		return withToken(
+			signing.TimestampHeader, timestamp,
+			signing.NonceHeader, nonce,
+			signing.SignatureHeader, signing.Sign([]byte(secret), timestamp, nonce, signing.Digest(body)),
+		)
+	}
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		req   *pb.ValidateRequest
+		valid bool
+		code  codes.Code
+	}{
+		{"unknown pair", withToken(), &pb.ValidateRequest{LicenseKey: "KEY", ProductId: "plain", Hwid: "other"}, false, codes.OK},
+		{"no access token", context.Background(), plain, false, codes.Unauthenticated},
+		{"unsigned product", withToken(), plain, true, codes.OK},
+		{"unsigned request", withToken(), signedReq, false, codes.Unauthenticated},
+		{"signed request", sign(signedReq, "nonce-0001"), signedReq, true, codes.OK},
+		{"reused nonce", sign(signedReq, "nonce-0001"), signedReq, false, codes.Unauthenticated},
+		{"challenge dropped", withToken(), &pb.ValidateRequest{LicenseKey: "KEY", ProductId: "challenged", Hwid: "hwid"}, false, codes.OK},
+		{"challenge", withToken(), &pb.ValidateRequest{LicenseKey: "KEY", ProductId: "challenged", Hwid: "hwid", Challenge: "c2"}, true, codes.OK},
+		{"challenge answered right", withToken(), &pb.ValidateRequest{LicenseKey: "KEY", ProductId: "challenged", Hwid: "hwid", Challenge: "c3", ChallengeResponse: challengeMAC("KEY", "c3")}, true, codes.OK},
+		{"challenge answered wrong", withToken(), &pb.ValidateRequest{LicenseKey: "KEY", ProductId: "challenged", Hwid: "hwid", Challenge: "c4", ChallengeResponse: challengeMAC("OTHER", "c4")}, false, codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := s.failOpenAnswer(tt.ctx, tt.req, tt.req)
+			if status.Code(err) != tt.code {
+				t.Fatalf("got %v, want %v", err, tt.code)
+			}
+			if r.GetValid() != tt.valid {
+				t.Errorf("got %v, want valid %v", r, tt.valid)
+			}
+			if r != nil && (!r.Degraded || r.ChallengeResponse != "") {
+				t.Errorf("got %v, want degraded and no challenge response", r)
+			}
+		})
+	}
+}
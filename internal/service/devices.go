@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mkseven15/whitelist-server/internal/hwid"
+	"github.com/mkseven15/whitelist-server/internal/store"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// bindResult is the outcome of bindDevice.
+type bindResult int
+
+const (
+	deviceKnown       bindResult = iota // already bound
+	deviceAdded                         // bound just now
+	deviceRejected                      // new device, no free seats
+	deviceCoolingDown                   // new device with a bound one's parts, too soon after the last HWID change
+)
+
+// deviceParts are a device's HWID components, hashed, in HwidComponents
+// order; "" for any the client didn't send.
+type deviceParts [4]string
+
+func partsOf(c *pb.HwidComponents) deviceParts {
+	return deviceParts{c.GetCpu(), c.GetDisk(), c.GetMac(), c.GetMachineGuid()}
+}
+
+// matching counts the parts p and q both have and agree on.
+func (p deviceParts) matching(q deviceParts) int {
+	n := 0
+	for i := range p {
+		if p[i] != "" && p[i] == q[i] {
+			n++
+		}
+	}
+	return n
+}
+
+// withHashedHwid returns req with its HWID and components in stored form
+// (see hwid.Hash), copying it only if that changes anything.
+func withHashedHwid(req *pb.ValidateRequest) *pb.ValidateRequest {
+	h := hwid.Hash(req.Hwid)
+	var components *pb.HwidComponents
+	if c := req.HwidComponents; c != nil {
+		components = &pb.HwidComponents{Cpu: hwid.Hash(c.Cpu), Disk: hwid.Hash(c.Disk), Mac: hwid.Hash(c.Mac), MachineGuid: hwid.Hash(c.MachineGuid)}
+	}
+	if h == req.Hwid && proto.Equal(components, req.HwidComponents) {
+		return req
+	}
+	out := proto.Clone(req).(*pb.ValidateRequest)
+	out.Hwid, out.HwidComponents = h, components
+	return out
+}
+
+// bindDevice registers hwid against the license if it isn't already bound.
+// A new hwid whose parts match enough of a bound device's takes that
+// device's place instead of a free seat, at most once per
+// s.hwidRebindCooldown; retryAfter is how much of that a deviceCoolingDown
+// device has left. The license row is locked so concurrent validations
+// can't overshoot the limit, and all of it is rerun after a transient
+// database error.
+func (s *WhitelistService) bindDevice(ctx context.Context, licenseKey, hwid string, parts deviceParts, maxDevices int) (bound bindResult, retryAfter time.Duration, err error) {
+	var replaced string
+	var matched int
+	err = s.txRetry.Tx(ctx, s.db, "tx.bind_device", func(tx *sql.Tx) error {
+		bound, retryAfter, replaced, matched = deviceRejected, 0, "", 0
+		reboundAt, err := s.devices.Lock(ctx, tx, licenseKey)
+		if err != nil {
+			return err
+		}
+
+		stored, err := s.devices.Get(ctx, tx, licenseKey, hwid)
+		if err != nil {
+			return err
+		}
+		if stored != nil {
+			// Keep the parts current, so the next hardware change is
+			// measured against what the device has now
+			if parts != (deviceParts{}) && parts != deviceParts(stored.Parts) {
+				if err := s.devices.SetParts(ctx, tx, licenseKey, hwid, parts); err != nil {
+					return err
+				}
+			}
+			bound = deviceKnown
+			return nil
+		}
+
+		replaced, matched, err = s.matchingDevice(ctx, tx, licenseKey, parts)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		if replaced != "" && !reboundAt.IsZero() {
+			// Too soon to replace it; a free seat still takes the device
+			if retryAfter = reboundAt.Add(s.hwidRebindCooldown).Sub(now); retryAfter > 0 {
+				replaced = ""
+			}
+		}
+		if replaced == "" {
+			used, err := s.devices.Count(ctx, tx, licenseKey)
+			if err != nil {
+				return err
+			}
+			if used >= maxDevices && retryAfter > 0 {
+				bound = deviceCoolingDown
+				return nil
+			}
+			if used >= maxDevices {
+				retryAfter = 0
+				return nil
+			}
+		}
+
+		old, err := s.licenses.Get(ctx, tx, licenseKey)
+		if err != nil {
+			return err
+		}
+		device := &store.Device{HWID: hwid, Parts: parts}
+		if replaced != "" {
+			err = s.devices.Replace(ctx, tx, licenseKey, replaced, device)
+			if err == nil {
+				err = s.devices.Rebound(ctx, tx, licenseKey, now)
+			}
+		} else {
+			err = s.devices.Add(ctx, tx, licenseKey, device)
+		}
+		if err != nil {
+			return err
+		}
+		updated, err := s.licenses.Get(ctx, tx, licenseKey)
+		if err != nil {
+			return err
+		}
+		if err := s.recordRevision(ctx, tx, "client", revisionBindHwid, old, updated); err != nil {
+			return err
+		}
+		bound, retryAfter = deviceAdded, 0
+		return nil
+	})
+	if err != nil {
+		return deviceRejected, 0, err
+	}
+	if bound == deviceAdded && replaced != "" {
+		log.Printf("Device %s of license %s is now %s (%d components match)", replaced, licenseKey, hwid, matched)
+		return deviceKnown, 0, nil
+	}
+	return bound, retryAfter, nil
+}
+
+// matchingDevice returns the device bound to the license that shares the
+// most parts with parts, and how many, if that's at least
+// s.hwidMatchThreshold; "" if no device does.
+func (s *WhitelistService) matchingDevice(ctx context.Context, q store.Querier, licenseKey string, parts deviceParts) (string, int, error) {
+	// matching(parts) counts the parts sent, too few can never match
+	if s.hwidMatchThreshold == 0 || parts.matching(parts) < s.hwidMatchThreshold {
+		return "", 0, nil
+	}
+	devices, err := s.devices.List(ctx, q, licenseKey)
+	if err != nil {
+		return "", 0, err
+	}
+
+	best, most := "", s.hwidMatchThreshold-1
+	for _, d := range devices {
+		if n := parts.matching(d.Parts); n > most {
+			best, most = d.HWID, n
+		}
+	}
+	if best == "" {
+		return "", 0, nil
+	}
+	return best, most, nil
+}
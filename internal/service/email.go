@@ -0,0 +1,209 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"net/mail"
+	"strings"
+	"text/template"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mkseven15/whitelist-server/internal/config"
+	"github.com/mkseven15/whitelist-server/internal/mailer"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+const (
+	auditLicenseEmail = "license.email"
+
+	deliverySent   = "sent"
+	deliveryFailed = "failed"
+
+	// Covers the SMTP dialogue or SendGrid call
+	mailSendTimeout = 30 * time.Second
+)
+
+var (
+	defaultMailSubject = template.Must(template.New("subject").Parse(`Your {{.ProductName}} license key`))
+	defaultMailBody    = template.Must(template.New("body").Parse(`Hi,
+
+Here is your license key for {{.ProductName}}:
+
+    {{.LicenseKey}}
+{{if .ExpiresAt}}
+It's valid until {{.ExpiresAt.Format "2 January 2006"}}.
+{{end}}
+Keep it somewhere safe, you'll need it to activate the software.
+`))
+)
+
+// licenseEmail is what the subject and body templates can use.
+type licenseEmail struct {
+	Email       string
+	LicenseKey  string
+	ProductID   string
+	ProductName string
+	ExpiresAt   *time.Time
+	MaxDevices  int32
+}
+
+// newMailer picks the configured provider, or returns nil if there's none.
+func newMailer(cfg config.Mail) mailer.Mailer {
+	switch {
+	case !cfg.Enabled():
+		return nil
+	case cfg.SendGridAPIKey != "":
+		return mailer.NewSendGrid(cfg.SendGridAPIKey, cfg.From)
+	}
+	return mailer.NewSMTP(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.From)
+}
+
+// 41. IssueLicenseToEmail (Admin)
+func (s *WhitelistService) IssueLicenseToEmail(ctx context.Context, req *pb.IssueLicenseToEmailRequest) (*pb.IssueLicenseToEmailResponse, error) {
+	if err := s.requireWrite(ctx, roleSupport); err != nil {
+		return nil, err
+	}
+
+	if s.mail == nil {
+		return nil, status.Error(codes.FailedPrecondition, "email delivery is not configured")
+	}
+	addr, err := mail.ParseAddress(req.Email)
+	if err != nil || addr.Address != strings.TrimSpace(req.Email) {
+		return nil, status.Error(codes.InvalidArgument, "invalid email")
+	}
+	email := strings.ToLower(addr.Address)
+
+	licenseKey := req.LicenseKey
+	if licenseKey == "" {
+		if licenseKey, err = s.generateOneLicense(ctx, req.License); err != nil {
+			return nil, err
+		}
+	}
+
+	l, err := s.licenses.Get(ctx, s.db, licenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if l == nil {
+		return nil, status.Error(codes.NotFound, "license not found")
+	}
+	p, err := s.products.Get(ctx, s.db, l.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	data := licenseEmail{
+		Email:       email,
+		LicenseKey:  l.LicenseKey,
+		ProductID:   l.ProductId,
+		ProductName: p.GetName(),
+		MaxDevices:  l.MaxDevices,
+	}
+	if l.ExpiresAt != nil {
+		t := l.ExpiresAt.AsTime()
+		data.ExpiresAt = &t
+	}
+	msg, err := s.renderLicenseEmail(data)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "mail template failed: %v", err)
+	}
+
+	// Record the attempt first so a crash mid-send still leaves a trace
+	id, err := s.deliveries.Start(ctx, s.db, licenseKey, email, adminActor(ctx))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	sendCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), mailSendTimeout)
+	sendErr := s.mail.Send(sendCtx, msg)
+	cancel()
+
+	result, errText, sentAt := deliverySent, "", time.Now()
+	if sendErr != nil {
+		result, errText, sentAt = deliveryFailed, truncate(sendErr.Error(), 500), time.Time{}
+	}
+	// The send already happened; don't let a cancelled request lose its outcome
+	delivery, err := s.deliveries.Finish(context.WithoutCancel(ctx), s.db, id, result, errText, sentAt)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	if err := s.recordAudit(ctx, s.db, adminActor(ctx), auditLicenseEmail, licenseKey, nil, delivery); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	return &pb.IssueLicenseToEmailResponse{LicenseKey: licenseKey, Delivery: delivery}, nil
+}
+
+// 42. ListLicenseDeliveries (Admin)
+func (s *WhitelistService) ListLicenseDeliveries(ctx context.Context, req *pb.ListLicenseDeliveriesRequest) (*pb.ListLicenseDeliveriesResponse, error) {
+	if err := s.requireRole(ctx, roleReadOnly); err != nil {
+		return nil, err
+	}
+
+	deliveries, err := s.deliveries.List(ctx, s.db, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	return &pb.ListLicenseDeliveriesResponse{Deliveries: deliveries}, nil
+}
+
+// generateOneLicense creates a license as GenerateLicenses would with a
+// count of 1. Errors are gRPC statuses.
+func (s *WhitelistService) generateOneLicense(ctx context.Context, req *pb.GenerateLicensesRequest) (string, error) {
+	if req.GetProductId() == "" {
+		return "", status.Error(codes.InvalidArgument, "license_key or license.product_id required")
+	}
+	pattern, err := requestKeyPattern(req)
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	keys, events, err := s.insertGeneratedLicenses(ctx, tx, adminActor(ctx), pattern, 1, req)
+	if err != nil {
+		return "", err
+	}
+	if err := tx.Commit(); err != nil {
+		return "", status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	s.notify(events...)
+	return keys[0], nil
+}
+
+func (s *WhitelistService) renderLicenseEmail(data licenseEmail) (mailer.Message, error) {
+	subjectTmpl, bodyTmpl := s.mailSubject, s.mailBody
+	if subjectTmpl == nil {
+		subjectTmpl = defaultMailSubject
+	}
+	if bodyTmpl == nil {
+		bodyTmpl = defaultMailBody
+	}
+	var subject, body bytes.Buffer
+	if err := subjectTmpl.Execute(&subject, data); err != nil {
+		return mailer.Message{}, err
+	}
+	if err := bodyTmpl.Execute(&body, data); err != nil {
+		return mailer.Message{}, err
+	}
+	// A header can't span lines
+	return mailer.Message{
+		To:      data.Email,
+		Subject: strings.Join(strings.Fields(subject.String()), " "),
+		Body:    body.String(),
+	}, nil
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
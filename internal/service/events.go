@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mkseven15/whitelist-server/internal/store"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// logValidation records a ValidateLicense attempt in validation_events.
+// Failures here are logged but never fail the validation itself.
+func (s *WhitelistService) logValidation(ctx context.Context, req *pb.ValidateRequest, resp *pb.ValidateResponse, err error) {
+	var valid bool
+	var result string
+	ip := clientIP(ctx)
+	if err != nil {
+		result = status.Convert(err).Message()
+	} else {
+		valid = resp.Valid
+		result = resp.Message
+	}
+
+	dbErr := s.events.Record(ctx, s.db, &pb.ValidationEvent{
+		LicenseKey: req.LicenseKey, ProductId: req.ProductId, Hwid: req.Hwid, Valid: valid, Result: result,
+		ClientIp: ip, Country: s.geo.Country(ip),
+	})
+	if dbErr != nil {
+		log.Printf("Error logging validation event: %v", dbErr)
+	}
+}
+
+// 64. ListValidationEvents (Admin)
+func (s *WhitelistService) ListValidationEvents(ctx context.Context, req *pb.ListValidationEventsRequest) (*pb.ListValidationEventsResponse, error) {
+	if err := s.requireRole(ctx, roleReadOnly); err != nil {
+		return nil, err
+	}
+
+	page, err := newPage(store.NewestFirst, req.PageSize, req.PageToken, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	events, err := s.events.List(ctx, s.db, store.EventFilter{LicenseKey: req.LicenseKey, ProductID: req.ProductId, Valid: req.Valid}, page.Page)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	resp := &pb.ListValidationEventsResponse{Events: events}
+	if len(resp.Events) > page.Size {
+		resp.Events = resp.Events[:page.Size]
+		resp.NextPageToken = page.next("", strconv.FormatInt(resp.Events[page.Size-1].Id, 10))
+	}
+	return resp, nil
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mkseven15/whitelist-server/internal/store"
+	"github.com/mkseven15/whitelist-server/internal/webhook"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+const (
+	auditLicenseExtend = "license.extend"
+
+	// Longest single extension, so a typo can't make a key effectively perpetual
+	maxExtension = 10 * 365 * 24 * time.Hour
+)
+
+// 44. ExtendLicense (Admin)
+func (s *WhitelistService) ExtendLicense(ctx context.Context, req *pb.ExtendLicenseRequest) (*pb.License, error) {
+	if err := s.requireWrite(ctx, roleSupport); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	updated, event, err := s.extendLicense(ctx, tx, adminActor(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	s.invalidateLicenses(ctx, req.LicenseKey)
+	s.notify(event)
+	return updated, nil
+}
+
+// 45. ResellerExtendLicense (Reseller)
+func (s *WhitelistService) ResellerExtendLicense(ctx context.Context, req *pb.ExtendLicenseRequest) (*pb.ResellerExtendLicenseResponse, error) {
+	resellerID, name, err := s.authReseller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkMaintenance(ctx); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	r, err := s.resellers.Lock(ctx, tx, resellerID)
+	if err == nil && r == nil {
+		err = sql.ErrNoRows
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	// Resellers may only touch keys they generated themselves. Unknown and
+	// foreign keys look the same so keys can't be probed.
+	productID, err := s.resellers.Generated(ctx, tx, resellerID, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if productID == "" {
+		return nil, status.Error(codes.NotFound, "license not found")
+	}
+	if !allowsProduct(r.ProductIds, productID) {
+		return nil, status.Errorf(codes.PermissionDenied, "not allowed to extend keys for %s", productID)
+	}
+	if r.Credits < 1 {
+		return nil, status.Error(codes.FailedPrecondition, "insufficient credits: have 0, need 1")
+	}
+
+	actor := "reseller:" + name
+	updated, event, err := s.extendLicense(ctx, tx, actor, req)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := s.resellers.AddCredits(ctx, tx, resellerID, -1, store.LedgerExtend, []string{req.LicenseKey}, actor, "")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	s.invalidateLicenses(ctx, req.LicenseKey)
+	s.notify(event)
+	return &pb.ResellerExtendLicenseResponse{ExpiresAt: updated.ExpiresAt, RemainingCredits: balance}, nil
+}
+
+// extendLicense moves the license's expiry forward by req's duration, from
+// now if it has already passed, and audits the change as actor. Errors are
+// gRPC statuses. The returned event should be sent once tx commits.
+func (s *WhitelistService) extendLicense(ctx context.Context, tx *sql.Tx, actor string, req *pb.ExtendLicenseRequest) (*pb.License, webhook.Event, error) {
+	d := time.Duration(req.DurationSeconds) * time.Second
+	if req.DurationSeconds <= 0 || d > maxExtension {
+		return nil, webhook.Event{}, status.Errorf(codes.InvalidArgument, "duration_seconds must be between 1 and %d", int64(maxExtension/time.Second))
+	}
+
+	old, err := s.licenses.Lock(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, webhook.Event{}, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if old == nil || old.DeletedAt != nil {
+		return nil, webhook.Event{}, status.Error(codes.NotFound, "license not found")
+	}
+	if old.ExpiresAt == nil {
+		return nil, webhook.Event{}, status.Error(codes.FailedPrecondition, "license never expires")
+	}
+
+	from := old.ExpiresAt.AsTime()
+	if now := time.Now(); from.Before(now) {
+		from = now
+	}
+	expiresAt := from.Add(d)
+	if err := s.licenses.Change(ctx, tx, req.LicenseKey, store.LicenseChange{ExpiresAt: &expiresAt}); err != nil {
+		return nil, webhook.Event{}, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	updated, err := s.licenses.Get(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, webhook.Event{}, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.recordLicenseChange(ctx, tx, actor, auditLicenseExtend, req.LicenseKey, old, updated); err != nil {
+		return nil, webhook.Event{}, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	return updated, licenseEvent(webhook.LicenseUpdated, updated), nil
+}
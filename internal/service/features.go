@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mkseven15/whitelist-server/internal/store"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// Feature flags let one key unlock more of a product, e.g. {"pro": true},
+// without a second product_id. A product's flags apply to all of its
+// licenses and a plan's to the licenses on it, over the product's; a
+// license's own flags override both one by one, so a license can also switch
+// off a feature its product turns on.
+
+const auditLicenseSetFeatures = "license.set_features"
+
+// Enough for any product; flags travel with every valid response
+const maxFeatures = 100
+
+var featurePattern = regexp.MustCompile(`^[A-Za-z0-9_.:-]{1,64}$`)
+
+// 85. SetLicenseFeatures (Admin)
+func (s *WhitelistService) SetLicenseFeatures(ctx context.Context, req *pb.SetLicenseFeaturesRequest) (*pb.License, error) {
+	if err := s.requireWrite(ctx, roleSupport); err != nil {
+		return nil, err
+	}
+
+	features, err := featuresJSON(req.Features)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "features: %v", err)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	old, err := s.licenses.Get(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if old == nil {
+		return nil, status.Error(codes.NotFound, "license not found")
+	}
+
+	if err := s.licenses.Change(ctx, tx, req.LicenseKey, store.LicenseChange{Features: &features}); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	updated, err := s.licenses.Get(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.recordLicenseChange(ctx, tx, adminActor(ctx), auditLicenseSetFeatures, req.LicenseKey, old, updated); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	s.invalidateLicenses(ctx, req.LicenseKey)
+	return updated, nil
+}
+
+// featuresJSON checks flag names and encodes flags for a features column.
+func featuresJSON(flags map[string]bool) (string, error) {
+	if len(flags) > maxFeatures {
+		return "", fmt.Errorf("at most %d flags", maxFeatures)
+	}
+	for name := range flags {
+		if !featurePattern.MatchString(name) {
+			return "", fmt.Errorf("%q is not a feature name: use up to 64 letters, digits and _.:-", name)
+		}
+	}
+	if flags == nil {
+		flags = map[string]bool{}
+	}
+	b, err := json.Marshal(flags)
+	return string(b), err
+}
+
+// featuresParam turns an optional update into a change: nil keeps the
+// column as is.
+func featuresParam(f *pb.FeatureFlags) (*string, error) {
+	if f == nil {
+		return nil, nil
+	}
+	features, err := featuresJSON(f.Flags)
+	if err != nil {
+		return nil, err
+	}
+	return &features, nil
+}
+
+// mergeFeatures lays each of layers over the ones before it; nil if no
+// layer has any flags.
+func mergeFeatures(layers ...map[string]bool) map[string]bool {
+	var out map[string]bool
+	for _, l := range layers {
+		if len(l) == 0 {
+			continue
+		}
+		if out == nil {
+			out = make(map[string]bool, len(l))
+		}
+		maps.Copy(out, l)
+	}
+	return out
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mkseven15/whitelist-server/internal/cache"
+	"github.com/mkseven15/whitelist-server/internal/store"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+const auditLicenseSetCountries = "license.set_countries"
+
+var countryPattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// 54. SetLicenseCountries (Admin)
+func (s *WhitelistService) SetLicenseCountries(ctx context.Context, req *pb.SetLicenseCountriesRequest) (*pb.License, error) {
+	if err := s.requireWrite(ctx, roleSupport); err != nil {
+		return nil, err
+	}
+
+	allowed, err := normalizeCountries(req.AllowedCountries)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "allowed_countries: %v", err)
+	}
+	blocked, err := normalizeCountries(req.BlockedCountries)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "blocked_countries: %v", err)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	old, err := s.licenses.Get(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if old == nil {
+		return nil, status.Error(codes.NotFound, "license not found")
+	}
+
+	countries := &store.Countries{Allowed: allowed, Blocked: blocked}
+	if err := s.licenses.Change(ctx, tx, req.LicenseKey, store.LicenseChange{Countries: countries}); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	updated, err := s.licenses.Get(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.recordLicenseChange(ctx, tx, adminActor(ctx), auditLicenseSetCountries, req.LicenseKey, old, updated); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	s.invalidateLicenses(ctx, req.LicenseKey)
+	return updated, nil
+}
+
+// regionAllowed applies the product's and then the license's country lists to
+// country. An unknown country ("") passes: without a GeoIP database, or for
+// private addresses, there's nothing to go on.
+func regionAllowed(l *cache.License, country string) bool {
+	if country == "" {
+		return true
+	}
+	return countryAllowed(l.ProductAllowedCountries, l.ProductBlockedCountries, country) &&
+		countryAllowed(l.AllowedCountries, l.BlockedCountries, country)
+}
+
+func countryAllowed(allowed, blocked []string, country string) bool {
+	if slices.Contains(blocked, country) {
+		return false
+	}
+	return len(allowed) == 0 || slices.Contains(allowed, country)
+}
+
+// normalizeCountries upper-cases and dedups ISO 3166-1 alpha-2 codes. It
+// never returns nil, so the result always overwrites a column.
+func normalizeCountries(list []string) ([]string, error) {
+	out := []string{}
+	for _, c := range list {
+		c = strings.ToUpper(strings.TrimSpace(c))
+		if !countryPattern.MatchString(c) {
+			return nil, fmt.Errorf("%q is not a two-letter country code", c)
+		}
+		if !slices.Contains(out, c) {
+			out = append(out, c)
+		}
+	}
+	slices.Sort(out)
+	return out, nil
+}
+
+// countryListParam turns an optional update into a change: nil (keep the
+// stored list) when unset.
+func countryListParam(l *pb.CountryList) (*[]string, error) {
+	if l == nil {
+		return nil, nil
+	}
+	countries, err := normalizeCountries(l.Countries)
+	if err != nil {
+		return nil, err
+	}
+	return &countries, nil
+}
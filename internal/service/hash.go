@@ -0,0 +1,42 @@
+package service
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+
+	"github.com/mkseven15/whitelist-server/internal/credhash"
+)
+
+// hashSecret returns the digest stored for a credential. Only these digests
+// are stored, so a database dump alone doesn't reveal usable API keys or
+// access tokens.
+func (s *WhitelistService) hashSecret(secret string) string {
+	return credhash.Sum(s.hashSalt, secret)
+}
+
+// withPreviousSalt looks a credential up with find, by its digest and, if
+// that finds nothing (sql.ErrNoRows) and HASH_SALT_PREVIOUS is set, by its
+// digest under the previous salt. One found that way is moved to the current
+// digest with move, so each credential is re-hashed on its first use.
+func (s *WhitelistService) withPreviousSalt(secret string, find func(hash string) error, move func(old, current string) error) error {
+	current := s.hashSecret(secret)
+	err := find(current)
+	if err != sql.ErrNoRows || s.previousSalt == nil {
+		return err
+	}
+	old := credhash.Sum(s.previousSalt, secret)
+	if err := find(old); err != nil {
+		return err
+	}
+	return move(old, current)
+}
+
+// newAccessToken returns a random 256-bit token, hex encoded.
+func newAccessToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/mkseven15/whitelist-server/internal/credhash"
+)
+
+func TestWithPreviousSalt(t *testing.T) {
+	current, previous := []byte("0123456789abcdef"), []byte("")
+	tests := []struct {
+		name     string
+		previous []byte
+		stored   string // the digest in the "database"
+		want     error
+		moved    bool
+	}{
+		{"current salt", previous, credhash.Sum(current, "key"), nil, false},
+		{"previous salt", previous, credhash.Sum(previous, "key"), nil, true},
+		{"previous salt unset", nil, credhash.Sum(previous, "key"), sql.ErrNoRows, false},
+		{"unknown", previous, credhash.Sum(current, "other"), sql.ErrNoRows, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &WhitelistService{hashSalt: current, previousSalt: tt.previous}
+			stored := tt.stored
+			err := s.withPreviousSalt("key", func(hash string) error {
+				if hash != stored {
+					return sql.ErrNoRows
+				}
+				return nil
+			}, func(old, current string) error {
+				if old != stored {
+					t.Errorf("moved %s, stored is %s", old, stored)
+				}
+				stored = current
+				return nil
+			})
+			if err != tt.want {
+				t.Fatalf("got %v, want %v", err, tt.want)
+			}
+			if moved := stored != tt.stored; moved != tt.moved {
+				t.Errorf("moved %v, want %v", moved, tt.moved)
+			}
+			if tt.moved && stored != credhash.Sum(current, "key") {
+				t.Error("not moved to the current digest")
+			}
+		})
+	}
+}
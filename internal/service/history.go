@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/mkseven15/whitelist-server/internal/store"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// A device bound during validation, which isn't audited
+const revisionBindHwid = "license.bind_hwid"
+
+// License fields that change without anyone changing the license
+var revisionIgnored = map[protoreflect.Name]bool{
+	"last_validated_at": true,
+	"active_sessions":   true,
+	"active_leases":     true,
+	// Spent by ConsumeCredits; the credit activity has every change
+	"credits": true,
+	// Follows hwids
+	"hwid": true,
+}
+
+// recordLicenseChange audits a change to a license and adds it to the
+// license's history.
+func (s *WhitelistService) recordLicenseChange(ctx context.Context, db store.Querier, actor, action, licenseKey string, old, updated *pb.License) error {
+	if err := s.recordAudit(ctx, db, actor, action, licenseKey, old, updated); err != nil {
+		return err
+	}
+	return s.recordRevision(ctx, db, actor, action, old, updated)
+}
+
+// recordRevision stores updated in its license's history, unless it's the
+// same as old.
+func (s *WhitelistService) recordRevision(ctx context.Context, db store.Querier, actor, action string, old, updated *pb.License) error {
+	if updated == nil {
+		return nil
+	}
+	changed := changedFields(old, updated)
+	if old != nil && len(changed) == 0 {
+		return nil
+	}
+	return s.revisions.Record(ctx, db, &pb.LicenseRevision{Actor: actor, Action: action, ChangedFields: changed, License: updated})
+}
+
+// changedFields names the License fields that differ, nil for a new license.
+func changedFields(old, updated *pb.License) []string {
+	if old == nil {
+		return nil
+	}
+	a, b := old.ProtoReflect(), updated.ProtoReflect()
+	var names []string
+	fields := a.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if revisionIgnored[fd.Name()] {
+			continue
+		}
+		if a.Has(fd) != b.Has(fd) || !a.Get(fd).Equal(b.Get(fd)) {
+			names = append(names, string(fd.Name()))
+		}
+	}
+	return names
+}
+
+// 68. GetLicenseHistory (Admin)
+func (s *WhitelistService) GetLicenseHistory(ctx context.Context, req *pb.GetLicenseHistoryRequest) (*pb.GetLicenseHistoryResponse, error) {
+	if err := s.requireRole(ctx, roleReadOnly); err != nil {
+		return nil, err
+	}
+
+	page, err := newPage(store.NewestFirst, req.PageSize, req.PageToken, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := s.revisions.List(ctx, s.db, req.LicenseKey, page.Page)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	resp := &pb.GetLicenseHistoryResponse{Revisions: revisions}
+	if len(resp.Revisions) > page.Size {
+		resp.Revisions = resp.Revisions[:page.Size]
+		resp.NextPageToken = page.next("", strconv.FormatInt(resp.Revisions[page.Size-1].Id, 10))
+	}
+	return resp, nil
+}
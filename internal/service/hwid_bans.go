@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/mkseven15/whitelist-server/internal/hwid"
+	"github.com/mkseven15/whitelist-server/internal/store"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// Audit actions for the HWID ban list
+const (
+	auditHwidBan   = "hwid.ban"
+	auditHwidUnban = "hwid.unban"
+)
+
+// 48. BanHwid (Admin)
+func (s *WhitelistService) BanHwid(ctx context.Context, req *pb.BanHwidRequest) (*pb.HwidBan, error) {
+	if err := s.requireWrite(ctx, roleSupport); err != nil {
+		return nil, err
+	}
+	device := hwid.Hash(req.Hwid)
+	if device == "" {
+		return nil, status.Error(codes.InvalidArgument, "hwid: required")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	old, err := s.bans.LockHWIDBan(ctx, tx, device)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	// Banning again updates the reason
+	ban, err := s.bans.BanHWID(ctx, tx, device, req.Reason, adminActor(ctx))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	if err := s.recordAudit(ctx, tx, adminActor(ctx), auditHwidBan, device, old, ban); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	return ban, nil
+}
+
+// 49. UnbanHwid (Admin)
+func (s *WhitelistService) UnbanHwid(ctx context.Context, req *pb.UnbanHwidRequest) (*emptypb.Empty, error) {
+	if err := s.requireWrite(ctx, roleSupport); err != nil {
+		return nil, err
+	}
+	device := hwid.Hash(req.Hwid)
+	if device == "" {
+		return nil, status.Error(codes.InvalidArgument, "hwid: required")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	old, err := s.bans.UnbanHWID(ctx, tx, device)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if old == nil {
+		return nil, status.Error(codes.NotFound, "hwid is not banned")
+	}
+
+	if err := s.recordAudit(ctx, tx, adminActor(ctx), auditHwidUnban, device, old, nil); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// 50. ListHwidBans (Admin)
+func (s *WhitelistService) ListHwidBans(ctx context.Context, req *pb.ListHwidBansRequest) (*pb.ListHwidBansResponse, error) {
+	if err := s.requireRole(ctx, roleReadOnly); err != nil {
+		return nil, err
+	}
+
+	page, err := newPage(store.HWIDBanOrder, req.PageSize, req.PageToken, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	bans, err := s.bans.ListHWIDBans(ctx, s.db, page.Page)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	resp := &pb.ListHwidBansResponse{Bans: bans}
+	if len(resp.Bans) > page.Size {
+		resp.Bans = resp.Bans[:page.Size]
+		last := resp.Bans[page.Size-1]
+		var value string
+		if page.Field == "created_at" {
+			value = timeCursor(last.CreatedAt, "")
+		}
+		resp.NextPageToken = page.next(value, last.Hwid)
+	}
+	return resp, nil
+}
@@ -0,0 +1,336 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mkseven15/whitelist-server/internal/hwid"
+	"github.com/mkseven15/whitelist-server/internal/store"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// Durations past this are KeyAuth's "lifetime" keys, which never expire here
+const maxImportDuration = 100 * 365 * 24 * time.Hour
+
+// 69. ImportExternalLicenses (Admin)
+func (s *WhitelistService) ImportExternalLicenses(ctx context.Context, req *pb.ImportExternalLicensesRequest) (*pb.ImportLicensesResponse, error) {
+	if err := s.requireWrite(ctx, roleOwner); err != nil {
+		return nil, err
+	}
+	if !req.DryRun {
+		if err := s.requireOTP(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	var entries []importEntry
+	var err error
+	switch req.Format {
+	case "keyauth":
+		if req.ProductId == "" {
+			return nil, status.Error(codes.InvalidArgument, "product_id required for KeyAuth imports")
+		}
+		entries, err = parseKeyAuthExport([]byte(req.Data), time.Now())
+	case "json":
+		entries, err = parseJSONExport([]byte(req.Data))
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, `format must be "keyauth" or "json", not %q`, req.Format)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s export: %v", req.Format, err)
+	}
+	if len(entries) > maxImportRows {
+		return nil, status.Errorf(codes.InvalidArgument, "more than %d licenses", maxImportRows)
+	}
+
+	resp := &pb.ImportLicensesResponse{DryRun: req.DryRun}
+	var licenses []*pb.UpdateLicenseRequest
+	var lines []int
+	links := map[string]importLinks{}
+	seen := map[string]int{}
+	for i, e := range entries {
+		line := i + 1
+		l := e.license
+		if l.ProductId == "" {
+			l.ProductId = req.ProductId
+		}
+		if l.LicenseKey == "" || l.ProductId == "" {
+			resp.Errors = append(resp.Errors, fmt.Sprintf("line %d: license_key and product_id required", line))
+			continue
+		}
+		if prev, dup := seen[l.LicenseKey]; dup {
+			resp.Errors = append(resp.Errors, fmt.Sprintf("line %d: duplicate license_key (first seen on line %d)", line, prev))
+			continue
+		}
+		seen[l.LicenseKey] = line
+		if err := checkLicenseUpdate(l); err != nil {
+			resp.Errors = append(resp.Errors, fmt.Sprintf("line %d: %v", line, err))
+			continue
+		}
+		// Room for every device the old system had bound
+		if n := int32(len(e.links.hwids)); l.MaxDevices < n {
+			l.MaxDevices = n
+		}
+		licenses = append(licenses, l)
+		lines = append(lines, line)
+		if len(e.links.hwids) > 0 || e.links.email != "" {
+			links[l.LicenseKey] = e.links
+		}
+	}
+	if len(resp.Errors) > 0 {
+		return resp, nil
+	}
+	return s.importLicenses(ctx, resp, licenses, lines, links)
+}
+
+// linkImportedLicense binds an imported license's devices and attaches it to
+// its customer, unless it already belongs to one.
+func (s *WhitelistService) linkImportedLicense(ctx context.Context, tx *sql.Tx, licenseKey string, link importLinks) error {
+	old, err := s.licenses.Get(ctx, tx, licenseKey)
+	if err != nil {
+		return err
+	}
+	for _, hwid := range link.hwids {
+		if err := s.devices.Add(ctx, tx, licenseKey, &store.Device{HWID: hwid}); err != nil {
+			return err
+		}
+	}
+	// Left alone if the license already belongs to a customer
+	if link.email != "" && (old == nil || old.CustomerId == 0) {
+		id, err := s.customers.ByEmail(ctx, tx, link.email, "Imported")
+		if err != nil {
+			return err
+		}
+		if err := s.licenses.Change(ctx, tx, licenseKey, store.LicenseChange{CustomerID: &id}); err != nil {
+			return err
+		}
+	}
+	updated, err := s.licenses.Get(ctx, tx, licenseKey)
+	if err != nil {
+		return err
+	}
+	return s.recordRevision(ctx, tx, adminActor(ctx), auditLicenseUpdate, old, updated)
+}
+
+// importEntry is one license read from an export.
+type importEntry struct {
+	license *pb.UpdateLicenseRequest
+	links   importLinks
+}
+
+// jsonLicense is an entry of the "json" format.
+type jsonLicense struct {
+	LicenseKey  string          `json:"license_key"`
+	ProductID   string          `json:"product_id"`
+	IsActive    *bool           `json:"is_active"`
+	ExpiresAt   string          `json:"expires_at"`
+	MaxDevices  int32           `json:"max_devices"`
+	MaxSessions int32           `json:"max_sessions"`
+	Hwids       []string        `json:"hwids"`
+	Email       string          `json:"email"`
+	Metadata    json.RawMessage `json:"metadata"`
+}
+
+func parseJSONExport(data []byte) ([]importEntry, error) {
+	var in []jsonLicense
+	if err := json.Unmarshal(data, &in); err != nil {
+		return nil, err
+	}
+	entries := make([]importEntry, len(in))
+	for i, j := range in {
+		l := &pb.UpdateLicenseRequest{
+			LicenseKey:  strings.TrimSpace(j.LicenseKey),
+			ProductId:   strings.TrimSpace(j.ProductID),
+			IsActive:    j.IsActive == nil || *j.IsActive,
+			MaxDevices:  j.MaxDevices,
+			MaxSessions: max(j.MaxSessions, 0),
+		}
+		if j.ExpiresAt != "" {
+			t, err := time.Parse(time.RFC3339, j.ExpiresAt)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid expires_at %q", i+1, j.ExpiresAt)
+			}
+			l.ExpiresAt = timestamppb.New(t)
+		}
+		if len(j.Metadata) > 0 && !bytes.Equal(j.Metadata, []byte("null")) {
+			m, err := store.ParseMetadata(j.Metadata)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid metadata: %v", i+1, err)
+			}
+			l.Metadata = m
+		}
+		entries[i] = importEntry{license: l, links: importLinks{hwids: cleanHwids(j.Hwids), email: importEmail(j.Email)}}
+	}
+	return entries, nil
+}
+
+// KeyAuth's seller API (fetchallkeys, fetchallusers). Numbers and times come
+// as strings or numbers depending on the endpoint.
+type keyAuthExport struct {
+	Keys  []keyAuthKey  `json:"keys"`
+	Users []keyAuthUser `json:"users"`
+}
+
+type keyAuthKey struct {
+	Key  string     `json:"key"`
+	Note flexString `json:"note"`
+	// Seconds the key lasts once used
+	Expires flexString `json:"expires"`
+	// "Not Used", "Used" or "Banned"
+	Status flexString `json:"status"`
+	Level  flexString `json:"level"`
+	// Ban reason, if banned
+	Banned flexString `json:"banned"`
+	// Unix time of first use
+	UsedOn flexString `json:"usedon"`
+	UsedBy flexString `json:"usedby"`
+}
+
+type keyAuthUser struct {
+	Username      flexString `json:"username"`
+	Email         flexString `json:"email"`
+	Hwid          flexString `json:"hwid"`
+	Banned        flexString `json:"banned"`
+	Subscriptions []struct {
+		Key flexString `json:"key"`
+		// Unix time
+		Expiry flexString `json:"expiry"`
+	} `json:"subscriptions"`
+}
+
+// parseKeyAuthExport maps KeyAuth keys to licenses. A used key expires
+// where its user's subscription does, or its duration after first use; an
+// unused one gets its full duration from now, since licenses here can't
+// start counting on first use. The user's HWID is bound and their email
+// becomes the customer.
+func parseKeyAuthExport(data []byte, now time.Time) ([]importEntry, error) {
+	var export keyAuthExport
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &export.Keys); err != nil {
+			return nil, err
+		}
+	} else if err := json.Unmarshal(data, &export); err != nil {
+		return nil, err
+	}
+
+	users := map[string]*keyAuthUser{}
+	keyUsers := map[string]*keyAuthUser{}
+	expiries := map[string]time.Time{}
+	for i := range export.Users {
+		u := &export.Users[i]
+		users[string(u.Username)] = u
+		for _, sub := range u.Subscriptions {
+			keyUsers[string(sub.Key)] = u
+			if t, ok := unixTime(sub.Expiry); ok {
+				expiries[string(sub.Key)] = t
+			}
+		}
+	}
+
+	entries := make([]importEntry, len(export.Keys))
+	for i, k := range export.Keys {
+		key := strings.TrimSpace(k.Key)
+		u := keyUsers[key]
+		if u == nil && k.UsedBy != "" {
+			u = users[string(k.UsedBy)]
+		}
+
+		l := &pb.UpdateLicenseRequest{
+			LicenseKey: key,
+			IsActive:   k.Banned == "" && !strings.EqualFold(string(k.Status), "banned") && (u == nil || u.Banned == ""),
+		}
+		if t, ok := expiries[key]; ok {
+			l.ExpiresAt = timestamppb.New(t)
+		} else if secs, err := strconv.ParseInt(string(k.Expires), 10, 64); err == nil && secs > 0 {
+			d := time.Duration(secs) * time.Second
+			start := now
+			if used, ok := unixTime(k.UsedOn); ok {
+				start = used
+			}
+			if secs < int64(maxImportDuration/time.Second) {
+				l.ExpiresAt = timestamppb.New(start.Add(d))
+			}
+		}
+
+		fields := map[string]interface{}{}
+		for name, v := range map[string]flexString{"keyauth_level": k.Level, "keyauth_note": k.Note, "keyauth_banned": k.Banned} {
+			if v != "" {
+				fields[name] = string(v)
+			}
+		}
+		var links importLinks
+		if u != nil {
+			fields["keyauth_user"] = string(u.Username)
+			links = importLinks{hwids: cleanHwids([]string{string(u.Hwid)}), email: importEmail(string(u.Email))}
+		}
+		if len(fields) > 0 {
+			m, err := structpb.NewStruct(fields)
+			if err != nil {
+				return nil, err
+			}
+			l.Metadata = m
+		}
+		entries[i] = importEntry{license: l, links: links}
+	}
+	return entries, nil
+}
+
+// flexString is a JSON string, number or bool as text; null and false are "".
+type flexString string
+
+func (f *flexString) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*f = flexString(strings.TrimSpace(s))
+		return nil
+	}
+	switch v := string(bytes.TrimSpace(b)); v {
+	case "null", "false":
+		*f = ""
+	default:
+		*f = flexString(v)
+	}
+	return nil
+}
+
+func unixTime(f flexString) (time.Time, bool) {
+	secs, err := strconv.ParseInt(string(f), 10, 64)
+	if err != nil || secs <= 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(secs, 0), true
+}
+
+// cleanHwids hashes hwids as ValidateLicense would, dropping blank and
+// repeated ones.
+func cleanHwids(hwids []string) []string {
+	var out []string
+	for _, h := range hwids {
+		if h = hwid.Hash(h); h != "" && !slices.Contains(out, h) {
+			out = append(out, h)
+		}
+	}
+	return out
+}
+
+// importEmail normalizes an email the way CreateCustomer does; anything that
+// isn't one is dropped.
+func importEmail(email string) string {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if !strings.Contains(email, "@") {
+		return ""
+	}
+	return email
+}
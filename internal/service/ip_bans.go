@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"net/netip"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/mkseven15/whitelist-server/internal/ipban"
+	"github.com/mkseven15/whitelist-server/internal/notify"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// Audit actions for the IP ban list
+const (
+	auditIPBan   = "ip.ban"
+	auditIPUnban = "ip.unban"
+)
+
+// Actor of bans made by the failed-validation tracker
+const autoBanActor = "auto"
+
+// 51. BanIp (Admin)
+func (s *WhitelistService) BanIp(ctx context.Context, req *pb.BanIpRequest) (*pb.IpBan, error) {
+	if err := s.requireWrite(ctx, roleSupport); err != nil {
+		return nil, err
+	}
+
+	network, err := ipban.ParsePrefix(req.Network)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "network must be an IP address or CIDR range")
+	}
+	if req.DurationSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "duration_seconds must not be negative")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	ban, err := s.insertIPBan(ctx, tx, adminActor(ctx), network, req.Reason, time.Duration(req.DurationSeconds)*time.Second)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+
+	s.reloadIPBans(ctx)
+	return ban, nil
+}
+
+// 52. UnbanIp (Admin)
+func (s *WhitelistService) UnbanIp(ctx context.Context, req *pb.UnbanIpRequest) (*emptypb.Empty, error) {
+	if err := s.requireWrite(ctx, roleSupport); err != nil {
+		return nil, err
+	}
+
+	network, err := ipban.ParsePrefix(req.Network)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "network must be an IP address or CIDR range")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	old, err := s.bans.UnbanIP(ctx, tx, network.String())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if old == nil {
+		return nil, status.Error(codes.NotFound, "network is not banned")
+	}
+	if err := s.recordAudit(ctx, tx, adminActor(ctx), auditIPUnban, old.Network, old, nil); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+
+	s.reloadIPBans(ctx)
+	return &emptypb.Empty{}, nil
+}
+
+// 53. ListIpBans (Admin)
+func (s *WhitelistService) ListIpBans(ctx context.Context, req *pb.ListIpBansRequest) (*pb.ListIpBansResponse, error) {
+	if err := s.requireRole(ctx, roleReadOnly); err != nil {
+		return nil, err
+	}
+
+	bans, err := s.bans.ListIPBans(ctx, s.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	return &pb.ListIpBansResponse{Bans: bans}, nil
+}
+
+// IPBans is the ban list the public endpoints' interceptor checks. It's kept
+// up to date by the service: right away for bans made on this instance, and
+// every cleanup interval for the rest.
+func (s *WhitelistService) IPBans() *ipban.List {
+	return s.ipBans
+}
+
+// insertIPBan bans network (replacing an existing ban of it) and audits it.
+// A zero duration bans for good.
+func (s *WhitelistService) insertIPBan(ctx context.Context, tx *sql.Tx, actor string, network netip.Prefix, reason string, duration time.Duration) (*pb.IpBan, error) {
+	var expiresAt time.Time
+	if duration > 0 {
+		expiresAt = time.Now().Add(duration)
+	}
+
+	old, err := s.bans.LockIPBan(ctx, tx, network.String())
+	if err != nil {
+		return nil, err
+	}
+	ban, err := s.bans.BanIP(ctx, tx, network.String(), reason, actor, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.recordAudit(ctx, tx, actor, auditIPBan, ban.Network, old, ban); err != nil {
+		return nil, err
+	}
+	return ban, nil
+}
+
+// reloadIPBans replaces the in-memory ban list with the bans in force. On
+// failure the previous list stays.
+func (s *WhitelistService) reloadIPBans(ctx context.Context) {
+	networks, err := s.bans.BannedNetworks(ctx, s.db)
+	if err != nil {
+		log.Printf("Error loading IP bans: %v", err)
+		return
+	}
+
+	var prefixes []netip.Prefix
+	for _, network := range networks {
+		p, err := ipban.ParsePrefix(network)
+		if err != nil {
+			log.Printf("Skipping IP ban %q: %v", network, err)
+			continue
+		}
+		prefixes = append(prefixes, p)
+	}
+	s.ipBans.Set(prefixes)
+}
+
+// trackClientFailure feeds a validation outcome into the auto-ban counters
+// and bans the caller's address once it fails too often. Outdated clients
+// and region refusals aren't counted; they're legitimate users.
+func (s *WhitelistService) trackClientFailure(ctx context.Context, resp *pb.ValidateResponse, err error) {
+	if err != nil || resp == nil || resp.Valid || resp.Reason == pb.Reason_REASON_CLIENT_OUTDATED || resp.Reason == pb.Reason_REASON_REGION_NOT_ALLOWED {
+		return
+	}
+	ip := clientIP(ctx)
+	if !s.ipFailures.record(ip, time.Now()) {
+		return
+	}
+	s.autoBanIP(ctx, ip, fmt.Sprintf("%d failed validations within %s", s.autoBan.Failures, s.autoBan.Window), s.autoBan.Duration)
+}
+
+// autoBanIP bans ip as the auto actor, logs it and raises a Telegram alert.
+func (s *WhitelistService) autoBanIP(ctx context.Context, ip, reason string, duration time.Duration) {
+	network, err := ipban.ParsePrefix(ip)
+	if err != nil {
+		return
+	}
+
+	// The request may be cancelled already; the ban should still land
+	ctx = context.WithoutCancel(ctx)
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Printf("Auto-ban of %s failed: %v", ip, err)
+		return
+	}
+	defer tx.Rollback()
+	if _, err := s.insertIPBan(ctx, tx, autoBanActor, network, reason, duration); err != nil {
+		log.Printf("Auto-ban of %s failed: %v", ip, err)
+		return
+	}
+	if err := tx.Commit(); err != nil {
+		log.Printf("Auto-ban of %s failed: %v", ip, err)
+		return
+	}
+	s.reloadIPBans(ctx)
+
+	log.Printf("Auto-banned %s: %s", ip, reason)
+	s.alerts.Send(notify.Alert{
+		Kind: notify.IPAutoBanned,
+		Key:  ip,
+		Text: fmt.Sprintf("Banned %s after %s", ip, reason),
+	})
+}
+
+// ipFailures counts failed validations per client address in fixed windows.
+type ipFailures struct {
+	threshold int
+	window    time.Duration
+
+	mu     sync.Mutex
+	counts map[string]*ipFailureCount
+}
+
+type ipFailureCount struct {
+	n     int
+	start time.Time
+}
+
+func newIPFailures(threshold int, window time.Duration) *ipFailures {
+	return &ipFailures{threshold: threshold, window: window, counts: make(map[string]*ipFailureCount)}
+}
+
+// record notes one failure from ip and reports whether it just reached the
+// threshold, so each address is banned once per window.
+func (f *ipFailures) record(ip string, now time.Time) bool {
+	if f.threshold <= 0 || ip == "" {
+		return false
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, ok := f.counts[ip]
+	if !ok || now.Sub(c.start) >= f.window {
+		c = &ipFailureCount{start: now}
+		f.counts[ip] = c
+	}
+	c.n++
+
+	// Keep the map from growing with every address ever seen
+	if len(f.counts) > 10000 {
+		for k, old := range f.counts {
+			if now.Sub(old.start) >= f.window {
+				delete(f.counts, k)
+			}
+		}
+	}
+	return c.n == f.threshold
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mkseven15/whitelist-server/internal/store"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// Placeholders in a product's key format; every other character stands for
+// itself.
+const (
+	keyFormatRandom = 'X'
+	keyFormatCheck  = '#'
+)
+
+// Fewer random characters would run out of unique keys too soon
+const minKeyFormatRandom = 4
+
+// keyFormat is a product's license key format, e.g. "PROD-XXXX-XXXX-XXX#".
+// Random and check characters come from defaultKeyCharset; the check
+// character is Luhn mod 32 over the random ones, so clients can catch typos
+// without asking the server. The empty format allows any key.
+type keyFormat string
+
+func parseKeyFormat(s string) (keyFormat, error) {
+	if s == "" {
+		return "", nil
+	}
+	var random, check int
+	for _, c := range s {
+		switch {
+		case c == keyFormatRandom:
+			random++
+		case c == keyFormatCheck:
+			check++
+		case c < '!' || c > '~':
+			return "", fmt.Errorf("%q can't be part of a license key", c)
+		}
+	}
+	if random < minKeyFormatRandom {
+		return "", fmt.Errorf("needs at least %d random characters (%c)", minKeyFormatRandom, keyFormatRandom)
+	}
+	if check > 1 {
+		return "", fmt.Errorf("can have only one check character (%c)", keyFormatCheck)
+	}
+	return keyFormat(s), nil
+}
+
+// generate returns a random key in the format using crypto/rand.
+func (f keyFormat) generate() (string, error) {
+	key := []byte(f)
+	random := make([]byte, 0, len(key))
+	max := big.NewInt(int64(len(defaultKeyCharset)))
+	for i, c := range key {
+		if c != keyFormatRandom {
+			continue
+		}
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		key[i] = defaultKeyCharset[n.Int64()]
+		random = append(random, key[i])
+	}
+	if i := strings.IndexByte(string(f), keyFormatCheck); i >= 0 {
+		key[i] = keyCheckChar(random)
+	}
+	return string(key), nil
+}
+
+// check returns why key doesn't fit the format, nil if it does.
+func (f keyFormat) check(key string) error {
+	if f == "" {
+		return nil
+	}
+	if len(key) != len(f) {
+		return fmt.Errorf("license key must look like %s", f)
+	}
+	random := make([]byte, 0, len(key))
+	check := -1
+	for i := 0; i < len(f); i++ {
+		switch f[i] {
+		case keyFormatRandom, keyFormatCheck:
+			if strings.IndexByte(defaultKeyCharset, key[i]) < 0 {
+				return fmt.Errorf("license key must look like %s", f)
+			}
+			if f[i] == keyFormatCheck {
+				check = i
+			} else {
+				random = append(random, key[i])
+			}
+		default:
+			if key[i] != f[i] {
+				return fmt.Errorf("license key must look like %s", f)
+			}
+		}
+	}
+	if check >= 0 && key[check] != keyCheckChar(random) {
+		return errors.New("license key has a typo: its check character doesn't match")
+	}
+	return nil
+}
+
+// keyCheckChar is the Luhn mod N check character of chars, which must all be
+// in defaultKeyCharset. It catches any single wrong character and most swaps
+// of neighbours.
+func keyCheckChar(chars []byte) byte {
+	n := len(defaultKeyCharset)
+	factor, sum := 2, 0
+	for i := len(chars) - 1; i >= 0; i-- {
+		addend := factor * strings.IndexByte(defaultKeyCharset, chars[i])
+		sum += addend/n + addend%n
+		factor = 3 - factor
+	}
+	return defaultKeyCharset[(n-sum%n)%n]
+}
+
+// productKeyFormats returns the key formats of the given products, leaving
+// out those without one.
+func (s *WhitelistService) productKeyFormats(ctx context.Context, q store.Querier, ids ...string) (map[string]keyFormat, error) {
+	formats := map[string]keyFormat{}
+	if len(ids) == 0 {
+		return formats, nil
+	}
+	stored, err := s.products.KeyFormats(ctx, q, ids)
+	if err != nil {
+		return nil, err
+	}
+	for id, format := range stored {
+		formats[id] = keyFormat(format)
+	}
+	return formats, nil
+}
+
+// keyFormatErrors returns, for each license naming a product, why its key
+// doesn't fit the product's key format; nil entries fit.
+func (s *WhitelistService) keyFormatErrors(ctx context.Context, q store.Querier, licenses ...*pb.UpdateLicenseRequest) ([]error, error) {
+	ids := make([]string, 0, len(licenses))
+	for _, l := range licenses {
+		if l.ProductId != "" {
+			ids = append(ids, l.ProductId)
+		}
+	}
+	formats, err := s.productKeyFormats(ctx, q, ids...)
+	if err != nil {
+		return nil, err
+	}
+	errs := make([]error, len(licenses))
+	for i, l := range licenses {
+		if l.ProductId != "" {
+			errs[i] = formats[l.ProductId].check(l.LicenseKey)
+		}
+	}
+	return errs, nil
+}
+
+// keyGenerator makes license keys: a keyPattern or a keyFormat.
+type keyGenerator interface {
+	generate() (string, error)
+}
+
+// productKeyGenerator returns what makes new keys for productID: its key
+// format if it has one, else pattern, else the default pattern. Errors are
+// gRPC statuses.
+func (s *WhitelistService) productKeyGenerator(ctx context.Context, q store.Querier, productID string, pattern *keyPattern) (keyGenerator, error) {
+	formats, err := s.productKeyFormats(ctx, q, productID)
+	if err != nil {
+		return nil, dbError("db error", err)
+	}
+	if format, ok := formats[productID]; ok {
+		if pattern != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "product %s has a key format; leave out prefix, groups, group_size and charset", productID)
+		}
+		return format, nil
+	}
+	if pattern != nil {
+		return *pattern, nil
+	}
+	p, err := newKeyPattern("", 0, 0, "")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "key pattern: %v", err)
+	}
+	return p, nil
+}
+
+// keyFormatOf returns productID's key format as of the last reload.
+func (s *WhitelistService) keyFormatOf(productID string) keyFormat {
+	if formats := s.keyFormats.Load(); formats != nil {
+		return (*formats)[productID]
+	}
+	return ""
+}
+
+// reloadKeyFormats replaces the in-memory key formats with those in the
+// database. On failure the previous ones stay.
+func (s *WhitelistService) reloadKeyFormats(ctx context.Context) {
+	stored, err := s.products.KeyFormats(ctx, s.db, nil)
+	if err != nil {
+		log.Printf("Error loading key formats: %v", err)
+		return
+	}
+	formats := make(map[string]keyFormat, len(stored))
+	for id, format := range stored {
+		formats[id] = keyFormat(format)
+	}
+	s.keyFormats.Store(&formats)
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseKeyFormat(t *testing.T) {
+	tests := []struct {
+		name, in string
+		ok       bool
+	}{
+		{"empty allows any key", "", true},
+		{"random only", "XXXX", true},
+		{"with literals and check", "PROD-XXXX-XXXX-XXX#", true},
+		{"too few random", "PROD-XXX#", false},
+		{"two check characters", "XXXX-##", false},
+		{"space", "PROD XXXX", false},
+		{"non-ASCII", "PRÖD-XXXX", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := parseKeyFormat(tt.in)
+			if (err == nil) != tt.ok {
+				t.Fatalf("got %v, want ok %v", err, tt.ok)
+			}
+			if err == nil && string(f) != tt.in {
+				t.Errorf("got %q", f)
+			}
+		})
+	}
+}
+
+func TestKeyFormatGenerate(t *testing.T) {
+	f := keyFormat("PROD-XXXX-XXXX-XXX#")
+	for i := 0; i < 100; i++ {
+		key, err := f.generate()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(key, "PROD-") || key[9] != '-' || key[14] != '-' {
+			t.Fatalf("%s doesn't keep the literals of %s", key, f)
+		}
+		if err := f.check(key); err != nil {
+			t.Fatalf("generated key %s: %v", key, err)
+		}
+	}
+}
+
+func TestKeyFormatCheck(t *testing.T) {
+	f := keyFormat("AB-XXXX#")
+	valid := "AB-KM7Q" + string(keyCheckChar([]byte("KM7Q")))
+	tests := []struct {
+		name, key string
+		ok        bool
+	}{
+		{"valid", valid, true},
+		{"too short", valid[:7], false},
+		{"too long", valid + "A", false},
+		{"wrong literal", "AC" + valid[2:], false},
+		{"outside the charset", "AB-KM1Q" + valid[7:], false},
+		{"lowercase", "AB-km7q" + valid[7:], false},
+		{"typo", "AB-KM7R" + valid[7:], false},
+		{"swapped neighbours", "AB-MK7Q" + valid[7:], false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := f.check(tt.key); (err == nil) != tt.ok {
+				t.Errorf("check(%q) = %v, want ok %v", tt.key, err, tt.ok)
+			}
+		})
+	}
+	if err := keyFormat("").check("anything goes"); err != nil {
+		t.Errorf("the empty format refused a key: %v", err)
+	}
+}
+
+// The check character catches every single wrong character.
+func TestKeyCheckCharSubstitutions(t *testing.T) {
+	chars := []byte("KM7QA2ZZ")
+	want := keyCheckChar(chars)
+	for i := range chars {
+		for _, c := range []byte(defaultKeyCharset) {
+			if c == chars[i] {
+				continue
+			}
+			typo := append([]byte(nil), chars...)
+			typo[i] = c
+			if keyCheckChar(typo) == want {
+				t.Errorf("%s has the same check character as %s", typo, chars)
+			}
+		}
+	}
+}
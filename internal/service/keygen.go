@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mkseven15/whitelist-server/internal/webhook"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+const (
+	defaultKeyCharset   = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	defaultKeyGroups    = 4
+	defaultKeyGroupSize = 4
+	maxGenerateCount    = 1000
+)
+
+// keyPattern describes the shape of generated license keys.
+type keyPattern struct {
+	prefix    string
+	groups    int
+	groupSize int
+	charset   []rune
+}
+
+func newKeyPattern(prefix string, groups, groupSize int, charset string) (keyPattern, error) {
+	if groups <= 0 {
+		groups = defaultKeyGroups
+	}
+	if groupSize <= 0 {
+		groupSize = defaultKeyGroupSize
+	}
+	if charset == "" {
+		charset = defaultKeyCharset
+	}
+	if groups*groupSize > 128 {
+		return keyPattern{}, errors.New("key too long")
+	}
+	runes := []rune(charset)
+	if len(runes) < 2 {
+		return keyPattern{}, errors.New("charset needs at least 2 characters")
+	}
+	return keyPattern{prefix: prefix, groups: groups, groupSize: groupSize, charset: runes}, nil
+}
+
+// requestKeyPattern returns the key pattern req asks for, nil if it leaves
+// that to the product. Errors are gRPC statuses.
+func requestKeyPattern(req *pb.GenerateLicensesRequest) (*keyPattern, error) {
+	if req.Prefix == "" && req.Groups == 0 && req.GroupSize == 0 && req.Charset == "" {
+		return nil, nil
+	}
+	pattern, err := newKeyPattern(req.Prefix, int(req.Groups), int(req.GroupSize), req.Charset)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid key pattern: %v", err)
+	}
+	return &pattern, nil
+}
+
+// generate returns a random key using crypto/rand.
+func (p keyPattern) generate() (string, error) {
+	parts := make([]string, 0, p.groups+1)
+	if p.prefix != "" {
+		parts = append(parts, p.prefix)
+	}
+	max := big.NewInt(int64(len(p.charset)))
+	for g := 0; g < p.groups; g++ {
+		var sb strings.Builder
+		for i := 0; i < p.groupSize; i++ {
+			n, err := rand.Int(rand.Reader, max)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteRune(p.charset[n.Int64()])
+		}
+		parts = append(parts, sb.String())
+	}
+	return strings.Join(parts, "-"), nil
+}
+
+// insertGeneratedLicenses creates count licenses with random keys from pattern
+// (nil for the product's key format or the default pattern) and the settings
+// in req, auditing each as actor. Errors are gRPC statuses. The returned
+// events should be sent once tx commits.
+func (s *WhitelistService) insertGeneratedLicenses(ctx context.Context, tx *sql.Tx, actor string, pattern *keyPattern, count int, req *pb.GenerateLicensesRequest) ([]string, []webhook.Event, error) {
+	if err := s.requireProducts(ctx, tx, req.ProductId); err != nil {
+		return nil, nil, err
+	}
+	if err := s.requirePlans(ctx, tx, req.Plan); err != nil {
+		return nil, nil, err
+	}
+	generator, err := s.productKeyGenerator(ctx, tx, req.ProductId, pattern)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Limits req leaves unset come from the plan
+	settings := &pb.UpdateLicenseRequest{MaxDevices: req.MaxDevices, ExpiresAt: req.ExpiresAt, Plan: &req.Plan}
+	if err := s.applyPlan(ctx, tx, nil, settings, settings); err != nil {
+		return nil, nil, dbError("db error", err)
+	}
+	var expiresAt *time.Time
+	if settings.ExpiresAt != nil {
+		t := settings.ExpiresAt.AsTime()
+		expiresAt = &t
+	}
+	maxDevices := settings.MaxDevices
+	if maxDevices <= 0 {
+		maxDevices = 1
+	}
+
+	var keys []string
+	var events []webhook.Event
+	for attempts := 0; len(keys) < count; attempts++ {
+		// Small patterns can run out of unique keys; don't loop forever
+		if attempts >= count*10 {
+			return nil, nil, status.Error(codes.ResourceExhausted, "could not generate enough unique keys, use a longer pattern")
+		}
+		key, err := generator.generate()
+		if err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "key generation failed: %v", err)
+		}
+
+		inserted, err := s.licenses.Create(ctx, tx, key, req.ProductId, req.IsActive, expiresAt, maxDevices, req.Plan)
+		if err != nil {
+			return nil, nil, dbError("insert failed", err)
+		}
+		if !inserted {
+			continue
+		}
+
+		created, err := s.licenses.Get(ctx, tx, key)
+		if err != nil {
+			return nil, nil, dbError("db error", err)
+		}
+		if err := s.recordLicenseChange(ctx, tx, actor, auditLicenseCreate, key, nil, created); err != nil {
+			return nil, nil, dbError("audit failed", err)
+		}
+		keys = append(keys, key)
+		events = append(events, licenseEvent(webhook.LicenseCreated, created))
+	}
+	return keys, events, nil
+}
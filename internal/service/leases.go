@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/mkseven15/whitelist-server/internal/hwid"
+	"github.com/mkseven15/whitelist-server/internal/store"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// A floating license binds no devices. Instead any floating_seats machines
+// may use it at once, each holding a lease from CheckoutLicense until it's
+// checked in or leaseTTL passes without the machine checking out again.
+// Validating a floating license passes only on machines holding a lease.
+
+const auditLicenseSetFloatingSeats = "license.set_floating_seats"
+
+// checkingOutKey marks the context of the validation inside CheckoutLicense,
+// which a floating license passes before the machine holds a lease.
+type checkingOutKey struct{}
+
+func checkingOut(ctx context.Context) bool {
+	v, _ := ctx.Value(checkingOutKey{}).(bool)
+	return v
+}
+
+// 79. SetLicenseFloatingSeats (Admin)
+func (s *WhitelistService) SetLicenseFloatingSeats(ctx context.Context, req *pb.SetLicenseFloatingSeatsRequest) (*pb.License, error) {
+	if err := s.requireWrite(ctx, roleSupport); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	old, err := s.licenses.Get(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if old == nil {
+		return nil, status.Error(codes.NotFound, "license not found")
+	}
+
+	// Leases already out stay until they expire, even past a smaller pool
+	if err := s.licenses.Change(ctx, tx, req.LicenseKey, store.LicenseChange{FloatingSeats: &req.FloatingSeats}); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	updated, err := s.licenses.Get(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.recordLicenseChange(ctx, tx, adminActor(ctx), auditLicenseSetFloatingSeats, req.LicenseKey, old, updated); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	s.invalidateLicenses(ctx, req.LicenseKey)
+	return updated, nil
+}
+
+// 80. CheckoutLicense
+func (s *WhitelistService) CheckoutLicense(ctx context.Context, req *pb.CheckoutLicenseRequest) (*pb.CheckoutLicenseResponse, error) {
+	resp, err := s.checkoutLicense(ctx, req)
+	if resp != nil {
+		// Answers for the checkout, not the validation inside it
+		resp.ChallengeResponse = answerChallenge(req.LicenseKey, req.Challenge, resp.Valid)
+		resp.Message = s.localMessage(req.ProductId, req.Locale, resp.Reason, resp.Message)
+	}
+	return resp, err
+}
+
+func (s *WhitelistService) checkoutLicense(ctx context.Context, req *pb.CheckoutLicenseRequest) (*pb.CheckoutLicenseResponse, error) {
+	device := hwid.Hash(req.Hwid)
+	if device == "" {
+		return nil, status.Error(codes.InvalidArgument, "hwid is required")
+	}
+
+	// Same checks (and access token, signature and challenge) as a plain validation
+	valid, err := s.ValidateLicense(context.WithValue(ctx, checkingOutKey{}, true), &pb.ValidateRequest{
+		LicenseKey: req.LicenseKey, ProductId: req.ProductId, Hwid: req.Hwid, ClientVersion: req.ClientVersion,
+		Challenge: req.Challenge, ChallengeResponse: req.ChallengeResponse, Locale: req.Locale,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !valid.Valid {
+		return &pb.CheckoutLicenseResponse{Valid: false, Reason: valid.Reason, Message: valid.Message, RequiredVersion: valid.RequiredVersion,
+			SuspendReason: valid.SuspendReason, RetryAfterSeconds: valid.RetryAfterSeconds}, nil
+	}
+
+	token, err := newAccessToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate lease token: %v", err)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	// Lock the license so concurrent checkouts can't overshoot the pool
+	l, err := s.licenses.Lock(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if l == nil {
+		return &pb.CheckoutLicenseResponse{Valid: false, Reason: pb.Reason_REASON_LICENSE_NOT_FOUND, Message: "License not found"}, nil
+	}
+	seats := int(l.FloatingSeats)
+	if seats == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "license is not floating; validate it instead")
+	}
+
+	// A machine holds one lease, so checking out again renews it
+	if err := s.leases.Release(ctx, tx, req.LicenseKey, device); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	active, firstExpiry, err := s.leases.Active(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if active >= seats {
+		resp := &pb.CheckoutLicenseResponse{Valid: false, Reason: pb.Reason_REASON_NO_FLOATING_SEATS, Message: "All floating seats are in use"}
+		if !firstExpiry.IsZero() {
+			resp.RetryAfterSeconds = int64(time.Until(firstExpiry).Seconds()) + 1
+		}
+		return resp, nil
+	}
+
+	if err := s.leases.Checkout(ctx, tx, s.hashSecret(token), req.LicenseKey, device, clientIP(ctx), time.Now().Add(s.leaseTTL)); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+
+	log.Printf("Device %s checked out license %s (%d/%d seats)", device, req.LicenseKey, active+1, seats)
+	return &pb.CheckoutLicenseResponse{
+		Valid:                 true,
+		Reason:                valid.Reason,
+		Message:               valid.Message,
+		ExpiresInSeconds:      valid.ExpiresInSeconds,
+		LeaseToken:            token,
+		LeaseExpiresInSeconds: int64(s.leaseTTL / time.Second),
+	}, nil
+}
+
+// 81. CheckinLicense
+func (s *WhitelistService) CheckinLicense(ctx context.Context, req *pb.CheckinLicenseRequest) (*emptypb.Empty, error) {
+	// Checking in an unknown or expired lease is not an error
+	if err := s.leases.Checkin(ctx, s.db, s.hashSecret(req.LeaseToken)); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/mkseven15/whitelist-server/internal/cache"
+	"github.com/mkseven15/whitelist-server/internal/hwid"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// Sessions count against a license's max_sessions until EndSession or until
+// sessionTTL passes without a Heartbeat. Unlike device binding this limits
+// simultaneous use, so it also works for licenses shared across machines.
+
+// 25. StartSession
+func (s *WhitelistService) StartSession(ctx context.Context, req *pb.StartSessionRequest) (*pb.StartSessionResponse, error) {
+	resp, err := s.startSession(ctx, req)
+	if resp != nil {
+		// Answers for the session, not the validation inside it
+		resp.ChallengeResponse = answerChallenge(req.LicenseKey, req.Challenge, resp.Valid)
+		resp.Message = s.localMessage(req.ProductId, req.Locale, resp.Reason, resp.Message)
+	}
+	return resp, err
+}
+
+func (s *WhitelistService) startSession(ctx context.Context, req *pb.StartSessionRequest) (*pb.StartSessionResponse, error) {
+	// Same checks (and access token, signature and challenge) as a plain validation
+	valid, err := s.ValidateLicense(ctx, &pb.ValidateRequest{
+		LicenseKey: req.LicenseKey, ProductId: req.ProductId, Hwid: req.Hwid, ClientVersion: req.ClientVersion,
+		Challenge: req.Challenge, ChallengeResponse: req.ChallengeResponse, Locale: req.Locale,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !valid.Valid {
+		return &pb.StartSessionResponse{Valid: false, Reason: valid.Reason, Message: valid.Message, RequiredVersion: valid.RequiredVersion, SuspendReason: valid.SuspendReason}, nil
+	}
+
+	token, err := newAccessToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate session token: %v", err)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	// Lock the license so concurrent starts can't overshoot the limit
+	l, err := s.licenses.Lock(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if l == nil {
+		return &pb.StartSessionResponse{Valid: false, Reason: pb.Reason_REASON_LICENSE_NOT_FOUND, Message: "License not found"}, nil
+	}
+	if l.MaxSessions > 0 {
+		// Counted after the lock, not from l, which may predate it
+		active, err := s.sessions.Count(ctx, tx, req.LicenseKey)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "db error: %v", err)
+		}
+		if active >= int(l.MaxSessions) {
+			return &pb.StartSessionResponse{Valid: false, Reason: pb.Reason_REASON_TOO_MANY_SESSIONS, Message: "Too many active sessions"}, nil
+		}
+	}
+
+	err = s.sessions.Start(ctx, tx, s.hashSecret(token), req.LicenseKey, hwid.Hash(req.Hwid), clientIP(ctx), time.Now().Add(s.sessionTTL))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+
+	return &pb.StartSessionResponse{
+		Valid:                    true,
+		Reason:                   valid.Reason,
+		Message:                  valid.Message,
+		ExpiresInSeconds:         valid.ExpiresInSeconds,
+		SessionToken:             token,
+		HeartbeatIntervalSeconds: s.heartbeatInterval(),
+	}, nil
+}
+
+// 26. Heartbeat
+func (s *WhitelistService) Heartbeat(ctx context.Context, req *pb.HeartbeatRequest) (*pb.HeartbeatResponse, error) {
+	tokenHash := s.hashSecret(req.SessionToken)
+
+	licenseKey, err := s.sessions.Beat(ctx, s.db, tokenHash, time.Now().Add(s.sessionTTL))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if licenseKey == "" {
+		return &pb.HeartbeatResponse{Valid: false, Reason: pb.Reason_REASON_SESSION_EXPIRED, Message: "Session expired"}, nil
+	}
+
+	// Suspending or expiring the license ends its sessions at their next beat
+	license, err := s.licenseState(ctx, licenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if reason, msg := sessionLicenseProblem(license); msg != "" {
+		if err := s.sessions.End(ctx, s.db, tokenHash); err != nil {
+			return nil, status.Errorf(codes.Internal, "db error: %v", err)
+		}
+		resp := &pb.HeartbeatResponse{Valid: false, Reason: reason, Message: msg}
+		if license != nil && !license.ProductDisabled && !license.IsActive {
+			resp.SuspendReason = license.SuspendReason
+		}
+		return resp, nil
+	}
+
+	return &pb.HeartbeatResponse{Valid: true, Reason: pb.Reason_REASON_OK, Message: "OK", HeartbeatIntervalSeconds: s.heartbeatInterval()}, nil
+}
+
+// 27. EndSession
+func (s *WhitelistService) EndSession(ctx context.Context, req *pb.EndSessionRequest) (*emptypb.Empty, error) {
+	// Ending an unknown or timed-out session is not an error
+	if err := s.sessions.End(ctx, s.db, s.hashSecret(req.SessionToken)); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// heartbeatInterval leaves room for a couple of lost heartbeats before the
+// session times out.
+func (s *WhitelistService) heartbeatInterval() int64 {
+	return int64(s.sessionTTL / 3 / time.Second)
+}
+
+// sessionLicenseProblem returns why a running session's license is no longer
+// usable, or "" if it still is.
+func sessionLicenseProblem(l *cache.License) (pb.Reason, string) {
+	switch {
+	case l == nil:
+		return pb.Reason_REASON_LICENSE_NOT_FOUND, "License not found"
+	case l.ProductDisabled:
+		return pb.Reason_REASON_PRODUCT_DISABLED, "Product is disabled"
+	case !l.IsActive:
+		return pb.Reason_REASON_SUSPENDED, "License is suspended"
+	case l.ExpiresAt != nil && !l.ExpiresAt.After(time.Now()):
+		return pb.Reason_REASON_EXPIRED, "License expired"
+	}
+	return pb.Reason_REASON_UNSPECIFIED, ""
+}
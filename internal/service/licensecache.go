@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/mkseven15/whitelist-server/internal/cache"
+)
+
+// licenseState returns what ValidateLicense needs to know about a license,
+// from the cache when one is configured. A missing license is (nil, nil).
+// Cache failures are logged and fall through to the database.
+func (s *WhitelistService) licenseState(ctx context.Context, key string) (*cache.License, error) {
+	if s.cache != nil {
+		l, err := s.cache.Get(ctx, key)
+		if err != nil {
+			log.Printf("license cache get: %v", err)
+		} else if l != nil {
+			return l, nil
+		}
+	}
+
+	// Concurrent misses for the same key share one query, so a burst of
+	// validations for a hot key doesn't stampede Postgres. The query outlives
+	// the caller's cancellation since other callers may be waiting on it.
+	v, err, _ := s.licenseLoad.Do(key, func() (interface{}, error) {
+		ctx := context.WithoutCancel(ctx)
+		l, err := s.licenses.State(ctx, key)
+		if err != nil || l == nil {
+			return l, err
+		}
+		if ttl := s.licenseCacheTTL(l.ProductID); s.cache != nil && ttl > 0 {
+			if err := s.cache.Set(ctx, key, l, ttl); err != nil {
+				log.Printf("license cache set: %v", err)
+			}
+		}
+		return l, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	l := v.(*cache.License)
+	if l == nil {
+		return nil, nil
+	}
+	// Callers get their own copy since the value is shared across the flight
+	cp := *l
+	return &cp, nil
+}
+
+// licenseCacheTTL is how long licenses of productID stay cached; 0 means
+// they aren't cached.
+func (s *WhitelistService) licenseCacheTTL(productID string) time.Duration {
+	if ttl, ok := s.productTTL[productID]; ok {
+		return ttl
+	}
+	return s.cacheTTL
+}
+
+// invalidateLicenses drops cached entries after their rows changed. Call it
+// after the transaction commits so a concurrent lookup can't re-cache old data.
+// It also wakes WatchLicense streams of those keys, after the cache is clean.
+func (s *WhitelistService) invalidateLicenses(ctx context.Context, keys ...string) {
+	if len(keys) == 0 {
+		return
+	}
+	if s.cache != nil {
+		if err := s.cache.Delete(ctx, keys...); err != nil {
+			log.Printf("license cache invalidate: %v", err)
+		}
+	}
+	s.watches.publish(keys...)
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"crypto/ed25519"
+	"log"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mkseven15/whitelist-server/internal/hwid"
+	"github.com/mkseven15/whitelist-server/internal/webhook"
+	"github.com/mkseven15/whitelist-server/licensefile"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+const auditLicenseExportFile = "license.export_file"
+
+// 24. ExportLicenseFile (Support)
+func (s *WhitelistService) ExportLicenseFile(ctx context.Context, req *pb.ExportLicenseFileRequest) (*pb.ExportLicenseFileResponse, error) {
+	if err := s.requireRole(ctx, roleSupport); err != nil {
+		return nil, err
+	}
+	if s.licenseSigningKey == nil {
+		return nil, status.Error(codes.FailedPrecondition, "license files are not configured")
+	}
+
+	validFor := s.licenseFileTTL
+	if req.ValidForSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "valid_for_seconds must not be negative")
+	} else if req.ValidForSeconds > 0 {
+		validFor = time.Duration(req.ValidForSeconds) * time.Second
+		if validFor > s.licenseFileMaxTTL {
+			return nil, status.Errorf(codes.InvalidArgument, "valid_for_seconds may be at most %d", int64(s.licenseFileMaxTTL.Seconds()))
+		}
+	}
+
+	l, err := s.licenses.Get(ctx, s.db, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if l == nil {
+		return nil, status.Error(codes.NotFound, "license not found")
+	}
+	if !l.IsActive {
+		return nil, status.Error(codes.FailedPrecondition, "license is suspended")
+	}
+	now := time.Now()
+	if l.ExpiresAt != nil && !l.ExpiresAt.AsTime().After(now) {
+		return nil, status.Error(codes.FailedPrecondition, "license expired")
+	}
+
+	// A file would outlive any lease, and go on working past the pool's size
+	if l.FloatingSeats > 0 {
+		return nil, status.Error(codes.FailedPrecondition, "floating licenses can't be exported")
+	}
+
+	// The file takes a device seat like an online validation would
+	device := hwid.Hash(req.Hwid)
+	if device != "" && !slices.Contains(l.Hwids, device) {
+		bound, _, err := s.bindDevice(ctx, req.LicenseKey, device, deviceParts{}, int(l.MaxDevices))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "db error: %v", err)
+		}
+		if bound == deviceRejected {
+			return nil, status.Error(codes.FailedPrecondition, "device limit reached")
+		}
+		s.notify(deviceEvent(webhook.HwidBound, &pb.ValidateRequest{LicenseKey: l.LicenseKey, ProductId: l.ProductId, Hwid: device}, int(l.MaxDevices)))
+	}
+
+	file := &licensefile.License{
+		Key:        l.LicenseKey,
+		ProductID:  l.ProductId,
+		HWID:       device,
+		IssuedAt:   now.UTC().Truncate(time.Second),
+		ValidUntil: now.Add(validFor).UTC().Truncate(time.Second),
+	}
+	if l.ExpiresAt != nil {
+		expiresAt := l.ExpiresAt.AsTime()
+		file.ExpiresAt = &expiresAt
+	}
+	signed, err := licensefile.Sign(s.licenseSigningKey, file)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "sign failed: %v", err)
+	}
+
+	actor := adminActor(ctx)
+	audited := proto.Clone(req).(*pb.ExportLicenseFileRequest)
+	audited.Hwid = device
+	if err := s.recordAudit(ctx, s.db, actor, auditLicenseExportFile, req.LicenseKey, nil, audited); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	log.Printf("License file for %s (hwid=%q, valid until %s) exported by %s", req.LicenseKey, device, file.ValidUntil.Format(time.RFC3339), actor)
+
+	pub := s.licenseSigningKey.Public().(ed25519.PublicKey)
+	return &pb.ExportLicenseFileResponse{
+		LicenseFile: signed,
+		ValidUntil:  timestamppb.New(file.ValidUntil),
+		PublicKey:   licensefile.EncodePublicKey(pub),
+		KeyId:       licensefile.KeyID(pub),
+	}, nil
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mkseven15/whitelist-server/internal/store"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+const auditLockoutClear = "lockout.clear"
+
+// Answer to locked out keys and IPs
+const lockedOutMessage = "Too many failed attempts"
+
+// 55. ClearLockouts (Admin)
+func (s *WhitelistService) ClearLockouts(ctx context.Context, req *pb.ClearLockoutsRequest) (*pb.ClearLockoutsResponse, error) {
+	if err := s.requireWrite(ctx, roleSupport); err != nil {
+		return nil, err
+	}
+	// Either one will do, which no field rule can express
+	if req.LicenseKey == "" && req.Ip == "" {
+		return nil, status.Error(codes.InvalidArgument, "license_key or ip is required")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	cleared, err := s.lockouts.Clear(ctx, tx, req.LicenseKey, req.Ip)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	resp := &pb.ClearLockoutsResponse{Cleared: cleared}
+	for _, l := range resp.Cleared {
+		if err := s.recordAudit(ctx, tx, adminActor(ctx), auditLockoutClear, l.Subject, l, nil); err != nil {
+			return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	return resp, nil
+}
+
+// lockedOut returns how long the license key or client IP stays locked out,
+// 0 if neither is.
+func (s *WhitelistService) lockedOut(ctx context.Context, key, ip string) (time.Duration, error) {
+	if s.lockout.Failures <= 0 {
+		return 0, nil
+	}
+	until, err := s.lockouts.LockedUntil(ctx, s.db, key, ip)
+	if err != nil || until.IsZero() {
+		return 0, err
+	}
+	return time.Until(until), nil
+}
+
+// trackLockout feeds a validation outcome into the lockout counters. A
+// success resets both the key's and the IP's; a failure bumps them and locks
+// whichever reaches the threshold. Keys that don't exist only count against
+// the IP, and answers a legitimate user can get (outdated client, region,
+// rebind cooldown, lapsed floating lease) aren't counted at all.
+func (s *WhitelistService) trackLockout(ctx context.Context, req *pb.ValidateRequest, resp *pb.ValidateResponse, err error) {
+	if s.lockout.Failures <= 0 || err != nil || resp == nil {
+		return
+	}
+	switch resp.Reason {
+	case pb.Reason_REASON_LOCKED_OUT, pb.Reason_REASON_CLIENT_OUTDATED, pb.Reason_REASON_REGION_NOT_ALLOWED, pb.Reason_REASON_REBIND_COOLDOWN,
+		pb.Reason_REASON_NOT_CHECKED_OUT:
+		return
+	}
+	ip := clientIP(ctx)
+	// The request may be cancelled already; the count should still land
+	ctx = context.WithoutCancel(ctx)
+
+	if resp.Valid {
+		if err := s.lockouts.Reset(ctx, s.db, req.LicenseKey, ip); err != nil {
+			log.Printf("Error resetting lockout counters: %v", err)
+		}
+		return
+	}
+
+	if ip != "" {
+		s.countFailure(ctx, store.LockoutIP, ip)
+	}
+	if req.LicenseKey != "" && resp.Reason != pb.Reason_REASON_LICENSE_NOT_FOUND && resp.Reason != pb.Reason_REASON_UNKNOWN_PRODUCT {
+		s.countFailure(ctx, store.LockoutKey, req.LicenseKey)
+	}
+}
+
+// countFailure adds a failure to one counter and locks its subject out once
+// it reaches the threshold, starting the count over.
+func (s *WhitelistService) countFailure(ctx context.Context, scope, subject string) {
+	failures, err := s.lockouts.Fail(ctx, s.db, scope, subject)
+	if err != nil {
+		log.Printf("Error counting failed validation for %s %s: %v", scope, subject, err)
+		return
+	}
+	if failures < s.lockout.Failures {
+		return
+	}
+	if err := s.lockouts.Lock(ctx, s.db, scope, subject, time.Now().Add(s.lockout.Duration)); err != nil {
+		log.Printf("Error locking out %s %s: %v", scope, subject, err)
+		return
+	}
+	log.Printf("Locked out %s %s for %s after %d failed validations", scope, subject, s.lockout.Duration, failures)
+}
+
+// deleteStaleLockouts drops ended lockouts and counters that haven't moved
+// for a lockout duration, so failures spread far apart never add up.
+func (s *WhitelistService) deleteStaleLockouts(ctx context.Context, now time.Time) (int64, error) {
+	return s.lockouts.DeleteStale(ctx, s.db, now, now.Add(-s.lockout.Duration))
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/mkseven15/whitelist-server/internal/errinfo"
+	"github.com/mkseven15/whitelist-server/internal/store"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+const auditMaintenanceSet = "maintenance.set"
+
+// Returned with refused changes when maintenance mode was turned on without one
+const defaultMaintenanceMessage = "the server is in maintenance mode, try again later"
+
+// 73. SetMaintenanceMode (Owner)
+func (s *WhitelistService) SetMaintenanceMode(ctx context.Context, req *pb.SetMaintenanceModeRequest) (*pb.MaintenanceMode, error) {
+	if err := s.requireRole(ctx, roleOwner); err != nil {
+		return nil, err
+	}
+	if !req.Enabled && s.maintenanceMessage != "" {
+		return nil, status.Error(codes.FailedPrecondition, "maintenance mode is on from MAINTENANCE_MESSAGE; unset it and restart")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	old, err := s.maintenanceMode(ctx, tx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if req.Enabled {
+		// Turning it on again only changes the message
+		err = s.maintenance.Start(ctx, tx, req.Message, adminActor(ctx))
+	} else {
+		err = s.maintenance.End(ctx, tx)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	mode, err := s.maintenanceMode(ctx, tx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	if err := s.recordAudit(ctx, tx, adminActor(ctx), auditMaintenanceSet, "maintenance", old, mode); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	return mode, nil
+}
+
+// 74. GetMaintenanceMode (Read-only)
+func (s *WhitelistService) GetMaintenanceMode(ctx context.Context, _ *emptypb.Empty) (*pb.MaintenanceMode, error) {
+	if err := s.requireRole(ctx, roleReadOnly); err != nil {
+		return nil, err
+	}
+	mode, err := s.maintenanceMode(ctx, s.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	return mode, nil
+}
+
+// maintenanceMode reads the current mode. It's stored in the database, not
+// kept in memory, so every replica sees a change at once.
+func (s *WhitelistService) maintenanceMode(ctx context.Context, q store.Querier) (*pb.MaintenanceMode, error) {
+	if s.maintenanceMessage != "" {
+		return &pb.MaintenanceMode{Enabled: true, Message: s.maintenanceMessage, FromConfig: true}, nil
+	}
+	return s.maintenance.Get(ctx, q)
+}
+
+// checkMaintenance refuses changes with Unavailable while maintenance mode
+// is on. Validations and everything else that only reads keep working.
+func (s *WhitelistService) checkMaintenance(ctx context.Context) error {
+	mode, err := s.maintenanceMode(ctx, s.db)
+	if err != nil {
+		return status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if !mode.Enabled {
+		return nil
+	}
+	if mode.Message == "" {
+		return errinfo.Error(codes.Unavailable, pb.Reason_REASON_MAINTENANCE, defaultMaintenanceMessage)
+	}
+	return errinfo.Error(codes.Unavailable, pb.Reason_REASON_MAINTENANCE, mode.Message)
+}
+
+// requireWrite is requireRole for RPCs that change data, which are also
+// refused in maintenance mode. The caller is checked first so that anonymous
+// ones don't learn about the maintenance.
+func (s *WhitelistService) requireWrite(ctx context.Context, need adminRole) error {
+	if err := s.requireRole(ctx, need); err != nil {
+		return err
+	}
+	return s.checkMaintenance(ctx)
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mkseven15/whitelist-server/internal/i18n"
+	"github.com/mkseven15/whitelist-server/internal/store"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+const auditProductMessages = "product.messages"
+
+// 75. SetProductMessages (Owner)
+func (s *WhitelistService) SetProductMessages(ctx context.Context, req *pb.SetProductMessagesRequest) (*pb.ProductMessages, error) {
+	if err := s.requireWrite(ctx, roleOwner); err != nil {
+		return nil, err
+	}
+
+	type key struct {
+		locale string
+		reason pb.Reason
+	}
+	seen := make(map[key]bool, len(req.Messages))
+	for i, m := range req.Messages {
+		if !i18n.Builtin().Has(m.Reason) {
+			return nil, status.Errorf(codes.InvalidArgument, "messages[%d]: %s has no message to override", i, m.Reason)
+		}
+		k := key{strings.ToLower(m.Locale), m.Reason}
+		if seen[k] {
+			return nil, status.Errorf(codes.InvalidArgument, "messages[%d]: %s is already set for %s", i, m.Reason, m.Locale)
+		}
+		seen[k] = true
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	if err := s.requireProducts(ctx, tx, req.ProductId); err != nil {
+		return nil, err
+	}
+	old, err := s.storedMessages(ctx, tx, req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.messages.Set(ctx, tx, req.ProductId, req.Messages); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	updated, err := s.storedMessages(ctx, tx, req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.recordAudit(ctx, tx, adminActor(ctx), auditProductMessages, req.ProductId, old, updated); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+
+	s.reloadProductMessages(ctx)
+	return updated, nil
+}
+
+// 76. GetProductMessages (Read-only)
+func (s *WhitelistService) GetProductMessages(ctx context.Context, req *pb.GetProductMessagesRequest) (*pb.ProductMessages, error) {
+	if err := s.requireRole(ctx, roleReadOnly); err != nil {
+		return nil, err
+	}
+
+	if err := s.requireProducts(ctx, s.db, req.ProductId); err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, status.Error(codes.NotFound, "product not found")
+		}
+		return nil, err
+	}
+	messages, err := s.storedMessages(ctx, s.db, req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	return messages, nil
+}
+
+func (s *WhitelistService) storedMessages(ctx context.Context, q store.Querier, productID string) (*pb.ProductMessages, error) {
+	messages, err := s.messages.Get(ctx, q, productID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ProductMessages{ProductId: productID, Messages: messages}, nil
+}
+
+// reloadProductMessages replaces the in-memory product messages with those
+// in the database. On failure the previous ones stay.
+func (s *WhitelistService) reloadProductMessages(ctx context.Context) {
+	stored, err := s.messages.All(ctx, s.db)
+	if err != nil {
+		log.Printf("Error loading product messages: %v", err)
+		return
+	}
+	byProduct := make(map[string]i18n.Catalog, len(stored))
+	for productID, messages := range stored {
+		c := i18n.Catalog{}
+		for _, m := range messages {
+			if c[m.Locale] == nil {
+				c[m.Locale] = map[pb.Reason]string{}
+			}
+			c[m.Locale][m.Reason] = m.Message
+		}
+		byProduct[productID] = c
+	}
+	s.productMessages.Store(&byProduct)
+}
+
+// localMessage returns the text for reason in locale: the product's own
+// wording if it has one, else the built-in translation, falling back to
+// English and finally to message.
+func (s *WhitelistService) localMessage(productID, locale string, reason pb.Reason, message string) string {
+	if !i18n.Builtin().Has(reason) {
+		return message
+	}
+	var product i18n.Catalog
+	if byProduct := s.productMessages.Load(); byProduct != nil {
+		product = (*byProduct)[productID]
+	}
+	for _, l := range []string{locale, i18n.DefaultLocale} {
+		for _, c := range []i18n.Catalog{product, i18n.Builtin()} {
+			if text, ok := c.Message(l, reason); ok {
+				return text
+			}
+		}
+	}
+	return message
+}
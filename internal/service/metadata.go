@@ -0,0 +1,79 @@
+package service
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// maxMetadataSize caps a license's metadata, encoded.
+const maxMetadataSize = 16 << 10
+
+// checkLicenseUpdate validates the parts of an UpdateLicenseRequest that
+// don't need the stored license.
+func checkLicenseUpdate(req *pb.UpdateLicenseRequest) error {
+	if req.Metadata != nil && proto.Size(req.Metadata) > maxMetadataSize {
+		return fmt.Errorf("metadata is larger than %d bytes", maxMetadataSize)
+	}
+	for _, path := range req.UpdateMask.GetPaths() {
+		if !licenseUpdatePaths[path] {
+			return fmt.Errorf("unknown update_mask path %q", path)
+		}
+	}
+	return nil
+}
+
+// licenseUpdatePaths are the fields update_mask may name.
+var licenseUpdatePaths = map[string]bool{
+	"product_id":   true,
+	"is_active":    true,
+	"expires_at":   true,
+	"max_devices":  true,
+	"max_sessions": true,
+	"metadata":     true,
+	"plan":         true,
+}
+
+// applyUpdateMask returns the full settings for a masked update of cur (nil
+// if the license doesn't exist yet): the fields named in req.UpdateMask come
+// from req, the rest from cur.
+func applyUpdateMask(cur *pb.License, req *pb.UpdateLicenseRequest) (*pb.UpdateLicenseRequest, error) {
+	out := &pb.UpdateLicenseRequest{LicenseKey: req.LicenseKey}
+	if cur != nil {
+		out.ProductId, out.IsActive, out.ExpiresAt = cur.ProductId, cur.IsActive, cur.ExpiresAt
+		out.MaxDevices, out.MaxSessions = cur.MaxDevices, cur.MaxSessions
+		out.Plan = &cur.Plan
+	}
+	for _, path := range req.UpdateMask.GetPaths() {
+		switch path {
+		case "product_id":
+			out.ProductId = req.ProductId
+		case "is_active":
+			out.IsActive = req.IsActive
+		case "expires_at":
+			out.ExpiresAt = req.ExpiresAt
+		case "max_devices":
+			out.MaxDevices = req.MaxDevices
+		case "max_sessions":
+			out.MaxSessions = req.MaxSessions
+		case "metadata":
+			// Naming metadata with no value clears it
+			out.Metadata = req.Metadata
+			if out.Metadata == nil {
+				out.Metadata = &structpb.Struct{}
+			}
+		case "plan":
+			// As does naming plan with no value
+			out.Plan = proto.String(req.GetPlan())
+		}
+	}
+	if cur == nil && out.ProductId == "" {
+		return nil, fmt.Errorf("license %s not found and update_mask doesn't set product_id", req.LicenseKey)
+	} else if out.ProductId == "" {
+		return nil, fmt.Errorf("product_id must not be empty")
+	}
+	return out, nil
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mkseven15/whitelist-server/internal/errinfo"
+	"github.com/mkseven15/whitelist-server/internal/hwid"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+const auditLicenseDeactivateDevice = "license.deactivate_device"
+
+// Audit actor for devices unbound by DeactivateDevice
+const customerActor = "customer"
+
+// 77. ListMyDevices
+func (s *WhitelistService) ListMyDevices(ctx context.Context, req *pb.ListMyDevicesRequest) (*pb.ListMyDevicesResponse, error) {
+	license, err := s.myLicense(ctx, req.LicenseKey, req.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	devices, err := s.devices.List(ctx, s.db, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	current := hwid.Hash(req.Hwid)
+	resp := &pb.ListMyDevicesResponse{MaxDevices: license.MaxDevices}
+	for _, d := range devices {
+		resp.Devices = append(resp.Devices, &pb.MyDevice{
+			DeviceId: d.HWID, BoundAt: timestamppb.New(d.BoundAt), Current: current != "" && d.HWID == current,
+		})
+	}
+	if next := s.nextRebind(license); next.After(time.Now()) {
+		resp.NextDeactivationAt = timestamppb.New(next)
+	}
+	return resp, nil
+}
+
+// 78. DeactivateDevice
+func (s *WhitelistService) DeactivateDevice(ctx context.Context, req *pb.DeactivateDeviceRequest) (*emptypb.Empty, error) {
+	if err := s.checkMaintenance(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := s.myLicense(ctx, req.LicenseKey, req.ProductId); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	// Locked as in bindDevice, so the cooldown can't be raced
+	old, err := s.licenses.Lock(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if old == nil || old.DeletedAt != nil {
+		return nil, errinfo.Error(codes.NotFound, pb.Reason_REASON_LICENSE_NOT_FOUND, "license not found")
+	}
+	now := time.Now()
+	if wait := s.nextRebind(old).Sub(now); wait > 0 {
+		return nil, errinfo.Errorf(codes.FailedPrecondition, pb.Reason_REASON_REBIND_COOLDOWN, "a device of this license changed too recently, retry in %ds", int64(wait.Seconds())+1)
+	}
+
+	device := hwid.Hash(req.DeviceId)
+	removed, err := s.devices.Remove(ctx, tx, req.LicenseKey, device)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if !removed {
+		return nil, status.Error(codes.NotFound, "device not bound to this license")
+	}
+	if err := s.devices.Rebound(ctx, tx, req.LicenseKey, now); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	updated, err := s.licenses.Get(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.recordLicenseChange(ctx, tx, customerActor, auditLicenseDeactivateDevice, req.LicenseKey, old, updated); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+
+	log.Printf("Device %s of license %s deactivated by its owner from %s", device, req.LicenseKey, clientIP(ctx))
+	return &emptypb.Empty{}, nil
+}
+
+// myLicense uses up the access token of a device management call and returns
+// the license it's about. Holding the key is what makes the caller its
+// owner, so unknown keys count towards lockouts as failed validations do.
+func (s *WhitelistService) myLicense(ctx context.Context, licenseKey, productID string) (*pb.License, error) {
+	if err := s.burnAccessToken(ctx, productID); err != nil {
+		return nil, err
+	}
+	retryAfter, err := s.lockedOut(ctx, licenseKey, clientIP(ctx))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if retryAfter > 0 {
+		return nil, errinfo.Errorf(codes.ResourceExhausted, pb.Reason_REASON_LOCKED_OUT, "%s, retry in %ds", lockedOutMessage, int64(retryAfter.Seconds())+1)
+	}
+
+	license, err := s.licenses.Get(ctx, s.db, licenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if license == nil || license.ProductId != productID {
+		s.trackLockout(ctx, &pb.ValidateRequest{LicenseKey: licenseKey, ProductId: productID},
+			&pb.ValidateResponse{Reason: pb.Reason_REASON_LICENSE_NOT_FOUND}, nil)
+		return nil, errinfo.Error(codes.NotFound, pb.Reason_REASON_LICENSE_NOT_FOUND, "license not found")
+	}
+	return license, nil
+}
+
+// nextRebind is when the license's devices may next change, by HWID or
+// DeactivateDevice; the zero time if they never have.
+func (s *WhitelistService) nextRebind(l *pb.License) time.Time {
+	if l.HwidReboundAt == nil {
+		return time.Time{}
+	}
+	return l.HwidReboundAt.AsTime().Add(s.hwidRebindCooldown)
+}
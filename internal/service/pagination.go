@@ -0,0 +1,102 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mkseven15/whitelist-server/internal/store"
+)
+
+// listPage is a list request's page_size, page_token and order_by, checked
+// against the list's order.
+type listPage struct {
+	store.Page
+	orderBy string
+}
+
+// pageCursor is what a page token holds: the order it was issued for and
+// the last row's sort value and tie column.
+type pageCursor struct {
+	OrderBy string `json:"o"`
+	Value   string `json:"v,omitempty"`
+	Tie     string `json:"t"`
+}
+
+// newPage checks a list request's paging fields against o.
+func newPage(o *store.Order, pageSize int32, pageToken, orderBy string) (*listPage, error) {
+	tie, tieDesc := o.Tie()
+	p := &listPage{Page: store.Page{Order: o, Size: int(pageSize), Field: tie, Desc: tieDesc}}
+	if p.Size <= 0 {
+		p.Size = defaultPageSize
+	} else if p.Size > maxPageSize {
+		p.Size = maxPageSize
+	}
+
+	var isTime bool
+	if orderBy != "" {
+		parts := strings.Fields(strings.ToLower(orderBy))
+		if len(parts) == 0 || len(parts) > 2 || (len(parts) == 2 && parts[1] != "asc" && parts[1] != "desc") {
+			return nil, status.Error(codes.InvalidArgument, `order_by must be a field name, optionally followed by "desc"`)
+		}
+		var ok bool
+		if isTime, ok = o.Field(parts[0]); !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "can't order by %q", parts[0])
+		}
+		p.Field = parts[0]
+		p.Desc = len(parts) == 2 && parts[1] == "desc"
+	}
+	p.orderBy = p.Field
+	if p.Desc {
+		p.orderBy += " desc"
+	}
+
+	if pageToken != "" {
+		raw, err := decodePageToken(pageToken)
+		var c pageCursor
+		if err != nil || json.Unmarshal([]byte(raw), &c) != nil || c.Tie == "" {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		if c.OrderBy != p.orderBy {
+			return nil, status.Error(codes.InvalidArgument, "page_token is for a different order_by")
+		}
+		if isTime && c.Value != "infinity" && c.Value != "-infinity" {
+			if _, err := time.Parse(time.RFC3339Nano, c.Value); err != nil {
+				return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+			}
+		}
+		p.After = &store.Cursor{Value: c.Value, Tie: c.Tie}
+	}
+	return p, nil
+}
+
+// next returns the token for the page after a row with the given sort
+// value and tie column.
+func (p *listPage) next(value, tie string) string {
+	b, _ := json.Marshal(pageCursor{OrderBy: p.orderBy, Value: value, Tie: tie})
+	return encodePageToken(string(b))
+}
+
+// timeCursor formats ts as a sort value, null standing in for a NULL the
+// same way the order's SQL does.
+func timeCursor(ts *timestamppb.Timestamp, null string) string {
+	if ts == nil {
+		return null
+	}
+	return ts.AsTime().UTC().Format(time.RFC3339Nano)
+}
+
+// Page tokens are opaque to clients: base64 of a pageCursor.
+func encodePageToken(cursor string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(cursor))
+}
+
+func decodePageToken(token string) (string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	return string(b), err
+}
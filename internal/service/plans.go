@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mkseven15/whitelist-server/internal/store"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// A plan names a bundle of license settings ("pro": 3 devices, a year, the
+// "pro" feature) so they needn't be repeated key by key. A license joining a
+// plan copies its max_devices and duration, which stay the license's own
+// after that; the plan's features apply live, between the product's and the
+// license's.
+
+// Audit actions for plans
+const (
+	auditPlanCreate = "plan.create"
+	auditPlanUpdate = "plan.update"
+	auditPlanDelete = "plan.delete"
+)
+
+// 86. CreatePlan (Owner)
+func (s *WhitelistService) CreatePlan(ctx context.Context, req *pb.CreatePlanRequest) (*pb.Plan, error) {
+	if err := s.requireWrite(ctx, roleOwner); err != nil {
+		return nil, err
+	}
+
+	maxDevices := req.MaxDevices
+	if maxDevices == 0 {
+		maxDevices = 1
+	}
+	if maxDevices < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max_devices must not be negative")
+	}
+	if req.DurationSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "duration_seconds must not be negative")
+	}
+	if _, err := featuresJSON(req.Features); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "features: %v", err)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	created, err := s.plans.Create(ctx, tx, &pb.Plan{
+		Name:            req.Name,
+		Description:     req.Description,
+		MaxDevices:      maxDevices,
+		DurationSeconds: req.DurationSeconds,
+		Features:        req.Features,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if !created {
+		return nil, status.Errorf(codes.AlreadyExists, "plan %q already exists", req.Name)
+	}
+
+	p, err := s.plans.Get(ctx, tx, req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.recordAudit(ctx, tx, adminActor(ctx), auditPlanCreate, req.Name, nil, p); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	return p, nil
+}
+
+// 87. UpdatePlan (Owner)
+func (s *WhitelistService) UpdatePlan(ctx context.Context, req *pb.UpdatePlanRequest) (*pb.Plan, error) {
+	if err := s.requireWrite(ctx, roleOwner); err != nil {
+		return nil, err
+	}
+
+	if req.MaxDevices != nil && req.GetMaxDevices() < 1 {
+		return nil, status.Error(codes.InvalidArgument, "max_devices must be at least 1")
+	}
+	if req.GetDurationSeconds() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "duration_seconds must not be negative")
+	}
+	features, err := featuresParam(req.Features)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "features: %v", err)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	old, err := s.plans.Get(ctx, tx, req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if old == nil {
+		return nil, status.Error(codes.NotFound, "plan not found")
+	}
+
+	// Licenses already on the plan keep the max_devices and expiry they
+	// joined with
+	err = s.plans.Change(ctx, tx, req.Name, store.PlanChange{
+		Description:     req.Description,
+		MaxDevices:      req.MaxDevices,
+		DurationSeconds: req.DurationSeconds,
+		Features:        features,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	updated, err := s.plans.Get(ctx, tx, req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.recordAudit(ctx, tx, adminActor(ctx), auditPlanUpdate, req.Name, old, updated); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+
+	// Cached licenses of the plan carry its features
+	var keys []string
+	if !maps.Equal(old.Features, updated.Features) {
+		if keys, err = s.licenseLists.Keys(ctx, tx, store.LicenseFilter{Plan: req.Name}); err != nil {
+			return nil, status.Errorf(codes.Internal, "db error: %v", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	s.invalidateLicenses(ctx, keys...)
+	return updated, nil
+}
+
+// 88. ListPlans (Admin)
+func (s *WhitelistService) ListPlans(ctx context.Context, req *pb.ListPlansRequest) (*pb.ListPlansResponse, error) {
+	if err := s.requireRole(ctx, roleReadOnly); err != nil {
+		return nil, err
+	}
+
+	plans, err := s.plans.List(ctx, s.db)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	return &pb.ListPlansResponse{Plans: plans}, nil
+}
+
+// 89. DeletePlan (Owner)
+func (s *WhitelistService) DeletePlan(ctx context.Context, req *pb.DeletePlanRequest) (*emptypb.Empty, error) {
+	if err := s.requireWrite(ctx, roleOwner); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	old, err := s.plans.Get(ctx, tx, req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if old == nil {
+		return nil, status.Error(codes.NotFound, "plan not found")
+	}
+	// Licenses would silently lose its features; move them first. Deleted
+	// licenses just drop off the plan.
+	if old.LicenseCount > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "plan still has %d licenses", old.LicenseCount)
+	}
+
+	if err := s.plans.Delete(ctx, tx, req.Name); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.recordAudit(ctx, tx, adminActor(ctx), auditPlanDelete, req.Name, old, nil); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// requirePlans fails with InvalidArgument unless every non-empty name is a
+// plan.
+func (s *WhitelistService) requirePlans(ctx context.Context, q store.Querier, names ...string) error {
+	names = slices.DeleteFunc(slices.Clone(names), func(n string) bool { return n == "" })
+	missing, err := s.plans.Unknown(ctx, q, names)
+	if err != nil {
+		return dbError("db error", err)
+	}
+	if len(missing) > 0 {
+		return status.Errorf(codes.InvalidArgument, "unknown plan %q, create it first", missing[0])
+	}
+	return nil
+}
+
+// applyPlan fills in what req leaves to the plan the license ends up on:
+// max_devices, and the expiry of a license joining the plan. full is req
+// with its update_mask applied and cur (nil for a new license) the stored
+// license; full is changed in place. Callers check the plan exists first
+// (requirePlans).
+func (s *WhitelistService) applyPlan(ctx context.Context, q store.Querier, cur *pb.License, req, full *pb.UpdateLicenseRequest) error {
+	plan := full.GetPlan()
+	if full.Plan == nil && cur != nil {
+		plan = cur.Plan
+	}
+	if plan == "" {
+		return nil
+	}
+	p, err := s.plans.Get(ctx, q, plan)
+	if err != nil {
+		return err
+	}
+	if p == nil {
+		return fmt.Errorf("unknown plan %q", plan)
+	}
+	joining := cur == nil || cur.Plan != plan
+
+	// Without a mask, unset fields are zero; with one, they're unnamed
+	masked := req.UpdateMask != nil
+	named := func(path string) bool { return !masked || slices.Contains(req.UpdateMask.Paths, path) }
+	if full.MaxDevices <= 0 || (joining && !named("max_devices")) {
+		full.MaxDevices = p.MaxDevices
+	}
+	expiryUnset := !named("expires_at") || (!masked && full.ExpiresAt == nil)
+	switch {
+	case !expiryUnset:
+	case joining && p.DurationSeconds > 0:
+		full.ExpiresAt = timestamppb.New(time.Now().Add(time.Duration(p.DurationSeconds) * time.Second))
+	case !joining && !masked:
+		// Staying on the plan doesn't renew (or end) the license
+		full.ExpiresAt = cur.ExpiresAt
+	}
+	return nil
+}
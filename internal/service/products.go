@@ -0,0 +1,302 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/mkseven15/whitelist-server/internal/store"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// Audit actions for the product catalog
+const (
+	auditProductCreate = "product.create"
+	auditProductUpdate = "product.update"
+	auditProductDelete = "product.delete"
+)
+
+// 30. CreateProduct (Owner)
+func (s *WhitelistService) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.Product, error) {
+	if err := s.requireWrite(ctx, roleOwner); err != nil {
+		return nil, err
+	}
+
+	name := req.Name
+	if name == "" {
+		name = req.ProductId
+	}
+	if req.MinVersion != "" && !validVersion(req.MinVersion) {
+		return nil, status.Error(codes.InvalidArgument, "min_version must be a version number like 1.4.0")
+	}
+	if req.TrialDurationSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "trial_duration_seconds must not be negative")
+	}
+	allowed, err := normalizeCountries(req.AllowedCountries)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "allowed_countries: %v", err)
+	}
+	blocked, err := normalizeCountries(req.BlockedCountries)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "blocked_countries: %v", err)
+	}
+	format, err := parseKeyFormat(req.KeyFormat)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "key_format: %v", err)
+	}
+	if _, err := featuresJSON(req.Features); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "features: %v", err)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	created, err := s.products.Create(ctx, tx, &pb.Product{
+		ProductId:            req.ProductId,
+		Name:                 name,
+		Description:          req.Description,
+		MinVersion:           req.MinVersion,
+		TrialDurationSeconds: req.TrialDurationSeconds,
+		AllowedCountries:     allowed,
+		BlockedCountries:     blocked,
+		RequireChallenge:     req.RequireChallenge,
+		KeyFormat:            string(format),
+		Features:             req.Features,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if !created {
+		return nil, status.Errorf(codes.AlreadyExists, "product %q already exists", req.ProductId)
+	}
+
+	p, err := s.products.Get(ctx, tx, req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.recordAudit(ctx, tx, adminActor(ctx), auditProductCreate, req.ProductId, nil, p); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	if format != "" {
+		s.reloadKeyFormats(ctx)
+	}
+	return p, nil
+}
+
+// 31. UpdateProduct (Owner)
+func (s *WhitelistService) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.Product, error) {
+	if err := s.requireWrite(ctx, roleOwner); err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil && req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name must not be empty")
+	}
+	if req.GetMinVersion() != "" && !validVersion(req.GetMinVersion()) {
+		return nil, status.Error(codes.InvalidArgument, "min_version must be a version number like 1.4.0")
+	}
+	if req.GetTrialDurationSeconds() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "trial_duration_seconds must not be negative")
+	}
+	allowed, err := countryListParam(req.AllowedCountries)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "allowed_countries: %v", err)
+	}
+	blocked, err := countryListParam(req.BlockedCountries)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "blocked_countries: %v", err)
+	}
+	format, err := parseKeyFormat(req.GetKeyFormat())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "key_format: %v", err)
+	}
+	features, err := featuresParam(req.Features)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "features: %v", err)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	old, err := s.products.Get(ctx, tx, req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if old == nil {
+		return nil, status.Error(codes.NotFound, "product not found")
+	}
+	// Validation turns away keys that don't fit, so existing ones must
+	if req.KeyFormat != nil && string(format) != old.KeyFormat {
+		if err := s.checkProductKeys(ctx, tx, req.ProductId, format); err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.products.Change(ctx, tx, req.ProductId, store.ProductChange{
+		Name:                 req.Name,
+		Description:          req.Description,
+		Disabled:             req.Disabled,
+		MinVersion:           req.MinVersion,
+		TrialDurationSeconds: req.TrialDurationSeconds,
+		AllowedCountries:     allowed,
+		BlockedCountries:     blocked,
+		RequireChallenge:     req.RequireChallenge,
+		KeyFormat:            req.KeyFormat,
+		Features:             features,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	updated, err := s.products.Get(ctx, tx, req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.recordAudit(ctx, tx, adminActor(ctx), auditProductUpdate, req.ProductId, old, updated); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+
+	// Cached licenses of the product carry its disabled flag, min_version,
+	// country lists, require_challenge and features
+	var keys []string
+	if old.Disabled != updated.Disabled || old.MinVersion != updated.MinVersion || old.RequireChallenge != updated.RequireChallenge ||
+		!slices.Equal(old.AllowedCountries, updated.AllowedCountries) || !slices.Equal(old.BlockedCountries, updated.BlockedCountries) ||
+		!maps.Equal(old.Features, updated.Features) {
+		if keys, err = s.licenseLists.Keys(ctx, tx, store.LicenseFilter{ProductID: req.ProductId}); err != nil {
+			return nil, status.Errorf(codes.Internal, "db error: %v", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	s.invalidateLicenses(ctx, keys...)
+	if old.KeyFormat != updated.KeyFormat {
+		s.reloadKeyFormats(ctx)
+	}
+
+	if old.MinVersion != updated.MinVersion {
+		log.Printf("Product %s min_version %q -> %q by %s", req.ProductId, old.MinVersion, updated.MinVersion, adminActor(ctx))
+	}
+	if old.Disabled != updated.Disabled {
+		log.Printf("Product %s disabled=%t by %s (%d licenses)", req.ProductId, updated.Disabled, adminActor(ctx), len(keys))
+	}
+	return updated, nil
+}
+
+// 32. ListProducts (Admin)
+func (s *WhitelistService) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
+	if err := s.requireRole(ctx, roleReadOnly); err != nil {
+		return nil, err
+	}
+
+	products, err := s.products.List(ctx, s.db, req.IncludeDisabled)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	return &pb.ListProductsResponse{Products: products}, nil
+}
+
+// 33. DeleteProduct (Owner)
+func (s *WhitelistService) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*emptypb.Empty, error) {
+	if err := s.requireWrite(ctx, roleOwner); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	old, err := s.products.Get(ctx, tx, req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if old == nil {
+		return nil, status.Error(codes.NotFound, "product not found")
+	}
+	// Licenses reference the product; they have to go (or move) first
+	if old.LicenseCount > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "product still has %d licenses", old.LicenseCount)
+	}
+
+	if err := s.products.Delete(ctx, tx, req.ProductId); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.recordAudit(ctx, tx, adminActor(ctx), auditProductDelete, req.ProductId, old, nil); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+
+	// Its messages and key format went with it
+	s.reloadProductMessages(ctx)
+	s.reloadKeyFormats(ctx)
+	return &emptypb.Empty{}, nil
+}
+
+// requireProducts fails with InvalidArgument unless every id is in the catalog.
+func (s *WhitelistService) requireProducts(ctx context.Context, q store.Querier, ids ...string) error {
+	missing, err := s.products.Unknown(ctx, q, ids)
+	if err != nil {
+		return dbError("db error", err)
+	}
+	if len(missing) > 0 {
+		return status.Errorf(codes.InvalidArgument, "unknown product %q, create it first", missing[0])
+	}
+	return nil
+}
+
+// checkProductKeys fails with FailedPrecondition unless every license of
+// productID, deleted ones included, fits format.
+func (s *WhitelistService) checkProductKeys(ctx context.Context, q store.Querier, productID string, format keyFormat) error {
+	if format == "" {
+		return nil
+	}
+	keys, err := s.licenseLists.Keys(ctx, q, store.LicenseFilter{ProductID: productID})
+	if err != nil {
+		return status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	var misfits []string
+	for _, key := range keys {
+		if format.check(key) != nil {
+			misfits = append(misfits, key)
+		}
+	}
+	if len(misfits) > 0 {
+		return status.Errorf(codes.FailedPrecondition, "%d licenses of the product don't fit key_format, e.g. %s", len(misfits), misfits[0])
+	}
+	return nil
+}
+
+// productErrors turns unknown product ids among licenses into per-line
+// import errors.
+func productErrors(missing []string, licenses []*pb.UpdateLicenseRequest, lines []int) []string {
+	unknown := make(map[string]bool, len(missing))
+	for _, id := range missing {
+		unknown[id] = true
+	}
+	var errs []string
+	for i, l := range licenses {
+		if unknown[l.ProductId] {
+			errs = append(errs, fmt.Sprintf("line %d: unknown product %q", lines[i], l.ProductId))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mkseven15/whitelist-server/internal/store"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+const defaultChannel = "stable"
+
+// Audit actions for releases and update channels
+const (
+	auditReleasePublish    = "release.publish"
+	auditLicenseSetChannel = "license.set_channel"
+)
+
+var channelPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,31}$`)
+
+// 34. GetLatestVersion
+func (s *WhitelistService) GetLatestVersion(ctx context.Context, req *pb.GetLatestVersionRequest) (*pb.Release, error) {
+	channel := req.Channel
+	if channel == "" {
+		channel = defaultChannel
+	}
+
+	// A pinned license overrides what the client asked for
+	if req.LicenseKey != "" {
+		l, err := s.licenses.Find(ctx, req.LicenseKey)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "db error: %v", err)
+		}
+		if l == nil || l.ProductId != req.ProductId {
+			return nil, status.Error(codes.NotFound, "license not found")
+		}
+		if l.Channel != "" {
+			channel = l.Channel
+		}
+	}
+
+	r, err := s.releases.Latest(ctx, s.db, req.ProductId, channel)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if r == nil {
+		return nil, status.Errorf(codes.NotFound, "no release on channel %q", channel)
+	}
+	return r, nil
+}
+
+// 35. PublishRelease (Owner)
+func (s *WhitelistService) PublishRelease(ctx context.Context, req *pb.PublishReleaseRequest) (*pb.Release, error) {
+	if err := s.requireWrite(ctx, roleOwner); err != nil {
+		return nil, err
+	}
+
+	if !channelPattern.MatchString(req.Channel) {
+		return nil, status.Error(codes.InvalidArgument, "channel must be 1-32 lowercase letters, digits or dashes")
+	}
+	if req.DownloadUrl != "" {
+		if u, err := url.Parse(req.DownloadUrl); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return nil, status.Error(codes.InvalidArgument, "download_url must be an http(s) URL")
+		}
+	}
+	if err := s.requireProducts(ctx, s.db, req.ProductId); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	old, err := s.releases.Lock(ctx, tx, req.ProductId, req.Channel)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	r, err := s.releases.Publish(ctx, tx, &pb.Release{
+		ProductId:   req.ProductId,
+		Channel:     req.Channel,
+		Version:     req.Version,
+		Changelog:   req.Changelog,
+		DownloadUrl: req.DownloadUrl,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	if err := s.recordAudit(ctx, tx, adminActor(ctx), auditReleasePublish, req.ProductId+"/"+req.Channel, old, r); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	return r, nil
+}
+
+// 36. SetLicenseChannel (Admin)
+func (s *WhitelistService) SetLicenseChannel(ctx context.Context, req *pb.SetLicenseChannelRequest) (*pb.License, error) {
+	if err := s.requireWrite(ctx, roleSupport); err != nil {
+		return nil, err
+	}
+
+	if req.Channel != "" && !channelPattern.MatchString(req.Channel) {
+		return nil, status.Error(codes.InvalidArgument, "channel must be 1-32 lowercase letters, digits or dashes")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	old, err := s.licenses.Get(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if old == nil {
+		return nil, status.Error(codes.NotFound, "license not found")
+	}
+
+	if err := s.licenses.Change(ctx, tx, req.LicenseKey, store.LicenseChange{Channel: &req.Channel}); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	updated, err := s.licenses.Get(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.recordLicenseChange(ctx, tx, adminActor(ctx), auditLicenseSetChannel, req.LicenseKey, old, updated); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	return updated, nil
+}
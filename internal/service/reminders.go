@@ -0,0 +1,251 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/mkseven15/whitelist-server/internal/config"
+	"github.com/mkseven15/whitelist-server/internal/mailer"
+	"github.com/mkseven15/whitelist-server/internal/notify"
+	"github.com/mkseven15/whitelist-server/internal/store"
+	"github.com/mkseven15/whitelist-server/internal/webhook"
+)
+
+// Longest list of licenses the admin digest spells out
+const maxDigestLines = 50
+
+var (
+	defaultReminderSubject = template.Must(template.New("subject").Parse(`Your {{.ProductName}} license expires in {{.DaysLeft}} {{if eq .DaysLeft 1}}day{{else}}days{{end}}`))
+	defaultReminderBody    = template.Must(template.New("body").Parse(`Hi,
+
+Your license for {{.ProductName}} expires on {{.ExpiresAt.Format "2 January 2006"}}:
+
+    {{.LicenseKey}}
+
+Renew it before then to keep using the software without interruption.
+`))
+)
+
+// reminderEmail is what the reminder templates can use.
+type reminderEmail struct {
+	licenseEmail
+	DaysLeft int
+}
+
+// expiryReminders is the reminder job's configuration.
+type expiryReminders struct {
+	// Ascending
+	days         []int
+	interval     time.Duration
+	templates    map[string]config.MailTemplates
+	digestEmails []string
+	digestHour   int
+}
+
+func newExpiryReminders(cfg config.ExpiryReminders) *expiryReminders {
+	if !cfg.Enabled() {
+		return nil
+	}
+	// Already checked by config.Validate
+	templates, _ := cfg.Templates()
+	days := append([]int(nil), cfg.Days...)
+	sort.Ints(days)
+	return &expiryReminders{
+		days:         days,
+		interval:     cfg.Interval,
+		templates:    templates,
+		digestEmails: cfg.DigestEmails,
+		digestHour:   cfg.DigestHour,
+	}
+}
+
+// SetDiscordDM lets expiry reminders reach customers by Discord DM through
+// send, e.g. the bot's DirectMessage.
+func (s *WhitelistService) SetDiscordDM(send func(userID, text string) error) {
+	s.discordDM.Store(&send)
+}
+
+// runExpiryReminders sends due reminders and the daily digest every interval.
+func (s *WhitelistService) runExpiryReminders() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(s.reminders.interval)
+	defer ticker.Stop()
+
+	for {
+		ctx := context.Background()
+		if s.leader.IsLeader(ctx) {
+			if err := s.sendExpiryReminders(ctx, time.Now()); err != nil {
+				log.Printf("Error sending expiry reminders: %v", err)
+			}
+			if err := s.sendExpiryDigest(ctx, time.Now()); err != nil {
+				log.Printf("Error sending expiry digest: %v", err)
+			}
+		}
+
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendExpiryReminders reminds about every license that crossed one of the
+// reminder days and hasn't been reminded at that day for its current expiry.
+// Reminders are claimed in the database first, so with several instances
+// each goes out once.
+func (s *WhitelistService) sendExpiryReminders(ctx context.Context, now time.Time) error {
+	r := s.reminders
+	expiring, err := s.reminderStore.Expiring(ctx, s.db, now, now.Add(time.Duration(r.days[len(r.days)-1])*24*time.Hour))
+	if err != nil {
+		return err
+	}
+
+	for _, l := range expiring {
+		// The nearest reminder day the license is within
+		days := 0
+		for _, d := range r.days {
+			if !l.ExpiresAt.After(now.Add(time.Duration(d) * 24 * time.Hour)) {
+				days = d
+				break
+			}
+		}
+		claimed, err := s.reminderStore.Claim(ctx, s.db, l.Key, l.ExpiresAt, days)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			continue
+		}
+		if err := s.remind(ctx, l, now); err != nil {
+			log.Printf("Expiry reminder for %s: %v", l.Key, err)
+		}
+	}
+	return nil
+}
+
+// remind sends one reminder through every channel that's set up for it.
+func (s *WhitelistService) remind(ctx context.Context, l store.ExpiringLicense, now time.Time) error {
+	license, err := s.licenses.Get(ctx, s.db, l.Key)
+	if err != nil || license == nil {
+		return err
+	}
+	s.notify(licenseEvent(webhook.LicenseExpiring, license))
+
+	p, err := s.products.Get(ctx, s.db, l.ProductID)
+	if err != nil {
+		return err
+	}
+	expiresAt := l.ExpiresAt
+	data := reminderEmail{
+		licenseEmail: licenseEmail{
+			Email:       l.Email,
+			LicenseKey:  l.Key,
+			ProductID:   l.ProductID,
+			ProductName: p.GetName(),
+			ExpiresAt:   &expiresAt,
+			MaxDevices:  license.MaxDevices,
+		},
+		// Rounded up, so a license 30 hours from expiry has 2 days left
+		DaysLeft: int((l.ExpiresAt.Sub(now) + 24*time.Hour - 1) / (24 * time.Hour)),
+	}
+	msg, err := s.renderReminder(data)
+	if err != nil {
+		return fmt.Errorf("template: %w", err)
+	}
+
+	var errs []string
+	if l.Email != "" && s.mail != nil {
+		sendCtx, cancel := context.WithTimeout(ctx, mailSendTimeout)
+		if err := s.mail.Send(sendCtx, msg); err != nil {
+			errs = append(errs, fmt.Sprintf("email: %v", err))
+		}
+		cancel()
+	}
+	if send := s.discordDM.Load(); l.Discord != "" && send != nil {
+		if err := (*send)(l.Discord, "**"+msg.Subject+"**\n\n"+msg.Body); err != nil {
+			errs = append(errs, fmt.Sprintf("discord: %v", err))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// renderReminder fills in the product's reminder templates, falling back to
+// the configured defaults and then the built-in ones.
+func (s *WhitelistService) renderReminder(data reminderEmail) (mailer.Message, error) {
+	t := s.reminders.templates[data.ProductID]
+	subjectTmpl, bodyTmpl := t.Subject, t.Body
+	if subjectTmpl == nil {
+		subjectTmpl = s.reminders.templates[""].Subject
+	}
+	if bodyTmpl == nil {
+		bodyTmpl = s.reminders.templates[""].Body
+	}
+	if subjectTmpl == nil {
+		subjectTmpl = defaultReminderSubject
+	}
+	if bodyTmpl == nil {
+		bodyTmpl = defaultReminderBody
+	}
+	var subject, body bytes.Buffer
+	if err := subjectTmpl.Execute(&subject, data); err != nil {
+		return mailer.Message{}, err
+	}
+	if err := bodyTmpl.Execute(&body, data); err != nil {
+		return mailer.Message{}, err
+	}
+	return mailer.Message{To: data.Email, Subject: strings.TrimSpace(subject.String()), Body: body.String()}, nil
+}
+
+// sendExpiryDigest sends admins the day's list of expiring licenses, once a
+// day at the digest hour.
+func (s *WhitelistService) sendExpiryDigest(ctx context.Context, now time.Time) error {
+	r := s.reminders
+	if (len(r.digestEmails) == 0 && s.alerts == nil) || now.UTC().Hour() != r.digestHour {
+		return nil
+	}
+	claimed, err := s.reminderStore.ClaimDigest(ctx, s.db, now.UTC().Format(time.DateOnly))
+	if err != nil || !claimed {
+		return err
+	}
+
+	days := r.days[len(r.days)-1]
+	expiring, err := s.reminderStore.Expiring(ctx, s.db, now, now.Add(time.Duration(days)*24*time.Hour))
+	if err != nil || len(expiring) == 0 {
+		return err
+	}
+	subject := fmt.Sprintf("%d licenses expire in the next %d days", len(expiring), days)
+	var body strings.Builder
+	for i, l := range expiring {
+		if i == maxDigestLines {
+			fmt.Fprintf(&body, "...and %d more\n", len(expiring)-i)
+			break
+		}
+		fmt.Fprintf(&body, "%s  %s  %s", l.ExpiresAt.UTC().Format("2006-01-02 15:04"), l.ProductID, l.Key)
+		if l.Email != "" {
+			fmt.Fprintf(&body, "  %s", l.Email)
+		}
+		body.WriteString("\n")
+	}
+
+	s.alerts.Send(notify.Alert{Kind: notify.ExpiryDigest, Key: now.UTC().Format(time.DateOnly), Text: subject + "\n\n" + body.String()})
+	for _, to := range r.digestEmails {
+		sendCtx, cancel := context.WithTimeout(ctx, mailSendTimeout)
+		err := s.mail.Send(sendCtx, mailer.Message{To: to, Subject: subject, Body: body.String()})
+		cancel()
+		if err != nil {
+			log.Printf("Expiry digest to %s: %v", to, err)
+		}
+	}
+	return nil
+}
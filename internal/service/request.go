@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/mkseven15/whitelist-server/internal/clientip"
+)
+
+// clientIP returns the original caller's address (see clientip.FromContext).
+func clientIP(ctx context.Context) string {
+	return clientip.FromContext(ctx)
+}
+
+// adminActor names the operator behind an admin call: the account of a bearer
+// token, else the optional x-admin-actor header, since the shared admin secret
+// itself doesn't identify anyone. The token isn't verified again here; only
+// call this once requireRole has accepted the request.
+func adminActor(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if token, ok := bearerToken(md); ok {
+			var claims jwt.RegisteredClaims
+			if _, _, err := jwt.NewParser().ParseUnverified(token, &claims); err == nil && claims.Subject != "" {
+				return claims.Subject
+			}
+		}
+		if v := md.Get("x-admin-actor"); len(v) > 0 && v[0] != "" {
+			return v[0]
+		}
+	}
+	return "admin"
+}
@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/mkseven15/whitelist-server/internal/errinfo"
+	"github.com/mkseven15/whitelist-server/internal/store"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// resellerAPIKeyPrefix marks reseller keys so they're recognisable in configs
+const resellerAPIKeyPrefix = "rk_"
+
+// authReseller resolves the x-reseller-key header to a reseller ID.
+func (s *WhitelistService) authReseller(ctx context.Context) (int64, string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	keys := md.Get("x-reseller-key")
+	if len(keys) == 0 || keys[0] == "" {
+		return 0, "", errinfo.Error(codes.Unauthenticated, pb.Reason_REASON_RESELLER_KEY_INVALID, "missing x-reseller-key header")
+	}
+	var r *pb.Reseller
+	err := s.withPreviousSalt(keys[0], func(hash string) (err error) {
+		if r, err = s.resellers.ByKey(ctx, s.db, hash); err == nil && r == nil {
+			err = sql.ErrNoRows
+		}
+		return err
+	}, func(old, current string) error {
+		return s.resellers.RehashKey(ctx, s.db, old, current)
+	})
+	if err == sql.ErrNoRows {
+		return 0, "", errinfo.Error(codes.Unauthenticated, pb.Reason_REASON_RESELLER_KEY_INVALID, "invalid reseller key")
+	} else if err != nil {
+		return 0, "", status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	return r.Id, r.Name, nil
+}
+
+// 13. ResellerGenerateLicense (Reseller)
+func (s *WhitelistService) ResellerGenerateLicense(ctx context.Context, req *pb.ResellerGenerateLicenseRequest) (*pb.ResellerGenerateLicenseResponse, error) {
+	resellerID, name, err := s.authReseller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkMaintenance(ctx); err != nil {
+		return nil, err
+	}
+
+	count := int(req.Count)
+	if count <= 0 {
+		count = 1
+	} else if count > maxGenerateCount {
+		return nil, status.Errorf(codes.InvalidArgument, "count must be at most %d", maxGenerateCount)
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	// Lock the balance so concurrent requests can't both spend the same credits
+	r, err := s.resellers.Lock(ctx, tx, resellerID)
+	if err == nil && r == nil {
+		err = sql.ErrNoRows
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if !allowsProduct(r.ProductIds, req.ProductId) {
+		return nil, status.Errorf(codes.PermissionDenied, "not allowed to generate keys for %s", req.ProductId)
+	}
+	if r.Credits < int64(count) {
+		return nil, status.Errorf(codes.FailedPrecondition, "insufficient credits: have %d, need %d", r.Credits, count)
+	}
+
+	actor := "reseller:" + name
+	keys, events, err := s.insertGeneratedLicenses(ctx, tx, actor, nil, count, &pb.GenerateLicensesRequest{
+		ProductId:  req.ProductId,
+		IsActive:   true,
+		ExpiresAt:  req.ExpiresAt,
+		MaxDevices: req.MaxDevices,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := s.resellers.AddCredits(ctx, tx, resellerID, -int64(len(keys)), store.LedgerGenerate, keys, actor, "")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	s.notify(events...)
+	return &pb.ResellerGenerateLicenseResponse{LicenseKeys: keys, RemainingCredits: balance}, nil
+}
+
+// 14. CreateReseller (Admin)
+func (s *WhitelistService) CreateReseller(ctx context.Context, req *pb.CreateResellerRequest) (*pb.CreateResellerResponse, error) {
+	if err := s.requireWrite(ctx, roleOwner); err != nil {
+		return nil, err
+	}
+
+	if err := s.requireProducts(ctx, s.db, req.ProductIds...); err != nil {
+		return nil, err
+	}
+	token, err := newAccessToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate key: %v", err)
+	}
+	apiKey := resellerAPIKeyPrefix + token
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	r, err := s.resellers.Create(ctx, tx, req.Name, s.hashSecret(apiKey), req.ProductIds)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if r == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "reseller %q already exists", req.Name)
+	}
+
+	if req.Credits > 0 {
+		if r.Credits, err = s.resellers.AddCredits(ctx, tx, r.Id, req.Credits, store.LedgerTopUp, nil, adminActor(ctx), "initial credits"); err != nil {
+			return nil, status.Errorf(codes.Internal, "db error: %v", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+
+	return &pb.CreateResellerResponse{Reseller: r, ApiKey: apiKey}, nil
+}
+
+// 15. TopUpResellerCredits (Admin)
+func (s *WhitelistService) TopUpResellerCredits(ctx context.Context, req *pb.TopUpResellerCreditsRequest) (*pb.Reseller, error) {
+	if err := s.requireWrite(ctx, roleOwner); err != nil {
+		return nil, err
+	}
+
+	if req.Credits == 0 {
+		return nil, status.Error(codes.InvalidArgument, "credits must not be zero")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	r, err := s.resellers.Lock(ctx, tx, req.ResellerId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if r == nil {
+		return nil, status.Error(codes.NotFound, "reseller not found")
+	}
+	if r.Credits+req.Credits < 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "reseller only has %d credits", r.Credits)
+	}
+
+	r.Credits, err = s.resellers.AddCredits(ctx, tx, r.Id, req.Credits, store.LedgerTopUp, nil, adminActor(ctx), req.Note)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	return r, nil
+}
+
+// 16. ListResellerActivity (Admin)
+func (s *WhitelistService) ListResellerActivity(ctx context.Context, req *pb.ListResellerActivityRequest) (*pb.ListResellerActivityResponse, error) {
+	if err := s.requireRole(ctx, roleReadOnly); err != nil {
+		return nil, err
+	}
+
+	page, err := newPage(store.NewestFirst, req.PageSize, req.PageToken, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	activity, err := s.resellers.Activity(ctx, s.db, req.ResellerId, page.Page)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	resp := &pb.ListResellerActivityResponse{Activity: activity}
+	if len(resp.Activity) > page.Size {
+		resp.Activity = resp.Activity[:page.Size]
+		resp.NextPageToken = page.next("", strconv.FormatInt(resp.Activity[page.Size-1].Id, 10))
+	}
+	return resp, nil
+}
+
+func allowsProduct(products []string, productID string) bool {
+	if len(products) == 0 {
+		return true
+	}
+	for _, p := range products {
+		if p == productID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/mkseven15/whitelist-server/internal/webhook"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// Audit actions for deleted licenses
+const (
+	auditLicenseRestore = "license.restore"
+	auditLicensePurge   = "license.purge"
+)
+
+// 66. RestoreLicense (Admin)
+func (s *WhitelistService) RestoreLicense(ctx context.Context, req *pb.RestoreLicenseRequest) (*pb.License, error) {
+	if err := s.requireWrite(ctx, roleSupport); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	old, err := s.loadDeletedLicense(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.licenses.Restore(ctx, tx, req.LicenseKey); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	restored, err := s.licenses.Get(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.recordLicenseChange(ctx, tx, adminActor(ctx), auditLicenseRestore, req.LicenseKey, old, restored); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	s.invalidateLicenses(ctx, req.LicenseKey)
+	s.notify(licenseEvent(webhook.LicenseRestored, restored))
+	return restored, nil
+}
+
+// 67. PurgeLicense (Admin)
+func (s *WhitelistService) PurgeLicense(ctx context.Context, req *pb.PurgeLicenseRequest) (*emptypb.Empty, error) {
+	if err := s.requireWrite(ctx, roleOwner); err != nil {
+		return nil, err
+	}
+	if err := s.requireOTP(ctx); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	old, err := s.loadDeletedLicense(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Devices, sessions and the rest go with it
+	if err := s.licenses.Purge(ctx, tx, req.LicenseKey); err != nil {
+		return nil, status.Errorf(codes.Internal, "delete failed: %v", err)
+	}
+	if err := s.recordAudit(ctx, tx, adminActor(ctx), auditLicensePurge, req.LicenseKey, old, nil); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// loadDeletedLicense locks and returns a soft-deleted license, failing if the
+// key doesn't exist or isn't deleted.
+func (s *WhitelistService) loadDeletedLicense(ctx context.Context, tx *sql.Tx, licenseKey string) (*pb.License, error) {
+	l, err := s.licenses.Lock(ctx, tx, licenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if l == nil {
+		return nil, status.Error(codes.NotFound, "license not found")
+	}
+	if l.DeletedAt == nil {
+		return nil, status.Error(codes.FailedPrecondition, "license isn't deleted")
+	}
+	return l, nil
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mkseven15/whitelist-server/internal/hwid"
+	"github.com/mkseven15/whitelist-server/internal/store"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// 65. SearchLicenses (Admin)
+func (s *WhitelistService) SearchLicenses(ctx context.Context, req *pb.SearchLicensesRequest) (*pb.SearchLicensesResponse, error) {
+	if err := s.requireRole(ctx, roleReadOnly); err != nil {
+		return nil, err
+	}
+
+	device := hwid.Hash(req.Hwid)
+	if req.KeySuffix == "" && device == "" && req.Email == "" && len(req.Metadata.GetFields()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "set at least one of key_suffix, hwid, email or metadata")
+	}
+	page, err := newPage(store.LicenseOrder, req.PageSize, req.PageToken, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := store.MetadataJSON(req.Metadata); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid metadata: %v", err)
+	}
+
+	licenses, err := s.licenseLists.List(ctx, s.db, store.LicenseFilter{
+		KeySuffix: req.KeySuffix,
+		HWID:      device,
+		Email:     strings.TrimSpace(req.Email),
+		Metadata:  req.Metadata,
+		ProductID: req.ProductId,
+		Live:      true,
+	}, page.Page)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	resp := &pb.SearchLicensesResponse{Licenses: licenses}
+	if len(resp.Licenses) > page.Size {
+		resp.Licenses = resp.Licenses[:page.Size]
+		resp.NextPageToken = licensePageToken(page, resp.Licenses[page.Size-1])
+	}
+	return resp, nil
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// Clients can't trust their own clock: some are simply wrong, and a clock
+// turned back keeps an expired offline license file alive. So the server
+// tells them its own, as GetServerTime and with every validation, along with
+// how far off the client's is when it says what time it thinks it is.
+
+// 90. GetServerTime
+func (s *WhitelistService) GetServerTime(ctx context.Context, req *pb.GetServerTimeRequest) (*pb.GetServerTimeResponse, error) {
+	now := time.Now()
+	return &pb.GetServerTimeResponse{ServerTime: timestamppb.New(now), ClockSkewSeconds: clockSkew(req.ClientTime, now)}, nil
+}
+
+// stampTime sets the server's clock, and how far the client's is off, on a
+// validation answer.
+func stampTime(req *pb.ValidateRequest, resp *pb.ValidateResponse, now time.Time) {
+	resp.ServerTime = timestamppb.New(now)
+	resp.ClockSkewSeconds = clockSkew(req.ClientTime, now)
+}
+
+// clockSkew is how many seconds clientTime is ahead of now, 0 if unset.
+func clockSkew(clientTime *timestamppb.Timestamp, now time.Time) int64 {
+	if clientTime == nil {
+		return 0
+	}
+	return int64(math.Round(clientTime.AsTime().Sub(now).Seconds()))
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mkseven15/whitelist-server/licensefile"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// With a license file key configured, validation answers are signed with it
+// too, so clients holding the public key can tell them from ones made up by
+// a proxy in between (see licensefile.Response). GetSigningKeys also lists
+// the keys used before it, so clients can be sent a new key ahead of a
+// rotation and keep accepting old files after it.
+
+// 91. GetPublicKey
+func (s *WhitelistService) GetPublicKey(ctx context.Context, req *pb.GetPublicKeyRequest) (*pb.GetPublicKeyResponse, error) {
+	if s.licenseSigningKey == nil {
+		return nil, status.Error(codes.FailedPrecondition, "response signing is not configured")
+	}
+	pub := s.licenseSigningKey.Public().(ed25519.PublicKey)
+	return &pb.GetPublicKeyResponse{PublicKey: licensefile.EncodePublicKey(pub), KeyId: licensefile.KeyID(pub)}, nil
+}
+
+// 92. GetSigningKeys
+func (s *WhitelistService) GetSigningKeys(ctx context.Context, req *pb.GetSigningKeysRequest) (*pb.GetSigningKeysResponse, error) {
+	keys := s.licenseOldKeys
+	if s.licenseSigningKey != nil {
+		keys = append([]ed25519.PublicKey{s.licenseSigningKey.Public().(ed25519.PublicKey)}, keys...)
+	}
+	if len(keys) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "response signing is not configured")
+	}
+
+	resp := &pb.GetSigningKeysResponse{}
+	seen := map[string]bool{}
+	for i, key := range keys {
+		kid := licensefile.KeyID(key)
+		// Left in previous_public_keys after being made active again
+		if seen[kid] {
+			continue
+		}
+		seen[kid] = true
+		resp.Keys = append(resp.Keys, &pb.SigningKey{
+			Kid:       kid,
+			Kty:       "OKP",
+			Crv:       "Ed25519",
+			X:         base64.RawURLEncoding.EncodeToString(key),
+			Alg:       "EdDSA",
+			PublicKey: licensefile.EncodePublicKey(key),
+			Active:    i == 0 && s.licenseSigningKey != nil,
+		})
+	}
+	return resp, nil
+}
+
+// signResponse signs resp as the answer to req, the request as the client
+// sent it. It's left unsigned without a key.
+func (s *WhitelistService) signResponse(req *pb.ValidateRequest, resp *pb.ValidateResponse) {
+	if s.licenseSigningKey == nil {
+		return
+	}
+	resp.Signature = licensefile.SignResponse(s.licenseSigningKey, licensefile.Response{
+		Key:        req.LicenseKey,
+		ProductID:  req.ProductId,
+		HWID:       req.Hwid,
+		ServerTime: resp.ServerTime.AsTime(),
+		Valid:      resp.Valid,
+	})
+	resp.SigningKeyId = licensefile.KeyID(s.licenseSigningKey.Public().(ed25519.PublicKey))
+}
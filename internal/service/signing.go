@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mkseven15/whitelist-server/internal/clientip"
+	"github.com/mkseven15/whitelist-server/internal/errinfo"
+	"github.com/mkseven15/whitelist-server/internal/signing"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+const auditProductSigning = "product.signing_secret"
+
+// 56. RotateProductSigningSecret (Owner)
+func (s *WhitelistService) RotateProductSigningSecret(ctx context.Context, req *pb.RotateProductSigningSecretRequest) (*pb.RotateProductSigningSecretResponse, error) {
+	if err := s.requireWrite(ctx, roleOwner); err != nil {
+		return nil, err
+	}
+
+	secret, err := newAccessToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate secret: %v", err)
+	}
+	p, err := s.setSigningSecret(ctx, req.ProductId, secret)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RotateProductSigningSecretResponse{Product: p, SigningSecret: secret}, nil
+}
+
+// 57. RemoveProductSigningSecret (Owner)
+func (s *WhitelistService) RemoveProductSigningSecret(ctx context.Context, req *pb.RemoveProductSigningSecretRequest) (*pb.Product, error) {
+	if err := s.requireWrite(ctx, roleOwner); err != nil {
+		return nil, err
+	}
+	return s.setSigningSecret(ctx, req.ProductId, "")
+}
+
+func (s *WhitelistService) setSigningSecret(ctx context.Context, productID, secret string) (*pb.Product, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	old, err := s.products.Get(ctx, tx, productID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if old == nil {
+		return nil, status.Error(codes.NotFound, "product not found")
+	}
+	if err := s.products.SetSigningSecret(ctx, tx, productID, secret); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	updated, err := s.products.Get(ctx, tx, productID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	// The secret itself stays out of the audit log
+	if err := s.recordAudit(ctx, tx, adminActor(ctx), auditProductSigning, productID, old, updated); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	return updated, nil
+}
+
+// checkSignature makes sure req is signed when any of the products asks for
+// signed requests, and that its nonce hasn't been seen. See bodyDigest for
+// what's signed.
+func (s *WhitelistService) checkSignature(ctx context.Context, req proto.Message, productIDs ...string) error {
+	secrets, err := s.signingSecrets(ctx, productIDs...)
+	if err != nil {
+		return err
+	}
+	return s.checkSignatureWith(ctx, req, secrets)
+}
+
+// signingSecrets returns the signing secrets of those of the products that
+// have one.
+func (s *WhitelistService) signingSecrets(ctx context.Context, productIDs ...string) (map[string]string, error) {
+	secrets, err := s.products.SigningSecrets(ctx, s.db, productIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	return secrets, nil
+}
+
+// checkSignatureWith is checkSignature with the products' secrets at hand.
+func (s *WhitelistService) checkSignatureWith(ctx context.Context, req proto.Message, secrets map[string]string) error {
+	if len(secrets) == 0 {
+		return nil
+	}
+	signedAt, nonce, err := s.verifySignature(ctx, req, secrets)
+	if err != nil {
+		return err
+	}
+
+	// Past its expiry the timestamp alone turns the request away
+	for id := range secrets {
+		fresh, err := s.challenges.UseNonce(ctx, s.db, id, nonce, signedAt.Add(s.signatureMaxSkew))
+		if err != nil {
+			return status.Errorf(codes.Internal, "db error: %v", err)
+		}
+		if !fresh {
+			return errNonceReused
+		}
+	}
+	return nil
+}
+
+var errNonceReused = errinfo.Error(codes.Unauthenticated, pb.Reason_REASON_NONCE_REUSED, "nonce already used")
+
+// verifySignature checks req's signature with each of secrets, and returns
+// when it was signed and its nonce. It needs no database, so recording the
+// nonce is up to the caller.
+func (s *WhitelistService) verifySignature(ctx context.Context, req proto.Message, secrets map[string]string) (time.Time, string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	first := func(key string) string {
+		if v := md.Get(key); len(v) > 0 {
+			return v[0]
+		}
+		return ""
+	}
+	timestamp, nonce, signature := first(signing.TimestampHeader), first(signing.NonceHeader), first(signing.SignatureHeader)
+	if signature == "" {
+		return time.Time{}, "", errinfo.Error(codes.Unauthenticated, pb.Reason_REASON_SIGNATURE_REQUIRED, "request must be signed")
+	}
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	signedAt := time.Unix(unix, 0)
+	if err != nil || time.Since(signedAt).Abs() > s.signatureMaxSkew {
+		// Tells clients with a wrong clock what to send instead
+		return time.Time{}, "", errinfo.Errorf(codes.Unauthenticated, pb.Reason_REASON_SIGNATURE_INVALID, "request timestamp missing or out of range, server time is %d", time.Now().Unix())
+	}
+	if len(nonce) < 8 || len(nonce) > 128 {
+		return time.Time{}, "", errinfo.Error(codes.Unauthenticated, pb.Reason_REASON_SIGNATURE_INVALID, "nonce must be 8 to 128 characters")
+	}
+	digest, err := bodyDigest(ctx, req, first(signing.BodyDigestHeader))
+	if err != nil {
+		return time.Time{}, "", err
+	}
+	for _, secret := range secrets {
+		if !signing.Verify([]byte(secret), timestamp, nonce, digest, signature) {
+			return time.Time{}, "", errinfo.Error(codes.Unauthenticated, pb.Reason_REASON_SIGNATURE_INVALID, "invalid request signature")
+		}
+	}
+	return signedAt, nonce, nil
+}
+
+// bodyDigest returns the digest a signature covers. Calls through the gateway
+// or Connect sign the HTTP body, whose digest signing.Middleware took before
+// the body was decoded; it's believed only from the gateway's connection or
+// the in-process Connect handler, so a client can't pass off another
+// request's digest as its own. Anything else signs req in deterministic wire
+// format.
+func bodyDigest(ctx context.Context, req proto.Message, supplied string) (string, error) {
+	if digest, ok := signing.FromContext(ctx); ok {
+		return digest, nil
+	}
+	if supplied != "" && clientip.FromGateway(ctx) {
+		return supplied, nil
+	}
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to encode request: %v", err)
+	}
+	return signing.Digest(body), nil
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/mkseven15/whitelist-server/internal/clientip"
+	"github.com/mkseven15/whitelist-server/internal/signing"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+func TestBodyDigest(t *testing.T) {
+	// A connection accepted the way the gateway's in-process server does
+	bl := bufconn.Listen(1 << 10)
+	defer bl.Close()
+	go func() {
+		if c, err := bl.DialContext(context.Background()); err == nil {
+			defer c.Close()
+		}
+	}()
+	c, err := clientip.GatewayListener(bl).Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	gateway := peer.NewContext(context.Background(), &peer.Peer{Addr: c.RemoteAddr()})
+	direct := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("203.0.113.9"), Port: 4000}})
+
+	req := &pb.ValidateRequest{LicenseKey: "KEY", Hwid: "hwid", ProductId: "prod"}
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	own := signing.Digest(body)
+	other := signing.Digest([]byte("another request"))
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		supplied string
+		want     string
+	}{
+		{"direct call", direct, "", own},
+		{"direct call claiming a digest", direct, other, own},
+		{"gateway", gateway, other, other},
+		{"gateway without a digest", gateway, "", own},
+		{"in-process Connect", signing.NewContext(direct, other), "", other},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := bodyDigest(tt.ctx, req, tt.supplied)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+const (
+	defaultStatsDays  = 7
+	maxStatsDays      = 366
+	maxFailureReasons = 20
+	statsDateLayout   = "2006-01-02"
+)
+
+// 63. GetStats (Admin)
+func (s *WhitelistService) GetStats(ctx context.Context, req *pb.GetStatsRequest) (*pb.GetStatsResponse, error) {
+	if err := s.requireRole(ctx, roleReadOnly); err != nil {
+		return nil, err
+	}
+
+	days := defaultStatsDays
+	if req.Period != "" {
+		n, err := strconv.Atoi(strings.TrimSuffix(req.Period, "d"))
+		if err != nil || !strings.HasSuffix(req.Period, "d") || n < 1 || n > maxStatsDays {
+			return nil, status.Errorf(codes.InvalidArgument, "period must be 1d to %dd", maxStatsDays)
+		}
+		days = n
+	}
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	since := today.AddDate(0, 0, -(days - 1))
+
+	resp := &pb.GetStatsResponse{Since: timestamppb.New(since)}
+	byDate := map[string]*pb.DailyStats{}
+	for d := since; !d.After(today); d = d.AddDate(0, 0, 1) {
+		day := &pb.DailyStats{Date: d.Format(statsDateLayout)}
+		resp.Days = append(resp.Days, day)
+		byDate[day.Date] = day
+	}
+
+	// An empty product_id matches every product
+	daily, err := s.stats.Validations(ctx, s.db, since, req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	for _, d := range daily {
+		if day, ok := byDate[d.Date]; ok {
+			day.Validations, day.FailedValidations, day.UniqueHwids = d.Validations, d.FailedValidations, d.UniqueHwids
+			resp.Validations += d.Validations
+			resp.FailedValidations += d.FailedValidations
+		}
+	}
+
+	if resp.UniqueHwids, err = s.stats.UniqueHWIDs(ctx, s.db, since, req.ProductId); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if resp.FailureReasons, err = s.stats.FailureReasons(ctx, s.db, since, req.ProductId, maxFailureReasons); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	tokens, err := s.stats.Tokens(ctx, s.db, since, req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	for date, n := range tokens {
+		if day, ok := byDate[date]; ok {
+			day.TokensIssued = n
+			resp.TokensIssued += n
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,305 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mkseven15/whitelist-server/internal/store"
+	"github.com/mkseven15/whitelist-server/internal/webhook"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+const (
+	stripeActor = "stripe"
+	// Maximum age of a signed Stripe request, to limit replays
+	stripeTolerance = 5 * time.Minute
+	maxStripeBody   = 1 << 20
+)
+
+type stripeEvent struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Data struct {
+		Object json.RawMessage `json:"object"`
+	} `json:"data"`
+}
+
+// stripeInvoice holds the invoice fields we use. Newer API versions moved the
+// subscription and price under parent/pricing; both shapes are accepted.
+type stripeInvoice struct {
+	Customer     string `json:"customer"`
+	Subscription string `json:"subscription"`
+	Parent       struct {
+		SubscriptionDetails struct {
+			Subscription string `json:"subscription"`
+		} `json:"subscription_details"`
+	} `json:"parent"`
+	Lines struct {
+		Data []struct {
+			Price struct {
+				ID string `json:"id"`
+			} `json:"price"`
+			Pricing struct {
+				PriceDetails struct {
+					Price string `json:"price"`
+				} `json:"price_details"`
+			} `json:"pricing"`
+			Period struct {
+				End int64 `json:"end"`
+			} `json:"period"`
+		} `json:"data"`
+	} `json:"lines"`
+}
+
+// StripeWebhook handles POST /webhooks/stripe. Paid invoices create or renew
+// the subscription's licenses and deleted subscriptions suspend them. Errors
+// answer 500 so Stripe retries; events already handled are acknowledged.
+func (s *WhitelistService) StripeWebhook(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxStripeBody))
+	if err != nil {
+		http.Error(w, "read failed", http.StatusBadRequest)
+		return
+	}
+	if err := verifyStripeSignature(r.Header.Get("Stripe-Signature"), body, s.stripe.WebhookSecret, time.Now()); err != nil {
+		http.Error(w, "invalid signature", http.StatusBadRequest)
+		return
+	}
+	// Stripe retries the event once maintenance is over
+	if err := s.checkMaintenance(r.Context()); err != nil {
+		http.Error(w, status.Convert(err).Message(), http.StatusServiceUnavailable)
+		return
+	}
+	var event stripeEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		http.Error(w, "invalid event", http.StatusBadRequest)
+		return
+	}
+
+	// Audit entries are attributed to Stripe
+	ctx := metadata.NewIncomingContext(r.Context(), metadata.Pairs("x-admin-actor", stripeActor))
+
+	switch event.Type {
+	case "invoice.paid":
+		err = s.stripeInvoicePaid(ctx, &event)
+	case "customer.subscription.deleted":
+		err = s.stripeSubscriptionDeleted(ctx, &event)
+	}
+	if err != nil {
+		log.Printf("stripe: %s %s: %v", event.Type, event.ID, err)
+		http.Error(w, "processing failed", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *WhitelistService) stripeInvoicePaid(ctx context.Context, event *stripeEvent) error {
+	var inv stripeInvoice
+	if err := json.Unmarshal(event.Data.Object, &inv); err != nil {
+		return err
+	}
+	subscription := inv.Subscription
+	if subscription == "" {
+		subscription = inv.Parent.SubscriptionDetails.Subscription
+	}
+	if subscription == "" {
+		return nil // one-off invoice
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if fresh, err := s.billing.ClaimEvent(ctx, tx, event.ID, event.Type); err != nil || !fresh {
+		return err
+	}
+
+	var keys []string
+	var events []webhook.Event
+	for _, line := range inv.Lines.Data {
+		priceID := line.Price.ID
+		if priceID == "" {
+			priceID = line.Pricing.PriceDetails.Price
+		}
+		price, ok := s.stripe.Prices[priceID]
+		if !ok {
+			continue
+		}
+		// Paid through the end of the billing period, plus slack for renewals
+		expiresAt := time.Unix(line.Period.End, 0).Add(s.stripe.GracePeriod)
+
+		key, err := s.billing.License(ctx, tx, subscription, priceID)
+		if err != nil {
+			return err
+		}
+
+		req := &pb.UpdateLicenseRequest{
+			LicenseKey: key,
+			ProductId:  price.ProductID,
+			IsActive:   true,
+			ExpiresAt:  timestamppb.New(expiresAt),
+			MaxDevices: int32(price.MaxDevices),
+		}
+		if key != "" {
+			// Renewal: keep whatever an admin changed since, except the state
+			cur, err := s.licenses.Get(ctx, tx, key)
+			if err != nil {
+				return err
+			}
+			if cur != nil {
+				req.ProductId, req.MaxDevices, req.MaxSessions = cur.ProductId, cur.MaxDevices, cur.MaxSessions
+			}
+		} else {
+			if req.LicenseKey, err = s.unusedLicenseKey(ctx, tx, price.ProductID); err != nil {
+				return err
+			}
+		}
+
+		ev, err := s.saveLicense(ctx, tx, req)
+		if err != nil {
+			return err
+		}
+		if key == "" {
+			if err := s.billing.Link(ctx, tx, subscription, priceID, inv.Customer, req.LicenseKey); err != nil {
+				return err
+			}
+		}
+		keys = append(keys, req.LicenseKey)
+		events = append(events, ev)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	s.invalidateLicenses(ctx, keys...)
+	s.notify(events...)
+	return nil
+}
+
+func (s *WhitelistService) stripeSubscriptionDeleted(ctx context.Context, event *stripeEvent) error {
+	var sub struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(event.Data.Object, &sub); err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if fresh, err := s.billing.ClaimEvent(ctx, tx, event.ID, event.Type); err != nil || !fresh {
+		return err
+	}
+
+	keys, err := s.billing.Licenses(ctx, tx, sub.ID)
+	if err != nil {
+		return err
+	}
+
+	var events []webhook.Event
+	for _, key := range keys {
+		cur, err := s.licenses.Get(ctx, tx, key)
+		if err != nil {
+			return err
+		}
+		if cur == nil || !cur.IsActive {
+			continue
+		}
+		ev, err := s.saveLicense(ctx, tx, &pb.UpdateLicenseRequest{
+			LicenseKey:  cur.LicenseKey,
+			ProductId:   cur.ProductId,
+			IsActive:    false,
+			ExpiresAt:   cur.ExpiresAt,
+			MaxDevices:  cur.MaxDevices,
+			MaxSessions: cur.MaxSessions,
+		})
+		if err != nil {
+			return err
+		}
+		events = append(events, ev)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	s.invalidateLicenses(ctx, keys...)
+	s.notify(events...)
+	return nil
+}
+
+// unusedLicenseKey generates a key for productID, in its key format or the
+// default pattern, that isn't taken yet.
+func (s *WhitelistService) unusedLicenseKey(ctx context.Context, q store.Querier, productID string) (string, error) {
+	generator, err := s.productKeyGenerator(ctx, q, productID, nil)
+	if err != nil {
+		return "", err
+	}
+	for attempt := 0; attempt < 10; attempt++ {
+		key, err := generator.generate()
+		if err != nil {
+			return "", err
+		}
+		existing, err := s.licenses.Get(ctx, q, key)
+		if err != nil {
+			return "", err
+		}
+		if existing == nil {
+			return key, nil
+		}
+	}
+	return "", errors.New("could not generate an unused license key")
+}
+
+// verifyStripeSignature checks a Stripe-Signature header
+// ("t=<unix>,v1=<hex hmac>,...") against body.
+func verifyStripeSignature(header string, body []byte, secret string, now time.Time) error {
+	var ts string
+	var sigs []string
+	for _, part := range strings.Split(header, ",") {
+		k, v, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch k {
+		case "t":
+			ts = v
+		case "v1":
+			sigs = append(sigs, v)
+		}
+	}
+	t, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return errors.New("missing timestamp")
+	}
+	if age := now.Sub(time.Unix(t, 0)); age > stripeTolerance || age < -stripeTolerance {
+		return fmt.Errorf("timestamp outside tolerance (%s)", age)
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	expected := mac.Sum(nil)
+	for _, sig := range sigs {
+		if b, err := hex.DecodeString(sig); err == nil && hmac.Equal(b, expected) {
+			return nil
+		}
+	}
+	return errors.New("no matching signature")
+}
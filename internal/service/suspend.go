@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mkseven15/whitelist-server/internal/store"
+	"github.com/mkseven15/whitelist-server/internal/webhook"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// Audit actions for suspensions
+const (
+	auditLicenseSuspend   = "license.suspend"
+	auditLicenseUnsuspend = "license.unsuspend"
+)
+
+const maxSuspendReason = 200
+
+// 46. SuspendLicense (Admin)
+func (s *WhitelistService) SuspendLicense(ctx context.Context, req *pb.SuspendLicenseRequest) (*pb.License, error) {
+	if err := s.requireWrite(ctx, roleSupport); err != nil {
+		return nil, err
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if utf8.RuneCountInString(reason) > maxSuspendReason {
+		return nil, status.Errorf(codes.InvalidArgument, "reason must be at most %d characters", maxSuspendReason)
+	}
+	// Suspending again just replaces the reason
+	return s.setSuspended(ctx, req.LicenseKey, false, reason, auditLicenseSuspend)
+}
+
+// 47. UnsuspendLicense (Admin)
+func (s *WhitelistService) UnsuspendLicense(ctx context.Context, req *pb.UnsuspendLicenseRequest) (*pb.License, error) {
+	if err := s.requireWrite(ctx, roleSupport); err != nil {
+		return nil, err
+	}
+
+	return s.setSuspended(ctx, req.LicenseKey, true, "", auditLicenseUnsuspend)
+}
+
+func (s *WhitelistService) setSuspended(ctx context.Context, licenseKey string, active bool, reason, action string) (*pb.License, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	updated, changed, err := s.suspendInTx(ctx, tx, licenseKey, active, reason, action)
+	if err != nil {
+		return nil, err
+	}
+	if !changed {
+		return updated, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	s.invalidateLicenses(ctx, licenseKey)
+	s.notify(licenseEvent(webhook.LicenseUpdated, updated))
+	return updated, nil
+}
+
+// suspendInTx is setSuspended inside tx, reporting whether anything changed.
+// Errors are gRPC statuses.
+func (s *WhitelistService) suspendInTx(ctx context.Context, tx *sql.Tx, licenseKey string, active bool, reason, action string) (*pb.License, bool, error) {
+	old, err := s.licenses.Lock(ctx, tx, licenseKey)
+	if err != nil {
+		return nil, false, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if old == nil || old.DeletedAt != nil {
+		return nil, false, status.Error(codes.NotFound, "license not found")
+	}
+	if old.IsActive == active && old.SuspendReason == reason {
+		return old, false, nil
+	}
+
+	if err := s.licenses.Change(ctx, tx, licenseKey, store.LicenseChange{IsActive: &active, SuspendReason: &reason}); err != nil {
+		return nil, false, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	updated, err := s.licenses.Get(ctx, tx, licenseKey)
+	if err != nil {
+		return nil, false, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := s.recordLicenseChange(ctx, tx, adminActor(ctx), action, licenseKey, old, updated); err != nil {
+		return nil, false, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	return updated, true, nil
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mkseven15/whitelist-server/internal/errinfo"
+	"github.com/mkseven15/whitelist-server/internal/hwid"
+	"github.com/mkseven15/whitelist-server/internal/store"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// Audit actor for licenses created by CreateTrialLicense
+const trialActor = "trial"
+
+// 43. CreateTrialLicense
+func (s *WhitelistService) CreateTrialLicense(ctx context.Context, req *pb.CreateTrialLicenseRequest) (*pb.CreateTrialLicenseResponse, error) {
+	if req.DurationSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "duration_seconds must not be negative")
+	}
+	if err := s.checkMaintenance(ctx); err != nil {
+		return nil, err
+	}
+	// Same one-shot token as ValidateLicense, so trials can't be farmed by script
+	if err := s.burnAccessToken(ctx, req.ProductId); err != nil {
+		return nil, err
+	}
+
+	device := hwid.Hash(req.Hwid)
+	if device == "" {
+		return nil, status.Error(codes.InvalidArgument, "hwid: required")
+	}
+	banned, err := s.bans.HWIDBanned(ctx, s.db, device)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if banned {
+		return nil, errinfo.Error(codes.PermissionDenied, pb.Reason_REASON_DEVICE_BANNED, "device is banned")
+	}
+
+	p, err := s.products.Get(ctx, s.db, req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if p == nil {
+		return nil, status.Error(codes.NotFound, "unknown product")
+	}
+	if p.Disabled || p.TrialDurationSeconds <= 0 {
+		return nil, status.Error(codes.FailedPrecondition, "no trials are offered for this product")
+	}
+	duration := p.TrialDurationSeconds
+	if req.DurationSeconds > 0 && req.DurationSeconds < duration {
+		duration = req.DurationSeconds
+	}
+	expiresAt := time.Now().Add(time.Duration(duration) * time.Second)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	keys, events, err := s.insertGeneratedLicenses(ctx, tx, trialActor, nil, 1, &pb.GenerateLicensesRequest{
+		ProductId:  req.ProductId,
+		IsActive:   true,
+		ExpiresAt:  timestamppb.New(expiresAt),
+		MaxDevices: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ip := clientIP(ctx)
+	started, err := s.trials.Start(ctx, tx, req.ProductId, device, ip, keys[0])
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if !started {
+		return nil, status.Error(codes.AlreadyExists, "a trial of this product was already used on this device or network")
+	}
+
+	if err := s.devices.Add(ctx, tx, keys[0], &store.Device{HWID: device}); err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	s.notify(events...)
+	log.Printf("Trial %s of %s issued to %s for %ds", keys[0], req.ProductId, ip, duration)
+
+	return &pb.CreateTrialLicenseResponse{LicenseKey: keys[0], ExpiresAt: timestamppb.New(expiresAt)}, nil
+}
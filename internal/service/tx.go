@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// inRetriedTx runs fn in a transaction and commits it, running all of it
+// again when the database fails it with a transient error (see
+// store.Retry.Tx). fn has to hand database errors back as they are or
+// through dbError, so they can be told apart, and must leave everything
+// outside the transaction alone: anything it sets up for after the commit
+// is redone on the next run. Errors that aren't gRPC statuses come back as
+// Internal.
+func (s *WhitelistService) inRetriedTx(ctx context.Context, call string, fn func(*sql.Tx) error) error {
+	err := s.txRetry.Tx(ctx, s.db, call, fn)
+	if _, ok := status.FromError(err); !ok {
+		return status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	return err
+}
+
+// dbError is an Internal status saying what failed, which keeps err behind
+// it for inRetriedTx.
+func dbError(what string, err error) error {
+	return &causedStatus{st: status.Newf(codes.Internal, "%s: %v", what, err), cause: err}
+}
+
+type causedStatus struct {
+	st    *status.Status
+	cause error
+}
+
+func (e *causedStatus) Error() string              { return e.st.Err().Error() }
+func (e *causedStatus) GRPCStatus() *status.Status { return e.st }
+func (e *causedStatus) Unwrap() error              { return e.cause }
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+	pbv2 "github.com/mkseven15/whitelist-server/proto/v2"
+)
+
+// WhitelistServiceV2 serves the whitelist.v2 API. Each call goes through
+// the v1 method of the same name, so both versions share tokens, lockouts,
+// logging and webhooks.
+type WhitelistServiceV2 struct {
+	pbv2.UnimplementedWhitelistServiceServer
+	s *WhitelistService
+}
+
+// V2 returns the v2 API backed by s.
+func (s *WhitelistService) V2() *WhitelistServiceV2 {
+	return &WhitelistServiceV2{s: s}
+}
+
+// GetAuthToken (v2)
+func (v *WhitelistServiceV2) GetAuthToken(ctx context.Context, req *pbv2.GetTokenRequest) (*pbv2.AuthTokenResponse, error) {
+	now := time.Now()
+	resp, err := v.s.GetAuthToken(ctx, &pb.GetTokenRequest{ApiKey: req.ApiKey, RefreshToken: req.RefreshToken, ProductId: req.ProductId})
+	if err != nil {
+		return nil, err
+	}
+	return &pbv2.AuthTokenResponse{
+		Token:            resp.Token,
+		ExpiresAt:        timestampIn(now, resp.ExpiresInSeconds),
+		RefreshToken:     resp.RefreshToken,
+		RefreshExpiresAt: timestampIn(now, resp.RefreshExpiresInSeconds),
+	}, nil
+}
+
+// ValidateLicense (v2)
+func (v *WhitelistServiceV2) ValidateLicense(ctx context.Context, req *pbv2.ValidateRequest) (*pbv2.ValidateResponse, error) {
+	now := time.Now()
+	resp, err := v.s.ValidateLicense(ctx, &pb.ValidateRequest{
+		LicenseKey:        req.LicenseKey,
+		ProductId:         req.ProductId,
+		Hwid:              req.Hwid,
+		ClientVersion:     req.ClientVersion,
+		Challenge:         req.Challenge,
+		ChallengeResponse: req.ChallengeResponse,
+		Locale:            req.Locale,
+		HwidComponents:    v1HwidComponents(req.HwidComponents),
+		ClientTime:        req.ClientTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := &pbv2.ValidateResponse{
+		Valid:             resp.Valid,
+		Reason:            v2Reason(resp.Reason),
+		Message:           resp.Message,
+		Metadata:          resp.Metadata,
+		RequiredVersion:   resp.RequiredVersion,
+		SuspendReason:     resp.SuspendReason,
+		RetryAfterSeconds: resp.RetryAfterSeconds,
+		ChallengeResponse: resp.ChallengeResponse,
+		ServerTime:        resp.ServerTime,
+		ClockSkewSeconds:  resp.ClockSkewSeconds,
+		Signature:         resp.Signature,
+		SigningKeyId:      resp.SigningKeyId,
+		Degraded:          resp.Degraded,
+	}
+	if resp.Valid {
+		out.ExpiresAt = timestampIn(now, resp.ExpiresInSeconds)
+		out.Features = resp.Features
+		for name, on := range resp.Features {
+			if on {
+				out.Entitlements = append(out.Entitlements, name)
+			}
+		}
+		slices.Sort(out.Entitlements)
+	}
+	return out, nil
+}
+
+// GetChallenge (v2)
+func (v *WhitelistServiceV2) GetChallenge(ctx context.Context, req *pbv2.GetChallengeRequest) (*pbv2.GetChallengeResponse, error) {
+	now := time.Now()
+	resp, err := v.s.GetChallenge(ctx, &pb.GetChallengeRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &pbv2.GetChallengeResponse{Challenge: resp.Challenge, ExpiresAt: timestampIn(now, resp.ExpiresInSeconds)}, nil
+}
+
+// GetServerTime (v2)
+func (v *WhitelistServiceV2) GetServerTime(ctx context.Context, req *pbv2.GetServerTimeRequest) (*pbv2.GetServerTimeResponse, error) {
+	resp, err := v.s.GetServerTime(ctx, &pb.GetServerTimeRequest{ClientTime: req.ClientTime})
+	if err != nil {
+		return nil, err
+	}
+	return &pbv2.GetServerTimeResponse{ServerTime: resp.ServerTime, ClockSkewSeconds: resp.ClockSkewSeconds}, nil
+}
+
+// GetPublicKey (v2)
+func (v *WhitelistServiceV2) GetPublicKey(ctx context.Context, req *pbv2.GetPublicKeyRequest) (*pbv2.GetPublicKeyResponse, error) {
+	resp, err := v.s.GetPublicKey(ctx, &pb.GetPublicKeyRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &pbv2.GetPublicKeyResponse{PublicKey: resp.PublicKey, KeyId: resp.KeyId}, nil
+}
+
+// GetSigningKeys (v2)
+func (v *WhitelistServiceV2) GetSigningKeys(ctx context.Context, req *pbv2.GetSigningKeysRequest) (*pbv2.GetSigningKeysResponse, error) {
+	resp, err := v.s.GetSigningKeys(ctx, &pb.GetSigningKeysRequest{})
+	if err != nil {
+		return nil, err
+	}
+	out := &pbv2.GetSigningKeysResponse{}
+	for _, k := range resp.Keys {
+		out.Keys = append(out.Keys, &pbv2.SigningKey{Kid: k.Kid, Kty: k.Kty, Crv: k.Crv, X: k.X, Alg: k.Alg, PublicKey: k.PublicKey, Active: k.Active})
+	}
+	return out, nil
+}
+
+// v2Reason is the v2 value of reason, which has the same name.
+func v2Reason(reason pb.Reason) pbv2.Reason {
+	return pbv2.Reason(pbv2.Reason_value[reason.String()])
+}
+
+// v1HwidComponents is c as a v1 message; nil stays nil.
+func v1HwidComponents(c *pbv2.HwidComponents) *pb.HwidComponents {
+	if c == nil {
+		return nil
+	}
+	return &pb.HwidComponents{Cpu: c.Cpu, Disk: c.Disk, Mac: c.Mac, MachineGuid: c.MachineGuid}
+}
+
+// timestampIn turns v1's seconds-from-now into a time, nil for 0 (never or
+// not issued).
+func timestampIn(now time.Time, seconds int64) *timestamppb.Timestamp {
+	if seconds <= 0 {
+		return nil
+	}
+	return timestamppb.New(now.Add(time.Duration(seconds) * time.Second).Truncate(time.Second))
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"strconv"
+	"strings"
+)
+
+// compareVersions orders dotted version strings such as "1.4.2", "v2.0" or
+// "1.5.0-beta.1" and returns -1, 0 or 1. Numeric parts compare as numbers,
+// missing parts count as 0, and a pre-release sorts before its release.
+func compareVersions(a, b string) int {
+	a, aPre, _ := strings.Cut(strings.TrimPrefix(strings.TrimSpace(a), "v"), "-")
+	b, bPre, _ := strings.Cut(strings.TrimPrefix(strings.TrimSpace(b), "v"), "-")
+
+	if c := compareParts(strings.Split(a, "."), strings.Split(b, ".")); c != 0 {
+		return c
+	}
+	switch {
+	case aPre == bPre:
+		return 0
+	case aPre == "":
+		return 1
+	case bPre == "":
+		return -1
+	}
+	return compareParts(strings.Split(aPre, "."), strings.Split(bPre, "."))
+}
+
+func compareParts(a, b []string) int {
+	for i := 0; i < max(len(a), len(b)); i++ {
+		var x, y string
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		if c := comparePart(x, y); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func comparePart(x, y string) int {
+	if x == "" {
+		x = "0"
+	}
+	if y == "" {
+		y = "0"
+	}
+	xn, xErr := strconv.ParseUint(x, 10, 64)
+	yn, yErr := strconv.ParseUint(y, 10, 64)
+	switch {
+	case xErr == nil && yErr == nil:
+		switch {
+		case xn < yn:
+			return -1
+		case xn > yn:
+			return 1
+		}
+		return 0
+	case xErr == nil:
+		// Numbers sort before words, as in semver pre-releases
+		return -1
+	case yErr == nil:
+		return 1
+	}
+	return strings.Compare(x, y)
+}
+
+// validVersion reports whether v looks like something compareVersions can
+// order meaningfully: it starts with a number.
+func validVersion(v string) bool {
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+	return v != "" && v[0] >= '0' && v[0] <= '9'
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mkseven15/whitelist-server/internal/cache"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// watchPollInterval is how often a watch re-reads its license even without a
+// local change, to pick up edits made through other instances or directly in
+// the database.
+const watchPollInterval = 30 * time.Second
+
+// watchHub wakes WatchLicense streams when their license changes.
+type watchHub struct {
+	mu     sync.Mutex
+	subs   map[string]map[chan struct{}]struct{}
+	closed chan struct{}
+	once   sync.Once
+}
+
+func newWatchHub() *watchHub {
+	return &watchHub{
+		subs:   map[string]map[chan struct{}]struct{}{},
+		closed: make(chan struct{}),
+	}
+}
+
+// subscribe returns a channel that receives after key changes. Wake-ups
+// coalesce, so a slow watcher sees one signal for several changes.
+func (h *watchHub) subscribe(key string) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	h.mu.Lock()
+	if h.subs[key] == nil {
+		h.subs[key] = map[chan struct{}]struct{}{}
+	}
+	h.subs[key][ch] = struct{}{}
+	h.mu.Unlock()
+
+	return ch, func() {
+		h.mu.Lock()
+		delete(h.subs[key], ch)
+		if len(h.subs[key]) == 0 {
+			delete(h.subs, key)
+		}
+		h.mu.Unlock()
+	}
+}
+
+func (h *watchHub) publish(keys ...string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, key := range keys {
+		for ch := range h.subs[key] {
+			select {
+			case ch <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// close ends every stream, current and future.
+func (h *watchHub) close() {
+	h.once.Do(func() { close(h.closed) })
+}
+
+// StopWatches ends open WatchLicense streams. Call it before draining the
+// servers; the streams would otherwise hold the drain open until it times out.
+func (s *WhitelistService) StopWatches() {
+	s.watches.close()
+}
+
+// 28. WatchLicense
+func (s *WhitelistService) WatchLicense(req *pb.WatchLicenseRequest, stream grpc.ServerStreamingServer[pb.LicenseStatusEvent]) error {
+	ctx := stream.Context()
+	if err := s.burnAccessToken(ctx, req.ProductId); err != nil {
+		return err
+	}
+
+	// Subscribe before the first read so no change slips in between
+	changed, unsubscribe := s.watches.subscribe(req.LicenseKey)
+	defer unsubscribe()
+
+	poll := time.NewTicker(watchPollInterval)
+	defer poll.Stop()
+
+	var last *pb.LicenseStatusEvent
+	for {
+		l, err := s.licenseState(ctx, req.LicenseKey)
+		if err != nil {
+			return status.Errorf(codes.Internal, "db error: %v", err)
+		}
+		ev := licenseStatus(l, req.ProductId, time.Now())
+		if last == nil && ev.Status == pb.LicenseStatus_LICENSE_STATUS_REVOKED {
+			return status.Error(codes.NotFound, "license not found")
+		}
+		if last == nil || ev.Status != last.Status || !ev.ExpiresAt.AsTime().Equal(last.ExpiresAt.AsTime()) {
+			if err := stream.Send(ev); err != nil {
+				return err
+			}
+			last = ev
+		}
+		if ev.Status == pb.LicenseStatus_LICENSE_STATUS_REVOKED {
+			return nil
+		}
+
+		// Wake up at expiry too, nothing else would signal it
+		expiry := time.NewTimer(time.Until(ev.ExpiresAt.AsTime()))
+		if ev.Status != pb.LicenseStatus_LICENSE_STATUS_ACTIVE || ev.ExpiresAt == nil {
+			expiry.Stop()
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.watches.closed:
+			return status.Error(codes.Unavailable, "server shutting down, reconnect")
+		case <-changed:
+		case <-poll.C:
+		case <-expiry.C:
+		}
+		expiry.Stop()
+	}
+}
+
+// licenseStatus describes l as seen by a client of productID.
+func licenseStatus(l *cache.License, productID string, now time.Time) *pb.LicenseStatusEvent {
+	ev := &pb.LicenseStatusEvent{ChangedAt: timestamppb.New(now)}
+	if l != nil && l.ExpiresAt != nil {
+		ev.ExpiresAt = timestamppb.New(*l.ExpiresAt)
+	}
+
+	switch {
+	case l == nil || l.ProductID != productID:
+		ev.Status, ev.Reason, ev.Message = pb.LicenseStatus_LICENSE_STATUS_REVOKED, pb.Reason_REASON_LICENSE_NOT_FOUND, "License not found"
+	case l.ProductDisabled:
+		ev.Status, ev.Reason, ev.Message = pb.LicenseStatus_LICENSE_STATUS_SUSPENDED, pb.Reason_REASON_PRODUCT_DISABLED, "Product is disabled"
+	case !l.IsActive:
+		ev.Status, ev.Reason, ev.Message, ev.SuspendReason = pb.LicenseStatus_LICENSE_STATUS_SUSPENDED, pb.Reason_REASON_SUSPENDED, "License is suspended", l.SuspendReason
+	case l.ExpiresAt != nil && !l.ExpiresAt.After(now):
+		ev.Status, ev.Reason, ev.Message = pb.LicenseStatus_LICENSE_STATUS_EXPIRED, pb.Reason_REASON_EXPIRED, "License expired"
+	default:
+		ev.Status, ev.Valid, ev.Reason, ev.Message = pb.LicenseStatus_LICENSE_STATUS_ACTIVE, true, pb.Reason_REASON_OK, "Authenticated"
+	}
+	return ev
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/mkseven15/whitelist-server/internal/notify"
+	"github.com/mkseven15/whitelist-server/internal/webhook"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// licenseEvent builds a lifecycle event carrying the license as data.
+func licenseEvent(eventType string, l *pb.License) webhook.Event {
+	e := webhook.Event{Type: eventType, LicenseKey: l.LicenseKey}
+	data, err := protojson.Marshal(l)
+	if err != nil {
+		log.Printf("webhook: encode license %s: %v", l.LicenseKey, err)
+		return e
+	}
+	e.Data = data
+	return e
+}
+
+// deviceEvent builds an hwid.* event for a validation request.
+func deviceEvent(eventType string, req *pb.ValidateRequest, maxDevices int) webhook.Event {
+	data, _ := json.Marshal(map[string]interface{}{
+		"product_id":  req.ProductId,
+		"hwid":        req.Hwid,
+		"max_devices": maxDevices,
+	})
+	return webhook.Event{Type: eventType, LicenseKey: req.LicenseKey, Data: data}
+}
+
+// notify hands events to the webhook dispatcher. Call it only once the change
+// behind them has committed.
+func (s *WhitelistService) notify(events ...webhook.Event) {
+	for _, e := range events {
+		s.hooks.Send(e)
+	}
+}
+
+// failureStreaks counts consecutive failed validations per license key.
+type failureStreaks struct {
+	threshold int
+
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+func newFailureStreaks(threshold int) *failureStreaks {
+	return &failureStreaks{threshold: threshold, counts: make(map[string]int)}
+}
+
+// record notes one validation outcome for key and reports the streak length
+// when it has just reached the threshold, so each streak fires only once.
+func (f *failureStreaks) record(key string, valid bool) (int, bool) {
+	if f.threshold <= 0 {
+		return 0, false
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if valid {
+		delete(f.counts, key)
+		return 0, false
+	}
+	f.counts[key]++
+	n := f.counts[key]
+	return n, n == f.threshold
+}
+
+// trackValidation feeds a ValidateLicense outcome into the failure streaks.
+// Unknown keys and request errors (bad token etc.) aren't counted; they say
+// nothing about the license and would let anyone grow the map.
+func (s *WhitelistService) trackValidation(req *pb.ValidateRequest, resp *pb.ValidateResponse, err error) {
+	if err != nil {
+		return
+	}
+	switch resp.Reason {
+	case pb.Reason_REASON_LICENSE_NOT_FOUND, pb.Reason_REASON_UNKNOWN_PRODUCT, pb.Reason_REASON_CLIENT_OUTDATED,
+		pb.Reason_REASON_DEVICE_BANNED, pb.Reason_REASON_REGION_NOT_ALLOWED, pb.Reason_REASON_LOCKED_OUT:
+		return
+	}
+	n, fire := s.streaks.record(req.LicenseKey, resp.Valid)
+	if !fire {
+		return
+	}
+	data, _ := json.Marshal(map[string]interface{}{
+		"product_id":  req.ProductId,
+		"failures":    n,
+		"last_result": resp.Message,
+		"last_hwid":   req.Hwid,
+	})
+	s.notify(webhook.Event{Type: webhook.ValidationFailureStreak, LicenseKey: req.LicenseKey, Data: data})
+	s.alerts.Send(notify.Alert{
+		Kind: notify.ValidationFailureStreak,
+		Key:  req.LicenseKey,
+		Text: fmt.Sprintf("License %s (%s) failed %d validations in a row, last: %s", req.LicenseKey, req.ProductId, n, resp.Message),
+	})
+}
@@ -2,163 +2,1063 @@ package service
 
 import (
 	"context"
+	"crypto/ed25519"
 	"database/sql"
+	"errors"
+	"fmt"
 	"log"
-	"os"
+	"sync"
+	"sync/atomic"
+	"text/template"
 	"time"
 
+	"golang.org/x/sync/singleflight"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/mkseven15/whitelist-server/internal/cache"
+	"github.com/mkseven15/whitelist-server/internal/config"
+	"github.com/mkseven15/whitelist-server/internal/errinfo"
+	"github.com/mkseven15/whitelist-server/internal/geoip"
+	"github.com/mkseven15/whitelist-server/internal/hwid"
+	"github.com/mkseven15/whitelist-server/internal/i18n"
+	"github.com/mkseven15/whitelist-server/internal/ipban"
+	"github.com/mkseven15/whitelist-server/internal/jobs"
+	"github.com/mkseven15/whitelist-server/internal/mailer"
+	"github.com/mkseven15/whitelist-server/internal/notify"
+	"github.com/mkseven15/whitelist-server/internal/store"
+	"github.com/mkseven15/whitelist-server/internal/webhook"
 	pb "github.com/mkseven15/whitelist-server/proto"
 )
 
 type WhitelistService struct {
 	pb.UnimplementedWhitelistServiceServer
-	db *sql.DB
+	db       *sql.DB
+	hashSalt []byte
+	// HASH_SALT_PREVIOUS, nil when unset (see withPreviousSalt)
+	previousSalt []byte
+
+	// Optional cache in front of ValidateLicense's license lookup
+	cache       cache.Cache
+	cacheTTL    time.Duration
+	productTTL  map[string]time.Duration
+	licenseLoad singleflight.Group
+	// Open WatchLicense streams, woken by invalidateLicenses
+	watches *watchHub
+	// Access tokens are in the database too unless Redis is configured
+	licenses store.LicenseStore
+	tokens   store.TokenStore
+	apiKeys  store.APIKeyStore
+	// Reruns the busiest transactions whole after a transient error (see
+	// inRetriedTx)
+	txRetry store.Retry
+	// The rest of the tables; not behind the breaker or retried on their own
+	admins        store.AdminStore
+	audit         store.AuditStore
+	cleaner       store.CleanupStore
+	bans          store.BanStore
+	credits       store.CreditStore
+	lockouts      store.LockoutStore
+	devices       store.DeviceStore
+	licenseLists  store.LicenseListStore
+	customers     store.CustomerStore
+	events        store.EventStore
+	stats         store.StatsStore
+	revisions     store.RevisionStore
+	trials        store.TrialStore
+	resellers     store.ResellerStore
+	sessions      store.SessionStore
+	leases        store.LeaseStore
+	releases      store.ReleaseStore
+	products      store.ProductStore
+	plans         store.PlanStore
+	messages      store.MessageStore
+	challenges    store.ChallengeStore
+	maintenance   store.MaintenanceStore
+	deliveries    store.DeliveryStore
+	reminderStore store.ReminderStore
+	billing       store.StripeStore
+	// nil never opens
+	breaker *store.Breaker
+	// Answers ValidateLicense while breaker is open
+	failOpen *failOpen
+
+	hooks   *webhook.Dispatcher
+	alerts  *notify.Telegram
+	streaks *failureStreaks
+
+	// Checked by the public endpoints' interceptor; see IPBans
+	ipBans     *ipban.List
+	autoBan    config.AutoBan
+	ipFailures *ipFailures
+	lockout    config.Lockout
+	// Slows down GetAuthToken for IPs sending invalid API keys
+	authBackoff     *authBackoff
+	authBanDuration time.Duration
+
+	// Country lookups for region restrictions; nil knows no countries
+	geo *geoip.DB
+
+	stripe config.Stripe
+
+	// Signs offline license files; nil disables ExportLicenseFile
+	licenseSigningKey ed25519.PrivateKey
+	// Retired keys' public halves, listed by GetSigningKeys
+	licenseOldKeys    []ed25519.PublicKey
+	licenseFileTTL    time.Duration
+	licenseFileMaxTTL time.Duration
+
+	// Emails license keys; nil disables IssueLicenseToEmail
+	mail        mailer.Mailer
+	mailSubject *template.Template
+	mailBody    *template.Template
+
+	// nil when expiry reminders are off
+	reminders *expiryReminders
+	// Sends Discord DMs once the bot is up
+	discordDM atomic.Pointer[func(userID, text string) error]
+
+	// Products' own wording of validation messages; see localMessage
+	productMessages atomic.Pointer[map[string]i18n.Catalog]
+	// Products' key formats, so mistyped keys are turned away without a query
+	keyFormats atomic.Pointer[map[string]keyFormat]
+
+	// Non-empty forces maintenance mode on; see checkMaintenance
+	maintenanceMessage string
+
+	adminSecrets    []string
+	adminJWTSecret  []byte
+	adminSessionTTL time.Duration
+	// Deletes, bulk changes and exports need a TOTP code even from callers
+	// who haven't enrolled (who then can't make them)
+	adminOTPRequired bool
+	tokenTTL         time.Duration
+	tokenPattern     keyPattern
+	// 0 issues no refresh tokens
+	refreshTokenTTL time.Duration
+	sessionTTL      time.Duration
+	leaseTTL        time.Duration
+	// Signed requests' timestamps may be this far off
+	signatureMaxSkew time.Duration
+	challengeTTL     time.Duration
+	// Components a new HWID must share with a bound device to replace it;
+	// 0 turns that off
+	hwidMatchThreshold int
+	hwidRebindCooldown time.Duration
+	cleanupInterval    time.Duration
+	cleanupJitter      time.Duration
+	// 0 keeps them for good
+	trialRetention           time.Duration
+	validationEventRetention time.Duration
+	// Only the replica holding it runs cleanups and reminders
+	leader *jobs.Leader
+
+	stop chan struct{}
+	wg   sync.WaitGroup
 }
 
 // NewWhitelistService initializes the service AND starts the background cleaner
-func NewWhitelistService(db *sql.DB) *WhitelistService {
-	s := &WhitelistService{db: db}
-	
-	// Start Automatic Token Cleanup in the background
-	go s.cleanupExpiredTokens()
-	
+// (lc may be nil to always read licenses from the database, tokens nil to
+// keep access tokens there, hooks and alerts nil to send no webhooks or
+// Telegram alerts, geo nil to skip region checks).
+func NewWhitelistService(db *sql.DB, cfg *config.Config, lc cache.Cache, tokens store.TokenStore, hooks *webhook.Dispatcher, alerts *notify.Telegram, geo *geoip.DB) *WhitelistService {
+	// Already checked by config.Validate
+	signingKey, _ := cfg.LicenseFiles.Key()
+	previousKeys, _ := cfg.LicenseFiles.PreviousKeys()
+	mailSubject, mailBody, _ := cfg.Mail.Templates()
+	tokenPattern, _ := newKeyPattern("", 1, cfg.TokenLength, cfg.TokenCharset)
+
+	// Retries happen inside the breaker, so it counts each call once
+	retry := store.Retry(cfg.DBRetry)
+	breaker := store.NewBreaker(cfg.DBBreaker.Failures, cfg.DBBreaker.Cooldown)
+	if tokens == nil {
+		tokens = store.BreakTokens(store.RetryTokens(store.NewSQLTokens(db), retry), breaker)
+	} else {
+		tokens = store.RetryTokens(tokens, retry)
+	}
+
+	s := &WhitelistService{
+		db:                       db,
+		licenses:                 store.BreakLicenses(store.RetryLicenses(store.NewSQLLicenses(db), retry), breaker),
+		tokens:                   tokens,
+		apiKeys:                  store.BreakAPIKeys(store.RetryAPIKeys(store.NewSQLAPIKeys(), retry), breaker),
+		txRetry:                  retry,
+		admins:                   store.NewSQLAdmins(),
+		audit:                    store.NewSQLAudit(),
+		cleaner:                  store.NewSQLCleanup(),
+		bans:                     store.NewSQLBans(),
+		credits:                  store.NewSQLCredits(),
+		lockouts:                 store.NewSQLLockouts(),
+		devices:                  store.NewSQLDevices(),
+		licenseLists:             store.NewSQLLicenseLists(),
+		customers:                store.NewSQLCustomers(),
+		events:                   store.NewSQLEvents(),
+		stats:                    store.NewSQLStats(),
+		revisions:                store.NewSQLRevisions(),
+		trials:                   store.NewSQLTrials(),
+		resellers:                store.NewSQLResellers(),
+		sessions:                 store.NewSQLSessions(),
+		leases:                   store.NewSQLLeases(),
+		releases:                 store.NewSQLReleases(),
+		products:                 store.NewSQLProducts(),
+		plans:                    store.NewSQLPlans(),
+		messages:                 store.NewSQLMessages(),
+		challenges:               store.NewSQLChallenges(),
+		maintenance:              store.NewSQLMaintenance(),
+		deliveries:               store.NewSQLDeliveries(),
+		reminderStore:            store.NewSQLReminders(),
+		billing:                  store.NewSQLStripe(),
+		breaker:                  breaker,
+		failOpen:                 newFailOpen(cfg.FailOpenWindow),
+		cache:                    lc,
+		cacheTTL:                 cfg.LicenseCacheTTL,
+		productTTL:               cfg.LicenseCacheProductTTL,
+		hooks:                    hooks,
+		alerts:                   alerts,
+		streaks:                  newFailureStreaks(cfg.FailureStreakThreshold),
+		ipBans:                   ipban.NewList(),
+		geo:                      geo,
+		autoBan:                  cfg.AutoBan,
+		ipFailures:               newIPFailures(cfg.AutoBan.Failures, cfg.AutoBan.Window),
+		lockout:                  cfg.Lockout,
+		authBackoff:              newAuthBackoff(cfg.AuthBackoff.Failures, cfg.AuthBackoff.Base, cfg.AuthBackoff.Max),
+		authBanDuration:          cfg.AuthBackoff.BanDuration,
+		stripe:                   cfg.Stripe,
+		watches:                  newWatchHub(),
+		licenseSigningKey:        signingKey,
+		licenseOldKeys:           previousKeys,
+		licenseFileTTL:           cfg.LicenseFiles.ValidFor,
+		licenseFileMaxTTL:        cfg.LicenseFiles.MaxValidFor,
+		mail:                     newMailer(cfg.Mail),
+		mailSubject:              mailSubject,
+		mailBody:                 mailBody,
+		reminders:                newExpiryReminders(cfg.ExpiryReminders),
+		hashSalt:                 []byte(cfg.HashSalt),
+		adminSecrets:             cfg.AdminSecrets(),
+		adminJWTSecret:           []byte(cfg.AdminJWTSecret),
+		adminSessionTTL:          cfg.AdminSessionTTL,
+		maintenanceMessage:       cfg.MaintenanceMessage,
+		adminOTPRequired:         cfg.AdminOTPRequired,
+		tokenTTL:                 cfg.TokenTTL,
+		tokenPattern:             tokenPattern,
+		refreshTokenTTL:          cfg.RefreshTokenTTL,
+		sessionTTL:               cfg.LicenseSessionTTL,
+		leaseTTL:                 cfg.FloatingLeaseTTL,
+		signatureMaxSkew:         cfg.SignatureMaxSkew,
+		challengeTTL:             cfg.ChallengeTTL,
+		hwidMatchThreshold:       cfg.HwidMatchThreshold,
+		hwidRebindCooldown:       cfg.HwidRebindCooldown,
+		cleanupInterval:          cfg.CleanupInterval,
+		cleanupJitter:            min(cfg.CleanupJitter, cfg.CleanupInterval),
+		trialRetention:           cfg.TrialRetention,
+		validationEventRetention: cfg.ValidationEventRetention,
+		leader:                   jobs.NewLeader(db, "background jobs"),
+		stop:                     make(chan struct{}),
+	}
+
+	if cfg.HashSaltPrevious != nil {
+		s.previousSalt = append([]byte{}, *cfg.HashSaltPrevious...)
+	}
+
+	// Start Automatic Cleanup in the background
+	s.wg.Add(1)
+	go s.runCleanup()
+
+	if s.reminders != nil {
+		s.wg.Add(1)
+		go s.runExpiryReminders()
+	}
+
 	return s
 }
 
-// cleanupExpiredTokens runs every minute to remove old tokens from DB
-func (s *WhitelistService) cleanupExpiredTokens() {
-	ticker := time.NewTicker(1 * time.Minute)
-	defer ticker.Stop()
-	
-	for range ticker.C {
-		// Delete tokens where 'expires_at' is in the past
-		_, err := s.db.Exec("DELETE FROM access_tokens WHERE expires_at < NOW()")
+// Close stops the background cleaner and waits for it to finish, then hands
+// the jobs over to another replica.
+// The database handle is left open; it belongs to the caller.
+func (s *WhitelistService) Close() {
+	close(s.stop)
+	s.wg.Wait()
+	s.leader.Release()
+}
+
+// 1. GetAuthToken: Now validates API Key (or a refresh token) before issuing token
+func (s *WhitelistService) GetAuthToken(ctx context.Context, req *pb.GetTokenRequest) (*pb.AuthTokenResponse, error) {
+	// Validate Input. Field rules can't say "one of two fields", and turning
+	// the pair into a oneof would change the generated types clients use.
+	if req.ApiKey == "" && req.RefreshToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "API Key or refresh token required")
+	}
+
+	// Addresses guessing keys are held off before the key is even looked at
+	ip := clientIP(ctx)
+	if wait := s.authBackoff.blocked(ip, time.Now()); wait > 0 {
+		return nil, errinfo.Errorf(codes.ResourceExhausted, pb.Reason_REASON_RATE_LIMITED, "too many invalid API keys, retry in %ds", int64(wait.Seconds())+1)
+	}
+
+	var keyHash string
+	var resp *pb.AuthTokenResponse
+	err := s.inRetriedTx(ctx, "tx.get_auth_token", func(tx *sql.Tx) error {
+		resp = nil
+		var err error
+		if req.RefreshToken != "" {
+			keyHash, err = s.redeemRefreshToken(ctx, tx, req.RefreshToken)
+		} else {
+			keyHash, err = s.checkAPIKey(ctx, tx, req.ApiKey)
+		}
 		if err != nil {
-			log.Printf("Error cleaning up tokens: %v", err)
+			return dbError("DB Check Failed", err)
+		}
+		if keyHash == "" {
+			return nil
+		}
+		if req.ProductId != "" {
+			if err := s.requireProducts(ctx, tx, req.ProductId); err != nil {
+				return err
+			}
 		}
+		resp, err = s.issueTokens(ctx, tx, keyHash, req.ProductId)
+		return err
+	})
+	if keyHash == "" && err == nil {
+		if block, ban := s.authBackoff.fail(ip, time.Now()); ban {
+			s.autoBanIP(ctx, ip, "repeated invalid API keys on GetAuthToken", s.authBanDuration)
+		} else if block > 0 {
+			log.Printf("Blocked %s from GetAuthToken for %s after invalid API keys", ip, block)
+		}
+		if req.RefreshToken != "" {
+			return nil, errinfo.Error(codes.Unauthenticated, pb.Reason_REASON_REFRESH_TOKEN_INVALID, "Invalid or Expired refresh token")
+		}
+		return nil, errinfo.Error(codes.Unauthenticated, pb.Reason_REASON_API_KEY_INVALID, "Invalid or Expired API Key")
 	}
+	if keyHash != "" {
+		s.authBackoff.succeed(ip)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
 }
 
-func (s *WhitelistService) checkAdmin(ctx context.Context) error {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return status.Error(codes.Unauthenticated, "metadata missing")
+// issueTokens issues an access token for productID inside tx, and a refresh
+// token for the API key keyHash names when those are enabled.
+func (s *WhitelistService) issueTokens(ctx context.Context, tx *sql.Tx, keyHash, productID string) (*pb.AuthTokenResponse, error) {
+	// Generate Token (only its hash is stored)
+	token, err := s.tokenPattern.generate()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
 	}
-	values := md.Get("x-admin-secret")
-	if len(values) == 0 || values[0] != os.Getenv("ADMIN_SECRET") {
-		return status.Error(codes.PermissionDenied, "invalid admin secret")
+	if err := s.tokens.Issue(ctx, tx, s.hashSecret(token), productID, s.tokenTTL); err != nil {
+		return nil, dbError("failed to generate token", err)
 	}
-	return nil
+	if err := s.stats.CountToken(ctx, tx, productID); err != nil {
+		return nil, dbError("db error", err)
+	}
+	resp := &pb.AuthTokenResponse{
+		Token:            token,
+		ExpiresInSeconds: int64(s.tokenTTL / time.Second),
+	}
+
+	if s.refreshTokenTTL > 0 {
+		refresh, err := newAccessToken()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
+		}
+		if err := s.apiKeys.IssueRefreshToken(ctx, tx, s.hashSecret(refresh), keyHash, s.refreshTokenTTL); err != nil {
+			return nil, dbError("failed to generate token", err)
+		}
+		resp.RefreshToken = refresh
+		resp.RefreshExpiresInSeconds = int64(s.refreshTokenTTL / time.Second)
+	}
+	return resp, nil
 }
 
-// 1. GetAuthToken: Now validates API Key before issuing token
-func (s *WhitelistService) GetAuthToken(ctx context.Context, req *pb.GetTokenRequest) (*pb.AuthTokenResponse, error) {
-	// Validate Input
-	if req.ApiKey == "" {
-		return nil, status.Error(codes.InvalidArgument, "API Key required")
+// checkAPIKey returns the stored hash of apiKey, "" unless it exists and
+// hasn't expired.
+func (s *WhitelistService) checkAPIKey(ctx context.Context, q store.Querier, apiKey string) (string, error) {
+	var keyHash string
+	err := s.withPreviousSalt(apiKey, func(hash string) error {
+		ok, err := s.apiKeys.Check(ctx, q, hash)
+		if err == nil && !ok {
+			return sql.ErrNoRows
+		}
+		keyHash = hash
+		return err
+	}, func(old, current string) error {
+		keyHash = current
+		return s.apiKeys.Rehash(ctx, q, old, current)
+	})
+	if err == sql.ErrNoRows {
+		return "", nil
 	}
+	return keyHash, err
+}
 
-	// Check DB: Key must exist AND (ExpiresAt is NULL OR ExpiresAt > Now)
-	var exists bool
-	query := `SELECT EXISTS(
-		SELECT 1 FROM api_keys 
-		WHERE key = $1 
-		AND (expires_at IS NULL OR expires_at > NOW())
-	)`
-	
-	err := s.db.QueryRow(query, req.ApiKey).Scan(&exists)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "DB Check Failed: %v", err)
+// redeemRefreshToken uses up a refresh token and returns the hash of its
+// API key, "" unless both are live.
+func (s *WhitelistService) redeemRefreshToken(ctx context.Context, q store.Querier, token string) (string, error) {
+	var keyHash string
+	err := s.withPreviousSalt(token, func(hash string) error {
+		var err error
+		keyHash, err = s.apiKeys.RedeemRefreshToken(ctx, q, hash)
+		if err == nil && keyHash == "" {
+			return sql.ErrNoRows
+		}
+		return err
+	}, func(string, string) error {
+		// Used up, so there's nothing left to move
+		return nil
+	})
+	if err == sql.ErrNoRows {
+		return "", nil
 	}
-	if !exists {
-		return nil, status.Error(codes.Unauthenticated, "Invalid or Expired API Key")
+	return keyHash, err
+}
+
+// 2. ValidateLicense
+func (s *WhitelistService) ValidateLicense(ctx context.Context, req *pb.ValidateRequest) (*pb.ValidateResponse, error) {
+	// The signature covers the HWID as sent; everything else sees its hash
+	signed := req
+	req = withHashedHwid(req)
+	resp, signingSecret, err := s.validateLicense(ctx, req, signed)
+	if err == nil {
+		s.failOpen.remember(req, resp, signingSecret, time.Now())
+	} else if status.Code(err) == codes.Internal && s.breaker.Open() {
+		// The database is down: keep recent customers working rather than
+		// locking everyone out. Logging the answer and the lockout counters
+		// would only wait on the database too.
+		r, ferr := s.failOpenAnswer(ctx, req, signed)
+		if ferr != nil {
+			s.trackValidation(req, nil, ferr)
+			return nil, ferr
+		}
+		if r != nil {
+			stampTime(req, r, time.Now())
+			failOpenAnswers.Add(1)
+			s.trackValidation(req, r, nil)
+			r.Message = s.localMessage(req.ProductId, req.Locale, r.Reason, r.Message)
+			s.signResponse(signed, r)
+			return r, nil
+		}
 	}
+	s.logValidation(ctx, req, resp, err)
+	s.trackValidation(req, resp, err)
+	s.trackClientFailure(ctx, resp, err)
+	s.trackLockout(ctx, req, resp, err)
+	// Only now, so the log, events and webhooks keep the English text
+	if resp != nil {
+		resp.Message = s.localMessage(req.ProductId, req.Locale, resp.Reason, resp.Message)
+		s.signResponse(signed, resp)
+	}
+	return resp, err
+}
 
-	// Generate Token
-	var token string
-	err = s.db.QueryRow("INSERT INTO access_tokens DEFAULT VALUES RETURNING token").Scan(&token)
+// validateLicense is ValidateLicense; it also returns the product's signing
+// secret, "" if it has none.
+func (s *WhitelistService) validateLicense(ctx context.Context, req, signed *pb.ValidateRequest) (*pb.ValidateResponse, string, error) {
+	if err := s.burnAccessToken(ctx, req.ProductId); err != nil {
+		return nil, "", err
+	}
+	secrets, err := s.signingSecrets(ctx, req.ProductId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
+		return nil, "", err
 	}
+	// StartSession's request encodes the same as the ValidateRequest it
+	// passes here, so direct gRPC signatures hold for either
+	if err := s.checkSignatureWith(ctx, signed, secrets); err != nil {
+		return nil, "", err
+	}
+	resp, err := s.checkLicense(ctx, req)
+	return resp, secrets[req.ProductId], err
+}
 
-	return &pb.AuthTokenResponse{
-		Token:            token,
-		ExpiresInSeconds: 30,
-	}, nil
+// checkLicense is ValidateLicense after the access token check.
+func (s *WhitelistService) checkLicense(ctx context.Context, req *pb.ValidateRequest) (*pb.ValidateResponse, error) {
+	resp, err := s.checkLicenseKey(ctx, req)
+	if resp != nil {
+		resp.ChallengeResponse = answerChallenge(req.LicenseKey, req.Challenge, resp.Valid)
+		stampTime(req, resp, time.Now())
+	}
+	return resp, err
 }
 
-// 2. ValidateLicense
-func (s *WhitelistService) ValidateLicense(ctx context.Context, req *pb.ValidateRequest) (*pb.ValidateResponse, error) {
-	md, ok := metadata.FromIncomingContext(ctx)
+// checkLicenseKey decides checkLicense's answer.
+func (s *WhitelistService) checkLicenseKey(ctx context.Context, req *pb.ValidateRequest) (*pb.ValidateResponse, error) {
+	// Every key of the product fits its format, so one that doesn't is a
+	// typo (or a guess) and isn't worth a trip to the database
+	if s.keyFormatOf(req.ProductId).check(req.LicenseKey) != nil {
+		return &pb.ValidateResponse{Valid: false, Reason: pb.Reason_REASON_LICENSE_NOT_FOUND, Message: "License not found"}, nil
+	}
+
+	// Locked out callers learn nothing about the key
+	retryAfter, err := s.lockedOut(ctx, req.LicenseKey, clientIP(ctx))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if retryAfter > 0 {
+		return &pb.ValidateResponse{Valid: false, Reason: pb.Reason_REASON_LOCKED_OUT, Message: lockedOutMessage, RetryAfterSeconds: int64(retryAfter.Seconds()) + 1}, nil
+	}
+
+	// Used up whatever the outcome, so it can't be tried twice
+	ok, err := s.redeemChallenge(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
 	if !ok {
-		return nil, status.Error(codes.Unauthenticated, "no metadata")
+		return &pb.ValidateResponse{Valid: false, Reason: pb.Reason_REASON_INVALID_CHALLENGE, Message: invalidChallengeMessage}, nil
 	}
-	tokens := md.Get("x-access-token")
-	if len(tokens) == 0 {
-		return nil, status.Error(codes.Unauthenticated, "missing x-access-token header")
+
+	// Checked before the key so rotating keys doesn't get a banned device back in
+	if req.Hwid != "" {
+		banned, err := s.bans.HWIDBanned(ctx, s.db, req.Hwid)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "db error: %v", err)
+		}
+		if banned {
+			return &pb.ValidateResponse{Valid: false, Reason: pb.Reason_REASON_DEVICE_BANNED, Message: "Device is banned"}, nil
+		}
 	}
 
-	// Validate & Burn Token
-	res, err := s.db.Exec("DELETE FROM access_tokens WHERE token = $1 AND expires_at > NOW()", tokens[0])
+	license, err := s.licenseState(ctx, req.LicenseKey)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "db error: %v", err)
 	}
-	rowsAffected, _ := res.RowsAffected()
-	if rowsAffected == 0 {
-		return nil, status.Error(codes.Unauthenticated, "invalid or expired access token")
+	if license == nil || license.ProductID != req.ProductId {
+		missing, err := s.products.Unknown(ctx, s.db, []string{req.ProductId})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "db error: %v", err)
+		}
+		if len(missing) > 0 {
+			return &pb.ValidateResponse{Valid: false, Reason: pb.Reason_REASON_UNKNOWN_PRODUCT, Message: "Unknown product"}, nil
+		}
+		return &pb.ValidateResponse{Valid: false, Reason: pb.Reason_REASON_LICENSE_NOT_FOUND, Message: "License not found"}, nil
+	}
+	maxDevices := license.MaxDevices
+
+	if license.RequireChallenge && req.Challenge == "" {
+		return &pb.ValidateResponse{Valid: false, Reason: pb.Reason_REASON_CHALLENGE_REQUIRED, Message: "Challenge required"}, nil
+	}
+
+	if license.ProductDisabled {
+		return &pb.ValidateResponse{Valid: false, Reason: pb.Reason_REASON_PRODUCT_DISABLED, Message: "Product is disabled"}, nil
+	}
+
+	if !license.IsActive {
+		return &pb.ValidateResponse{Valid: false, Reason: pb.Reason_REASON_SUSPENDED, Message: "License is suspended", SuspendReason: license.SuspendReason}, nil
 	}
 
-	// Validate License
-	var isActive bool
-	var storedHwid sql.NullString
-	query := "SELECT is_active, hwid FROM licenses WHERE license_key = $1 AND product_id = $2"
-	err = s.db.QueryRow(query, req.LicenseKey, req.ProductId).Scan(&isActive, &storedHwid)
+	// Expiry: NULL means lifetime license
+	var expiresIn int64
+	if license.ExpiresAt != nil {
+		remaining := time.Until(*license.ExpiresAt)
+		if remaining <= 0 {
+			return &pb.ValidateResponse{Valid: false, Reason: pb.Reason_REASON_EXPIRED, Message: "License expired"}, nil
+		}
+		expiresIn = int64(remaining.Seconds())
+	}
 
+	if !regionAllowed(license, s.geo.Country(clientIP(ctx))) {
+		return &pb.ValidateResponse{Valid: false, Reason: pb.Reason_REASON_REGION_NOT_ALLOWED, Message: "Region not allowed"}, nil
+	}
+
+	// Outdated clients are turned away before they can take a device seat
+	if license.MinVersion != "" && (req.ClientVersion == "" || compareVersions(req.ClientVersion, license.MinVersion) < 0) {
+		return &pb.ValidateResponse{Valid: false, Reason: pb.Reason_REASON_CLIENT_OUTDATED, Message: "Client outdated", RequiredVersion: license.MinVersion}, nil
+	}
+
+	// Floating licenses bind no devices; CheckoutLicense hands out leases
+	// once these checks pass
+	checkout := checkingOut(ctx)
+	if license.FloatingSeats > 0 && !checkout {
+		leased, err := s.leases.Holds(ctx, s.db, req.LicenseKey, hwid.Hash(req.Hwid))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "db error: %v", err)
+		}
+		if !leased {
+			return &pb.ValidateResponse{Valid: false, Reason: pb.Reason_REASON_NOT_CHECKED_OUT, Message: "License not checked out"}, nil
+		}
+	}
+
+	if req.Hwid != "" && license.FloatingSeats == 0 && !checkout {
+		bound, retryAfter, err := s.bindDevice(ctx, req.LicenseKey, req.Hwid, partsOf(req.HwidComponents), maxDevices)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "db error: %v", err)
+		}
+		switch bound {
+		case deviceAdded:
+			s.notify(deviceEvent(webhook.HwidBound, req, maxDevices))
+		case deviceCoolingDown:
+			return &pb.ValidateResponse{Valid: false, Reason: pb.Reason_REASON_REBIND_COOLDOWN, Message: "Device changed too recently", RetryAfterSeconds: int64(retryAfter.Seconds()) + 1}, nil
+		case deviceRejected:
+			s.notify(deviceEvent(webhook.HwidMismatch, req, maxDevices))
+			s.alerts.Send(notify.Alert{
+				Kind: notify.HwidMismatch,
+				Key:  req.LicenseKey,
+				Text: fmt.Sprintf("HWID mismatch on license %s (%s): device %s from %s, %d device(s) allowed", req.LicenseKey, req.ProductId, req.Hwid, clientIP(ctx), maxDevices),
+			})
+			// Single-seat licenses keep the original message clients already handle
+			if maxDevices <= 1 {
+				return &pb.ValidateResponse{Valid: false, Reason: pb.Reason_REASON_HWID_MISMATCH, Message: "HWID mismatch"}, nil
+			}
+			return &pb.ValidateResponse{Valid: false, Reason: pb.Reason_REASON_DEVICE_LIMIT_REACHED, Message: "Device limit reached"}, nil
+		}
+	}
+
+	_ = s.licenses.Touch(ctx, req.LicenseKey)
+
+	metadata, err := store.ParseMetadata(license.Metadata)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "bad license metadata: %v", err)
+	}
+	return &pb.ValidateResponse{Valid: true, Reason: pb.Reason_REASON_OK, Message: "Authenticated", ExpiresInSeconds: expiresIn, Metadata: metadata,
+		Features: mergeFeatures(license.ProductFeatures, license.PlanFeatures, license.Features)}, nil
+}
+
+// burnAccessToken checks the request's x-access-token and deletes it, so
+// each token from GetAuthToken is good for one call. A token scoped to a
+// product is refused (and still used up) for calls about any other product.
+func (s *WhitelistService) burnAccessToken(ctx context.Context, productIDs ...string) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return errinfo.Error(codes.Unauthenticated, pb.Reason_REASON_TOKEN_MISSING, "no metadata")
+	}
+	tokens := md.Get("x-access-token")
+	if len(tokens) == 0 {
+		return errinfo.Error(codes.Unauthenticated, pb.Reason_REASON_TOKEN_MISSING, "missing x-access-token header")
+	}
+
+	scope, err := s.burnToken(ctx, s.hashSecret(tokens[0]))
 	if err == sql.ErrNoRows {
-		return &pb.ValidateResponse{Valid: false, Message: "License not found"}, nil
+		return errinfo.Error(codes.Unauthenticated, pb.Reason_REASON_TOKEN_INVALID, "invalid or expired access token")
 	} else if err != nil {
-		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+		return status.Errorf(codes.Internal, "db error: %v", err)
 	}
+	for _, id := range productIDs {
+		if scope != "" && id != scope {
+			return errinfo.Error(codes.PermissionDenied, pb.Reason_REASON_TOKEN_WRONG_PRODUCT, "access token is for another product")
+		}
+	}
+	return nil
+}
 
-	if !isActive {
-		return &pb.ValidateResponse{Valid: false, Message: "License is suspended"}, nil
+// burnToken deletes an access token and returns the product it's scoped to
+// ("" for any), or sql.ErrNoRows if it doesn't exist or has expired.
+func (s *WhitelistService) burnToken(ctx context.Context, hash string) (string, error) {
+	scope, ok, err := s.tokens.Burn(ctx, hash)
+	if err == nil && !ok {
+		err = sql.ErrNoRows
 	}
+	return scope, err
+}
 
-	if req.Hwid != "" {
-		if !storedHwid.Valid || storedHwid.String == "" {
-			_, _ = s.db.Exec("UPDATE licenses SET hwid = $1 WHERE license_key = $2", req.Hwid, req.LicenseKey)
-		} else if storedHwid.String != req.Hwid {
-			return &pb.ValidateResponse{Valid: false, Message: "HWID mismatch"}, nil
+// 29. ValidateLicenses: several licenses on a single access token
+func (s *WhitelistService) ValidateLicenses(ctx context.Context, req *pb.ValidateLicensesRequest) (*pb.ValidateLicensesResponse, error) {
+	if len(req.Licenses) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no licenses given")
+	}
+	if len(req.Licenses) > maxValidateBatch {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d licenses per call", maxValidateBatch)
+	}
+
+	// The signature covers the HWIDs as sent; everything else sees their hashes
+	licenses := make([]*pb.ValidateRequest, len(req.Licenses))
+	products := make([]string, len(req.Licenses))
+	for i, l := range req.Licenses {
+		licenses[i] = withHashedHwid(l)
+		products[i] = l.ProductId
+	}
+	err := s.burnAccessToken(ctx, products...)
+	if err == nil {
+		err = s.checkSignature(ctx, req, products...)
+	}
+	if err != nil {
+		for _, l := range licenses {
+			s.logValidation(ctx, l, nil, err)
 		}
+		return nil, err
 	}
 
-	return &pb.ValidateResponse{Valid: true, Message: "Authenticated"}, nil
+	results := make([]*pb.ValidateResponse, 0, len(licenses))
+	for i, l := range licenses {
+		resp, err := s.checkLicense(ctx, l)
+		s.logValidation(ctx, l, resp, err)
+		s.trackValidation(l, resp, err)
+		s.trackClientFailure(ctx, resp, err)
+		s.trackLockout(ctx, l, resp, err)
+		if err != nil {
+			return nil, err
+		}
+		resp.Message = s.localMessage(l.ProductId, l.Locale, resp.Reason, resp.Message)
+		s.signResponse(req.Licenses[i], resp)
+		results = append(results, resp)
+	}
+	return &pb.ValidateLicensesResponse{Results: results}, nil
 }
 
 // 3. UpdateLicense (Admin)
 func (s *WhitelistService) UpdateLicense(ctx context.Context, req *pb.UpdateLicenseRequest) (*emptypb.Empty, error) {
-	if err := s.checkAdmin(ctx); err != nil { return nil, err }
-
-	_, err := s.db.Exec(`
-		INSERT INTO licenses (license_key, product_id, is_active)
-		VALUES ($1, $2, $3)
-		ON CONFLICT (license_key) 
-		DO UPDATE SET product_id = $2, is_active = $3
-	`, req.LicenseKey, req.ProductId, req.IsActive)
+	if err := s.requireWrite(ctx, roleSupport); err != nil {
+		return nil, err
+	}
+	if err := checkLicenseUpdate(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.ProductId != "" {
+		if err := s.requireProducts(ctx, s.db, req.ProductId); err != nil {
+			return nil, err
+		}
+		errs, err := s.keyFormatErrors(ctx, s.db, req)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "db error: %v", err)
+		}
+		if errs[0] != nil {
+			return nil, status.Error(codes.InvalidArgument, errs[0].Error())
+		}
+	}
+	if err := s.requirePlans(ctx, s.db, req.GetPlan()); err != nil {
+		return nil, err
+	}
 
-	if err != nil { return nil, status.Errorf(codes.Internal, "upsert failed: %v", err) }
+	var event webhook.Event
+	err := s.inRetriedTx(ctx, "tx.update_license", func(tx *sql.Tx) error {
+		var err error
+		event, err = s.saveLicense(ctx, tx, req)
+		if err != nil && !errors.Is(err, errLicenseDeleted) {
+			return dbError("upsert failed", err)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.invalidateLicenses(ctx, req.LicenseKey)
+	s.notify(event)
 	return &emptypb.Empty{}, nil
 }
 
 // 4. DeleteLicense (Admin)
 func (s *WhitelistService) DeleteLicense(ctx context.Context, req *pb.DeleteLicenseRequest) (*emptypb.Empty, error) {
-	if err := s.checkAdmin(ctx); err != nil { return nil, err }
-	_, err := s.db.Exec("DELETE FROM licenses WHERE license_key = $1", req.LicenseKey)
-	if err != nil { return nil, status.Errorf(codes.Internal, "delete failed: %v", err) }
+	if err := s.requireWrite(ctx, roleOwner); err != nil {
+		return nil, err
+	}
+	if err := s.requireOTP(ctx); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	old, err := s.deleteLicense(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+	s.invalidateLicenses(ctx, req.LicenseKey)
+	if old != nil {
+		s.notify(licenseEvent(webhook.LicenseDeleted, old))
+	}
 	return &emptypb.Empty{}, nil
 }
+
+// deleteLicense soft-deletes the license inside tx and returns it as it was,
+// nil if it didn't exist or was already deleted. Errors are gRPC statuses.
+func (s *WhitelistService) deleteLicense(ctx context.Context, tx *sql.Tx, licenseKey string) (*pb.License, error) {
+	old, err := s.licenses.Get(ctx, tx, licenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if old == nil {
+		return nil, nil
+	}
+	if err := s.licenses.Delete(ctx, tx, licenseKey); err != nil {
+		return nil, status.Errorf(codes.Internal, "delete failed: %v", err)
+	}
+
+	if err := s.recordAudit(ctx, tx, adminActor(ctx), auditLicenseDelete, licenseKey, old, nil); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	deleted, err := s.loadDeletedLicense(ctx, tx, licenseKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.recordRevision(ctx, tx, adminActor(ctx), auditLicenseDelete, old, deleted); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	return old, nil
+}
+
+// 5. GetLicense (Admin)
+func (s *WhitelistService) GetLicense(ctx context.Context, req *pb.GetLicenseRequest) (*pb.License, error) {
+	if err := s.requireRole(ctx, roleReadOnly); err != nil {
+		return nil, err
+	}
+
+	l, err := s.licenses.Find(ctx, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	} else if l == nil {
+		return nil, status.Error(codes.NotFound, "license not found")
+	}
+	return l, nil
+}
+
+// 6. ListLicenses (Admin)
+func (s *WhitelistService) ListLicenses(ctx context.Context, req *pb.ListLicensesRequest) (*pb.ListLicensesResponse, error) {
+	if err := s.requireRole(ctx, roleReadOnly); err != nil {
+		return nil, err
+	}
+
+	page, err := newPage(store.LicenseOrder, req.PageSize, req.PageToken, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	f := store.LicenseFilter{
+		ProductID:  req.ProductId,
+		CustomerID: req.CustomerId,
+		IsActive:   req.IsActive,
+		HWIDBound:  req.HwidBound,
+		Search:     req.Search,
+		Live:       !req.Deleted,
+		Deleted:    req.Deleted,
+	}
+	licenses, err := s.licenseLists.List(ctx, s.db, f, page.Page)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	resp := &pb.ListLicensesResponse{Licenses: licenses}
+	if len(resp.Licenses) > page.Size {
+		resp.Licenses = resp.Licenses[:page.Size]
+		resp.NextPageToken = licensePageToken(page, resp.Licenses[page.Size-1])
+	}
+	return resp, nil
+}
+
+// licensePageToken returns the token for the page after last.
+func licensePageToken(page *listPage, last *pb.License) string {
+	var value string
+	switch page.Field {
+	case "created_at":
+		value = timeCursor(last.CreatedAt, "")
+	case "expires_at":
+		value = timeCursor(last.ExpiresAt, "infinity")
+	case "last_validated_at":
+		value = timeCursor(last.LastValidatedAt, "-infinity")
+	}
+	return page.next(value, last.LicenseKey)
+}
+
+// 7. ResetHwid (Admin)
+func (s *WhitelistService) ResetHwid(ctx context.Context, req *pb.ResetHwidRequest) (*emptypb.Empty, error) {
+	if err := s.requireWrite(ctx, roleSupport); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	defer tx.Rollback()
+
+	old, err := s.licenses.Get(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	if old == nil {
+		return nil, status.Error(codes.NotFound, "license not found")
+	}
+
+	// Empty hwid clears every device bound to the license
+	if req.Hwid == "" {
+		err = s.devices.RemoveAll(ctx, tx, req.LicenseKey)
+	} else {
+		_, err = s.devices.Remove(ctx, tx, req.LicenseKey, hwid.Hash(req.Hwid))
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "reset failed: %v", err)
+	}
+
+	updated, err := s.licenses.Get(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+
+	actor := req.Actor
+	if actor == "" {
+		actor = adminActor(ctx)
+	}
+	if err := s.recordLicenseChange(ctx, tx, actor, auditLicenseResetHwid, req.LicenseKey, old, updated); err != nil {
+		return nil, status.Errorf(codes.Internal, "audit failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "commit failed: %v", err)
+	}
+
+	log.Printf("HWID reset for license %s (hwid=%q) by %s", req.LicenseKey, req.Hwid, actor)
+	return &emptypb.Empty{}, nil
+}
+
+// 8. GenerateLicenses (Admin)
+func (s *WhitelistService) GenerateLicenses(ctx context.Context, req *pb.GenerateLicensesRequest) (*pb.GenerateLicensesResponse, error) {
+	if err := s.requireWrite(ctx, roleSupport); err != nil {
+		return nil, err
+	}
+
+	count := int(req.Count)
+	if count <= 0 {
+		count = 1
+	} else if count > maxGenerateCount {
+		return nil, status.Errorf(codes.InvalidArgument, "count must be at most %d", maxGenerateCount)
+	}
+	if count > 1 {
+		if err := s.requireOTP(ctx); err != nil {
+			return nil, err
+		}
+	}
+	pattern, err := requestKeyPattern(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	var events []webhook.Event
+	err = s.inRetriedTx(ctx, "tx.generate_licenses", func(tx *sql.Tx) error {
+		var err error
+		keys, events, err = s.insertGeneratedLicenses(ctx, tx, adminActor(ctx), pattern, count, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.notify(events...)
+	return &pb.GenerateLicensesResponse{LicenseKeys: keys}, nil
+}
+
+// 9. BatchUpsertLicenses (Admin)
+func (s *WhitelistService) BatchUpsertLicenses(ctx context.Context, req *pb.BatchUpsertLicensesRequest) (*pb.BatchUpsertLicensesResponse, error) {
+	if err := s.requireWrite(ctx, roleOwner); err != nil {
+		return nil, err
+	}
+	if err := s.requireOTP(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(req.Licenses) > maxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d licenses per batch", maxBatchSize)
+	}
+	var productIDs, plans []string
+	for i, l := range req.Licenses {
+		if l.LicenseKey == "" || (l.ProductId == "" && l.UpdateMask == nil) {
+			return nil, status.Errorf(codes.InvalidArgument, "licenses[%d]: license_key and product_id required", i)
+		}
+		if err := checkLicenseUpdate(l); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "licenses[%d]: %v", i, err)
+		}
+		if l.ProductId != "" {
+			productIDs = append(productIDs, l.ProductId)
+		}
+		plans = append(plans, l.GetPlan())
+	}
+	if err := s.requireProducts(ctx, s.db, productIDs...); err != nil {
+		return nil, err
+	}
+	if err := s.requirePlans(ctx, s.db, plans...); err != nil {
+		return nil, err
+	}
+	errs, err := s.keyFormatErrors(ctx, s.db, req.Licenses...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "db error: %v", err)
+	}
+	for i, err := range errs {
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "licenses[%d]: %v", i, err)
+		}
+	}
+
+	// All or nothing: one bad row rolls back the whole batch
+	keys := make([]string, len(req.Licenses))
+	events := make([]webhook.Event, len(req.Licenses))
+	err = s.inRetriedTx(ctx, "tx.batch_upsert_licenses", func(tx *sql.Tx) error {
+		for i, l := range req.Licenses {
+			event, err := s.saveLicense(ctx, tx, l)
+			if err != nil {
+				return dbError(fmt.Sprintf("licenses[%d]: upsert failed", i), err)
+			}
+			keys[i], events[i] = l.LicenseKey, event
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.invalidateLicenses(ctx, keys...)
+	s.notify(events...)
+
+	return &pb.BatchUpsertLicensesResponse{Upserted: int32(len(req.Licenses))}, nil
+}
+
+// saveLicense upserts the license inside tx and records the change in the audit
+// log. The returned webhook event should be sent once tx commits.
+// A request with an update_mask only changes the fields it names.
+func (s *WhitelistService) saveLicense(ctx context.Context, tx *sql.Tx, req *pb.UpdateLicenseRequest) (webhook.Event, error) {
+	if req.UpdateMask != nil {
+		// Lock the row so concurrent partial updates don't undo each other
+		if _, err := s.licenses.Lock(ctx, tx, req.LicenseKey); err != nil {
+			return webhook.Event{}, err
+		}
+	}
+	old, err := s.licenses.Get(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return webhook.Event{}, err
+	}
+	// applyPlan changes full, which leaves req as it came for a transaction
+	// run again (inRetriedTx)
+	full := proto.Clone(req).(*pb.UpdateLicenseRequest)
+	if req.UpdateMask != nil {
+		if full, err = applyUpdateMask(old, req); err != nil {
+			return webhook.Event{}, err
+		}
+	}
+	if err := s.applyPlan(ctx, tx, old, req, full); err != nil {
+		return webhook.Event{}, err
+	}
+	req = full
+	if err := s.licenses.Upsert(ctx, tx, req); errors.Is(err, store.ErrLicenseDeleted) {
+		return webhook.Event{}, errLicenseDeleted
+	} else if err != nil {
+		return webhook.Event{}, err
+	}
+	updated, err := s.licenses.Get(ctx, tx, req.LicenseKey)
+	if err != nil {
+		return webhook.Event{}, err
+	}
+
+	action, event := auditLicenseUpdate, webhook.LicenseUpdated
+	if old == nil {
+		action, event = auditLicenseCreate, webhook.LicenseCreated
+	}
+	if err := s.recordLicenseChange(ctx, tx, adminActor(ctx), action, req.LicenseKey, old, updated); err != nil {
+		return webhook.Event{}, err
+	}
+	return licenseEvent(event, updated), nil
+}
+
+// errLicenseDeleted is returned by saveLicense for a soft-deleted key.
+var errLicenseDeleted = status.Error(codes.FailedPrecondition, "license is deleted; restore or purge it first")
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+	maxBatchSize    = 1000
+
+	// ValidateLicenses items per call
+	maxValidateBatch = 50
+)
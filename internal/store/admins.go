@@ -0,0 +1,391 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// AdminLogin is what signing in as an admin checks.
+type AdminLogin struct {
+	PasswordHash []byte
+	Role         string
+	Disabled     bool
+}
+
+// AdminUpdate changes an admin account; zero fields stay as they are.
+type AdminUpdate struct {
+	Role         string
+	PasswordHash []byte
+	Disabled     *bool
+}
+
+// AdminSessionState is what authenticating a session token checks.
+type AdminSessionState struct {
+	Role     string
+	Disabled bool
+	// Revoked or expired
+	Ended bool
+}
+
+// AdminOTP is an admin's TOTP enrollment. Secret is invalid until the
+// enrollment is confirmed.
+type AdminOTP struct {
+	Secret   sql.NullString
+	LastStep int64
+}
+
+// AdminStore keeps admin accounts, their sessions and TOTP secrets, and the
+// rotated shared admin secrets (by hash). A missing account is (nil, nil).
+type AdminStore interface {
+	// Login returns the account's credentials.
+	Login(ctx context.Context, q Querier, username string) (*AdminLogin, error)
+	// Get returns the account.
+	Get(ctx context.Context, q Querier, username string) (*pb.Admin, error)
+	// List returns every account by username.
+	List(ctx context.Context, q Querier) ([]*pb.Admin, error)
+	// Create adds the account, reporting false if the username is taken.
+	Create(ctx context.Context, q Querier, username string, passwordHash []byte, role string) (bool, error)
+	// Update changes the account.
+	Update(ctx context.Context, q Querier, username string, u AdminUpdate) error
+	// TouchLogin records that the account just logged in.
+	TouchLogin(ctx context.Context, q Querier, username string) error
+	// EnabledOwners counts the owner accounts that aren't disabled.
+	EnabledOwners(ctx context.Context, q Querier) (int, error)
+
+	// CreateSession records a new session.
+	CreateSession(ctx context.Context, q Querier, s *pb.AdminSession) error
+	// Session returns the state of the username's session id, nil if
+	// there's no such session.
+	Session(ctx context.Context, q Querier, id, username string) (*AdminSessionState, error)
+	// SeeSession records activity on the session, at most once a minute.
+	SeeSession(ctx context.Context, q Querier, id string) error
+	// ListSessions returns username's sessions (everyone's for ""), newest
+	// first, only live ones unless includeEnded.
+	ListSessions(ctx context.Context, q Querier, username string, includeEnded bool) ([]*pb.AdminSession, error)
+	// SessionOwner returns the username of the session, "" if there's no
+	// such session.
+	SessionOwner(ctx context.Context, q Querier, id string) (string, error)
+	// RevokeSession ends the session, reporting false if it had ended.
+	RevokeSession(ctx context.Context, q Querier, id string) (bool, error)
+	// RevokeSessions ends every live session of username.
+	RevokeSessions(ctx context.Context, q Querier, username string) error
+	// DeleteEndedSessions deletes sessions that expired or were revoked
+	// before t.
+	DeleteEndedSessions(ctx context.Context, q Querier, before time.Time) (int64, error)
+
+	// AddSecret stores a new shared secret.
+	AddSecret(ctx context.Context, q Querier, hash, createdBy string) error
+	// ExpireSecrets makes the shared secrets stop working at t, unless they
+	// expire sooner.
+	ExpireSecrets(ctx context.Context, q Querier, t time.Time) error
+	// SecretLive reports whether the shared secret exists and hasn't
+	// expired.
+	SecretLive(ctx context.Context, q Querier, hash string) (bool, error)
+	// AnySecretLive reports whether any stored shared secret works.
+	AnySecretLive(ctx context.Context, q Querier) (bool, error)
+	// RehashSecret moves a shared secret from oldHash to newHash, after the
+	// hash salt changed.
+	RehashSecret(ctx context.Context, q Querier, oldHash, newHash string) error
+
+	// OTP returns username's TOTP enrollment, nil if they never started
+	// one.
+	OTP(ctx context.Context, q Querier, username string) (*AdminOTP, error)
+	// AnyOTP reports whether any admin has a confirmed TOTP.
+	AnyOTP(ctx context.Context, q Querier) (bool, error)
+	// StartOTP sets secret as username's pending TOTP secret.
+	StartOTP(ctx context.Context, q Querier, username, secret string) error
+	// PendingOTP returns username's pending TOTP secret, locking it until
+	// q commits; invalid when there's none.
+	PendingOTP(ctx context.Context, q Querier, username string) (sql.NullString, error)
+	// ConfirmOTP makes the pending secret username's TOTP secret, used up
+	// to step.
+	ConfirmOTP(ctx context.Context, q Querier, username string, step int64) error
+	// UseOTP records that username used the code of step, reporting false
+	// if that step or a later one was used already.
+	UseOTP(ctx context.Context, q Querier, username string, step int64) (bool, error)
+	// ResetOTP removes username's TOTP.
+	ResetOTP(ctx context.Context, q Querier, username string) error
+}
+
+type sqlAdmins struct{}
+
+// NewSQLAdmins keeps admins in the admins, admin_sessions, admin_totp and
+// admin_secrets tables.
+func NewSQLAdmins() AdminStore {
+	return sqlAdmins{}
+}
+
+const adminColumns = "username, role, disabled, created_at, last_login_at, EXISTS (SELECT 1 FROM admin_totp t WHERE t.username = admins.username AND t.secret IS NOT NULL)"
+
+func scanAdmin(row interface{ Scan(...interface{}) error }) (*pb.Admin, error) {
+	var a pb.Admin
+	var createdAt time.Time
+	var lastLogin sql.NullTime
+	if err := row.Scan(&a.Username, &a.Role, &a.Disabled, &createdAt, &lastLogin, &a.TotpEnabled); err != nil {
+		return nil, err
+	}
+	a.CreatedAt = timestamppb.New(createdAt)
+	if lastLogin.Valid {
+		a.LastLoginAt = timestamppb.New(lastLogin.Time)
+	}
+	return &a, nil
+}
+
+func (sqlAdmins) Login(ctx context.Context, q Querier, username string) (*AdminLogin, error) {
+	var l AdminLogin
+	err := q.QueryRowContext(ctx, "SELECT password_hash, role, disabled FROM admins WHERE username = $1", username).Scan(&l.PasswordHash, &l.Role, &l.Disabled)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return &l, err
+}
+
+func (sqlAdmins) Get(ctx context.Context, q Querier, username string) (*pb.Admin, error) {
+	a, err := scanAdmin(q.QueryRowContext(ctx, "SELECT "+adminColumns+" FROM admins WHERE username = $1", username))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return a, err
+}
+
+func (sqlAdmins) List(ctx context.Context, q Querier) ([]*pb.Admin, error) {
+	rows, err := q.QueryContext(ctx, "SELECT "+adminColumns+" FROM admins ORDER BY username")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var admins []*pb.Admin
+	for rows.Next() {
+		a, err := scanAdmin(rows)
+		if err != nil {
+			return nil, err
+		}
+		admins = append(admins, a)
+	}
+	return admins, rows.Err()
+}
+
+func (sqlAdmins) Create(ctx context.Context, q Querier, username string, passwordHash []byte, role string) (bool, error) {
+	res, err := q.ExecContext(ctx, `
+		INSERT INTO admins (username, password_hash, role) VALUES ($1, $2, $3)
+		ON CONFLICT (username) DO NOTHING
+	`, username, passwordHash, role)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+func (sqlAdmins) Update(ctx context.Context, q Querier, username string, u AdminUpdate) error {
+	if u.Role != "" {
+		if _, err := q.ExecContext(ctx, "UPDATE admins SET role = $2 WHERE username = $1", username, u.Role); err != nil {
+			return err
+		}
+	}
+	if u.PasswordHash != nil {
+		if _, err := q.ExecContext(ctx, "UPDATE admins SET password_hash = $2 WHERE username = $1", username, u.PasswordHash); err != nil {
+			return err
+		}
+	}
+	if u.Disabled != nil {
+		if _, err := q.ExecContext(ctx, "UPDATE admins SET disabled = $2 WHERE username = $1", username, *u.Disabled); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (sqlAdmins) TouchLogin(ctx context.Context, q Querier, username string) error {
+	_, err := q.ExecContext(ctx, "UPDATE admins SET last_login_at = NOW() WHERE username = $1", username)
+	return err
+}
+
+func (sqlAdmins) EnabledOwners(ctx context.Context, q Querier) (int, error) {
+	var owners int
+	err := q.QueryRowContext(ctx, "SELECT COUNT(*) FROM admins WHERE role = 'owner' AND NOT disabled").Scan(&owners)
+	return owners, err
+}
+
+func (sqlAdmins) CreateSession(ctx context.Context, q Querier, s *pb.AdminSession) error {
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO admin_sessions (id, username, expires_at, client_ip, user_agent)
+		VALUES ($1, $2, $3, $4, $5)
+	`, s.Id, s.Username, s.ExpiresAt.AsTime(), s.ClientIp, s.UserAgent)
+	return err
+}
+
+func (sqlAdmins) Session(ctx context.Context, q Querier, id, username string) (*AdminSessionState, error) {
+	var s AdminSessionState
+	err := q.QueryRowContext(ctx, `
+		SELECT a.role, a.disabled, s.revoked_at IS NOT NULL OR s.expires_at <= NOW()
+		FROM admin_sessions s JOIN admins a ON a.username = s.username
+		WHERE s.id = $1 AND s.username = $2
+	`, id, username).Scan(&s.Role, &s.Disabled, &s.Ended)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return &s, err
+}
+
+func (sqlAdmins) SeeSession(ctx context.Context, q Querier, id string) error {
+	_, err := q.ExecContext(ctx, "UPDATE admin_sessions SET last_seen_at = NOW() WHERE id = $1 AND last_seen_at < NOW() - INTERVAL '1 minute'", id)
+	return err
+}
+
+func (sqlAdmins) ListSessions(ctx context.Context, q Querier, username string, includeEnded bool) ([]*pb.AdminSession, error) {
+	query := "SELECT id, username, created_at, expires_at, last_seen_at, revoked_at, client_ip, user_agent FROM admin_sessions"
+	var args []interface{}
+	if username != "" {
+		args = append(args, username)
+		query += " WHERE username = $1"
+	} else {
+		query += " WHERE TRUE"
+	}
+	if !includeEnded {
+		query += " AND revoked_at IS NULL AND expires_at > NOW()"
+	}
+	query += " ORDER BY created_at DESC"
+
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []*pb.AdminSession
+	for rows.Next() {
+		var sess pb.AdminSession
+		var createdAt, expiresAt, lastSeen time.Time
+		var revokedAt sql.NullTime
+		var ip, ua sql.NullString
+		if err := rows.Scan(&sess.Id, &sess.Username, &createdAt, &expiresAt, &lastSeen, &revokedAt, &ip, &ua); err != nil {
+			return nil, err
+		}
+		sess.CreatedAt = timestamppb.New(createdAt)
+		sess.ExpiresAt = timestamppb.New(expiresAt)
+		sess.LastSeenAt = timestamppb.New(lastSeen)
+		if revokedAt.Valid {
+			sess.RevokedAt = timestamppb.New(revokedAt.Time)
+		}
+		sess.ClientIp, sess.UserAgent = ip.String, ua.String
+		sessions = append(sessions, &sess)
+	}
+	return sessions, rows.Err()
+}
+
+func (sqlAdmins) SessionOwner(ctx context.Context, q Querier, id string) (string, error) {
+	var owner string
+	err := q.QueryRowContext(ctx, "SELECT username FROM admin_sessions WHERE id = $1", id).Scan(&owner)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return owner, err
+}
+
+func (sqlAdmins) RevokeSession(ctx context.Context, q Querier, id string) (bool, error) {
+	res, err := q.ExecContext(ctx, "UPDATE admin_sessions SET revoked_at = NOW() WHERE id = $1 AND revoked_at IS NULL", id)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+func (sqlAdmins) RevokeSessions(ctx context.Context, q Querier, username string) error {
+	_, err := q.ExecContext(ctx, "UPDATE admin_sessions SET revoked_at = NOW() WHERE username = $1 AND revoked_at IS NULL", username)
+	return err
+}
+
+func (sqlAdmins) DeleteEndedSessions(ctx context.Context, q Querier, before time.Time) (int64, error) {
+	return execRows(ctx, q, "DELETE FROM admin_sessions WHERE expires_at < $1 OR revoked_at < $1", before)
+}
+
+func (sqlAdmins) AddSecret(ctx context.Context, q Querier, hash, createdBy string) error {
+	_, err := q.ExecContext(ctx, "INSERT INTO admin_secrets (secret_hash, created_by) VALUES ($1, $2)", hash, createdBy)
+	return err
+}
+
+func (sqlAdmins) ExpireSecrets(ctx context.Context, q Querier, t time.Time) error {
+	_, err := q.ExecContext(ctx, "UPDATE admin_secrets SET expires_at = $1 WHERE expires_at IS NULL OR expires_at > $1", t)
+	return err
+}
+
+func (sqlAdmins) SecretLive(ctx context.Context, q Querier, hash string) (bool, error) {
+	var ok bool
+	err := q.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM admin_secrets WHERE secret_hash = $1 AND (expires_at IS NULL OR expires_at > NOW()))
+	`, hash).Scan(&ok)
+	return ok, err
+}
+
+func (sqlAdmins) AnySecretLive(ctx context.Context, q Querier) (bool, error) {
+	var ok bool
+	err := q.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM admin_secrets WHERE expires_at IS NULL OR expires_at > NOW())").Scan(&ok)
+	return ok, err
+}
+
+func (sqlAdmins) RehashSecret(ctx context.Context, q Querier, oldHash, newHash string) error {
+	_, err := q.ExecContext(ctx, "UPDATE admin_secrets SET secret_hash = $2 WHERE secret_hash = $1", oldHash, newHash)
+	return err
+}
+
+func (sqlAdmins) OTP(ctx context.Context, q Querier, username string) (*AdminOTP, error) {
+	var o AdminOTP
+	err := q.QueryRowContext(ctx, "SELECT secret, last_step FROM admin_totp WHERE username = $1", username).Scan(&o.Secret, &o.LastStep)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return &o, err
+}
+
+func (sqlAdmins) AnyOTP(ctx context.Context, q Querier) (bool, error) {
+	var ok bool
+	err := q.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM admin_totp WHERE secret IS NOT NULL)").Scan(&ok)
+	return ok, err
+}
+
+func (sqlAdmins) StartOTP(ctx context.Context, q Querier, username, secret string) error {
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO admin_totp (username, pending_secret) VALUES ($1, $2)
+		ON CONFLICT (username) DO UPDATE SET pending_secret = EXCLUDED.pending_secret
+	`, username, secret)
+	return err
+}
+
+func (sqlAdmins) PendingOTP(ctx context.Context, q Querier, username string) (sql.NullString, error) {
+	var pending sql.NullString
+	err := q.QueryRowContext(ctx, "SELECT pending_secret FROM admin_totp WHERE username = $1 FOR UPDATE", username).Scan(&pending)
+	if err == sql.ErrNoRows {
+		err = nil
+	}
+	return pending, err
+}
+
+func (sqlAdmins) ConfirmOTP(ctx context.Context, q Querier, username string, step int64) error {
+	_, err := q.ExecContext(ctx, `
+		UPDATE admin_totp SET secret = pending_secret, pending_secret = NULL, last_step = $2, enabled_at = NOW()
+		WHERE username = $1
+	`, username, step)
+	return err
+}
+
+func (sqlAdmins) UseOTP(ctx context.Context, q Querier, username string, step int64) (bool, error) {
+	res, err := q.ExecContext(ctx, "UPDATE admin_totp SET last_step = $2 WHERE username = $1 AND last_step < $2", username, step)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+func (sqlAdmins) ResetOTP(ctx context.Context, q Querier, username string) error {
+	_, err := q.ExecContext(ctx, "DELETE FROM admin_totp WHERE username = $1", username)
+	return err
+}
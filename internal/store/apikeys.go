@@ -0,0 +1,82 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// APIKeyStore checks API keys and the refresh tokens issued for them, both
+// by hash.
+type APIKeyStore interface {
+	// Check reports whether the key exists and hasn't expired.
+	Check(ctx context.Context, q Querier, keyHash string) (bool, error)
+	// IssueRefreshToken stores a refresh token for the key.
+	IssueRefreshToken(ctx context.Context, q Querier, tokenHash, keyHash string, ttl time.Duration) error
+	// RedeemRefreshToken uses up a refresh token and returns the hash of
+	// the API key it was issued for, "" when the token is unknown or
+	// expired or its key has expired since.
+	RedeemRefreshToken(ctx context.Context, q Querier, tokenHash string) (string, error)
+	// RevokeRefreshTokens deletes the key's refresh tokens and returns how
+	// many there were.
+	RevokeRefreshTokens(ctx context.Context, q Querier, keyHash string) (int64, error)
+	// Rehash moves a key and its refresh tokens from oldHash to newHash,
+	// after the hash salt changed.
+	Rehash(ctx context.Context, q Querier, oldHash, newHash string) error
+}
+
+type sqlAPIKeys struct{}
+
+// NewSQLAPIKeys keeps API keys and refresh tokens in the database the
+// Querier passed to each call belongs to.
+func NewSQLAPIKeys() APIKeyStore {
+	return sqlAPIKeys{}
+}
+
+func (sqlAPIKeys) Check(ctx context.Context, q Querier, keyHash string) (bool, error) {
+	// Key must exist AND (ExpiresAt is NULL OR ExpiresAt > Now)
+	var exists bool
+	query := `SELECT EXISTS(
+		SELECT 1 FROM api_keys 
+		WHERE key_hash = $1 
+		AND (expires_at IS NULL OR expires_at > NOW())
+	)`
+	err := q.QueryRowContext(ctx, query, keyHash).Scan(&exists)
+	return exists, err
+}
+
+func (sqlAPIKeys) IssueRefreshToken(ctx context.Context, q Querier, tokenHash, keyHash string, ttl time.Duration) error {
+	_, err := q.ExecContext(ctx, "INSERT INTO refresh_tokens (token_hash, api_key_hash, expires_at) VALUES ($1, $2, $3)", tokenHash, keyHash, time.Now().Add(ttl))
+	return err
+}
+
+func (sqlAPIKeys) RedeemRefreshToken(ctx context.Context, q Querier, tokenHash string) (string, error) {
+	var keyHash string
+	var valid bool
+	err := q.QueryRowContext(ctx, `
+		DELETE FROM refresh_tokens r WHERE token_hash = $1
+		RETURNING api_key_hash, expires_at > NOW() AND EXISTS (
+			SELECT 1 FROM api_keys k WHERE k.key_hash = r.api_key_hash
+			AND (k.expires_at IS NULL OR k.expires_at > NOW()))
+	`, tokenHash).Scan(&keyHash, &valid)
+	if err == sql.ErrNoRows || (err == nil && !valid) {
+		return "", nil
+	}
+	return keyHash, err
+}
+
+func (sqlAPIKeys) RevokeRefreshTokens(ctx context.Context, q Querier, keyHash string) (int64, error) {
+	res, err := q.ExecContext(ctx, "DELETE FROM refresh_tokens WHERE api_key_hash = $1", keyHash)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+func (sqlAPIKeys) Rehash(ctx context.Context, q Querier, oldHash, newHash string) error {
+	if _, err := q.ExecContext(ctx, "UPDATE api_keys SET key_hash = $2 WHERE key_hash = $1", oldHash, newHash); err != nil {
+		return err
+	}
+	_, err := q.ExecContext(ctx, "UPDATE refresh_tokens SET api_key_hash = $2 WHERE api_key_hash = $1", oldHash, newHash)
+	return err
+}
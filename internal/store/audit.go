@@ -0,0 +1,133 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AuditEntry is one audit log row. OldValue and NewValue hold JSON, and are
+// invalid when there was none.
+type AuditEntry struct {
+	ID        int64
+	CreatedAt time.Time
+	Actor     string
+	Action    string
+	Target    string
+	OldValue  sql.NullString
+	NewValue  sql.NullString
+	SourceIP  string
+}
+
+// AuditFilter narrows the audit log down; zero fields don't filter.
+type AuditFilter struct {
+	Actor, Action, Target string
+	Since, Until          time.Time
+}
+
+// AuditStore keeps the audit log.
+type AuditStore interface {
+	// Record appends e (its ID and CreatedAt are set by the store). Pass
+	// the transaction making the change, so both commit or roll back
+	// together.
+	Record(ctx context.Context, q Querier, e *AuditEntry) error
+	// List returns a page of the entries matching f.
+	List(ctx context.Context, q Querier, f AuditFilter, page Page) ([]*AuditEntry, error)
+	// Export calls fn with every entry matching f, oldest first, stopping
+	// at the first error.
+	Export(ctx context.Context, q Querier, f AuditFilter, fn func(*AuditEntry) error) error
+}
+
+type sqlAudit struct{}
+
+// NewSQLAudit keeps the audit log in the audit_log table.
+func NewSQLAudit() AuditStore {
+	return sqlAudit{}
+}
+
+func (sqlAudit) Record(ctx context.Context, q Querier, e *AuditEntry) error {
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO audit_log (actor, action, target, old_value, new_value, source_ip)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, e.Actor, e.Action, e.Target, e.OldValue, e.NewValue, e.SourceIP)
+	return err
+}
+
+const auditColumns = "id, created_at, actor, action, target, old_value, new_value, source_ip"
+
+func (sqlAudit) List(ctx context.Context, q Querier, f AuditFilter, page Page) ([]*AuditEntry, error) {
+	conds, args := f.where()
+	if cond, a := page.where(args); cond != "" {
+		conds, args = append(conds, cond), a
+	}
+	query := "SELECT " + auditColumns + " FROM audit_log"
+	if len(conds) > 0 {
+		query += " WHERE " + strings.Join(conds, " AND ")
+	}
+	orderLimit, args := page.orderLimit(args)
+
+	var entries []*AuditEntry
+	err := queryAudit(ctx, q, query+orderLimit, args, func(e *AuditEntry) error {
+		entries = append(entries, e)
+		return nil
+	})
+	return entries, err
+}
+
+func (sqlAudit) Export(ctx context.Context, q Querier, f AuditFilter, fn func(*AuditEntry) error) error {
+	conds, args := f.where()
+	query := "SELECT " + auditColumns + " FROM audit_log"
+	if len(conds) > 0 {
+		query += " WHERE " + strings.Join(conds, " AND ")
+	}
+	return queryAudit(ctx, q, query+" ORDER BY id", args, fn)
+}
+
+func queryAudit(ctx context.Context, q Querier, query string, args []interface{}, fn func(*AuditEntry) error) error {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var e AuditEntry
+		var sourceIP sql.NullString
+		if err := rows.Scan(&e.ID, &e.CreatedAt, &e.Actor, &e.Action, &e.Target, &e.OldValue, &e.NewValue, &sourceIP); err != nil {
+			return err
+		}
+		e.SourceIP = sourceIP.String
+		if err := fn(&e); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// where turns f into WHERE conditions and their arguments.
+func (f AuditFilter) where() ([]string, []interface{}) {
+	var conds []string
+	var args []interface{}
+	addCond := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+
+	if f.Actor != "" {
+		addCond("actor = $%d", f.Actor)
+	}
+	if f.Action != "" {
+		addCond("action = $%d", f.Action)
+	}
+	if f.Target != "" {
+		addCond("target = $%d", f.Target)
+	}
+	if !f.Since.IsZero() {
+		addCond("created_at >= $%d", f.Since)
+	}
+	if !f.Until.IsZero() {
+		addCond("created_at < $%d", f.Until)
+	}
+	return conds, args
+}
@@ -0,0 +1,194 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// HWIDBanOrder is how HWID bans can be listed.
+var HWIDBanOrder = &Order{
+	fields: map[string]string{"created_at": "created_at"},
+	times:  map[string]bool{"created_at": true},
+	tie:    "hwid",
+}
+
+// BanStore keeps the banned HWIDs (hashed) and IP networks. A missing ban
+// is (nil, nil).
+type BanStore interface {
+	// HWIDBanned reports whether the HWID is banned.
+	HWIDBanned(ctx context.Context, q Querier, hwid string) (bool, error)
+	// LockHWIDBan returns the HWID's ban, locking it until the
+	// transaction q commits.
+	LockHWIDBan(ctx context.Context, q Querier, hwid string) (*pb.HwidBan, error)
+	// BanHWID bans the HWID, or updates its ban, and returns the ban.
+	BanHWID(ctx context.Context, q Querier, hwid, reason, bannedBy string) (*pb.HwidBan, error)
+	// UnbanHWID lifts the HWID's ban and returns it.
+	UnbanHWID(ctx context.Context, q Querier, hwid string) (*pb.HwidBan, error)
+	// ListHWIDBans returns a page of the HWID bans, in HWIDBanOrder.
+	ListHWIDBans(ctx context.Context, q Querier, page Page) ([]*pb.HwidBan, error)
+
+	// LockIPBan returns the network's ban, locking it until the
+	// transaction q commits.
+	LockIPBan(ctx context.Context, q Querier, network string) (*pb.IpBan, error)
+	// BanIP bans the network until expiresAt (for good if zero), replacing
+	// its ban, and returns the ban.
+	BanIP(ctx context.Context, q Querier, network, reason, bannedBy string, expiresAt time.Time) (*pb.IpBan, error)
+	// UnbanIP lifts the network's ban and returns it.
+	UnbanIP(ctx context.Context, q Querier, network string) (*pb.IpBan, error)
+	// ListIPBans returns the IP bans in force, newest first.
+	ListIPBans(ctx context.Context, q Querier) ([]*pb.IpBan, error)
+	// BannedNetworks returns the networks of the IP bans in force.
+	BannedNetworks(ctx context.Context, q Querier) ([]string, error)
+}
+
+type sqlBans struct{}
+
+// NewSQLBans keeps bans in the banned_hwids and ip_bans tables.
+func NewSQLBans() BanStore {
+	return sqlBans{}
+}
+
+const hwidBanColumns = "hwid, reason, banned_by, created_at"
+
+func scanHWIDBan(row interface{ Scan(...interface{}) error }) (*pb.HwidBan, error) {
+	var b pb.HwidBan
+	var createdAt time.Time
+	err := row.Scan(&b.Hwid, &b.Reason, &b.BannedBy, &createdAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	b.CreatedAt = timestamppb.New(createdAt)
+	return &b, nil
+}
+
+func (sqlBans) HWIDBanned(ctx context.Context, q Querier, hwid string) (bool, error) {
+	var banned bool
+	err := q.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM banned_hwids WHERE hwid = $1)", hwid).Scan(&banned)
+	return banned, err
+}
+
+func (sqlBans) LockHWIDBan(ctx context.Context, q Querier, hwid string) (*pb.HwidBan, error) {
+	return scanHWIDBan(q.QueryRowContext(ctx, "SELECT "+hwidBanColumns+" FROM banned_hwids WHERE hwid = $1 FOR UPDATE", hwid))
+}
+
+func (sqlBans) BanHWID(ctx context.Context, q Querier, hwid, reason, bannedBy string) (*pb.HwidBan, error) {
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO banned_hwids (hwid, reason, banned_by) VALUES ($1, $2, $3)
+		ON CONFLICT (hwid) DO UPDATE SET reason = $2, banned_by = $3
+	`, hwid, reason, bannedBy)
+	if err != nil {
+		return nil, err
+	}
+	return scanHWIDBan(q.QueryRowContext(ctx, "SELECT "+hwidBanColumns+" FROM banned_hwids WHERE hwid = $1", hwid))
+}
+
+func (sqlBans) UnbanHWID(ctx context.Context, q Querier, hwid string) (*pb.HwidBan, error) {
+	return scanHWIDBan(q.QueryRowContext(ctx, "DELETE FROM banned_hwids WHERE hwid = $1 RETURNING "+hwidBanColumns, hwid))
+}
+
+func (sqlBans) ListHWIDBans(ctx context.Context, q Querier, page Page) ([]*pb.HwidBan, error) {
+	query := "SELECT " + hwidBanColumns + " FROM banned_hwids"
+	cond, args := page.where(nil)
+	if cond != "" {
+		query += " WHERE " + cond
+	}
+	orderLimit, args := page.orderLimit(args)
+
+	rows, err := q.QueryContext(ctx, query+orderLimit, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var bans []*pb.HwidBan
+	for rows.Next() {
+		b, err := scanHWIDBan(rows)
+		if err != nil {
+			return nil, err
+		}
+		bans = append(bans, b)
+	}
+	return bans, rows.Err()
+}
+
+const ipBanColumns = "network::text, reason, banned_by, created_at, expires_at"
+
+func scanIPBan(row interface{ Scan(...interface{}) error }) (*pb.IpBan, error) {
+	var b pb.IpBan
+	var createdAt time.Time
+	var expiresAt sql.NullTime
+	err := row.Scan(&b.Network, &b.Reason, &b.BannedBy, &createdAt, &expiresAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	b.CreatedAt = timestamppb.New(createdAt)
+	if expiresAt.Valid {
+		b.ExpiresAt = timestamppb.New(expiresAt.Time)
+	}
+	return &b, nil
+}
+
+func (sqlBans) LockIPBan(ctx context.Context, q Querier, network string) (*pb.IpBan, error) {
+	return scanIPBan(q.QueryRowContext(ctx, "SELECT "+ipBanColumns+" FROM ip_bans WHERE network = $1 FOR UPDATE", network))
+}
+
+func (sqlBans) BanIP(ctx context.Context, q Querier, network, reason, bannedBy string, expiresAt time.Time) (*pb.IpBan, error) {
+	expires := sql.NullTime{Time: expiresAt, Valid: !expiresAt.IsZero()}
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO ip_bans (network, reason, banned_by, expires_at) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (network) DO UPDATE SET reason = $2, banned_by = $3, created_at = NOW(), expires_at = $4
+	`, network, reason, bannedBy, expires)
+	if err != nil {
+		return nil, err
+	}
+	return scanIPBan(q.QueryRowContext(ctx, "SELECT "+ipBanColumns+" FROM ip_bans WHERE network = $1", network))
+}
+
+func (sqlBans) UnbanIP(ctx context.Context, q Querier, network string) (*pb.IpBan, error) {
+	return scanIPBan(q.QueryRowContext(ctx, "DELETE FROM ip_bans WHERE network = $1 RETURNING "+ipBanColumns, network))
+}
+
+func (sqlBans) ListIPBans(ctx context.Context, q Querier) ([]*pb.IpBan, error) {
+	rows, err := q.QueryContext(ctx, "SELECT "+ipBanColumns+" FROM ip_bans WHERE expires_at IS NULL OR expires_at > NOW() ORDER BY created_at DESC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var bans []*pb.IpBan
+	for rows.Next() {
+		b, err := scanIPBan(rows)
+		if err != nil {
+			return nil, err
+		}
+		bans = append(bans, b)
+	}
+	return bans, rows.Err()
+}
+
+func (sqlBans) BannedNetworks(ctx context.Context, q Querier) ([]string, error) {
+	rows, err := q.QueryContext(ctx, "SELECT network::text FROM ip_bans WHERE expires_at IS NULL OR expires_at > NOW()")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var networks []string
+	for rows.Next() {
+		var network string
+		if err := rows.Scan(&network); err != nil {
+			return nil, err
+		}
+		networks = append(networks, network)
+	}
+	return networks, rows.Err()
+}
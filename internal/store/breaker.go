@@ -0,0 +1,271 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/mkseven15/whitelist-server/internal/cache"
+	"github.com/mkseven15/whitelist-server/internal/database"
+	"github.com/mkseven15/whitelist-server/internal/metrics"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// ErrUnavailable is returned instead of calling the database while a
+// Breaker is open.
+var ErrUnavailable = errors.New("database unavailable")
+
+var breakerOpen = metrics.NewGauge("whitelist_db_breaker_open", "1 while store calls fail fast because the database is down.")
+
+// Breaker stops store calls from waiting on a database that is down. After
+// Failures transient errors in a row (see database.Transient) it opens and
+// fails calls with ErrUnavailable for Cooldown, then lets a single call
+// through: if that one works the breaker closes again, otherwise it stays
+// open for another Cooldown.
+type Breaker struct {
+	failures int
+	cooldown time.Duration
+
+	mu        sync.Mutex
+	errs      int
+	openUntil time.Time
+	probing   bool
+}
+
+// NewBreaker returns a breaker opening after failures transient errors in a
+// row, or nil (which never opens) if failures is 0.
+func NewBreaker(failures int, cooldown time.Duration) *Breaker {
+	if failures <= 0 {
+		return nil
+	}
+	return &Breaker{failures: failures, cooldown: cooldown}
+}
+
+// Open reports whether calls are currently failing fast.
+func (b *Breaker) Open() bool {
+	if b == nil {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.errs >= b.failures
+}
+
+// do runs fn unless the breaker is open, and counts its outcome.
+func (b *Breaker) do(fn func() error) error {
+	if !b.allow(time.Now()) {
+		return ErrUnavailable
+	}
+	err := fn()
+	b.record(err, time.Now())
+	return err
+}
+
+func (b *Breaker) allow(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.errs < b.failures {
+		return true
+	}
+	// Open: one probe at a time once the cooldown is over
+	if b.probing || now.Before(b.openUntil) {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+func (b *Breaker) record(err error, now time.Time) {
+	transient, _ := database.Transient(err)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if !transient {
+		if b.errs >= b.failures {
+			log.Printf("Database is back, closing the store breaker")
+			breakerOpen.Set(0)
+		}
+		b.errs = 0
+		return
+	}
+	b.errs++
+	if b.errs == b.failures {
+		log.Printf("Database failing (%v), opening the store breaker for %s", err, b.cooldown)
+		breakerOpen.Set(1)
+	}
+	if b.errs >= b.failures {
+		b.openUntil = now.Add(b.cooldown)
+	}
+}
+
+type breakLicenses struct {
+	next LicenseStore
+	b    *Breaker
+}
+
+// BreakLicenses wraps next with the breaker b, unless b is nil.
+func BreakLicenses(next LicenseStore, b *Breaker) LicenseStore {
+	if b == nil {
+		return next
+	}
+	return &breakLicenses{next: next, b: b}
+}
+
+func (s *breakLicenses) Get(ctx context.Context, q Querier, key string) (l *pb.License, err error) {
+	err = s.b.do(func() error {
+		l, err = s.next.Get(ctx, q, key)
+		return err
+	})
+	return l, err
+}
+
+func (s *breakLicenses) Find(ctx context.Context, key string) (l *pb.License, err error) {
+	err = s.b.do(func() error {
+		l, err = s.next.Find(ctx, key)
+		return err
+	})
+	return l, err
+}
+
+func (s *breakLicenses) Lock(ctx context.Context, q Querier, key string) (l *pb.License, err error) {
+	err = s.b.do(func() error {
+		l, err = s.next.Lock(ctx, q, key)
+		return err
+	})
+	return l, err
+}
+
+func (s *breakLicenses) State(ctx context.Context, key string) (l *cache.License, err error) {
+	err = s.b.do(func() error {
+		l, err = s.next.State(ctx, key)
+		return err
+	})
+	return l, err
+}
+
+func (s *breakLicenses) Upsert(ctx context.Context, q Querier, req *pb.UpdateLicenseRequest) error {
+	return s.b.do(func() error {
+		return s.next.Upsert(ctx, q, req)
+	})
+}
+
+func (s *breakLicenses) Create(ctx context.Context, q Querier, key, productID string, isActive bool, expiresAt *time.Time, maxDevices int32, plan string) (created bool, err error) {
+	err = s.b.do(func() error {
+		created, err = s.next.Create(ctx, q, key, productID, isActive, expiresAt, maxDevices, plan)
+		return err
+	})
+	return created, err
+}
+
+func (s *breakLicenses) Change(ctx context.Context, q Querier, key string, c LicenseChange) error {
+	return s.b.do(func() error {
+		return s.next.Change(ctx, q, key, c)
+	})
+}
+
+func (s *breakLicenses) Delete(ctx context.Context, q Querier, key string) error {
+	return s.b.do(func() error {
+		return s.next.Delete(ctx, q, key)
+	})
+}
+
+func (s *breakLicenses) Restore(ctx context.Context, q Querier, key string) error {
+	return s.b.do(func() error {
+		return s.next.Restore(ctx, q, key)
+	})
+}
+
+func (s *breakLicenses) Purge(ctx context.Context, q Querier, key string) error {
+	return s.b.do(func() error {
+		return s.next.Purge(ctx, q, key)
+	})
+}
+
+func (s *breakLicenses) Touch(ctx context.Context, key string) error {
+	return s.b.do(func() error {
+		return s.next.Touch(ctx, key)
+	})
+}
+
+type breakTokens struct {
+	next TokenStore
+	b    *Breaker
+}
+
+// BreakTokens wraps next with the breaker b, unless b is nil. Only wrap
+// stores kept in the database the breaker watches.
+func BreakTokens(next TokenStore, b *Breaker) TokenStore {
+	if b == nil {
+		return next
+	}
+	return &breakTokens{next: next, b: b}
+}
+
+func (s *breakTokens) Issue(ctx context.Context, q Querier, hash, productID string, ttl time.Duration) error {
+	return s.b.do(func() error {
+		return s.next.Issue(ctx, q, hash, productID, ttl)
+	})
+}
+
+func (s *breakTokens) Burn(ctx context.Context, hash string) (productID string, ok bool, err error) {
+	err = s.b.do(func() error {
+		productID, ok, err = s.next.Burn(ctx, hash)
+		return err
+	})
+	return productID, ok, err
+}
+
+func (s *breakTokens) Close() error {
+	return s.next.Close()
+}
+
+type breakAPIKeys struct {
+	next APIKeyStore
+	b    *Breaker
+}
+
+// BreakAPIKeys wraps next with the breaker b, unless b is nil.
+func BreakAPIKeys(next APIKeyStore, b *Breaker) APIKeyStore {
+	if b == nil {
+		return next
+	}
+	return &breakAPIKeys{next: next, b: b}
+}
+
+func (s *breakAPIKeys) Check(ctx context.Context, q Querier, keyHash string) (ok bool, err error) {
+	err = s.b.do(func() error {
+		ok, err = s.next.Check(ctx, q, keyHash)
+		return err
+	})
+	return ok, err
+}
+
+func (s *breakAPIKeys) IssueRefreshToken(ctx context.Context, q Querier, tokenHash, keyHash string, ttl time.Duration) error {
+	return s.b.do(func() error {
+		return s.next.IssueRefreshToken(ctx, q, tokenHash, keyHash, ttl)
+	})
+}
+
+func (s *breakAPIKeys) RedeemRefreshToken(ctx context.Context, q Querier, tokenHash string) (keyHash string, err error) {
+	err = s.b.do(func() error {
+		keyHash, err = s.next.RedeemRefreshToken(ctx, q, tokenHash)
+		return err
+	})
+	return keyHash, err
+}
+
+func (s *breakAPIKeys) RevokeRefreshTokens(ctx context.Context, q Querier, keyHash string) (n int64, err error) {
+	err = s.b.do(func() error {
+		n, err = s.next.RevokeRefreshTokens(ctx, q, keyHash)
+		return err
+	})
+	return n, err
+}
+
+func (s *breakAPIKeys) Rehash(ctx context.Context, q Querier, oldHash, newHash string) error {
+	return s.b.do(func() error {
+		return s.next.Rehash(ctx, q, oldHash, newHash)
+	})
+}
@@ -0,0 +1,45 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// ChallengeStore keeps the outstanding validation challenges and the
+// nonces of signed requests, so that neither is used twice.
+type ChallengeStore interface {
+	// Issue records a challenge, by its hash, good until expiresAt.
+	Issue(ctx context.Context, q Querier, challengeHash string, expiresAt time.Time) error
+	// Redeem uses up an unexpired challenge, reporting false if there was
+	// none.
+	Redeem(ctx context.Context, q Querier, challengeHash string) (bool, error)
+	// UseNonce records a request nonce for the product until expiresAt,
+	// reporting false if it was seen before.
+	UseNonce(ctx context.Context, q Querier, productID, nonce string, expiresAt time.Time) (bool, error)
+}
+
+type sqlChallenges struct{}
+
+// NewSQLChallenges keeps challenges in the validation_challenges table and
+// nonces in request_nonces.
+func NewSQLChallenges() ChallengeStore {
+	return sqlChallenges{}
+}
+
+func (sqlChallenges) Issue(ctx context.Context, q Querier, challengeHash string, expiresAt time.Time) error {
+	_, err := q.ExecContext(ctx, "INSERT INTO validation_challenges (challenge_hash, expires_at) VALUES ($1, $2)", challengeHash, expiresAt)
+	return err
+}
+
+func (sqlChallenges) Redeem(ctx context.Context, q Querier, challengeHash string) (bool, error) {
+	n, err := execRows(ctx, q, "DELETE FROM validation_challenges WHERE challenge_hash = $1 AND expires_at > NOW()", challengeHash)
+	return n > 0, err
+}
+
+func (sqlChallenges) UseNonce(ctx context.Context, q Querier, productID, nonce string, expiresAt time.Time) (bool, error) {
+	n, err := execRows(ctx, q, `
+		INSERT INTO request_nonces (product_id, nonce, expires_at) VALUES ($1, $2, $3)
+		ON CONFLICT (product_id, nonce) DO NOTHING
+	`, productID, nonce, expiresAt)
+	return n > 0, err
+}
@@ -0,0 +1,24 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// CleanupStore deletes rows once their expires_at has passed. Deletes
+// that go by anything else belong to the store of their table.
+type CleanupStore interface {
+	// DeleteExpired deletes the rows of table that expired before t.
+	DeleteExpired(ctx context.Context, q Querier, table string, before time.Time) (int64, error)
+}
+
+type sqlCleanup struct{}
+
+// NewSQLCleanup deletes from the database q belongs to.
+func NewSQLCleanup() CleanupStore {
+	return sqlCleanup{}
+}
+
+func (sqlCleanup) DeleteExpired(ctx context.Context, q Querier, table string, before time.Time) (int64, error) {
+	return execRows(ctx, q, "DELETE FROM "+table+" WHERE expires_at < $1", before)
+}
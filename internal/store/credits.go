@@ -0,0 +1,72 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// CreditStore changes licenses' credit balances and keeps a ledger of every
+// change.
+type CreditStore interface {
+	// Add changes the license's balance by delta and records it, returning
+	// the new balance. A balance can't go below zero.
+	Add(ctx context.Context, q Querier, licenseKey string, delta int64, kind, actor, note string) (int64, error)
+	// Activity returns a page of the license's ledger.
+	Activity(ctx context.Context, q Querier, licenseKey string, page Page) ([]*pb.LicenseCreditActivity, error)
+}
+
+type sqlCredits struct{}
+
+// NewSQLCredits keeps balances in the licenses table and the ledger in
+// license_credit_ledger.
+func NewSQLCredits() CreditStore {
+	return sqlCredits{}
+}
+
+func (sqlCredits) Add(ctx context.Context, q Querier, licenseKey string, delta int64, kind, actor, note string) (int64, error) {
+	// The credits >= 0 check constraint rejects overdrafts
+	_, err := q.ExecContext(ctx, "UPDATE licenses SET credits = credits + $2 WHERE license_key = $1", licenseKey, delta)
+	if err != nil {
+		return 0, err
+	}
+	var balance int64
+	if err := q.QueryRowContext(ctx, "SELECT credits FROM licenses WHERE license_key = $1", licenseKey).Scan(&balance); err != nil {
+		return 0, err
+	}
+	_, err = q.ExecContext(ctx, `
+		INSERT INTO license_credit_ledger (license_key, kind, delta, balance, actor, note)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, licenseKey, kind, delta, balance, actor, note)
+	return balance, err
+}
+
+func (sqlCredits) Activity(ctx context.Context, q Querier, licenseKey string, page Page) ([]*pb.LicenseCreditActivity, error) {
+	args := []interface{}{licenseKey}
+	query := "SELECT id, created_at, kind, delta, balance, actor, note FROM license_credit_ledger WHERE license_key = $1"
+	if cond, a := page.where(args); cond != "" {
+		query, args = query+" AND "+cond, a
+	}
+	orderLimit, args := page.orderLimit(args)
+
+	rows, err := q.QueryContext(ctx, query+orderLimit, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var activity []*pb.LicenseCreditActivity
+	for rows.Next() {
+		var a pb.LicenseCreditActivity
+		var createdAt time.Time
+		if err := rows.Scan(&a.Id, &createdAt, &a.Kind, &a.Delta, &a.Balance, &a.Actor, &a.Note); err != nil {
+			return nil, err
+		}
+		a.CreatedAt = timestamppb.New(createdAt)
+		activity = append(activity, &a)
+	}
+	return activity, rows.Err()
+}
@@ -0,0 +1,139 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// CustomerOrder is how customers can be listed.
+var CustomerOrder = &Order{
+	fields: map[string]string{"email": "email", "created_at": "created_at"},
+	times:  map[string]bool{"created_at": true},
+	tie:    "id",
+}
+
+// CustomerFilter narrows customers down; zero fields don't filter.
+type CustomerFilter struct {
+	// Lowercase
+	Email     string
+	DiscordID string
+	// The customer the license belongs to
+	LicenseKey string
+}
+
+// CustomerStore keeps customers. A missing customer is (nil, nil).
+type CustomerStore interface {
+	// Create adds a customer and returns its id, or 0 if its email or
+	// Discord ID is taken.
+	Create(ctx context.Context, q Querier, email, discordID, notes string) (int64, error)
+	// Get returns the customer.
+	Get(ctx context.Context, q Querier, id int64) (*pb.Customer, error)
+	// ByEmail returns the id of the customer with the (lowercase) email,
+	// creating one with notes if there's none.
+	ByEmail(ctx context.Context, q Querier, email, notes string) (int64, error)
+	// List returns a page of the customers matching f, in CustomerOrder.
+	List(ctx context.Context, q Querier, f CustomerFilter, page Page) ([]*pb.Customer, error)
+}
+
+type sqlCustomers struct{}
+
+// NewSQLCustomers keeps customers in the customers table.
+func NewSQLCustomers() CustomerStore {
+	return sqlCustomers{}
+}
+
+const customerColumns = `id, email, discord_id, notes, created_at,
+	(SELECT COUNT(*) FROM licenses l WHERE l.customer_id = customers.id AND l.deleted_at IS NULL)`
+
+func scanCustomer(row interface{ Scan(...interface{}) error }) (*pb.Customer, error) {
+	var c pb.Customer
+	var createdAt time.Time
+	err := row.Scan(&c.Id, &c.Email, &c.DiscordId, &c.Notes, &createdAt, &c.LicenseCount)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	c.CreatedAt = timestamppb.New(createdAt)
+	return &c, nil
+}
+
+func (sqlCustomers) Create(ctx context.Context, q Querier, email, discordID, notes string) (int64, error) {
+	// Both unique indexes count as a conflict here
+	var id int64
+	err := q.QueryRowContext(ctx, `
+		INSERT INTO customers (email, discord_id, notes) VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING
+		RETURNING id
+	`, email, discordID, notes).Scan(&id)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return id, err
+}
+
+func (sqlCustomers) Get(ctx context.Context, q Querier, id int64) (*pb.Customer, error) {
+	return scanCustomer(q.QueryRowContext(ctx, "SELECT "+customerColumns+" FROM customers WHERE id = $1", id))
+}
+
+func (sqlCustomers) ByEmail(ctx context.Context, q Querier, email, notes string) (int64, error) {
+	var id int64
+	err := q.QueryRowContext(ctx, `
+		INSERT INTO customers (email, notes) VALUES ($1, $2) ON CONFLICT DO NOTHING RETURNING id
+	`, email, notes).Scan(&id)
+	if err == sql.ErrNoRows {
+		err = q.QueryRowContext(ctx, "SELECT id FROM customers WHERE lower(email) = $1", email).Scan(&id)
+	}
+	return id, err
+}
+
+func (sqlCustomers) List(ctx context.Context, q Querier, f CustomerFilter, page Page) ([]*pb.Customer, error) {
+	var conds []string
+	var args []interface{}
+	addCond := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+
+	if f.Email != "" {
+		addCond("lower(email) = $%d", f.Email)
+	}
+	if f.DiscordID != "" {
+		addCond("discord_id = $%d", f.DiscordID)
+	}
+	if f.LicenseKey != "" {
+		addCond("id = (SELECT customer_id FROM licenses WHERE license_key = $%d)", f.LicenseKey)
+	}
+	if cond, a := page.where(args); cond != "" {
+		conds, args = append(conds, cond), a
+	}
+
+	query := "SELECT " + customerColumns + " FROM customers"
+	if len(conds) > 0 {
+		query += " WHERE " + strings.Join(conds, " AND ")
+	}
+	orderLimit, args := page.orderLimit(args)
+
+	rows, err := q.QueryContext(ctx, query+orderLimit, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var customers []*pb.Customer
+	for rows.Next() {
+		c, err := scanCustomer(rows)
+		if err != nil {
+			return nil, err
+		}
+		customers = append(customers, c)
+	}
+	return customers, rows.Err()
+}
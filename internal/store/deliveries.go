@@ -0,0 +1,81 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// DeliveryStore keeps a record of license keys mailed to customers.
+type DeliveryStore interface {
+	// Start records a send about to be attempted and returns its id.
+	Start(ctx context.Context, q Querier, licenseKey, email, requestedBy string) (int64, error)
+	// Finish records how the send went, sentAt being zero when it failed,
+	// and returns the delivery.
+	Finish(ctx context.Context, q Querier, id int64, status, errText string, sentAt time.Time) (*pb.LicenseDelivery, error)
+	// List returns the license's deliveries, newest first.
+	List(ctx context.Context, q Querier, licenseKey string) ([]*pb.LicenseDelivery, error)
+}
+
+type sqlDeliveries struct{}
+
+// NewSQLDeliveries keeps deliveries in the license_deliveries table.
+func NewSQLDeliveries() DeliveryStore {
+	return sqlDeliveries{}
+}
+
+const deliveryColumns = "id, license_key, email, status, error, requested_by, created_at, sent_at"
+
+func (sqlDeliveries) Start(ctx context.Context, q Querier, licenseKey, email, requestedBy string) (int64, error) {
+	var id int64
+	err := q.QueryRowContext(ctx, `
+		INSERT INTO license_deliveries (license_key, email, requested_by) VALUES ($1, $2, $3)
+		RETURNING id
+	`, licenseKey, email, requestedBy).Scan(&id)
+	return id, err
+}
+
+func (sqlDeliveries) Finish(ctx context.Context, q Querier, id int64, status, errText string, sentAt time.Time) (*pb.LicenseDelivery, error) {
+	sent := sql.NullTime{Time: sentAt, Valid: !sentAt.IsZero()}
+	_, err := q.ExecContext(ctx, "UPDATE license_deliveries SET status = $2, error = $3, sent_at = $4 WHERE id = $1", id, status, errText, sent)
+	if err != nil {
+		return nil, err
+	}
+	return scanDelivery(q.QueryRowContext(ctx, "SELECT "+deliveryColumns+" FROM license_deliveries WHERE id = $1", id))
+}
+
+func (sqlDeliveries) List(ctx context.Context, q Querier, licenseKey string) ([]*pb.LicenseDelivery, error) {
+	rows, err := q.QueryContext(ctx, "SELECT "+deliveryColumns+" FROM license_deliveries WHERE license_key = $1 ORDER BY id DESC", licenseKey)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []*pb.LicenseDelivery
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, rows.Err()
+}
+
+func scanDelivery(row interface{ Scan(...interface{}) error }) (*pb.LicenseDelivery, error) {
+	var d pb.LicenseDelivery
+	var createdAt time.Time
+	var sentAt sql.NullTime
+	if err := row.Scan(&d.Id, &d.LicenseKey, &d.Email, &d.Status, &d.Error, &d.RequestedBy, &createdAt, &sentAt); err != nil {
+		return nil, err
+	}
+	d.CreatedAt = timestamppb.New(createdAt)
+	if sentAt.Valid {
+		d.SentAt = timestamppb.New(sentAt.Time)
+	}
+	return &d, nil
+}
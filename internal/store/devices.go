@@ -0,0 +1,127 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// Device is a device bound to a license. Parts are its hashed HWID
+// components (CPU, disk, MAC, machine GUID), "" for any it didn't send.
+type Device struct {
+	HWID    string
+	Parts   [4]string
+	BoundAt time.Time
+}
+
+// DeviceStore keeps the devices bound to licenses, by hashed HWID.
+type DeviceStore interface {
+	// Lock locks the license's devices until the transaction q commits
+	// and returns when one last replaced another (see Rebound), the zero
+	// time if never.
+	Lock(ctx context.Context, q Querier, licenseKey string) (time.Time, error)
+	// Get returns the device, nil if it isn't bound to the license.
+	Get(ctx context.Context, q Querier, licenseKey, hwid string) (*Device, error)
+	// List returns the license's devices, in the order they were bound.
+	List(ctx context.Context, q Querier, licenseKey string) ([]*Device, error)
+	// Count returns how many devices are bound to the license.
+	Count(ctx context.Context, q Querier, licenseKey string) (int, error)
+	// Add binds d to the license, unless it is already.
+	Add(ctx context.Context, q Querier, licenseKey string, d *Device) error
+	// SetParts updates a bound device's parts.
+	SetParts(ctx context.Context, q Querier, licenseKey, hwid string, parts [4]string) error
+	// Replace puts d in the place of the bound device old.
+	Replace(ctx context.Context, q Querier, licenseKey, old string, d *Device) error
+	// Remove unbinds the device, reporting false if it wasn't bound.
+	Remove(ctx context.Context, q Querier, licenseKey, hwid string) (bool, error)
+	// RemoveAll unbinds every device of the license.
+	RemoveAll(ctx context.Context, q Querier, licenseKey string) error
+	// Rebound records that the license's devices changed at t, which the
+	// rebind cooldown counts from.
+	Rebound(ctx context.Context, q Querier, licenseKey string, t time.Time) error
+}
+
+type sqlDevices struct{}
+
+// NewSQLDevices keeps devices in the license_devices table.
+func NewSQLDevices() DeviceStore {
+	return sqlDevices{}
+}
+
+func (sqlDevices) Lock(ctx context.Context, q Querier, licenseKey string) (time.Time, error) {
+	var reboundAt sql.NullTime
+	err := q.QueryRowContext(ctx, "SELECT hwid_rebound_at FROM licenses WHERE license_key = $1 FOR UPDATE", licenseKey).Scan(&reboundAt)
+	if err == sql.ErrNoRows {
+		err = nil
+	}
+	return reboundAt.Time, err
+}
+
+func (sqlDevices) Get(ctx context.Context, q Querier, licenseKey, hwid string) (*Device, error) {
+	d := &Device{HWID: hwid}
+	err := q.QueryRowContext(ctx, "SELECT cpu, disk, mac, machine_guid, created_at FROM license_devices WHERE license_key = $1 AND hwid = $2", licenseKey, hwid).
+		Scan(&d.Parts[0], &d.Parts[1], &d.Parts[2], &d.Parts[3], &d.BoundAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return d, err
+}
+
+func (sqlDevices) List(ctx context.Context, q Querier, licenseKey string) ([]*Device, error) {
+	rows, err := q.QueryContext(ctx, "SELECT hwid, cpu, disk, mac, machine_guid, created_at FROM license_devices WHERE license_key = $1 ORDER BY created_at", licenseKey)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var devices []*Device
+	for rows.Next() {
+		var d Device
+		if err := rows.Scan(&d.HWID, &d.Parts[0], &d.Parts[1], &d.Parts[2], &d.Parts[3], &d.BoundAt); err != nil {
+			return nil, err
+		}
+		devices = append(devices, &d)
+	}
+	return devices, rows.Err()
+}
+
+func (sqlDevices) Count(ctx context.Context, q Querier, licenseKey string) (int, error) {
+	var n int
+	err := q.QueryRowContext(ctx, "SELECT COUNT(*) FROM license_devices WHERE license_key = $1", licenseKey).Scan(&n)
+	return n, err
+}
+
+func (sqlDevices) Add(ctx context.Context, q Querier, licenseKey string, d *Device) error {
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO license_devices (license_key, hwid, cpu, disk, mac, machine_guid) VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT DO NOTHING
+	`, licenseKey, d.HWID, d.Parts[0], d.Parts[1], d.Parts[2], d.Parts[3])
+	return err
+}
+
+func (sqlDevices) SetParts(ctx context.Context, q Querier, licenseKey, hwid string, parts [4]string) error {
+	_, err := q.ExecContext(ctx, "UPDATE license_devices SET cpu = $3, disk = $4, mac = $5, machine_guid = $6 WHERE license_key = $1 AND hwid = $2",
+		licenseKey, hwid, parts[0], parts[1], parts[2], parts[3])
+	return err
+}
+
+func (sqlDevices) Replace(ctx context.Context, q Querier, licenseKey, old string, d *Device) error {
+	_, err := q.ExecContext(ctx, "UPDATE license_devices SET hwid = $3, cpu = $4, disk = $5, mac = $6, machine_guid = $7 WHERE license_key = $1 AND hwid = $2",
+		licenseKey, old, d.HWID, d.Parts[0], d.Parts[1], d.Parts[2], d.Parts[3])
+	return err
+}
+
+func (sqlDevices) Remove(ctx context.Context, q Querier, licenseKey, hwid string) (bool, error) {
+	n, err := execRows(ctx, q, "DELETE FROM license_devices WHERE license_key = $1 AND hwid = $2", licenseKey, hwid)
+	return n > 0, err
+}
+
+func (sqlDevices) RemoveAll(ctx context.Context, q Querier, licenseKey string) error {
+	_, err := q.ExecContext(ctx, "DELETE FROM license_devices WHERE license_key = $1", licenseKey)
+	return err
+}
+
+func (sqlDevices) Rebound(ctx context.Context, q Querier, licenseKey string, t time.Time) error {
+	_, err := q.ExecContext(ctx, "UPDATE licenses SET hwid_rebound_at = $2 WHERE license_key = $1", licenseKey, t)
+	return err
+}
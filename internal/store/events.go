@@ -0,0 +1,101 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// EventFilter narrows validation events down; zero fields don't filter.
+type EventFilter struct {
+	LicenseKey string
+	ProductID  string
+	Valid      *bool
+}
+
+// EventStore keeps the validation events ValidateLicense logs.
+type EventStore interface {
+	// Record appends e; its ID and CreatedAt are set by the store.
+	Record(ctx context.Context, q Querier, e *pb.ValidationEvent) error
+	// List returns a page of the events matching f.
+	List(ctx context.Context, q Querier, f EventFilter, page Page) ([]*pb.ValidationEvent, error)
+	// DeleteBefore deletes up to limit events older than before.
+	DeleteBefore(ctx context.Context, q Querier, before time.Time, limit int) (int64, error)
+}
+
+type sqlEvents struct{}
+
+// NewSQLEvents keeps events in the validation_events table.
+func NewSQLEvents() EventStore {
+	return sqlEvents{}
+}
+
+func (sqlEvents) Record(ctx context.Context, q Querier, e *pb.ValidationEvent) error {
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO validation_events (license_key, product_id, hwid, valid, result, client_ip, country)
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''))
+	`, e.LicenseKey, e.ProductId, e.Hwid, e.Valid, e.Result, e.ClientIp, e.Country)
+	return err
+}
+
+func (sqlEvents) List(ctx context.Context, q Querier, f EventFilter, page Page) ([]*pb.ValidationEvent, error) {
+	var conds []string
+	var args []interface{}
+	addCond := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+
+	if f.LicenseKey != "" {
+		addCond("license_key = $%d", f.LicenseKey)
+	}
+	if f.ProductID != "" {
+		addCond("product_id = $%d", f.ProductID)
+	}
+	if f.Valid != nil {
+		addCond("valid = $%d", *f.Valid)
+	}
+	if cond, a := page.where(args); cond != "" {
+		conds, args = append(conds, cond), a
+	}
+
+	query := "SELECT id, created_at, license_key, product_id, hwid, valid, result, client_ip, country FROM validation_events"
+	if len(conds) > 0 {
+		query += " WHERE " + strings.Join(conds, " AND ")
+	}
+	orderLimit, args := page.orderLimit(args)
+
+	rows, err := q.QueryContext(ctx, query+orderLimit, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*pb.ValidationEvent
+	for rows.Next() {
+		var e pb.ValidationEvent
+		var createdAt time.Time
+		var clientIP, country sql.NullString
+		if err := rows.Scan(&e.Id, &createdAt, &e.LicenseKey, &e.ProductId, &e.Hwid, &e.Valid, &e.Result, &clientIP, &country); err != nil {
+			return nil, err
+		}
+		e.CreatedAt = timestamppb.New(createdAt)
+		e.ClientIp, e.Country = clientIP.String, country.String
+		events = append(events, &e)
+	}
+	return events, rows.Err()
+}
+
+func (sqlEvents) DeleteBefore(ctx context.Context, q Querier, before time.Time, limit int) (int64, error) {
+	return execRows(ctx, q, `
+		DELETE FROM validation_events WHERE id IN (
+			SELECT id FROM validation_events WHERE created_at < $1 LIMIT $2
+		)
+	`, before, limit)
+}
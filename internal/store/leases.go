@@ -0,0 +1,66 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// LeaseStore keeps the leases machines hold on floating licenses, by the
+// hash of their token. Devices are hashed HWIDs.
+type LeaseStore interface {
+	// Active counts the license's unexpired leases and returns when the
+	// first of them expires, the zero time if there are none.
+	Active(ctx context.Context, q Querier, licenseKey string) (int, time.Time, error)
+	// Holds reports whether the device holds an unexpired lease on the
+	// license.
+	Holds(ctx context.Context, q Querier, licenseKey, hwid string) (bool, error)
+	// Checkout gives the device a lease on the license until expiresAt,
+	// in place of any it held.
+	Checkout(ctx context.Context, q Querier, tokenHash, licenseKey, hwid, ip string, expiresAt time.Time) error
+	// Release ends the device's lease on the license, if it holds one.
+	Release(ctx context.Context, q Querier, licenseKey, hwid string) error
+	// Checkin ends the lease, if there is one.
+	Checkin(ctx context.Context, q Querier, tokenHash string) error
+}
+
+type sqlLeases struct{}
+
+// NewSQLLeases keeps leases in the license_leases table.
+func NewSQLLeases() LeaseStore {
+	return sqlLeases{}
+}
+
+func (sqlLeases) Active(ctx context.Context, q Querier, licenseKey string) (int, time.Time, error) {
+	var n int
+	var first sql.NullTime
+	err := q.QueryRowContext(ctx, "SELECT COUNT(*), MIN(expires_at) FROM license_leases WHERE license_key = $1 AND expires_at > NOW()", licenseKey).Scan(&n, &first)
+	return n, first.Time, err
+}
+
+func (sqlLeases) Holds(ctx context.Context, q Querier, licenseKey, hwid string) (bool, error) {
+	var n int
+	err := q.QueryRowContext(ctx, "SELECT COUNT(*) FROM license_leases WHERE license_key = $1 AND hwid = $2 AND expires_at > NOW()", licenseKey, hwid).Scan(&n)
+	return n > 0, err
+}
+
+func (s sqlLeases) Checkout(ctx context.Context, q Querier, tokenHash, licenseKey, hwid, ip string, expiresAt time.Time) error {
+	if err := s.Release(ctx, q, licenseKey, hwid); err != nil {
+		return err
+	}
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO license_leases (token_hash, license_key, hwid, client_ip, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`, tokenHash, licenseKey, hwid, ip, expiresAt)
+	return err
+}
+
+func (sqlLeases) Release(ctx context.Context, q Querier, licenseKey, hwid string) error {
+	_, err := q.ExecContext(ctx, "DELETE FROM license_leases WHERE license_key = $1 AND hwid = $2", licenseKey, hwid)
+	return err
+}
+
+func (sqlLeases) Checkin(ctx context.Context, q Querier, tokenHash string) error {
+	_, err := q.ExecContext(ctx, "DELETE FROM license_leases WHERE token_hash = $1", tokenHash)
+	return err
+}
@@ -0,0 +1,272 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// LicenseOrder is how licenses can be listed; the COALESCEs match the
+// indexes in migration 00032.
+var LicenseOrder = &Order{
+	fields: map[string]string{
+		"created_at":        "created_at",
+		"expires_at":        "COALESCE(expires_at, 'infinity'::timestamptz)",
+		"last_validated_at": "COALESCE(last_validated_at, '-infinity'::timestamptz)",
+	},
+	times: map[string]bool{"created_at": true, "expires_at": true, "last_validated_at": true},
+	tie:   "license_key",
+}
+
+// LicenseFilter narrows licenses down; zero fields don't filter.
+type LicenseFilter struct {
+	ProductID  string
+	Plan       string
+	CustomerID int64
+	IsActive   *bool
+	// Whether the license has devices bound
+	HWIDBound *bool
+	// Part of the key, any case
+	Search string
+	// The end of the key, any case
+	KeySuffix string
+	// A bound device, hashed
+	HWID string
+	// Part of the customer's email, any case
+	Email string
+	// Contained in the license's metadata
+	Metadata *structpb.Struct
+	// Only licenses with an expiry, and of those only the ones not expired
+	Expires, Unexpired bool
+	// Only live licenses, or only deleted ones
+	Live, Deleted bool
+}
+
+// LicenseListStore finds licenses for the admin API. Unlike LicenseStore it
+// isn't behind the breaker or retried.
+type LicenseListStore interface {
+	// List returns a page of the licenses matching f, in LicenseOrder.
+	List(ctx context.Context, q Querier, f LicenseFilter, page Page) ([]*pb.License, error)
+	// Keys returns the keys of the licenses matching f, sorted.
+	Keys(ctx context.Context, q Querier, f LicenseFilter) ([]string, error)
+	// LockKeys is Keys, locking the licenses until the transaction q
+	// commits.
+	LockKeys(ctx context.Context, q Querier, f LicenseFilter) ([]string, error)
+	// Settings returns the settings a CSV export holds of the given keys,
+	// by key; unknown keys are left out.
+	Settings(ctx context.Context, q Querier, keys []string) (map[string]*pb.UpdateLicenseRequest, error)
+	// Export calls fn with the settings of every license matching f,
+	// sorted by key, stopping at the first error.
+	Export(ctx context.Context, q Querier, f LicenseFilter, fn func(*pb.UpdateLicenseRequest) error) error
+}
+
+type sqlLicenseLists struct{}
+
+// NewSQLLicenseLists finds licenses in the licenses table.
+func NewSQLLicenseLists() LicenseListStore {
+	return sqlLicenseLists{}
+}
+
+// Escapes LIKE wildcards so searched text only matches itself
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// where turns f into WHERE conditions and their arguments.
+func (f LicenseFilter) where() ([]string, []interface{}, error) {
+	var conds []string
+	var args []interface{}
+	addCond := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+
+	if f.ProductID != "" {
+		addCond("product_id = $%d", f.ProductID)
+	}
+	if f.Plan != "" {
+		addCond("plan = $%d", f.Plan)
+	}
+	if f.CustomerID != 0 {
+		addCond("customer_id = $%d", f.CustomerID)
+	}
+	if f.IsActive != nil {
+		addCond("is_active = $%d", *f.IsActive)
+	}
+	if f.HWIDBound != nil {
+		bound := "EXISTS (SELECT 1 FROM license_devices d WHERE d.license_key = licenses.license_key)"
+		if !*f.HWIDBound {
+			bound = "NOT " + bound
+		}
+		conds = append(conds, bound)
+	}
+	if f.Search != "" {
+		addCond(`license_key ILIKE $%d ESCAPE '\'`, "%"+likeEscaper.Replace(f.Search)+"%")
+	}
+	if f.KeySuffix != "" {
+		// A prefix of the reversed key, which licenses_key_reverse_idx covers
+		suffix := reverseString(strings.ToLower(f.KeySuffix))
+		addCond(`reverse(lower(license_key)) LIKE $%d ESCAPE '\'`, likeEscaper.Replace(suffix)+"%")
+	}
+	if f.HWID != "" {
+		// Stored hashed, so only the whole HWID can match
+		addCond(`EXISTS (SELECT 1 FROM license_devices d WHERE d.license_key = licenses.license_key AND d.hwid = $%d)`, f.HWID)
+	}
+	if f.Email != "" {
+		addCond(`customer_id IN (SELECT id FROM customers WHERE email ILIKE $%d ESCAPE '\')`, "%"+likeEscaper.Replace(f.Email)+"%")
+	}
+	if len(f.Metadata.GetFields()) > 0 {
+		metadata, err := MetadataJSON(f.Metadata)
+		if err != nil {
+			return nil, nil, err
+		}
+		addCond("metadata @> $%d::jsonb", metadata)
+	}
+	if f.Expires {
+		conds = append(conds, "expires_at IS NOT NULL")
+	}
+	if f.Unexpired {
+		conds = append(conds, "(expires_at IS NULL OR expires_at > NOW())")
+	}
+	if f.Live {
+		conds = append(conds, "deleted_at IS NULL")
+	}
+	if f.Deleted {
+		conds = append(conds, "deleted_at IS NOT NULL")
+	}
+	return conds, args, nil
+}
+
+func reverseString(s string) string {
+	r := []rune(s)
+	for i, j := 0, len(r)-1; i < j; i, j = i+1, j-1 {
+		r[i], r[j] = r[j], r[i]
+	}
+	return string(r)
+}
+
+// query returns SELECT columns FROM licenses with f's conditions, and their
+// arguments.
+func (f LicenseFilter) query(columns string) (string, []interface{}, error) {
+	conds, args, err := f.where()
+	if err != nil {
+		return "", nil, err
+	}
+	query := "SELECT " + columns + " FROM licenses"
+	if len(conds) > 0 {
+		query += " WHERE " + strings.Join(conds, " AND ")
+	}
+	return query, args, nil
+}
+
+func (sqlLicenseLists) List(ctx context.Context, q Querier, f LicenseFilter, page Page) ([]*pb.License, error) {
+	conds, args, err := f.where()
+	if err != nil {
+		return nil, err
+	}
+	if cond, a := page.where(args); cond != "" {
+		conds, args = append(conds, cond), a
+	}
+	query := "SELECT " + LicenseColumns + " FROM licenses"
+	if len(conds) > 0 {
+		query += " WHERE " + strings.Join(conds, " AND ")
+	}
+	orderLimit, args := page.orderLimit(args)
+
+	rows, err := q.QueryContext(ctx, query+orderLimit, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var licenses []*pb.License
+	for rows.Next() {
+		l, err := ScanLicense(rows)
+		if err != nil {
+			return nil, err
+		}
+		licenses = append(licenses, l)
+	}
+	return licenses, rows.Err()
+}
+
+func (sqlLicenseLists) Keys(ctx context.Context, q Querier, f LicenseFilter) ([]string, error) {
+	query, args, err := f.query("license_key")
+	if err != nil {
+		return nil, err
+	}
+	return queryKeys(ctx, q, query+" ORDER BY license_key", args)
+}
+
+func (sqlLicenseLists) LockKeys(ctx context.Context, q Querier, f LicenseFilter) ([]string, error) {
+	query, args, err := f.query("license_key")
+	if err != nil {
+		return nil, err
+	}
+	return queryKeys(ctx, q, query+" ORDER BY license_key FOR UPDATE", args)
+}
+
+func queryKeys(ctx context.Context, q Querier, query string, args []interface{}) ([]string, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []string
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+const settingsColumns = "license_key, product_id, is_active, expires_at, max_devices, max_sessions"
+
+func (sqlLicenseLists) Settings(ctx context.Context, q Querier, keys []string) (map[string]*pb.UpdateLicenseRequest, error) {
+	out := map[string]*pb.UpdateLicenseRequest{}
+	err := querySettings(ctx, q, "SELECT "+settingsColumns+" FROM licenses WHERE license_key = ANY($1)", []interface{}{pq.Array(keys)}, func(l *pb.UpdateLicenseRequest) error {
+		out[l.LicenseKey] = l
+		return nil
+	})
+	return out, err
+}
+
+func (sqlLicenseLists) Export(ctx context.Context, q Querier, f LicenseFilter, fn func(*pb.UpdateLicenseRequest) error) error {
+	query, args, err := f.query(settingsColumns)
+	if err != nil {
+		return err
+	}
+	return querySettings(ctx, q, query+" ORDER BY license_key", args, fn)
+}
+
+func querySettings(ctx context.Context, q Querier, query string, args []interface{}, fn func(*pb.UpdateLicenseRequest) error) error {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var l pb.UpdateLicenseRequest
+		var expiresAt sql.NullTime
+		if err := rows.Scan(&l.LicenseKey, &l.ProductId, &l.IsActive, &expiresAt, &l.MaxDevices, &l.MaxSessions); err != nil {
+			return err
+		}
+		if expiresAt.Valid {
+			l.ExpiresAt = timestamppb.New(expiresAt.Time)
+		}
+		if err := fn(&l); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
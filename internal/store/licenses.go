@@ -0,0 +1,357 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mkseven15/whitelist-server/internal/cache"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// ErrLicenseDeleted is returned by LicenseStore.Upsert for a soft-deleted key.
+var ErrLicenseDeleted = errors.New("license is deleted")
+
+// LicenseStore reads and writes licenses. A missing license is (nil, nil).
+type LicenseStore interface {
+	// Get returns the license unless it's deleted.
+	Get(ctx context.Context, q Querier, key string) (*pb.License, error)
+	// Find returns the license, deleted or not.
+	Find(ctx context.Context, key string) (*pb.License, error)
+	// Lock returns the license, deleted or not, and locks its row until
+	// the transaction q commits.
+	Lock(ctx context.Context, q Querier, key string) (*pb.License, error)
+	// State returns what ValidateLicense needs to know about a live license.
+	State(ctx context.Context, key string) (*cache.License, error)
+	// Upsert creates the license or overwrites all of its settings
+	// (metadata and plan only when set).
+	Upsert(ctx context.Context, q Querier, req *pb.UpdateLicenseRequest) error
+	// Create adds a license with just these settings, reporting false if
+	// the key is taken. A nil expiresAt never expires; "" is no plan.
+	Create(ctx context.Context, q Querier, key, productID string, isActive bool, expiresAt *time.Time, maxDevices int32, plan string) (bool, error)
+	// Change sets c's fields on the license.
+	Change(ctx context.Context, q Querier, key string, c LicenseChange) error
+	// Delete soft-deletes the license and ends its sessions.
+	Delete(ctx context.Context, q Querier, key string) error
+	// Restore undoes Delete.
+	Restore(ctx context.Context, q Querier, key string) error
+	// Purge deletes the license for good, with its devices and the rest.
+	Purge(ctx context.Context, q Querier, key string) error
+	// Touch records that the license was just validated.
+	Touch(ctx context.Context, key string) error
+}
+
+// LicenseChange is what LicenseStore.Change sets; nil fields are left alone.
+type LicenseChange struct {
+	ExpiresAt     *time.Time
+	IsActive      *bool
+	SuspendReason *string
+	FloatingSeats *int32
+	// Set together
+	Countries *Countries
+	// JSON, as stored in the features column
+	Features *string
+	// "" unpins the license
+	Channel *string
+	// 0 detaches the license from its customer
+	CustomerID *int64
+}
+
+// Countries are a license's or product's country lists.
+type Countries struct {
+	Allowed, Blocked []string
+}
+
+type sqlLicenses struct {
+	db *sql.DB
+}
+
+// NewSQLLicenses keeps licenses in db's licenses table.
+func NewSQLLicenses(db *sql.DB) LicenseStore {
+	return &sqlLicenses{db: db}
+}
+
+func (s *sqlLicenses) Get(ctx context.Context, q Querier, key string) (*pb.License, error) {
+	l, err := ScanLicense(q.QueryRowContext(ctx, "SELECT "+LicenseColumns+" FROM licenses WHERE license_key = $1 AND deleted_at IS NULL", key))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return l, err
+}
+
+func (s *sqlLicenses) Find(ctx context.Context, key string) (*pb.License, error) {
+	l, err := ScanLicense(s.db.QueryRowContext(ctx, "SELECT "+LicenseColumns+" FROM licenses WHERE license_key = $1", key))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return l, err
+}
+
+func (s *sqlLicenses) Lock(ctx context.Context, q Querier, key string) (*pb.License, error) {
+	l, err := ScanLicense(q.QueryRowContext(ctx, "SELECT "+LicenseColumns+" FROM licenses WHERE license_key = $1 FOR UPDATE", key))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return l, err
+}
+
+func (s *sqlLicenses) State(ctx context.Context, key string) (*cache.License, error) {
+	var l cache.License
+	var expiresAt sql.NullTime
+	var metadata, features, productFeatures, planFeatures []byte
+	err := s.db.QueryRowContext(ctx, `
+		SELECT l.product_id, l.is_active, l.max_devices, l.expires_at, l.suspend_reason, l.metadata,
+			l.allowed_countries, l.blocked_countries, p.disabled, p.min_version, p.allowed_countries, p.blocked_countries,
+			p.require_challenge, l.floating_seats, l.features, p.features, pl.features
+		FROM licenses l JOIN products p ON p.product_id = l.product_id
+			LEFT JOIN plans pl ON pl.name = l.plan
+		WHERE l.license_key = $1 AND l.deleted_at IS NULL
+	`, key).Scan(&l.ProductID, &l.IsActive, &l.MaxDevices, &expiresAt, &l.SuspendReason, &metadata,
+		(*pq.StringArray)(&l.AllowedCountries), (*pq.StringArray)(&l.BlockedCountries), &l.ProductDisabled, &l.MinVersion,
+		(*pq.StringArray)(&l.ProductAllowedCountries), (*pq.StringArray)(&l.ProductBlockedCountries),
+		&l.RequireChallenge, &l.FloatingSeats, &features, &productFeatures, &planFeatures)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if l.Features, err = ParseFeatures(features); err != nil {
+		return nil, err
+	}
+	if l.ProductFeatures, err = ParseFeatures(productFeatures); err != nil {
+		return nil, err
+	}
+	if l.PlanFeatures, err = ParseFeatures(planFeatures); err != nil {
+		return nil, err
+	}
+	if expiresAt.Valid {
+		l.ExpiresAt = &expiresAt.Time
+	}
+	l.Metadata = metadata
+	return &l, nil
+}
+
+func (s *sqlLicenses) Upsert(ctx context.Context, q Querier, req *pb.UpdateLicenseRequest) error {
+	// Unset expires_at stores NULL (lifetime license)
+	var expiresAt sql.NullTime
+	if req.ExpiresAt != nil {
+		expiresAt = sql.NullTime{Time: req.ExpiresAt.AsTime(), Valid: true}
+	}
+
+	maxDevices := req.MaxDevices
+	if maxDevices <= 0 {
+		maxDevices = 1
+	}
+
+	// Unset metadata keeps what's stored
+	metadata, err := MetadataJSON(req.Metadata)
+	if err != nil {
+		return err
+	}
+
+	// Checked up front too: on MariaDB the upsert below reports its row as
+	// affected even when its WHERE leaves the row alone
+	var deleted bool
+	err = q.QueryRowContext(ctx, "SELECT deleted_at IS NOT NULL FROM licenses WHERE license_key = $1", req.LicenseKey).Scan(&deleted)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	if deleted {
+		return ErrLicenseDeleted
+	}
+
+	// Unset plan keeps what's stored, empty takes the license off its plan
+	res, err := q.ExecContext(ctx, `
+		INSERT INTO licenses (license_key, product_id, is_active, expires_at, max_devices, max_sessions, metadata, plan)
+		VALUES ($1, $2, $3, $4, $5, $6, COALESCE($7::jsonb, '{}'), NULLIF($8::text, ''))
+		ON CONFLICT (license_key) 
+		DO UPDATE SET product_id = $2, is_active = $3, expires_at = $4, max_devices = $5, max_sessions = $6,
+			metadata = COALESCE($7::jsonb, licenses.metadata),
+			plan = CASE WHEN $8::text IS NULL THEN licenses.plan ELSE NULLIF($8::text, '') END,
+			suspend_reason = CASE WHEN $3 THEN '' ELSE licenses.suspend_reason END
+		WHERE licenses.deleted_at IS NULL
+	`, req.LicenseKey, req.ProductId, req.IsActive, expiresAt, maxDevices, max(req.MaxSessions, 0), metadata, req.Plan)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrLicenseDeleted
+	}
+	return nil
+}
+
+func (s *sqlLicenses) Create(ctx context.Context, q Querier, key, productID string, isActive bool, expiresAt *time.Time, maxDevices int32, plan string) (bool, error) {
+	n, err := execRows(ctx, q, `
+		INSERT INTO licenses (license_key, product_id, is_active, expires_at, max_devices, plan)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6::text, ''))
+		ON CONFLICT (license_key) DO NOTHING
+	`, key, productID, isActive, expiresAt, maxDevices, plan)
+	return n > 0, err
+}
+
+func (s *sqlLicenses) Change(ctx context.Context, q Querier, key string, c LicenseChange) error {
+	var sets []string
+	args := []interface{}{key}
+	set := func(column string, arg interface{}) {
+		args = append(args, arg)
+		sets = append(sets, fmt.Sprintf(column, len(args)))
+	}
+
+	if c.ExpiresAt != nil {
+		set("expires_at = $%d", *c.ExpiresAt)
+	}
+	if c.IsActive != nil {
+		set("is_active = $%d", *c.IsActive)
+	}
+	if c.SuspendReason != nil {
+		set("suspend_reason = $%d", *c.SuspendReason)
+	}
+	if c.FloatingSeats != nil {
+		set("floating_seats = $%d", *c.FloatingSeats)
+	}
+	if c.Countries != nil {
+		set("allowed_countries = $%d", pq.StringArray(c.Countries.Allowed))
+		set("blocked_countries = $%d", pq.StringArray(c.Countries.Blocked))
+	}
+	if c.Features != nil {
+		set("features = $%d", *c.Features)
+	}
+	if c.Channel != nil {
+		set("update_channel = NULLIF($%d, '')", *c.Channel)
+	}
+	if c.CustomerID != nil {
+		set("customer_id = NULLIF($%d, 0)", *c.CustomerID)
+	}
+	if len(sets) == 0 {
+		return nil
+	}
+	_, err := q.ExecContext(ctx, "UPDATE licenses SET "+strings.Join(sets, ", ")+" WHERE license_key = $1", args...)
+	return err
+}
+
+func (s *sqlLicenses) Delete(ctx context.Context, q Querier, key string) error {
+	// Kept, devices and all, until PurgeLicense; only running sessions and
+	// leases end
+	_, err := q.ExecContext(ctx, "UPDATE licenses SET deleted_at = NOW() WHERE license_key = $1 AND deleted_at IS NULL", key)
+	if err != nil {
+		return err
+	}
+	if _, err := q.ExecContext(ctx, "DELETE FROM license_sessions WHERE license_key = $1", key); err != nil {
+		return err
+	}
+	_, err = q.ExecContext(ctx, "DELETE FROM license_leases WHERE license_key = $1", key)
+	return err
+}
+
+func (s *sqlLicenses) Restore(ctx context.Context, q Querier, key string) error {
+	_, err := q.ExecContext(ctx, "UPDATE licenses SET deleted_at = NULL WHERE license_key = $1", key)
+	return err
+}
+
+func (s *sqlLicenses) Purge(ctx context.Context, q Querier, key string) error {
+	_, err := q.ExecContext(ctx, "DELETE FROM licenses WHERE license_key = $1", key)
+	return err
+}
+
+func (s *sqlLicenses) Touch(ctx context.Context, key string) error {
+	_, err := s.db.ExecContext(ctx, "UPDATE licenses SET last_validated_at = NOW() WHERE license_key = $1", key)
+	return err
+}
+
+// LicenseColumns is the column list ScanLicense expects, in order.
+const LicenseColumns = `license_key, product_id, is_active, max_devices, expires_at, created_at, last_validated_at,
+	ARRAY(SELECT d.hwid FROM license_devices d WHERE d.license_key = licenses.license_key ORDER BY d.created_at),
+	max_sessions, (SELECT COUNT(*) FROM license_sessions ls WHERE ls.license_key = licenses.license_key AND ls.expires_at > NOW()),
+	metadata, COALESCE(update_channel, ''), COALESCE(customer_id, 0), suspend_reason,
+	allowed_countries, blocked_countries, deleted_at, hwid_rebound_at,
+	floating_seats, (SELECT COUNT(*) FROM license_leases ll WHERE ll.license_key = licenses.license_key AND ll.expires_at > NOW()),
+	credits, features, COALESCE(plan, '')`
+
+// ScanLicense reads one row selected with LicenseColumns.
+func ScanLicense(row interface{ Scan(...interface{}) error }) (*pb.License, error) {
+	var l pb.License
+	var expiresAt, lastValidatedAt, deletedAt, reboundAt sql.NullTime
+	var createdAt time.Time
+	var hwids pq.StringArray
+	var metadata, features []byte
+	if err := row.Scan(&l.LicenseKey, &l.ProductId, &l.IsActive, &l.MaxDevices, &expiresAt, &createdAt, &lastValidatedAt, &hwids, &l.MaxSessions, &l.ActiveSessions, &metadata, &l.Channel, &l.CustomerId, &l.SuspendReason,
+		(*pq.StringArray)(&l.AllowedCountries), (*pq.StringArray)(&l.BlockedCountries), &deletedAt, &reboundAt,
+		&l.FloatingSeats, &l.ActiveLeases, &l.Credits, &features, &l.Plan); err != nil {
+		return nil, err
+	}
+	m, err := ParseMetadata(metadata)
+	if err != nil {
+		return nil, err
+	}
+	l.Metadata = m
+	if l.Features, err = ParseFeatures(features); err != nil {
+		return nil, err
+	}
+	l.Hwids = hwids
+	if len(hwids) > 0 {
+		l.Hwid = hwids[0]
+	}
+	l.CreatedAt = timestamppb.New(createdAt)
+	if expiresAt.Valid {
+		l.ExpiresAt = timestamppb.New(expiresAt.Time)
+	}
+	if lastValidatedAt.Valid {
+		l.LastValidatedAt = timestamppb.New(lastValidatedAt.Time)
+	}
+	if deletedAt.Valid {
+		l.DeletedAt = timestamppb.New(deletedAt.Time)
+	}
+	if reboundAt.Valid {
+		l.HwidReboundAt = timestamppb.New(reboundAt.Time)
+	}
+	return &l, nil
+}
+
+// MetadataJSON encodes m for the metadata column; nil stays nil so Upsert
+// keeps the stored value.
+func MetadataJSON(m *structpb.Struct) (interface{}, error) {
+	if m == nil {
+		return nil, nil
+	}
+	b, err := protojson.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// ParseMetadata decodes a metadata column value.
+func ParseMetadata(b []byte) (*structpb.Struct, error) {
+	m := &structpb.Struct{}
+	if len(b) == 0 {
+		return m, nil
+	}
+	if err := protojson.Unmarshal(b, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ParseFeatures decodes a features column value; no flags is nil.
+func ParseFeatures(b []byte) (map[string]bool, error) {
+	var m map[string]bool
+	if len(b) == 0 {
+		return nil, nil
+	}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	if len(m) == 0 {
+		return nil, nil
+	}
+	return m, nil
+}
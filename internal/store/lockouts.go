@@ -0,0 +1,116 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// Lockout scopes
+const (
+	LockoutKey = "key"
+	LockoutIP  = "ip"
+)
+
+// LockoutStore counts failed validations per license key and per IP, and
+// locks out those that fail too often.
+type LockoutStore interface {
+	// LockedUntil returns when the later of the key's and the IP's
+	// lockouts ends, the zero time if neither is locked out.
+	LockedUntil(ctx context.Context, q Querier, key, ip string) (time.Time, error)
+	// Fail counts a failure against the subject and returns its count.
+	Fail(ctx context.Context, q Querier, scope, subject string) (int, error)
+	// Lock locks the subject out until t and starts its count over.
+	Lock(ctx context.Context, q Querier, scope, subject string, until time.Time) error
+	// Reset drops the key's and the IP's counts, unless they're locked out.
+	Reset(ctx context.Context, q Querier, key, ip string) error
+	// Clear drops the key's and the IP's counts and lockouts, returning
+	// them.
+	Clear(ctx context.Context, q Querier, key, ip string) ([]*pb.Lockout, error)
+	// DeleteStale drops lockouts that ended before now and counts last
+	// changed before idleSince.
+	DeleteStale(ctx context.Context, q Querier, now, idleSince time.Time) (int64, error)
+}
+
+type sqlLockouts struct{}
+
+// NewSQLLockouts keeps lockouts in the validation_lockouts table.
+func NewSQLLockouts() LockoutStore {
+	return sqlLockouts{}
+}
+
+func (sqlLockouts) LockedUntil(ctx context.Context, q Querier, key, ip string) (time.Time, error) {
+	var until sql.NullTime
+	err := q.QueryRowContext(ctx, `
+		SELECT MAX(locked_until) FROM validation_lockouts
+		WHERE ((scope = 'key' AND subject = $1) OR (scope = 'ip' AND subject = $2)) AND locked_until > NOW()
+	`, key, ip).Scan(&until)
+	return until.Time, err
+}
+
+func (sqlLockouts) Fail(ctx context.Context, q Querier, scope, subject string) (int, error) {
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO validation_lockouts (scope, subject, failures) VALUES ($1, $2, 1)
+		ON CONFLICT (scope, subject) DO UPDATE SET failures = validation_lockouts.failures + 1, updated_at = NOW()
+	`, scope, subject)
+	if err != nil {
+		return 0, err
+	}
+	// Read back separately, as MariaDB upserts can't return the updated row
+	var failures int
+	err = q.QueryRowContext(ctx, "SELECT failures FROM validation_lockouts WHERE scope = $1 AND subject = $2", scope, subject).Scan(&failures)
+	return failures, err
+}
+
+func (sqlLockouts) Lock(ctx context.Context, q Querier, scope, subject string, until time.Time) error {
+	_, err := q.ExecContext(ctx, `
+		UPDATE validation_lockouts SET failures = 0, locked_until = $3, updated_at = NOW()
+		WHERE scope = $1 AND subject = $2
+	`, scope, subject, until)
+	return err
+}
+
+func (sqlLockouts) Reset(ctx context.Context, q Querier, key, ip string) error {
+	_, err := q.ExecContext(ctx, `
+		DELETE FROM validation_lockouts
+		WHERE ((scope = 'key' AND subject = $1) OR (scope = 'ip' AND subject = $2)) AND (locked_until IS NULL OR locked_until < NOW())
+	`, key, ip)
+	return err
+}
+
+func (sqlLockouts) Clear(ctx context.Context, q Querier, key, ip string) ([]*pb.Lockout, error) {
+	rows, err := q.QueryContext(ctx, `
+		DELETE FROM validation_lockouts
+		WHERE (scope = 'key' AND subject = $1) OR (scope = 'ip' AND subject = $2)
+		RETURNING scope, subject, failures, locked_until
+	`, key, ip)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var cleared []*pb.Lockout
+	for rows.Next() {
+		var l pb.Lockout
+		var lockedUntil sql.NullTime
+		if err := rows.Scan(&l.Scope, &l.Subject, &l.Failures, &lockedUntil); err != nil {
+			return nil, err
+		}
+		if lockedUntil.Valid && lockedUntil.Time.After(time.Now()) {
+			l.LockedUntil = timestamppb.New(lockedUntil.Time)
+		}
+		cleared = append(cleared, &l)
+	}
+	return cleared, rows.Err()
+}
+
+func (sqlLockouts) DeleteStale(ctx context.Context, q Querier, now, idleSince time.Time) (int64, error) {
+	return execRows(ctx, q, `
+		DELETE FROM validation_lockouts
+		WHERE (locked_until IS NULL OR locked_until < $1) AND updated_at < $2
+	`, now, idleSince)
+}
@@ -0,0 +1,57 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// MaintenanceStore keeps whether maintenance mode was turned on through the
+// API, so every replica sees a change at once.
+type MaintenanceStore interface {
+	// Get returns the stored mode, not enabled if it's off.
+	Get(ctx context.Context, q Querier) (*pb.MaintenanceMode, error)
+	// Start turns maintenance mode on; when it's on already only the
+	// message changes.
+	Start(ctx context.Context, q Querier, message, startedBy string) error
+	// End turns maintenance mode off.
+	End(ctx context.Context, q Querier) error
+}
+
+type sqlMaintenance struct{}
+
+// NewSQLMaintenance keeps maintenance mode in the maintenance table.
+func NewSQLMaintenance() MaintenanceStore {
+	return sqlMaintenance{}
+}
+
+func (sqlMaintenance) Get(ctx context.Context, q Querier) (*pb.MaintenanceMode, error) {
+	mode := &pb.MaintenanceMode{}
+	var startedAt time.Time
+	err := q.QueryRowContext(ctx, "SELECT message, started_by, started_at FROM maintenance WHERE id = 1").Scan(&mode.Message, &mode.StartedBy, &startedAt)
+	if err == sql.ErrNoRows {
+		return mode, nil
+	} else if err != nil {
+		return nil, err
+	}
+	mode.Enabled = true
+	mode.StartedAt = timestamppb.New(startedAt)
+	return mode, nil
+}
+
+func (sqlMaintenance) Start(ctx context.Context, q Querier, message, startedBy string) error {
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO maintenance (id, message, started_by) VALUES (1, $1, $2)
+		ON CONFLICT (id) DO UPDATE SET message = $1
+	`, message, startedBy)
+	return err
+}
+
+func (sqlMaintenance) End(ctx context.Context, q Querier) error {
+	_, err := q.ExecContext(ctx, "DELETE FROM maintenance")
+	return err
+}
@@ -0,0 +1,72 @@
+package store
+
+import (
+	"context"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// MessageStore keeps the products' own wording of validation messages.
+type MessageStore interface {
+	// Get returns the product's messages by locale and reason.
+	Get(ctx context.Context, q Querier, productID string) ([]*pb.ProductMessage, error)
+	// All returns every product's messages, by product.
+	All(ctx context.Context, q Querier) (map[string][]*pb.ProductMessage, error)
+	// Set replaces the product's messages with messages.
+	Set(ctx context.Context, q Querier, productID string, messages []*pb.ProductMessage) error
+}
+
+type sqlMessages struct{}
+
+// NewSQLMessages keeps messages in the product_messages table.
+func NewSQLMessages() MessageStore {
+	return sqlMessages{}
+}
+
+func (sqlMessages) Get(ctx context.Context, q Querier, productID string) ([]*pb.ProductMessage, error) {
+	var messages []*pb.ProductMessage
+	err := queryMessages(ctx, q, "SELECT product_id, locale, reason, message FROM product_messages WHERE product_id = $1 ORDER BY locale, reason", []interface{}{productID}, func(_ string, m *pb.ProductMessage) {
+		messages = append(messages, m)
+	})
+	return messages, err
+}
+
+func (sqlMessages) All(ctx context.Context, q Querier) (map[string][]*pb.ProductMessage, error) {
+	byProduct := map[string][]*pb.ProductMessage{}
+	err := queryMessages(ctx, q, "SELECT product_id, locale, reason, message FROM product_messages", nil, func(productID string, m *pb.ProductMessage) {
+		byProduct[productID] = append(byProduct[productID], m)
+	})
+	return byProduct, err
+}
+
+func (sqlMessages) Set(ctx context.Context, q Querier, productID string, messages []*pb.ProductMessage) error {
+	if _, err := q.ExecContext(ctx, "DELETE FROM product_messages WHERE product_id = $1", productID); err != nil {
+		return err
+	}
+	for _, m := range messages {
+		_, err := q.ExecContext(ctx, "INSERT INTO product_messages (product_id, locale, reason, message) VALUES ($1, $2, $3, $4)",
+			productID, m.Locale, m.Reason.String(), m.Message)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func queryMessages(ctx context.Context, q Querier, query string, args []interface{}, fn func(productID string, m *pb.ProductMessage)) error {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var productID, reason string
+		var m pb.ProductMessage
+		if err := rows.Scan(&productID, &m.Locale, &reason, &m.Message); err != nil {
+			return err
+		}
+		m.Reason = pb.Reason(pb.Reason_value[reason])
+		fn(productID, &m)
+	}
+	return rows.Err()
+}
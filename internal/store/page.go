@@ -0,0 +1,104 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/mkseven15/whitelist-server/internal/database"
+)
+
+// Order is how a list can be sorted. Pages are fetched by keyset: each one
+// starts after the last row of the one before, so rows inserted or deleted
+// in between don't shift or repeat what follows.
+type Order struct {
+	// Sort field names and their SQL, which must never be NULL
+	fields map[string]string
+	// Those of fields sorting by a time
+	times map[string]bool
+	// A unique column, sorted on last to break ties, and alone without a
+	// field
+	tie     string
+	tieDesc bool
+}
+
+// The order of lists that only go by id, newest first unless sorted by "id"
+var NewestFirst = &Order{tie: "id", tieDesc: true}
+
+// Field reports whether the list can be sorted by name, and whether that
+// sorts by a time. The tie column is always a field.
+func (o *Order) Field(name string) (isTime, ok bool) {
+	if name == o.tie {
+		return false, true
+	}
+	_, ok = o.fields[name]
+	return o.times[name], ok
+}
+
+// Tie returns the unique column and whether it sorts descending by default.
+func (o *Order) Tie() (string, bool) {
+	return o.tie, o.tieDesc
+}
+
+// Page is one page of a list in an Order. Lists return one row more than
+// Size, when there is one, to tell whether another page follows.
+type Page struct {
+	Order *Order
+	Size  int
+	// One of Order's fields
+	Field string
+	Desc  bool
+	// The last row of the page before, nil on the first page
+	After *Cursor
+}
+
+// Cursor is a row's sort value and tie column. Sort values of times are
+// RFC 3339, or "infinity" and "-infinity" standing in for NULL where the
+// order's SQL does the same.
+type Cursor struct {
+	Value string
+	Tie   string
+}
+
+// where returns the condition selecting rows after p.After, with its
+// arguments appended to args, or "" on the first page.
+func (p Page) where(args []interface{}) (string, []interface{}) {
+	if p.After == nil {
+		return "", args
+	}
+	op := ">"
+	if p.Desc {
+		op = "<"
+	}
+	expr, tie := p.expr(), p.Order.tie
+	if expr == tie {
+		args = append(args, p.After.Tie)
+		return fmt.Sprintf("%s %s $%d", tie, op, len(args)), args
+	}
+	var value interface{} = p.After.Value
+	if p.Order.times[p.Field] {
+		value = database.TimeArg(p.After.Value)
+	}
+	args = append(args, value, p.After.Tie)
+	return fmt.Sprintf("(%s, %s) %s ($%d, $%d)", expr, tie, op, len(args)-1, len(args)), args
+}
+
+// orderLimit returns the ORDER BY and LIMIT clauses.
+func (p Page) orderLimit(args []interface{}) (string, []interface{}) {
+	dir := "ASC"
+	if p.Desc {
+		dir = "DESC"
+	}
+	expr, tie := p.expr(), p.Order.tie
+	order := expr + " " + dir
+	if expr != tie {
+		order += ", " + tie + " " + dir
+	}
+	args = append(args, p.Size+1)
+	return fmt.Sprintf(" ORDER BY %s LIMIT $%d", order, len(args)), args
+}
+
+func (p Page) expr() string {
+	if expr, ok := p.Order.fields[p.Field]; ok {
+		return expr
+	}
+	return p.Order.tie
+}
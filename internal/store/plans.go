@@ -0,0 +1,132 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// PlanStore keeps the license plans. A missing plan is (nil, nil).
+type PlanStore interface {
+	Get(ctx context.Context, q Querier, name string) (*pb.Plan, error)
+	// List returns every plan by name.
+	List(ctx context.Context, q Querier) ([]*pb.Plan, error)
+	// Create adds p, reporting false if its name is taken.
+	Create(ctx context.Context, q Querier, p *pb.Plan) (bool, error)
+	// Change sets c's fields on the plan.
+	Change(ctx context.Context, q Querier, name string, c PlanChange) error
+	Delete(ctx context.Context, q Querier, name string) error
+	// Unknown returns those of names that aren't plans, sorted and without
+	// repeats.
+	Unknown(ctx context.Context, q Querier, names []string) ([]string, error)
+}
+
+// PlanChange is what PlanStore.Change sets; nil fields are left alone.
+type PlanChange struct {
+	Description     *string
+	MaxDevices      *int32
+	DurationSeconds *int64
+	// JSON, as stored in the features column
+	Features *string
+}
+
+type sqlPlans struct{}
+
+// NewSQLPlans keeps plans in the plans table.
+func NewSQLPlans() PlanStore {
+	return sqlPlans{}
+}
+
+const planColumns = `name, description, max_devices, duration_seconds, features, created_at, updated_at,
+	(SELECT COUNT(*) FROM licenses l WHERE l.plan = plans.name AND l.deleted_at IS NULL)`
+
+func (sqlPlans) Get(ctx context.Context, q Querier, name string) (*pb.Plan, error) {
+	p, err := scanPlan(q.QueryRowContext(ctx, "SELECT "+planColumns+" FROM plans WHERE name = $1", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return p, err
+}
+
+func (sqlPlans) List(ctx context.Context, q Querier) ([]*pb.Plan, error) {
+	rows, err := q.QueryContext(ctx, "SELECT "+planColumns+" FROM plans ORDER BY name")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var plans []*pb.Plan
+	for rows.Next() {
+		p, err := scanPlan(rows)
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, p)
+	}
+	return plans, rows.Err()
+}
+
+func (sqlPlans) Create(ctx context.Context, q Querier, p *pb.Plan) (bool, error) {
+	features, err := featuresJSON(p.Features)
+	if err != nil {
+		return false, err
+	}
+	n, err := execRows(ctx, q, `
+		INSERT INTO plans (name, description, max_devices, duration_seconds, features)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (name) DO NOTHING
+	`, p.Name, p.Description, p.MaxDevices, p.DurationSeconds, features)
+	return n > 0, err
+}
+
+func (sqlPlans) Change(ctx context.Context, q Querier, name string, c PlanChange) error {
+	// NULL parameters keep the current value
+	_, err := q.ExecContext(ctx, `
+		UPDATE plans SET
+			description = COALESCE($2, description),
+			max_devices = COALESCE($3, max_devices),
+			duration_seconds = COALESCE($4, duration_seconds),
+			features = COALESCE($5, features),
+			updated_at = NOW()
+		WHERE name = $1
+	`, name, c.Description, c.MaxDevices, c.DurationSeconds, c.Features)
+	return err
+}
+
+func (sqlPlans) Delete(ctx context.Context, q Querier, name string) error {
+	_, err := q.ExecContext(ctx, "DELETE FROM plans WHERE name = $1", name)
+	return err
+}
+
+func (sqlPlans) Unknown(ctx context.Context, q Querier, names []string) ([]string, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	var missing pq.StringArray
+	err := q.QueryRowContext(ctx, `
+		SELECT ARRAY(SELECT DISTINCT n FROM unnest($1::text[]) n
+			WHERE NOT EXISTS (SELECT 1 FROM plans p WHERE p.name = n) ORDER BY n)
+	`, pq.Array(names)).Scan(&missing)
+	return missing, err
+}
+
+func scanPlan(row interface{ Scan(...interface{}) error }) (*pb.Plan, error) {
+	var p pb.Plan
+	var createdAt, updatedAt time.Time
+	var features []byte
+	if err := row.Scan(&p.Name, &p.Description, &p.MaxDevices, &p.DurationSeconds, &features, &createdAt, &updatedAt, &p.LicenseCount); err != nil {
+		return nil, err
+	}
+	var err error
+	if p.Features, err = ParseFeatures(features); err != nil {
+		return nil, err
+	}
+	p.CreatedAt = timestamppb.New(createdAt)
+	p.UpdatedAt = timestamppb.New(updatedAt)
+	return &p, nil
+}
@@ -0,0 +1,211 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/lib/pq"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// ProductStore keeps the product catalog. A missing product is (nil, nil).
+type ProductStore interface {
+	Get(ctx context.Context, q Querier, productID string) (*pb.Product, error)
+	// List returns the products by id, disabled ones only if asked to.
+	List(ctx context.Context, q Querier, includeDisabled bool) ([]*pb.Product, error)
+	// Create adds p, reporting false if its id is taken.
+	Create(ctx context.Context, q Querier, p *pb.Product) (bool, error)
+	// Change sets c's fields on the product.
+	Change(ctx context.Context, q Querier, productID string, c ProductChange) error
+	Delete(ctx context.Context, q Querier, productID string) error
+	// Unknown returns those of ids that aren't products, sorted and without
+	// repeats.
+	Unknown(ctx context.Context, q Querier, ids []string) ([]string, error)
+	// SetSigningSecret sets the secret requests for the product are signed
+	// with; "" stops asking for signatures.
+	SetSigningSecret(ctx context.Context, q Querier, productID, secret string) error
+	// SigningSecrets returns the secrets of those of the products that
+	// have one.
+	SigningSecrets(ctx context.Context, q Querier, productIDs []string) (map[string]string, error)
+	// KeyFormats returns the key formats of those of the products that
+	// have one, of all products when productIDs is nil.
+	KeyFormats(ctx context.Context, q Querier, productIDs []string) (map[string]string, error)
+}
+
+// ProductChange is what ProductStore.Change sets; nil fields are left alone.
+type ProductChange struct {
+	Name, Description                  *string
+	Disabled                           *bool
+	MinVersion                         *string
+	TrialDurationSeconds               *int64
+	AllowedCountries, BlockedCountries *[]string
+	RequireChallenge                   *bool
+	KeyFormat                          *string
+	// JSON, as stored in the features column
+	Features *string
+}
+
+type sqlProducts struct{}
+
+// NewSQLProducts keeps products in the products table.
+func NewSQLProducts() ProductStore {
+	return sqlProducts{}
+}
+
+const productColumns = `product_id, name, description, disabled, created_at, updated_at,
+	(SELECT COUNT(*) FROM licenses l WHERE l.product_id = products.product_id AND l.deleted_at IS NULL), min_version, trial_duration_seconds,
+	allowed_countries, blocked_countries, signing_secret IS NOT NULL, require_challenge, key_format, features`
+
+func (sqlProducts) Get(ctx context.Context, q Querier, productID string) (*pb.Product, error) {
+	p, err := scanProduct(q.QueryRowContext(ctx, "SELECT "+productColumns+" FROM products WHERE product_id = $1", productID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return p, err
+}
+
+func (sqlProducts) List(ctx context.Context, q Querier, includeDisabled bool) ([]*pb.Product, error) {
+	query := "SELECT " + productColumns + " FROM products"
+	if !includeDisabled {
+		query += " WHERE NOT disabled"
+	}
+	rows, err := q.QueryContext(ctx, query+" ORDER BY product_id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var products []*pb.Product
+	for rows.Next() {
+		p, err := scanProduct(rows)
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, p)
+	}
+	return products, rows.Err()
+}
+
+func (sqlProducts) Create(ctx context.Context, q Querier, p *pb.Product) (bool, error) {
+	features, err := featuresJSON(p.Features)
+	if err != nil {
+		return false, err
+	}
+	n, err := execRows(ctx, q, `
+		INSERT INTO products (product_id, name, description, min_version, trial_duration_seconds, allowed_countries, blocked_countries, require_challenge, key_format, features)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (product_id) DO NOTHING
+	`, p.ProductId, p.Name, p.Description, p.MinVersion, p.TrialDurationSeconds, pq.StringArray(p.AllowedCountries), pq.StringArray(p.BlockedCountries), p.RequireChallenge, p.KeyFormat, features)
+	return n > 0, err
+}
+
+func (sqlProducts) Change(ctx context.Context, q Querier, productID string, c ProductChange) error {
+	countries := func(l *[]string) interface{} {
+		if l == nil {
+			return nil
+		}
+		return pq.StringArray(*l)
+	}
+	// NULL parameters keep the current value
+	_, err := q.ExecContext(ctx, `
+		UPDATE products SET
+			name = COALESCE($2, name),
+			description = COALESCE($3, description),
+			disabled = COALESCE($4, disabled),
+			min_version = COALESCE($5, min_version),
+			trial_duration_seconds = COALESCE($6, trial_duration_seconds),
+			allowed_countries = COALESCE($7, allowed_countries),
+			blocked_countries = COALESCE($8, blocked_countries),
+			require_challenge = COALESCE($9, require_challenge),
+			key_format = COALESCE($10, key_format),
+			features = COALESCE($11, features),
+			updated_at = NOW()
+		WHERE product_id = $1
+	`, productID, c.Name, c.Description, c.Disabled, c.MinVersion, c.TrialDurationSeconds, countries(c.AllowedCountries), countries(c.BlockedCountries), c.RequireChallenge, c.KeyFormat, c.Features)
+	return err
+}
+
+func (sqlProducts) Delete(ctx context.Context, q Querier, productID string) error {
+	_, err := q.ExecContext(ctx, "DELETE FROM products WHERE product_id = $1", productID)
+	return err
+}
+
+func (sqlProducts) Unknown(ctx context.Context, q Querier, ids []string) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	var missing pq.StringArray
+	err := q.QueryRowContext(ctx, `
+		SELECT ARRAY(SELECT DISTINCT id FROM unnest($1::text[]) id
+			WHERE NOT EXISTS (SELECT 1 FROM products p WHERE p.product_id = id) ORDER BY id)
+	`, pq.Array(ids)).Scan(&missing)
+	return missing, err
+}
+
+func (sqlProducts) SetSigningSecret(ctx context.Context, q Querier, productID, secret string) error {
+	_, err := q.ExecContext(ctx, "UPDATE products SET signing_secret = NULLIF($2, ''), updated_at = NOW() WHERE product_id = $1", productID, secret)
+	return err
+}
+
+func (sqlProducts) SigningSecrets(ctx context.Context, q Querier, productIDs []string) (map[string]string, error) {
+	return productSettings(ctx, q, "SELECT product_id, signing_secret FROM products WHERE product_id = ANY($1) AND signing_secret IS NOT NULL", pq.Array(productIDs))
+}
+
+func (sqlProducts) KeyFormats(ctx context.Context, q Querier, productIDs []string) (map[string]string, error) {
+	if productIDs == nil {
+		return productSettings(ctx, q, "SELECT product_id, key_format FROM products WHERE key_format <> ''")
+	}
+	if len(productIDs) == 0 {
+		return map[string]string{}, nil
+	}
+	return productSettings(ctx, q, "SELECT product_id, key_format FROM products WHERE product_id = ANY($1) AND key_format <> ''", pq.Array(productIDs))
+}
+
+// productSettings runs a query selecting product ids and one setting of
+// theirs, keyed by id.
+func productSettings(ctx context.Context, q Querier, query string, args ...interface{}) (map[string]string, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := map[string]string{}
+	for rows.Next() {
+		var id, value string
+		if err := rows.Scan(&id, &value); err != nil {
+			return nil, err
+		}
+		out[id] = value
+	}
+	return out, rows.Err()
+}
+
+func scanProduct(row interface{ Scan(...interface{}) error }) (*pb.Product, error) {
+	var p pb.Product
+	var createdAt, updatedAt time.Time
+	var features []byte
+	if err := row.Scan(&p.ProductId, &p.Name, &p.Description, &p.Disabled, &createdAt, &updatedAt, &p.LicenseCount, &p.MinVersion, &p.TrialDurationSeconds,
+		(*pq.StringArray)(&p.AllowedCountries), (*pq.StringArray)(&p.BlockedCountries), &p.SignedRequests, &p.RequireChallenge, &p.KeyFormat, &features); err != nil {
+		return nil, err
+	}
+	var err error
+	if p.Features, err = ParseFeatures(features); err != nil {
+		return nil, err
+	}
+	p.CreatedAt = timestamppb.New(createdAt)
+	p.UpdatedAt = timestamppb.New(updatedAt)
+	return &p, nil
+}
+
+// featuresJSON encodes flags for a features column; no flags is {}.
+func featuresJSON(flags map[string]bool) (string, error) {
+	if flags == nil {
+		flags = map[string]bool{}
+	}
+	b, err := json.Marshal(flags)
+	return string(b), err
+}
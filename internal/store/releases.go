@@ -0,0 +1,67 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// ReleaseStore keeps the latest release of each product on each update
+// channel.
+type ReleaseStore interface {
+	// Latest returns the channel's release, nil if nothing was published
+	// on it.
+	Latest(ctx context.Context, q Querier, productID, channel string) (*pb.Release, error)
+	// Lock is Latest, locking the row until q commits.
+	Lock(ctx context.Context, q Querier, productID, channel string) (*pb.Release, error)
+	// Publish makes r the channel's release, published now, and returns it
+	// as stored.
+	Publish(ctx context.Context, q Querier, r *pb.Release) (*pb.Release, error)
+}
+
+type sqlReleases struct{}
+
+// NewSQLReleases keeps releases in the product_releases table.
+func NewSQLReleases() ReleaseStore {
+	return sqlReleases{}
+}
+
+const releaseColumns = "product_id, channel, version, changelog, download_url, published_at"
+
+func (sqlReleases) Latest(ctx context.Context, q Querier, productID, channel string) (*pb.Release, error) {
+	return scanRelease(q.QueryRowContext(ctx, "SELECT "+releaseColumns+" FROM product_releases WHERE product_id = $1 AND channel = $2", productID, channel))
+}
+
+func (sqlReleases) Lock(ctx context.Context, q Querier, productID, channel string) (*pb.Release, error) {
+	return scanRelease(q.QueryRowContext(ctx, "SELECT "+releaseColumns+" FROM product_releases WHERE product_id = $1 AND channel = $2 FOR UPDATE", productID, channel))
+}
+
+func (s sqlReleases) Publish(ctx context.Context, q Querier, r *pb.Release) (*pb.Release, error) {
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO product_releases (product_id, channel, version, changelog, download_url)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (product_id, channel)
+		DO UPDATE SET version = $3, changelog = $4, download_url = $5, published_at = NOW()
+	`, r.ProductId, r.Channel, r.Version, r.Changelog, r.DownloadUrl)
+	if err != nil {
+		return nil, err
+	}
+	return s.Latest(ctx, q, r.ProductId, r.Channel)
+}
+
+func scanRelease(row *sql.Row) (*pb.Release, error) {
+	var r pb.Release
+	var publishedAt time.Time
+	err := row.Scan(&r.ProductId, &r.Channel, &r.Version, &r.Changelog, &r.DownloadUrl, &publishedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	r.PublishedAt = timestamppb.New(publishedAt)
+	return &r, nil
+}
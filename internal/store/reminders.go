@@ -0,0 +1,72 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// ExpiringLicense is a license coming up for renewal, with where to remind
+// its customer; Email and Discord are "" when unknown.
+type ExpiringLicense struct {
+	Key, ProductID string
+	ExpiresAt      time.Time
+	Email, Discord string
+}
+
+// ReminderStore finds licenses about to expire and keeps track of the
+// reminders sent, so that with several instances each goes out once.
+type ReminderStore interface {
+	// Expiring lists the active licenses expiring after from and by to,
+	// soonest first.
+	Expiring(ctx context.Context, q Querier, from, to time.Time) ([]ExpiringLicense, error)
+	// Claim records the reminder at days for the license's current expiry,
+	// reporting false if it was claimed before.
+	Claim(ctx context.Context, q Querier, licenseKey string, expiresAt time.Time, days int) (bool, error)
+	// ClaimDigest records the digest of day (a date), reporting false if
+	// it was claimed before.
+	ClaimDigest(ctx context.Context, q Querier, day string) (bool, error)
+}
+
+type sqlReminders struct{}
+
+// NewSQLReminders keeps reminders in the license_reminders and
+// expiry_digests tables.
+func NewSQLReminders() ReminderStore {
+	return sqlReminders{}
+}
+
+func (sqlReminders) Expiring(ctx context.Context, q Querier, from, to time.Time) ([]ExpiringLicense, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT l.license_key, l.product_id, l.expires_at, COALESCE(c.email, ''), COALESCE(c.discord_id, '')
+		FROM licenses l LEFT JOIN customers c ON c.id = l.customer_id
+		WHERE l.deleted_at IS NULL AND l.is_active AND l.expires_at > $1 AND l.expires_at <= $2
+		ORDER BY l.expires_at, l.license_key
+	`, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []ExpiringLicense
+	for rows.Next() {
+		var l ExpiringLicense
+		if err := rows.Scan(&l.Key, &l.ProductID, &l.ExpiresAt, &l.Email, &l.Discord); err != nil {
+			return nil, err
+		}
+		out = append(out, l)
+	}
+	return out, rows.Err()
+}
+
+func (sqlReminders) Claim(ctx context.Context, q Querier, licenseKey string, expiresAt time.Time, days int) (bool, error) {
+	n, err := execRows(ctx, q, `
+		INSERT INTO license_reminders (license_key, expires_at, days) VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING
+	`, licenseKey, expiresAt, days)
+	return n > 0, err
+}
+
+func (sqlReminders) ClaimDigest(ctx context.Context, q Querier, day string) (bool, error) {
+	n, err := execRows(ctx, q, "INSERT INTO expiry_digests (day) VALUES ($1) ON CONFLICT DO NOTHING", day)
+	return n > 0, err
+}
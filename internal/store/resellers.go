@@ -0,0 +1,149 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// Reseller ledger entry kinds
+const (
+	LedgerTopUp    = "topup"
+	LedgerGenerate = "generate"
+	LedgerExtend   = "extend"
+)
+
+// ResellerStore keeps resellers and their credit ledgers. A missing reseller
+// is (nil, nil).
+type ResellerStore interface {
+	// ByKey returns the reseller whose API key hashes to keyHash.
+	ByKey(ctx context.Context, q Querier, keyHash string) (*pb.Reseller, error)
+	// RehashKey replaces the hash of a reseller's API key.
+	RehashKey(ctx context.Context, q Querier, old, current string) error
+	// Lock returns the reseller and locks its row until the transaction q
+	// commits.
+	Lock(ctx context.Context, q Querier, id int64) (*pb.Reseller, error)
+	// Create adds a reseller without credits, returning nil if the name is
+	// taken.
+	Create(ctx context.Context, q Querier, name, keyHash string, productIDs []string) (*pb.Reseller, error)
+	// AddCredits changes the balance by delta and records it in the
+	// ledger, returning the new balance. Overdrafts fail.
+	AddCredits(ctx context.Context, q Querier, id, delta int64, kind string, licenseKeys []string, actor, note string) (int64, error)
+	// Activity returns a page of the reseller's ledger.
+	Activity(ctx context.Context, q Querier, id int64, page Page) ([]*pb.ResellerActivity, error)
+	// Generated returns the product of the live license, if the reseller
+	// generated it, and "" otherwise.
+	Generated(ctx context.Context, q Querier, id int64, licenseKey string) (string, error)
+}
+
+type sqlResellers struct{}
+
+// NewSQLResellers keeps resellers in the resellers and reseller_ledger
+// tables.
+func NewSQLResellers() ResellerStore {
+	return sqlResellers{}
+}
+
+const resellerColumns = "id, name, credits, product_ids, created_at"
+
+func scanReseller(row interface{ Scan(...interface{}) error }) (*pb.Reseller, error) {
+	var r pb.Reseller
+	var products pq.StringArray
+	var createdAt time.Time
+	err := row.Scan(&r.Id, &r.Name, &r.Credits, &products, &createdAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	r.ProductIds = products
+	r.CreatedAt = timestamppb.New(createdAt)
+	return &r, nil
+}
+
+func (sqlResellers) ByKey(ctx context.Context, q Querier, keyHash string) (*pb.Reseller, error) {
+	return scanReseller(q.QueryRowContext(ctx, "SELECT "+resellerColumns+" FROM resellers WHERE key_hash = $1", keyHash))
+}
+
+func (sqlResellers) RehashKey(ctx context.Context, q Querier, old, current string) error {
+	_, err := q.ExecContext(ctx, "UPDATE resellers SET key_hash = $2 WHERE key_hash = $1", old, current)
+	return err
+}
+
+func (sqlResellers) Lock(ctx context.Context, q Querier, id int64) (*pb.Reseller, error) {
+	return scanReseller(q.QueryRowContext(ctx, "SELECT "+resellerColumns+" FROM resellers WHERE id = $1 FOR UPDATE", id))
+}
+
+func (sqlResellers) Create(ctx context.Context, q Querier, name, keyHash string, productIDs []string) (*pb.Reseller, error) {
+	return scanReseller(q.QueryRowContext(ctx, `
+		INSERT INTO resellers (name, key_hash, product_ids) VALUES ($1, $2, $3)
+		ON CONFLICT (name) DO NOTHING
+		RETURNING `+resellerColumns, name, keyHash, pq.Array(productIDs)))
+}
+
+func (sqlResellers) AddCredits(ctx context.Context, q Querier, id, delta int64, kind string, licenseKeys []string, actor, note string) (int64, error) {
+	// The credits >= 0 check constraint rejects overdrafts
+	_, err := q.ExecContext(ctx, "UPDATE resellers SET credits = credits + $2 WHERE id = $1", id, delta)
+	if err != nil {
+		return 0, err
+	}
+	var balance int64
+	if err := q.QueryRowContext(ctx, "SELECT credits FROM resellers WHERE id = $1", id).Scan(&balance); err != nil {
+		return 0, err
+	}
+	if licenseKeys == nil {
+		licenseKeys = []string{}
+	}
+	_, err = q.ExecContext(ctx, `
+		INSERT INTO reseller_ledger (reseller_id, kind, delta, balance, license_keys, actor, note)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, id, kind, delta, balance, pq.Array(licenseKeys), actor, note)
+	return balance, err
+}
+
+func (sqlResellers) Activity(ctx context.Context, q Querier, id int64, page Page) ([]*pb.ResellerActivity, error) {
+	args := []interface{}{id}
+	query := "SELECT id, created_at, kind, delta, balance, license_keys, actor, note FROM reseller_ledger WHERE reseller_id = $1"
+	if cond, a := page.where(args); cond != "" {
+		query, args = query+" AND "+cond, a
+	}
+	orderLimit, args := page.orderLimit(args)
+
+	rows, err := q.QueryContext(ctx, query+orderLimit, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var activity []*pb.ResellerActivity
+	for rows.Next() {
+		var a pb.ResellerActivity
+		var createdAt time.Time
+		var keys pq.StringArray
+		if err := rows.Scan(&a.Id, &createdAt, &a.Kind, &a.Delta, &a.Balance, &keys, &a.Actor, &a.Note); err != nil {
+			return nil, err
+		}
+		a.CreatedAt = timestamppb.New(createdAt)
+		a.LicenseKeys = keys
+		activity = append(activity, &a)
+	}
+	return activity, rows.Err()
+}
+
+func (sqlResellers) Generated(ctx context.Context, q Querier, id int64, licenseKey string) (string, error) {
+	var productID string
+	err := q.QueryRowContext(ctx, `
+		SELECT l.product_id FROM licenses l
+		WHERE l.license_key = $2 AND l.deleted_at IS NULL AND EXISTS (
+			SELECT 1 FROM reseller_ledger rl WHERE rl.reseller_id = $1 AND rl.kind = $3 AND l.license_key = ANY(rl.license_keys))
+	`, id, licenseKey, LedgerGenerate).Scan(&productID)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return productID, err
+}
@@ -0,0 +1,306 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"math/rand/v2"
+	"time"
+
+	"github.com/mkseven15/whitelist-server/internal/cache"
+	"github.com/mkseven15/whitelist-server/internal/database"
+	"github.com/mkseven15/whitelist-server/internal/metrics"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+var retries = metrics.NewCounter("whitelist_db_retries_total", "Store calls rerun after a transient database error.", "call")
+
+// Retry reruns store calls that fail with a transient database error (see
+// database.Transient), waiting Base before the first retry and twice as
+// long before each next one, up to Max, with jitter.
+//
+// Calls made inside the caller's transaction are never retried: the error
+// has aborted the transaction, so only rerunning all of it would help, which
+// is what Tx does. Calls that aren't idempotent are only retried when the
+// failed attempt is known not to have taken effect.
+type Retry struct {
+	// Tries per call, including the first; 1 or less never retries
+	Attempts int
+	Base     time.Duration
+	Max      time.Duration
+}
+
+// do runs fn until it succeeds, fails for good or runs out of attempts.
+func (r Retry) do(ctx context.Context, call string, idempotent bool, fn func() error) error {
+	return r.loop(ctx, call, func() (bool, error) {
+		err := fn()
+		transient, unapplied := database.Transient(err)
+		return transient && (idempotent || unapplied), err
+	})
+}
+
+// Tx runs fn in a transaction of db and commits it, running all of it again
+// in a new transaction when fn, or beginning, fails with a transient error:
+// the failed transaction was rolled back, so none of it took effect. A
+// failed commit is only retried when it's known not to have gone through.
+// fn may run more than once, so it must not change anything outside the
+// transaction; errors it wraps (see errors.Unwrap) are looked through.
+func (r Retry) Tx(ctx context.Context, db *sql.DB, call string, fn func(*sql.Tx) error) error {
+	return r.loop(ctx, call, func() (bool, error) {
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			transient, _ := database.Transient(err)
+			return transient, err
+		}
+		if err := fn(tx); err != nil {
+			tx.Rollback()
+			transient, _ := database.Transient(err)
+			return transient, err
+		}
+		err = tx.Commit()
+		_, unapplied := database.Transient(err)
+		return unapplied, err
+	})
+}
+
+// loop runs fn until it succeeds, reports it shouldn't run again or runs
+// out of attempts.
+func (r Retry) loop(ctx context.Context, call string, fn func() (again bool, err error)) error {
+	wait := r.Base
+	for attempt := 1; ; attempt++ {
+		again, err := fn()
+		if !again || attempt >= r.Attempts {
+			return err
+		}
+		timer := time.NewTimer(wait/2 + rand.N(wait/2+1))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		retries.Add(1, call)
+		wait = min(wait*2, r.Max)
+	}
+}
+
+// inTx reports whether q is a transaction, whose calls can't be retried.
+func inTx(q Querier) bool {
+	_, ok := q.(*sql.Tx)
+	return ok
+}
+
+type retryLicenses struct {
+	next  LicenseStore
+	retry Retry
+}
+
+// RetryLicenses wraps next so its calls are retried per r. Every
+// LicenseStore call but Create is idempotent: a retried Create would find
+// the key its lost first attempt took.
+func RetryLicenses(next LicenseStore, r Retry) LicenseStore {
+	if r.Attempts <= 1 {
+		return next
+	}
+	return &retryLicenses{next: next, retry: r}
+}
+
+func (s *retryLicenses) Get(ctx context.Context, q Querier, key string) (l *pb.License, err error) {
+	if inTx(q) {
+		return s.next.Get(ctx, q, key)
+	}
+	err = s.retry.do(ctx, "licenses.get", true, func() error {
+		l, err = s.next.Get(ctx, q, key)
+		return err
+	})
+	return l, err
+}
+
+func (s *retryLicenses) Find(ctx context.Context, key string) (l *pb.License, err error) {
+	err = s.retry.do(ctx, "licenses.find", true, func() error {
+		l, err = s.next.Find(ctx, key)
+		return err
+	})
+	return l, err
+}
+
+func (s *retryLicenses) Lock(ctx context.Context, q Querier, key string) (l *pb.License, err error) {
+	if inTx(q) {
+		return s.next.Lock(ctx, q, key)
+	}
+	err = s.retry.do(ctx, "licenses.lock", true, func() error {
+		l, err = s.next.Lock(ctx, q, key)
+		return err
+	})
+	return l, err
+}
+
+func (s *retryLicenses) State(ctx context.Context, key string) (l *cache.License, err error) {
+	err = s.retry.do(ctx, "licenses.state", true, func() error {
+		l, err = s.next.State(ctx, key)
+		return err
+	})
+	return l, err
+}
+
+func (s *retryLicenses) Upsert(ctx context.Context, q Querier, req *pb.UpdateLicenseRequest) error {
+	if inTx(q) {
+		return s.next.Upsert(ctx, q, req)
+	}
+	return s.retry.do(ctx, "licenses.upsert", true, func() error {
+		return s.next.Upsert(ctx, q, req)
+	})
+}
+
+func (s *retryLicenses) Create(ctx context.Context, q Querier, key, productID string, isActive bool, expiresAt *time.Time, maxDevices int32, plan string) (created bool, err error) {
+	if inTx(q) {
+		return s.next.Create(ctx, q, key, productID, isActive, expiresAt, maxDevices, plan)
+	}
+	err = s.retry.do(ctx, "licenses.create", false, func() error {
+		created, err = s.next.Create(ctx, q, key, productID, isActive, expiresAt, maxDevices, plan)
+		return err
+	})
+	return created, err
+}
+
+func (s *retryLicenses) Change(ctx context.Context, q Querier, key string, c LicenseChange) error {
+	if inTx(q) {
+		return s.next.Change(ctx, q, key, c)
+	}
+	return s.retry.do(ctx, "licenses.change", true, func() error {
+		return s.next.Change(ctx, q, key, c)
+	})
+}
+
+func (s *retryLicenses) Delete(ctx context.Context, q Querier, key string) error {
+	if inTx(q) {
+		return s.next.Delete(ctx, q, key)
+	}
+	return s.retry.do(ctx, "licenses.delete", true, func() error {
+		return s.next.Delete(ctx, q, key)
+	})
+}
+
+func (s *retryLicenses) Restore(ctx context.Context, q Querier, key string) error {
+	if inTx(q) {
+		return s.next.Restore(ctx, q, key)
+	}
+	return s.retry.do(ctx, "licenses.restore", true, func() error {
+		return s.next.Restore(ctx, q, key)
+	})
+}
+
+func (s *retryLicenses) Purge(ctx context.Context, q Querier, key string) error {
+	if inTx(q) {
+		return s.next.Purge(ctx, q, key)
+	}
+	return s.retry.do(ctx, "licenses.purge", true, func() error {
+		return s.next.Purge(ctx, q, key)
+	})
+}
+
+func (s *retryLicenses) Touch(ctx context.Context, key string) error {
+	return s.retry.do(ctx, "licenses.touch", true, func() error {
+		return s.next.Touch(ctx, key)
+	})
+}
+
+type retryTokens struct {
+	next  TokenStore
+	retry Retry
+}
+
+// RetryTokens wraps next so its calls are retried per r. Issuing a token
+// twice would collide with itself and burning it twice would find it gone,
+// so neither is idempotent.
+func RetryTokens(next TokenStore, r Retry) TokenStore {
+	if r.Attempts <= 1 {
+		return next
+	}
+	return &retryTokens{next: next, retry: r}
+}
+
+func (s *retryTokens) Issue(ctx context.Context, q Querier, hash, productID string, ttl time.Duration) error {
+	if inTx(q) {
+		return s.next.Issue(ctx, q, hash, productID, ttl)
+	}
+	return s.retry.do(ctx, "tokens.issue", false, func() error {
+		return s.next.Issue(ctx, q, hash, productID, ttl)
+	})
+}
+
+func (s *retryTokens) Burn(ctx context.Context, hash string) (productID string, ok bool, err error) {
+	err = s.retry.do(ctx, "tokens.burn", false, func() error {
+		productID, ok, err = s.next.Burn(ctx, hash)
+		return err
+	})
+	return productID, ok, err
+}
+
+func (s *retryTokens) Close() error {
+	return s.next.Close()
+}
+
+type retryAPIKeys struct {
+	next  APIKeyStore
+	retry Retry
+}
+
+// RetryAPIKeys wraps next so its calls are retried per r. Only Check and
+// Rehash are idempotent.
+func RetryAPIKeys(next APIKeyStore, r Retry) APIKeyStore {
+	if r.Attempts <= 1 {
+		return next
+	}
+	return &retryAPIKeys{next: next, retry: r}
+}
+
+func (s *retryAPIKeys) Check(ctx context.Context, q Querier, keyHash string) (ok bool, err error) {
+	if inTx(q) {
+		return s.next.Check(ctx, q, keyHash)
+	}
+	err = s.retry.do(ctx, "api_keys.check", true, func() error {
+		ok, err = s.next.Check(ctx, q, keyHash)
+		return err
+	})
+	return ok, err
+}
+
+func (s *retryAPIKeys) IssueRefreshToken(ctx context.Context, q Querier, tokenHash, keyHash string, ttl time.Duration) error {
+	if inTx(q) {
+		return s.next.IssueRefreshToken(ctx, q, tokenHash, keyHash, ttl)
+	}
+	return s.retry.do(ctx, "api_keys.issue_refresh_token", false, func() error {
+		return s.next.IssueRefreshToken(ctx, q, tokenHash, keyHash, ttl)
+	})
+}
+
+func (s *retryAPIKeys) RedeemRefreshToken(ctx context.Context, q Querier, tokenHash string) (keyHash string, err error) {
+	if inTx(q) {
+		return s.next.RedeemRefreshToken(ctx, q, tokenHash)
+	}
+	err = s.retry.do(ctx, "api_keys.redeem_refresh_token", false, func() error {
+		keyHash, err = s.next.RedeemRefreshToken(ctx, q, tokenHash)
+		return err
+	})
+	return keyHash, err
+}
+
+func (s *retryAPIKeys) RevokeRefreshTokens(ctx context.Context, q Querier, keyHash string) (n int64, err error) {
+	if inTx(q) {
+		return s.next.RevokeRefreshTokens(ctx, q, keyHash)
+	}
+	err = s.retry.do(ctx, "api_keys.revoke_refresh_tokens", false, func() error {
+		n, err = s.next.RevokeRefreshTokens(ctx, q, keyHash)
+		return err
+	})
+	return n, err
+}
+
+func (s *retryAPIKeys) Rehash(ctx context.Context, q Querier, oldHash, newHash string) error {
+	if inTx(q) {
+		return s.next.Rehash(ctx, q, oldHash, newHash)
+	}
+	return s.retry.do(ctx, "api_keys.rehash", true, func() error {
+		return s.next.Rehash(ctx, q, oldHash, newHash)
+	})
+}
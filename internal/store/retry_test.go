@@ -0,0 +1,94 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/mkseven15/whitelist-server/internal/database"
+)
+
+func TestRetryTx(t *testing.T) {
+	db, _, err := database.Open("sqlite:" + filepath.Join(t.TempDir(), "retry.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	ctx := context.Background()
+	if _, err := db.ExecContext(ctx, "CREATE TABLE runs (n INTEGER)"); err != nil {
+		t.Fatal(err)
+	}
+
+	errFatal := errors.New("not transient")
+	tests := []struct {
+		name string
+		// Errors of each run, past the last nil
+		fails    []error
+		attempts int
+		runs     int
+		err      error
+	}{
+		{"succeeds", nil, 3, 1, nil},
+		{"transient then succeeds", []error{driver.ErrBadConn}, 3, 2, nil},
+		{"transient through a wrapper", []error{wrapped{driver.ErrBadConn}}, 3, 2, nil},
+		{"out of attempts", []error{driver.ErrBadConn, driver.ErrBadConn}, 2, 2, driver.ErrBadConn},
+		{"not transient", []error{errFatal}, 3, 1, errFatal},
+		{"never retries", []error{driver.ErrBadConn}, 1, 1, driver.ErrBadConn},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := db.ExecContext(ctx, "DELETE FROM runs"); err != nil {
+				t.Fatal(err)
+			}
+			runs := 0
+			err := Retry{Attempts: tt.attempts}.Tx(ctx, db, "test", func(tx *sql.Tx) error {
+				runs++
+				if _, err := tx.ExecContext(ctx, "INSERT INTO runs (n) VALUES ($1)", runs); err != nil {
+					return err
+				}
+				if runs <= len(tt.fails) {
+					return tt.fails[runs-1]
+				}
+				return nil
+			})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("got %v, want %v", err, tt.err)
+			}
+			if runs != tt.runs {
+				t.Errorf("ran %d times, want %d", runs, tt.runs)
+			}
+
+			// Only the last run may have committed
+			var committed []int
+			rows, err := db.QueryContext(ctx, "SELECT n FROM runs")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rows.Close()
+			for rows.Next() {
+				var n int
+				if err := rows.Scan(&n); err != nil {
+					t.Fatal(err)
+				}
+				committed = append(committed, n)
+			}
+			want := 0
+			if tt.err == nil {
+				want = 1
+			}
+			if len(committed) != want || (want == 1 && committed[0] != tt.runs) {
+				t.Errorf("committed runs %v, want only run %d", committed, tt.runs)
+			}
+		})
+	}
+}
+
+// wrapped hides err behind an error of its own, as the service's gRPC
+// statuses do.
+type wrapped struct{ err error }
+
+func (w wrapped) Error() string { return "wrapped: " + w.err.Error() }
+func (w wrapped) Unwrap() error { return w.err }
@@ -0,0 +1,77 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// RevisionStore keeps each license's history: a copy of the license after
+// every change.
+type RevisionStore interface {
+	// Record appends r (its ID and CreatedAt are set by the store) to the
+	// history of r.License.
+	Record(ctx context.Context, q Querier, r *pb.LicenseRevision) error
+	// List returns a page of the license's revisions.
+	List(ctx context.Context, q Querier, licenseKey string, page Page) ([]*pb.LicenseRevision, error)
+}
+
+type sqlRevisions struct{}
+
+// NewSQLRevisions keeps revisions in the license_revisions table.
+func NewSQLRevisions() RevisionStore {
+	return sqlRevisions{}
+}
+
+func (sqlRevisions) Record(ctx context.Context, q Querier, r *pb.LicenseRevision) error {
+	b, err := protojson.Marshal(r.License)
+	if err != nil {
+		return err
+	}
+	changed := r.ChangedFields
+	if changed == nil {
+		// The column is NOT NULL; a nil array would be sent as NULL
+		changed = []string{}
+	}
+	_, err = q.ExecContext(ctx, `
+		INSERT INTO license_revisions (license_key, actor, action, changed_fields, license)
+		VALUES ($1, $2, $3, $4, $5)
+	`, r.License.LicenseKey, r.Actor, r.Action, pq.StringArray(changed), string(b))
+	return err
+}
+
+func (sqlRevisions) List(ctx context.Context, q Querier, licenseKey string, page Page) ([]*pb.LicenseRevision, error) {
+	conds, args := "license_key = $1", []interface{}{licenseKey}
+	if cond, a := page.where(args); cond != "" {
+		conds, args = conds+" AND "+cond, a
+	}
+	orderLimit, args := page.orderLimit(args)
+
+	rows, err := q.QueryContext(ctx, "SELECT id, created_at, actor, action, changed_fields, license FROM license_revisions WHERE "+conds+orderLimit, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisions []*pb.LicenseRevision
+	for rows.Next() {
+		r := &pb.LicenseRevision{License: &pb.License{}}
+		var createdAt time.Time
+		var license string
+		if err := rows.Scan(&r.Id, &createdAt, &r.Actor, &r.Action, (*pq.StringArray)(&r.ChangedFields), &license); err != nil {
+			return nil, err
+		}
+		r.CreatedAt = timestamppb.New(createdAt)
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal([]byte(license), r.License); err != nil {
+			return nil, fmt.Errorf("bad revision payload: %w", err)
+		}
+		revisions = append(revisions, r)
+	}
+	return revisions, rows.Err()
+}
@@ -0,0 +1,64 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// SessionStore keeps the running sessions of licenses with max_sessions,
+// by the hash of their token.
+type SessionStore interface {
+	// Count returns how many of the license's sessions haven't expired.
+	Count(ctx context.Context, q Querier, licenseKey string) (int, error)
+	// Start adds a session on the device (hashed HWID, "" if none) until
+	// expiresAt.
+	Start(ctx context.Context, q Querier, tokenHash, licenseKey, hwid, ip string, expiresAt time.Time) error
+	// Beat extends an unexpired session to expiresAt and returns its
+	// license, "" if there's no such session.
+	Beat(ctx context.Context, q Querier, tokenHash string, expiresAt time.Time) (string, error)
+	// End ends the session, if there is one.
+	End(ctx context.Context, q Querier, tokenHash string) error
+}
+
+type sqlSessions struct{}
+
+// NewSQLSessions keeps sessions in the license_sessions table.
+func NewSQLSessions() SessionStore {
+	return sqlSessions{}
+}
+
+func (sqlSessions) Count(ctx context.Context, q Querier, licenseKey string) (int, error) {
+	var n int
+	err := q.QueryRowContext(ctx, "SELECT COUNT(*) FROM license_sessions WHERE license_key = $1 AND expires_at > NOW()", licenseKey).Scan(&n)
+	return n, err
+}
+
+func (sqlSessions) Start(ctx context.Context, q Querier, tokenHash, licenseKey, hwid, ip string, expiresAt time.Time) error {
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO license_sessions (token_hash, license_key, hwid, client_ip, expires_at)
+		VALUES ($1, $2, NULLIF($3, ''), $4, $5)
+	`, tokenHash, licenseKey, hwid, ip, expiresAt)
+	return err
+}
+
+func (sqlSessions) Beat(ctx context.Context, q Querier, tokenHash string, expiresAt time.Time) (string, error) {
+	n, err := execRows(ctx, q, `
+		UPDATE license_sessions SET last_heartbeat_at = NOW(), expires_at = $2
+		WHERE token_hash = $1 AND expires_at > NOW()
+	`, tokenHash, expiresAt)
+	if err != nil || n == 0 {
+		return "", err
+	}
+	var licenseKey string
+	err = q.QueryRowContext(ctx, "SELECT license_key FROM license_sessions WHERE token_hash = $1", tokenHash).Scan(&licenseKey)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return licenseKey, err
+}
+
+func (sqlSessions) End(ctx context.Context, q Querier, tokenHash string) error {
+	_, err := q.ExecContext(ctx, "DELETE FROM license_sessions WHERE token_hash = $1", tokenHash)
+	return err
+}
@@ -0,0 +1,118 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// StatsStore sums up validation events and issued access tokens by UTC day
+// ("YYYY-MM-DD"). An empty product ID counts every product.
+type StatsStore interface {
+	// Validations returns each day's validation counts since since, for
+	// the days with any.
+	Validations(ctx context.Context, q Querier, since time.Time, productID string) ([]*pb.DailyStats, error)
+	// UniqueHWIDs counts the HWIDs validated since since.
+	UniqueHWIDs(ctx context.Context, q Querier, since time.Time, productID string) (int64, error)
+	// FailureReasons returns the limit most common reasons validations
+	// failed since since, most common first.
+	FailureReasons(ctx context.Context, q Querier, since time.Time, productID string, limit int) ([]*pb.FailureReason, error)
+	// Tokens returns how many access tokens each day since since issued.
+	Tokens(ctx context.Context, q Querier, since time.Time, productID string) (map[string]int64, error)
+	// CountToken adds an access token for productID to today's count.
+	CountToken(ctx context.Context, q Querier, productID string) error
+}
+
+type sqlStats struct{}
+
+// NewSQLStats sums up the validation_events and token_issuance tables.
+func NewSQLStats() StatsStore {
+	return sqlStats{}
+}
+
+func (sqlStats) Validations(ctx context.Context, q Querier, since time.Time, productID string) ([]*pb.DailyStats, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT to_char(created_at AT TIME ZONE 'UTC', 'YYYY-MM-DD'),
+		       COUNT(*), COUNT(*) FILTER (WHERE NOT valid), COUNT(DISTINCT hwid)
+		FROM validation_events
+		WHERE created_at >= $1 AND ($2 = '' OR product_id = $2)
+		GROUP BY 1
+	`, since, productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var days []*pb.DailyStats
+	for rows.Next() {
+		var d pb.DailyStats
+		if err := rows.Scan(&d.Date, &d.Validations, &d.FailedValidations, &d.UniqueHwids); err != nil {
+			return nil, err
+		}
+		days = append(days, &d)
+	}
+	return days, rows.Err()
+}
+
+func (sqlStats) UniqueHWIDs(ctx context.Context, q Querier, since time.Time, productID string) (int64, error) {
+	var n int64
+	err := q.QueryRowContext(ctx, `
+		SELECT COUNT(DISTINCT hwid) FROM validation_events
+		WHERE created_at >= $1 AND ($2 = '' OR product_id = $2)
+	`, since, productID).Scan(&n)
+	return n, err
+}
+
+func (sqlStats) FailureReasons(ctx context.Context, q Querier, since time.Time, productID string, limit int) ([]*pb.FailureReason, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT result, COUNT(*) FROM validation_events
+		WHERE created_at >= $1 AND ($2 = '' OR product_id = $2) AND NOT valid
+		GROUP BY result ORDER BY COUNT(*) DESC, result LIMIT $3
+	`, since, productID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reasons []*pb.FailureReason
+	for rows.Next() {
+		var r pb.FailureReason
+		if err := rows.Scan(&r.Reason, &r.Count); err != nil {
+			return nil, err
+		}
+		reasons = append(reasons, &r)
+	}
+	return reasons, rows.Err()
+}
+
+func (sqlStats) Tokens(ctx context.Context, q Querier, since time.Time, productID string) (map[string]int64, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT to_char(day, 'YYYY-MM-DD'), SUM(tokens) FROM token_issuance
+		WHERE day >= $1::date AND ($2 = '' OR product_id = $2)
+		GROUP BY day
+	`, since.Format("2006-01-02"), productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	days := map[string]int64{}
+	for rows.Next() {
+		var date string
+		var n int64
+		if err := rows.Scan(&date, &n); err != nil {
+			return nil, err
+		}
+		days[date] = n
+	}
+	return days, rows.Err()
+}
+
+func (sqlStats) CountToken(ctx context.Context, q Querier, productID string) error {
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO token_issuance (day, product_id, tokens) VALUES ((NOW() AT TIME ZONE 'UTC')::date, $1, 1)
+		ON CONFLICT (day, product_id) DO UPDATE SET tokens = token_issuance.tokens + 1
+	`, productID)
+	return err
+}
@@ -0,0 +1,30 @@
+// Package store keeps the service's SQL behind interfaces, one per table or
+// group of tables, so they can be backed by something else, wrapped in a
+// cache or faked in tests. Only the busiest paths (license lookups and
+// writes, access tokens, API keys and their refresh tokens) are behind the
+// breaker and retried.
+//
+// Methods that take a Querier run on it, so callers can include them in a
+// transaction of their own.
+package store
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Querier is satisfied by both *sql.DB and *sql.Tx.
+type Querier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// execRows runs a statement and returns how many rows it changed.
+func execRows(ctx context.Context, q Querier, query string, args ...interface{}) (int64, error) {
+	res, err := q.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,55 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+)
+
+// StripeStore keeps what the Stripe webhook has seen: the events it
+// processed, and the license each subscription item pays for.
+type StripeStore interface {
+	// ClaimEvent marks the event processed, reporting false if an earlier
+	// delivery already did.
+	ClaimEvent(ctx context.Context, q Querier, eventID, eventType string) (bool, error)
+	// License returns the key the subscription's price pays for, "" if
+	// there's none yet.
+	License(ctx context.Context, q Querier, subscriptionID, priceID string) (string, error)
+	// Link records that the subscription's price pays for the license.
+	Link(ctx context.Context, q Querier, subscriptionID, priceID, customerID, licenseKey string) error
+	// Licenses returns the keys of every license the subscription pays for.
+	Licenses(ctx context.Context, q Querier, subscriptionID string) ([]string, error)
+}
+
+type sqlStripe struct{}
+
+// NewSQLStripe keeps Stripe state in the stripe_events and
+// stripe_subscriptions tables.
+func NewSQLStripe() StripeStore {
+	return sqlStripe{}
+}
+
+func (sqlStripe) ClaimEvent(ctx context.Context, q Querier, eventID, eventType string) (bool, error) {
+	n, err := execRows(ctx, q, "INSERT INTO stripe_events (event_id, type) VALUES ($1, $2) ON CONFLICT DO NOTHING", eventID, eventType)
+	return n > 0, err
+}
+
+func (sqlStripe) License(ctx context.Context, q Querier, subscriptionID, priceID string) (string, error) {
+	var key string
+	err := q.QueryRowContext(ctx, "SELECT license_key FROM stripe_subscriptions WHERE subscription_id = $1 AND price_id = $2", subscriptionID, priceID).Scan(&key)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return key, err
+}
+
+func (sqlStripe) Link(ctx context.Context, q Querier, subscriptionID, priceID, customerID, licenseKey string) error {
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO stripe_subscriptions (subscription_id, price_id, customer_id, license_key)
+		VALUES ($1, $2, $3, $4)
+	`, subscriptionID, priceID, customerID, licenseKey)
+	return err
+}
+
+func (sqlStripe) Licenses(ctx context.Context, q Querier, subscriptionID string) ([]string, error) {
+	return queryKeys(ctx, q, "SELECT license_key FROM stripe_subscriptions WHERE subscription_id = $1", []interface{}{subscriptionID})
+}
@@ -0,0 +1,50 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// TokenStore holds GetAuthToken's one-shot access tokens by hash until
+// they're burned or expire.
+type TokenStore interface {
+	// Issue stores a token scoped to productID ("" for any product) as
+	// part of q's transaction, if the store is in the database at all.
+	Issue(ctx context.Context, q Querier, hash, productID string, ttl time.Duration) error
+	// Burn deletes a token and returns its scope; ok is false when there
+	// was no such token or it had expired.
+	Burn(ctx context.Context, hash string) (productID string, ok bool, err error)
+	Close() error
+}
+
+type sqlTokens struct {
+	db *sql.DB
+}
+
+// NewSQLTokens keeps access tokens in db's access_tokens table, which the
+// cleanup job clears of expired ones.
+func NewSQLTokens(db *sql.DB) TokenStore {
+	return &sqlTokens{db: db}
+}
+
+func (s *sqlTokens) Issue(ctx context.Context, q Querier, hash, productID string, ttl time.Duration) error {
+	_, err := q.ExecContext(ctx, "INSERT INTO access_tokens (token_hash, expires_at, product_id) VALUES ($1, $2, NULLIF($3, ''))", hash, time.Now().Add(ttl), productID)
+	return err
+}
+
+func (s *sqlTokens) Burn(ctx context.Context, hash string) (string, bool, error) {
+	var scope sql.NullString
+	err := s.db.QueryRowContext(ctx, "DELETE FROM access_tokens WHERE token_hash = $1 AND expires_at > NOW() RETURNING product_id", hash).Scan(&scope)
+	if err == sql.ErrNoRows {
+		return "", false, nil
+	} else if err != nil {
+		return "", false, err
+	}
+	return scope.String, true, nil
+}
+
+// Close does nothing; the database belongs to the caller.
+func (s *sqlTokens) Close() error {
+	return nil
+}
@@ -0,0 +1,40 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// TrialStore remembers the trials handed out, one per product for each
+// device and each client address.
+type TrialStore interface {
+	// Start records the trial licenseKey of productID, reporting false if
+	// the device (hashed HWID) or ip already had one.
+	Start(ctx context.Context, q Querier, productID, hwid, ip, licenseKey string) (bool, error)
+	// DeleteLicenses deletes the trial licenses that expired before t.
+	// The trials stay, so the device still can't start another.
+	DeleteLicenses(ctx context.Context, q Querier, before time.Time) (int64, error)
+}
+
+type sqlTrials struct{}
+
+// NewSQLTrials keeps trials in the license_trials table.
+func NewSQLTrials() TrialStore {
+	return sqlTrials{}
+}
+
+func (sqlTrials) Start(ctx context.Context, q Querier, productID, hwid, ip, licenseKey string) (bool, error) {
+	// Either unique index (device or IP) turns this into a no-op
+	n, err := execRows(ctx, q, `
+		INSERT INTO license_trials (product_id, hwid, client_ip, license_key) VALUES ($1, $2, $3, $4)
+		ON CONFLICT DO NOTHING
+	`, productID, hwid, ip, licenseKey)
+	return n > 0, err
+}
+
+func (sqlTrials) DeleteLicenses(ctx context.Context, q Querier, before time.Time) (int64, error) {
+	return execRows(ctx, q, `
+		DELETE FROM licenses
+		WHERE expires_at < $1 AND license_key IN (SELECT license_key FROM license_trials)
+	`, before)
+}
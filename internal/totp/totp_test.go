@@ -0,0 +1,105 @@
+package totp
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// The RFC 6238 appendix B SHA-1 secret, "12345678901234567890", in base32
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode(t *testing.T) {
+	// RFC 6238 appendix B, cut to 6 digits (the RFC's are 8, the same number
+	// mod 10^8)
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, tt := range tests {
+		got, err := Code(rfcSecret, Step(time.Unix(tt.unix, 0)))
+		if err != nil || got != tt.want {
+			t.Errorf("Code at %d = %q, %v; want %q", tt.unix, got, err, tt.want)
+		}
+	}
+	if _, err := Code("not base32!", 1); err == nil {
+		t.Error("Code accepted an invalid secret")
+	}
+}
+
+func TestVerify(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	step := Step(now)
+	codeAt := func(s int64) string {
+		c, err := Code(rfcSecret, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	tests := []struct {
+		name     string
+		code     string
+		wantStep int64
+		ok       bool
+	}{
+		{"current step", codeAt(step), step, true},
+		{"one step behind", codeAt(step - 1), step - 1, true},
+		{"one step ahead", codeAt(step + 1), step + 1, true},
+		{"two steps behind", codeAt(step - 2), 0, false},
+		{"two steps ahead", codeAt(step + 2), 0, false},
+		{"wrong code", "000000", 0, false},
+		{"too short", codeAt(step)[:5], 0, false},
+		{"too long", codeAt(step) + "0", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Verify(rfcSecret, tt.code, now)
+			if ok != tt.ok || got != tt.wantStep {
+				t.Errorf("got %d, %v; want %d, %v", got, ok, tt.wantStep, tt.ok)
+			}
+		})
+	}
+	if _, ok := Verify("not base32!", "123456", now); ok {
+		t.Error("Verify accepted a code for an invalid secret")
+	}
+}
+
+func TestNewSecret(t *testing.T) {
+	a, err := NewSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := NewSecret()
+	if a == b {
+		t.Error("two secrets are the same")
+	}
+	if _, err := Code(a, 1); err != nil {
+		t.Errorf("new secret doesn't decode: %v", err)
+	}
+	if len(a) != 32 {
+		t.Errorf("secret %q isn't 160 bits", a)
+	}
+}
+
+func TestURL(t *testing.T) {
+	u, err := url.Parse(URL("Whitelist", "alice", rfcSecret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Scheme != "otpauth" || u.Host != "totp" || !strings.HasSuffix(u.Path, "Whitelist:alice") {
+		t.Errorf("got %s", u)
+	}
+	q := u.Query()
+	if q.Get("secret") != rfcSecret || q.Get("issuer") != "Whitelist" || q.Get("digits") != "6" || q.Get("period") != "30" {
+		t.Errorf("got query %v", q)
+	}
+}
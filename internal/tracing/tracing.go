@@ -0,0 +1,67 @@
+// Package tracing configures OpenTelemetry for the gateway, gRPC server and
+// database calls.
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Setup installs the global tracer provider and propagators. Spans are
+// exported over OTLP/gRPC only when OTEL_EXPORTER_OTLP_ENDPOINT (or the
+// traces-specific variant) is set; otherwise tracing stays a no-op. The
+// exporter honours the rest of the standard OTEL_* variables.
+//
+// The returned function flushes pending spans and should run on shutdown.
+func Setup(ctx context.Context, serviceName string) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" && os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Merges OTEL_SERVICE_NAME / OTEL_RESOURCE_ATTRIBUTES over the default name
+	res, err := resource.Merge(
+		resource.NewSchemaless(attribute.String("service.name", serviceName)),
+		resource.Environment(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tp)
+	return tp.Shutdown, nil
+}
+
+// TraceIDHeader adds an X-Trace-Id response header carrying the current
+// span's trace ID, so clients can quote it when reporting slow calls.
+// It must run inside the otelhttp handler that starts the span.
+func TraceIDHeader(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if sc := trace.SpanContextFromContext(r.Context()); sc.HasTraceID() {
+			w.Header().Set("X-Trace-Id", sc.TraceID().String())
+		}
+		h.ServeHTTP(w, r)
+	})
+}
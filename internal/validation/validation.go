@@ -0,0 +1,423 @@
+// Package validation enforces the protoc-gen-validate rules annotated on the
+// request messages in the .proto files, so a malformed request is turned
+// away the same way whichever RPC it's for, before it reaches the handler.
+//
+// Only the rules the API uses are implemented: lengths, patterns and sets
+// for strings, bounds for integers, item counts for repeated fields and
+// required messages. New rejects any other rule, so an annotation can't be
+// silently ignored.
+package validation
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/envoyproxy/protoc-gen-validate/validate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/mkseven15/whitelist-server/internal/errinfo"
+	pb "github.com/mkseven15/whitelist-server/proto"
+)
+
+// Validator checks requests against the rules of their message type.
+type Validator struct {
+	messages map[protoreflect.FullName][]field
+}
+
+// field is a field with rules, or one holding messages that have some.
+type field struct {
+	desc     protoreflect.FieldDescriptor
+	checks   []check
+	required bool
+	// Items of a repeated field
+	items []check
+	// The field's messages are validated too
+	nested bool
+}
+
+// check returns what's wrong with v, or "" if nothing is.
+type check func(v protoreflect.Value) string
+
+// New compiles the rules of every method input of the given services.
+func New(services ...protoreflect.ServiceDescriptor) (*Validator, error) {
+	v := &Validator{messages: map[protoreflect.FullName][]field{}}
+	for _, sd := range services {
+		methods := sd.Methods()
+		for i := 0; i < methods.Len(); i++ {
+			if err := v.compile(methods.Get(i).Input()); err != nil {
+				return nil, fmt.Errorf("%s: %w", methods.Get(i).FullName(), err)
+			}
+		}
+	}
+	return v, nil
+}
+
+// compile records the rules of md and of the messages it contains.
+func (v *Validator) compile(md protoreflect.MessageDescriptor) error {
+	if _, ok := v.messages[md.FullName()]; ok {
+		return nil
+	}
+	// Placeholder for recursive messages
+	v.messages[md.FullName()] = nil
+
+	var fields []field
+	fds := md.Fields()
+	for i := 0; i < fds.Len(); i++ {
+		fd := fds.Get(i)
+		f := field{desc: fd}
+		rules, _ := proto.GetExtension(fd.Options(), validate.E_Rules).(*validate.FieldRules)
+		if rules != nil {
+			if err := compileField(&f, rules); err != nil {
+				return fmt.Errorf("%s: %w", fd.Name(), err)
+			}
+		}
+		if fd.Message() != nil && !fd.IsMap() {
+			if err := v.compile(fd.Message()); err != nil {
+				return err
+			}
+			f.nested = v.hasRules(fd.Message(), map[protoreflect.FullName]bool{})
+		}
+		if len(f.checks) > 0 || len(f.items) > 0 || f.required || f.nested {
+			fields = append(fields, f)
+		}
+	}
+	v.messages[md.FullName()] = fields
+	return nil
+}
+
+// hasRules reports whether md, or a message it contains, has rules.
+func (v *Validator) hasRules(md protoreflect.MessageDescriptor, seen map[protoreflect.FullName]bool) bool {
+	if seen[md.FullName()] {
+		return false
+	}
+	seen[md.FullName()] = true
+	fds := md.Fields()
+	for i := 0; i < fds.Len(); i++ {
+		fd := fds.Get(i)
+		if rules, _ := proto.GetExtension(fd.Options(), validate.E_Rules).(*validate.FieldRules); rules != nil {
+			return true
+		}
+		if fd.Message() != nil && !fd.IsMap() && v.hasRules(fd.Message(), seen) {
+			return true
+		}
+	}
+	return false
+}
+
+func compileField(f *field, rules *validate.FieldRules) error {
+	if err := supported(rules, "message", "string", "int32", "int64", "repeated"); err != nil {
+		return err
+	}
+	if m := rules.GetMessage(); m != nil {
+		if err := supported(m, "required"); err != nil {
+			return err
+		}
+		f.required = m.GetRequired()
+	}
+	checks, err := compileScalar(rules)
+	if err != nil {
+		return err
+	}
+	if r := rules.GetRepeated(); r != nil {
+		if err := supported(r, "min_items", "max_items", "items"); err != nil {
+			return err
+		}
+		if r.MinItems != nil {
+			n := int(r.GetMinItems())
+			checks = append(checks, func(v protoreflect.Value) string {
+				if v.List().Len() < n {
+					return fmt.Sprintf("must have at least %d items", n)
+				}
+				return ""
+			})
+		}
+		if r.MaxItems != nil {
+			n := int(r.GetMaxItems())
+			checks = append(checks, func(v protoreflect.Value) string {
+				if v.List().Len() > n {
+					return fmt.Sprintf("must have at most %d items", n)
+				}
+				return ""
+			})
+		}
+		if r.Items != nil {
+			if err := supported(r.Items, "string", "int32", "int64"); err != nil {
+				return fmt.Errorf("items: %w", err)
+			}
+			if f.items, err = compileScalar(r.Items); err != nil {
+				return fmt.Errorf("items: %w", err)
+			}
+		}
+	}
+	f.checks = checks
+	return nil
+}
+
+// compileScalar compiles the string or integer rules in rules, if any.
+func compileScalar(rules *validate.FieldRules) ([]check, error) {
+	switch {
+	case rules.GetString_() != nil:
+		return compileString(rules.GetString_())
+	case rules.GetInt32() != nil:
+		r := rules.GetInt32()
+		if err := supported(r, "gt", "gte", "lt", "lte"); err != nil {
+			return nil, err
+		}
+		return compileInt(int64Ptr(r.Gt), int64Ptr(r.Gte), int64Ptr(r.Lt), int64Ptr(r.Lte)), nil
+	case rules.GetInt64() != nil:
+		r := rules.GetInt64()
+		if err := supported(r, "gt", "gte", "lt", "lte"); err != nil {
+			return nil, err
+		}
+		return compileInt(r.Gt, r.Gte, r.Lt, r.Lte), nil
+	}
+	return nil, nil
+}
+
+func compileString(r *validate.StringRules) ([]check, error) {
+	if err := supported(r, "min_len", "max_len", "min_bytes", "max_bytes", "pattern", "prefix", "in", "not_in", "ignore_empty"); err != nil {
+		return nil, err
+	}
+	var checks []check
+	add := func(c func(s string) string) {
+		checks = append(checks, func(v protoreflect.Value) string {
+			s := v.String()
+			if s == "" && r.GetIgnoreEmpty() {
+				return ""
+			}
+			return c(s)
+		})
+	}
+	if r.MinLen != nil {
+		n := int(r.GetMinLen())
+		add(func(s string) string {
+			if utf8.RuneCountInString(s) >= n {
+				return ""
+			}
+			if n == 1 {
+				return "required"
+			}
+			return fmt.Sprintf("must be at least %d characters", n)
+		})
+	}
+	if r.MaxLen != nil {
+		n := int(r.GetMaxLen())
+		add(func(s string) string {
+			if utf8.RuneCountInString(s) > n {
+				return fmt.Sprintf("must be at most %d characters", n)
+			}
+			return ""
+		})
+	}
+	if r.MinBytes != nil {
+		n := int(r.GetMinBytes())
+		add(func(s string) string {
+			if len(s) < n {
+				return fmt.Sprintf("must be at least %d bytes", n)
+			}
+			return ""
+		})
+	}
+	if r.MaxBytes != nil {
+		n := int(r.GetMaxBytes())
+		add(func(s string) string {
+			if len(s) > n {
+				return fmt.Sprintf("must be at most %d bytes", n)
+			}
+			return ""
+		})
+	}
+	if r.Pattern != nil {
+		re, err := regexp.Compile(r.GetPattern())
+		if err != nil {
+			return nil, fmt.Errorf("pattern: %w", err)
+		}
+		add(func(s string) string {
+			// An empty value is left to min_len, which says "required"
+			if s != "" && !re.MatchString(s) {
+				return "has an invalid format"
+			}
+			return ""
+		})
+	}
+	if r.Prefix != nil {
+		prefix := r.GetPrefix()
+		add(func(s string) string {
+			if !strings.HasPrefix(s, prefix) {
+				return fmt.Sprintf("must start with %q", prefix)
+			}
+			return ""
+		})
+	}
+	if len(r.In) > 0 {
+		in := r.In
+		add(func(s string) string {
+			if !slices.Contains(in, s) {
+				return "must be one of " + strings.Join(in, ", ")
+			}
+			return ""
+		})
+	}
+	if len(r.NotIn) > 0 {
+		notIn := r.NotIn
+		add(func(s string) string {
+			if slices.Contains(notIn, s) {
+				return fmt.Sprintf("must not be %q", s)
+			}
+			return ""
+		})
+	}
+	return checks, nil
+}
+
+func compileInt(gt, gte, lt, lte *int64) []check {
+	var checks []check
+	bound := func(limit *int64, ok func(n, limit int64) bool, msg string) {
+		if limit == nil {
+			return
+		}
+		l := *limit
+		checks = append(checks, func(v protoreflect.Value) string {
+			if !ok(v.Int(), l) {
+				return fmt.Sprintf(msg, l)
+			}
+			return ""
+		})
+	}
+	bound(gt, func(n, l int64) bool { return n > l }, "must be greater than %d")
+	bound(gte, func(n, l int64) bool { return n >= l }, "must be at least %d")
+	bound(lt, func(n, l int64) bool { return n < l }, "must be less than %d")
+	bound(lte, func(n, l int64) bool { return n <= l }, "must be at most %d")
+	return checks
+}
+
+func int64Ptr(n *int32) *int64 {
+	if n == nil {
+		return nil
+	}
+	v := int64(*n)
+	return &v
+}
+
+// supported returns an error naming the first rule set in m that isn't one
+// of names.
+func supported(m proto.Message, names ...string) error {
+	var err error
+	m.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if fd.ContainingOneof() != nil && slices.Contains(names, string(fd.ContainingOneof().Name())) {
+			return true
+		}
+		if !slices.Contains(names, string(fd.Name())) {
+			err = fmt.Errorf("unsupported validation rule %q", fd.Name())
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// Validate returns an InvalidArgument error listing what's wrong with msg,
+// with a BadRequest detail holding one violation per field next to the
+// REASON_INVALID_REQUEST ErrorInfo, or nil if nothing is.
+func (v *Validator) Validate(msg proto.Message) error {
+	var violations []*errdetails.BadRequest_FieldViolation
+	v.validate(msg.ProtoReflect(), "", &violations)
+	if len(violations) == 0 {
+		return nil
+	}
+	parts := make([]string, len(violations))
+	for i, fv := range violations {
+		parts[i] = fv.Field + ": " + fv.Description
+	}
+	st := errinfo.Status(codes.InvalidArgument, pb.Reason_REASON_INVALID_REQUEST, strings.Join(parts, "; "))
+	if withDetails, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations}); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+func (v *Validator) validate(m protoreflect.Message, prefix string, violations *[]*errdetails.BadRequest_FieldViolation) {
+	for _, f := range v.messages[m.Descriptor().FullName()] {
+		path := prefix + string(f.desc.Name())
+		report := func(path, problem string) {
+			*violations = append(*violations, &errdetails.BadRequest_FieldViolation{Field: path, Description: problem})
+		}
+		if f.required && !m.Has(f.desc) {
+			report(path, "required")
+			continue
+		}
+		val := m.Get(f.desc)
+		if problem := firstProblem(f.checks, val); problem != "" {
+			report(path, problem)
+			continue
+		}
+		if !f.desc.IsList() {
+			if f.nested && m.Has(f.desc) {
+				v.validate(val.Message(), path+".", violations)
+			}
+			continue
+		}
+		list := val.List()
+		for i := 0; i < list.Len(); i++ {
+			item := fmt.Sprintf("%s[%d]", path, i)
+			if problem := firstProblem(f.items, list.Get(i)); problem != "" {
+				report(item, problem)
+			} else if f.nested {
+				v.validate(list.Get(i).Message(), item+".", violations)
+			}
+		}
+	}
+}
+
+func firstProblem(checks []check, v protoreflect.Value) string {
+	for _, c := range checks {
+		if problem := c(v); problem != "" {
+			return problem
+		}
+	}
+	return ""
+}
+
+// UnaryServerInterceptor rejects requests that break their rules with
+// InvalidArgument (HTTP 400 through the gateway).
+func (v *Validator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if msg, ok := req.(proto.Message); ok {
+			if err := v.Validate(msg); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is UnaryServerInterceptor for streaming methods:
+// it checks each message the handler receives.
+func (v *Validator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{ServerStream: ss, v: v})
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	v *Validator
+}
+
+func (s *serverStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if msg, ok := m.(proto.Message); ok {
+		return s.v.Validate(msg)
+	}
+	return nil
+}
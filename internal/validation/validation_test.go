@@ -0,0 +1,289 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/envoyproxy/protoc-gen-validate/validate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	pb "github.com/mkseven15/whitelist-server/proto"
+	pbv2 "github.com/mkseven15/whitelist-server/proto/v2"
+)
+
+// compileRules builds a service whose one method takes a Req with field f,
+// and a Validator for it. Req also has an unvalidated Child message type
+// (with a name field holding childRules, if any) for f to refer to.
+func compileRules(t *testing.T, f *descriptorpb.FieldDescriptorProto, childRules *validate.FieldRules) (*Validator, protoreflect.MessageDescriptor, error) {
+	t.Helper()
+	child := &descriptorpb.DescriptorProto{
+		Name:  proto.String("Child"),
+		Field: []*descriptorpb.FieldDescriptorProto{fieldProto("name", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, false, childRules)},
+	}
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("validation_test.proto"),
+		Package: proto.String("test"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{Name: proto.String("Req"), Field: []*descriptorpb.FieldDescriptorProto{f}},
+			child,
+		},
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name: proto.String("S"),
+			Method: []*descriptorpb.MethodDescriptorProto{{
+				Name:       proto.String("M"),
+				InputType:  proto.String(".test.Req"),
+				OutputType: proto.String(".test.Child"),
+			}},
+		}},
+	}
+	fd, err := protodesc.NewFile(fdp, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := New(fd.Services().Get(0))
+	return v, fd.Messages().ByName("Req"), err
+}
+
+func fieldProto(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type, repeated bool, rules *validate.FieldRules) *descriptorpb.FieldDescriptorProto {
+	label := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+	if repeated {
+		label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	}
+	f := &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String(name),
+		JsonName: proto.String(name),
+		Number:   proto.Int32(number),
+		Type:     typ.Enum(),
+		Label:    label.Enum(),
+	}
+	if typ == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE {
+		f.TypeName = proto.String(".test.Child")
+	}
+	if rules != nil {
+		f.Options = &descriptorpb.FieldOptions{}
+		proto.SetExtension(f.Options, validate.E_Rules, rules)
+	}
+	return f
+}
+
+func stringRules(r *validate.StringRules) *validate.FieldRules {
+	return &validate.FieldRules{Type: &validate.FieldRules_String_{String_: r}}
+}
+
+func TestStringRules(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules *validate.StringRules
+		value string
+		want  string
+	}{
+		{"min_len 1", &validate.StringRules{MinLen: proto.Uint64(1)}, "", "v: required"},
+		{"min_len", &validate.StringRules{MinLen: proto.Uint64(3)}, "ab", "v: must be at least 3 characters"},
+		{"min_len met", &validate.StringRules{MinLen: proto.Uint64(3)}, "abc", ""},
+		{"max_len", &validate.StringRules{MaxLen: proto.Uint64(3)}, "abcd", "v: must be at most 3 characters"},
+		{"max_len counts characters", &validate.StringRules{MaxLen: proto.Uint64(3)}, "äöü", ""},
+		{"min_bytes", &validate.StringRules{MinBytes: proto.Uint64(4)}, "äb", "v: must be at least 4 bytes"},
+		{"max_bytes", &validate.StringRules{MaxBytes: proto.Uint64(3)}, "äö", "v: must be at most 3 bytes"},
+		{"pattern", &validate.StringRules{Pattern: proto.String("^[a-z]+$")}, "ABC", "v: has an invalid format"},
+		{"pattern match", &validate.StringRules{Pattern: proto.String("^[a-z]+$")}, "abc", ""},
+		{"pattern leaves empty to min_len", &validate.StringRules{Pattern: proto.String("^[a-z]+$")}, "", ""},
+		{"prefix", &validate.StringRules{Prefix: proto.String("wl_")}, "key", `v: must start with "wl_"`},
+		{"in", &validate.StringRules{In: []string{"a", "b"}}, "c", "v: must be one of a, b"},
+		{"in match", &validate.StringRules{In: []string{"a", "b"}}, "b", ""},
+		{"not_in", &validate.StringRules{NotIn: []string{"root"}}, "root", `v: must not be "root"`},
+		{"ignore_empty", &validate.StringRules{MinLen: proto.Uint64(3), IgnoreEmpty: proto.Bool(true)}, "", ""},
+		{"ignore_empty set value", &validate.StringRules{MinLen: proto.Uint64(3), IgnoreEmpty: proto.Bool(true)}, "ab", "v: must be at least 3 characters"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, md, err := compileRules(t, fieldProto("v", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, false, stringRules(tt.rules)), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			msg := dynamicpb.NewMessage(md)
+			msg.Set(md.Fields().ByName("v"), protoreflect.ValueOfString(tt.value))
+			checkResult(t, v.Validate(msg), tt.want)
+		})
+	}
+}
+
+func TestIntRules(t *testing.T) {
+	int32Rules := func(r *validate.Int32Rules) *validate.FieldRules {
+		return &validate.FieldRules{Type: &validate.FieldRules_Int32{Int32: r}}
+	}
+	int64Rules := func(r *validate.Int64Rules) *validate.FieldRules {
+		return &validate.FieldRules{Type: &validate.FieldRules_Int64{Int64: r}}
+	}
+	tests := []struct {
+		name  string
+		typ   descriptorpb.FieldDescriptorProto_Type
+		rules *validate.FieldRules
+		value int64
+		want  string
+	}{
+		{"int32 gt", descriptorpb.FieldDescriptorProto_TYPE_INT32, int32Rules(&validate.Int32Rules{Gt: proto.Int32(0)}), 0, "v: must be greater than 0"},
+		{"int32 gte", descriptorpb.FieldDescriptorProto_TYPE_INT32, int32Rules(&validate.Int32Rules{Gte: proto.Int32(1)}), 0, "v: must be at least 1"},
+		{"int32 lt", descriptorpb.FieldDescriptorProto_TYPE_INT32, int32Rules(&validate.Int32Rules{Lt: proto.Int32(10)}), 10, "v: must be less than 10"},
+		{"int32 lte", descriptorpb.FieldDescriptorProto_TYPE_INT32, int32Rules(&validate.Int32Rules{Lte: proto.Int32(10)}), 11, "v: must be at most 10"},
+		{"int32 in range", descriptorpb.FieldDescriptorProto_TYPE_INT32, int32Rules(&validate.Int32Rules{Gte: proto.Int32(0), Lte: proto.Int32(10)}), 10, ""},
+		{"int64 gt", descriptorpb.FieldDescriptorProto_TYPE_INT64, int64Rules(&validate.Int64Rules{Gt: proto.Int64(0)}), -1, "v: must be greater than 0"},
+		{"int64 gte", descriptorpb.FieldDescriptorProto_TYPE_INT64, int64Rules(&validate.Int64Rules{Gte: proto.Int64(0)}), -1, "v: must be at least 0"},
+		{"int64 lt", descriptorpb.FieldDescriptorProto_TYPE_INT64, int64Rules(&validate.Int64Rules{Lt: proto.Int64(5)}), 5, "v: must be less than 5"},
+		{"int64 lte", descriptorpb.FieldDescriptorProto_TYPE_INT64, int64Rules(&validate.Int64Rules{Lte: proto.Int64(5)}), 6, "v: must be at most 5"},
+		{"int64 in range", descriptorpb.FieldDescriptorProto_TYPE_INT64, int64Rules(&validate.Int64Rules{Gte: proto.Int64(0)}), 0, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, md, err := compileRules(t, fieldProto("v", 1, tt.typ, false, tt.rules), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			msg := dynamicpb.NewMessage(md)
+			fd := md.Fields().ByName("v")
+			if tt.typ == descriptorpb.FieldDescriptorProto_TYPE_INT32 {
+				msg.Set(fd, protoreflect.ValueOfInt32(int32(tt.value)))
+			} else {
+				msg.Set(fd, protoreflect.ValueOfInt64(tt.value))
+			}
+			checkResult(t, v.Validate(msg), tt.want)
+		})
+	}
+}
+
+func TestRepeatedRules(t *testing.T) {
+	repeated := func(r *validate.RepeatedRules) *validate.FieldRules {
+		return &validate.FieldRules{Type: &validate.FieldRules_Repeated{Repeated: r}}
+	}
+	tests := []struct {
+		name  string
+		rules *validate.RepeatedRules
+		items []string
+		want  string
+	}{
+		{"min_items", &validate.RepeatedRules{MinItems: proto.Uint64(1)}, nil, "v: must have at least 1 items"},
+		{"max_items", &validate.RepeatedRules{MaxItems: proto.Uint64(2)}, []string{"a", "b", "c"}, "v: must have at most 2 items"},
+		{"items", &validate.RepeatedRules{Items: stringRules(&validate.StringRules{MaxLen: proto.Uint64(1)})}, []string{"a", "bc", "de"}, "v[1]: must be at most 1 characters; v[2]: must be at most 1 characters"},
+		{"items met", &validate.RepeatedRules{MaxItems: proto.Uint64(2), Items: stringRules(&validate.StringRules{MinLen: proto.Uint64(1)})}, []string{"a", "b"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, md, err := compileRules(t, fieldProto("v", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, true, repeated(tt.rules)), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			msg := dynamicpb.NewMessage(md)
+			list := msg.Mutable(md.Fields().ByName("v")).List()
+			for _, s := range tt.items {
+				list.Append(protoreflect.ValueOfString(s))
+			}
+			checkResult(t, v.Validate(msg), tt.want)
+		})
+	}
+}
+
+func TestMessageRules(t *testing.T) {
+	required := &validate.FieldRules{Message: &validate.MessageRules{Required: proto.Bool(true)}}
+	childName := stringRules(&validate.StringRules{MinLen: proto.Uint64(1)})
+	tests := []struct {
+		name       string
+		rules      *validate.FieldRules
+		childRules *validate.FieldRules
+		child      *string
+		want       string
+	}{
+		{"required missing", required, nil, nil, "v: required"},
+		{"required set", required, nil, proto.String(""), ""},
+		{"nested", nil, childName, proto.String(""), "v.name: required"},
+		{"nested unset", nil, childName, nil, ""},
+		{"required and nested", required, childName, proto.String("x"), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, md, err := compileRules(t, fieldProto("v", 1, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, false, tt.rules), tt.childRules)
+			if err != nil {
+				t.Fatal(err)
+			}
+			msg := dynamicpb.NewMessage(md)
+			if tt.child != nil {
+				fd := md.Fields().ByName("v")
+				child := msg.Mutable(fd).Message()
+				child.Set(fd.Message().Fields().ByName("name"), protoreflect.ValueOfString(*tt.child))
+			}
+			checkResult(t, v.Validate(msg), tt.want)
+		})
+	}
+}
+
+func TestUnsupportedRules(t *testing.T) {
+	str := descriptorpb.FieldDescriptorProto_TYPE_STRING
+	tests := []struct {
+		name     string
+		typ      descriptorpb.FieldDescriptorProto_Type
+		repeated bool
+		rules    *validate.FieldRules
+		want     string
+	}{
+		{"string rule", str, false, stringRules(&validate.StringRules{WellKnown: &validate.StringRules_Email{Email: true}}), `unsupported validation rule "email"`},
+		{"string const", str, false, stringRules(&validate.StringRules{Const: proto.String("x")}), `unsupported validation rule "const"`},
+		{"rule type", descriptorpb.FieldDescriptorProto_TYPE_UINT32, false, &validate.FieldRules{Type: &validate.FieldRules_Uint32{Uint32: &validate.UInt32Rules{Gt: proto.Uint32(0)}}}, `unsupported validation rule "uint32"`},
+		{"int64 rule", descriptorpb.FieldDescriptorProto_TYPE_INT64, false, &validate.FieldRules{Type: &validate.FieldRules_Int64{Int64: &validate.Int64Rules{In: []int64{1}}}}, `unsupported validation rule "in"`},
+		{"message rule", descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, false, &validate.FieldRules{Message: &validate.MessageRules{Skip: proto.Bool(true)}}, `unsupported validation rule "skip"`},
+		{"repeated rule", str, true, &validate.FieldRules{Type: &validate.FieldRules_Repeated{Repeated: &validate.RepeatedRules{Unique: proto.Bool(true)}}}, `unsupported validation rule "unique"`},
+		{"item type", descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, true, &validate.FieldRules{Type: &validate.FieldRules_Repeated{Repeated: &validate.RepeatedRules{Items: &validate.FieldRules{Message: &validate.MessageRules{Required: proto.Bool(true)}}}}}, `items: unsupported validation rule "message"`},
+		{"item rule", str, true, &validate.FieldRules{Type: &validate.FieldRules_Repeated{Repeated: &validate.RepeatedRules{Items: stringRules(&validate.StringRules{WellKnown: &validate.StringRules_Uri{Uri: true}})}}}, `items: unsupported validation rule "uri"`},
+		{"bad pattern", str, false, stringRules(&validate.StringRules{Pattern: proto.String("(")}), "pattern: "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := compileRules(t, fieldProto("v", 1, tt.typ, tt.repeated, tt.rules), nil)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("got %v, want an error containing %q", err, tt.want)
+			}
+			// The error names where the rule is
+			if err != nil && !strings.HasPrefix(err.Error(), "test.S.M: v: ") {
+				t.Errorf("error %q doesn't name the method and field", err)
+			}
+		})
+	}
+	// A rule on a nested message's field is found too
+	_, _, err := compileRules(t, fieldProto("v", 1, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, false, nil), stringRules(&validate.StringRules{WellKnown: &validate.StringRules_Email{Email: true}}))
+	if err == nil || !strings.Contains(err.Error(), `name: unsupported validation rule "email"`) {
+		t.Errorf("got %v for an unsupported nested rule", err)
+	}
+}
+
+// The rules in the .proto files all compile, and ValidateRequest needs a
+// product.
+func TestAPIRules(t *testing.T) {
+	v, err := New(
+		pb.File_proto_whitelist_proto.Services().ByName("WhitelistService"),
+		pbv2.File_proto_v2_whitelist_proto.Services().ByName("WhitelistService"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkResult(t, v.Validate(&pb.ValidateRequest{LicenseKey: "KEY-1"}), "product_id: required")
+	checkResult(t, v.Validate(&pbv2.ValidateRequest{LicenseKey: "KEY-1"}), "product_id: required")
+	checkResult(t, v.Validate(&pb.ValidateRequest{LicenseKey: "KEY-1", ProductId: "app"}), "")
+}
+
+func checkResult(t *testing.T, err error, want string) {
+	t.Helper()
+	if want == "" {
+		if err != nil {
+			t.Errorf("got %v, want no error", err)
+		}
+		return
+	}
+	st := status.Convert(err)
+	if err == nil || st.Code() != codes.InvalidArgument || st.Message() != want {
+		t.Errorf("got %v, want InvalidArgument %q", err, want)
+	}
+}
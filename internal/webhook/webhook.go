@@ -0,0 +1,241 @@
+// Package webhook delivers signed license lifecycle events to HTTP endpoints.
+//
+// Each delivery is a JSON POST carrying an Event. The body is signed with the
+// endpoint's secret:
+//
+//	X-Webhook-Timestamp: <unix seconds>
+//	X-Webhook-Signature: sha256=<hex HMAC-SHA256 of "<timestamp>.<body>">
+//
+// Deliveries are queued in memory and retried with exponential backoff, so
+// events still queued when the process exits are lost.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Event types
+const (
+	LicenseCreated          = "license.created"
+	LicenseUpdated          = "license.updated"
+	LicenseDeleted          = "license.deleted"
+	LicenseRestored         = "license.restored"
+	LicenseExpiring         = "license.expiring"
+	HwidBound               = "hwid.bound"
+	HwidMismatch            = "hwid.mismatch"
+	ValidationFailureStreak = "validation.failure_streak"
+)
+
+const (
+	queueSize   = 1000
+	workers     = 4
+	maxAttempts = 6
+	baseBackoff = time.Second
+)
+
+// Event is the JSON body of every delivery.
+type Event struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	CreatedAt  time.Time       `json:"created_at"`
+	LicenseKey string          `json:"license_key"`
+	Data       json.RawMessage `json:"data,omitempty"`
+}
+
+// Endpoint receives the events listed in Events, or all of them when empty.
+type Endpoint struct {
+	URL    string
+	Secret string
+	Events []string
+}
+
+func (e Endpoint) wants(eventType string) bool {
+	if len(e.Events) == 0 {
+		return true
+	}
+	for _, t := range e.Events {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+type delivery struct {
+	endpoint Endpoint
+	body     []byte
+	event    *Event
+}
+
+// Dispatcher fans events out to endpoints in the background. A nil
+// *Dispatcher (no endpoints configured) drops every event.
+type Dispatcher struct {
+	endpoints []Endpoint
+	client    *http.Client
+
+	queue chan delivery
+	wg    sync.WaitGroup
+
+	// ctx is cancelled when Close gives up waiting, aborting retries
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// New starts a Dispatcher for endpoints, or returns nil when there are none.
+func New(endpoints []Endpoint) *Dispatcher {
+	if len(endpoints) == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	d := &Dispatcher{
+		endpoints: endpoints,
+		client:    &http.Client{Timeout: 10 * time.Second},
+		queue:     make(chan delivery, queueSize),
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+	for i := 0; i < workers; i++ {
+		d.wg.Add(1)
+		go d.work()
+	}
+	return d
+}
+
+// Send queues e for every endpoint subscribed to its type. It never blocks;
+// if the queue is full the event is dropped and logged.
+func (d *Dispatcher) Send(e Event) {
+	if d == nil {
+		return
+	}
+	if e.ID == "" {
+		e.ID = newEventID()
+	}
+	if e.CreatedAt.IsZero() {
+		e.CreatedAt = time.Now().UTC()
+	}
+	body, err := json.Marshal(e)
+	if err != nil {
+		log.Printf("webhook: encode %s: %v", e.Type, err)
+		return
+	}
+	for _, ep := range d.endpoints {
+		if !ep.wants(e.Type) {
+			continue
+		}
+		select {
+		case d.queue <- delivery{endpoint: ep, body: body, event: &e}:
+		default:
+			log.Printf("webhook: queue full, dropping %s %s for %s", e.Type, e.ID, ep.URL)
+		}
+	}
+}
+
+// Close stops accepting events and waits for queued deliveries, abandoning
+// whatever is left when ctx expires. Send must not be called after Close.
+func (d *Dispatcher) Close(ctx context.Context) {
+	if d == nil {
+		return
+	}
+	close(d.queue)
+	done := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		d.cancel()
+		<-done
+	}
+	d.cancel()
+}
+
+func (d *Dispatcher) work() {
+	defer d.wg.Done()
+	for dl := range d.queue {
+		d.deliver(dl)
+	}
+}
+
+// deliver posts dl until it succeeds, fails permanently or runs out of attempts.
+func (d *Dispatcher) deliver(dl delivery) {
+	backoff := baseBackoff
+	for attempt := 1; ; attempt++ {
+		retry, err := d.post(dl)
+		if err == nil {
+			return
+		}
+		if !retry || attempt == maxAttempts {
+			log.Printf("webhook: %s %s to %s failed after %d attempt(s): %v", dl.event.Type, dl.event.ID, dl.endpoint.URL, attempt, err)
+			return
+		}
+		select {
+		case <-time.After(backoff):
+		case <-d.ctx.Done():
+			log.Printf("webhook: %s %s to %s abandoned on shutdown", dl.event.Type, dl.event.ID, dl.endpoint.URL)
+			return
+		}
+		backoff *= 2
+	}
+}
+
+// post makes one delivery attempt, reporting whether a failure is worth retrying.
+func (d *Dispatcher) post(dl delivery) (bool, error) {
+	req, err := http.NewRequestWithContext(d.ctx, http.MethodPost, dl.endpoint.URL, bytes.NewReader(dl.body))
+	if err != nil {
+		return false, err
+	}
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "whitelist-server-webhook")
+	req.Header.Set("X-Webhook-Id", dl.event.ID)
+	req.Header.Set("X-Webhook-Event", dl.event.Type)
+	req.Header.Set("X-Webhook-Timestamp", ts)
+	req.Header.Set("X-Webhook-Signature", "sha256="+Sign(dl.endpoint.Secret, ts, dl.body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	resp.Body.Close()
+
+	switch {
+	case resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, fmt.Errorf("status %d", resp.StatusCode)
+	default:
+		return false, fmt.Errorf("status %d", resp.StatusCode)
+	}
+}
+
+// Sign returns the hex HMAC-SHA256 receivers should compare against the
+// X-Webhook-Signature header (after its "sha256=" prefix).
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func newEventID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return "evt_" + hex.EncodeToString(b)
+}
@@ -0,0 +1,44 @@
+package licensefile
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// Keys are public keys any of which may have signed, so files and answers
+// signed before a key rotation keep checking; fill it from GetSigningKeys or
+// embed them all in the client.
+type Keys []ed25519.PublicKey
+
+// Verify is like the package-level Verify, trying each key in turn.
+func (k Keys) Verify(file []byte) (*License, error) {
+	err := ErrBadSignature
+	for _, key := range k {
+		var l *License
+		if l, err = Verify(key, file); err != ErrBadSignature {
+			return l, err
+		}
+	}
+	return nil, err
+}
+
+// VerifyResponse is like the package-level VerifyResponse, trying each key
+// in turn.
+func (k Keys) VerifyResponse(r Response, signature string) error {
+	for _, key := range k {
+		if VerifyResponse(key, r, signature) == nil {
+			return nil
+		}
+	}
+	return ErrBadSignature
+}
+
+// KeyID names key by its JWK thumbprint (RFC 7638): the server reports it
+// with every signature so clients holding several keys can pick the right
+// one.
+func KeyID(key ed25519.PublicKey) string {
+	jwk := `{"crv":"Ed25519","kty":"OKP","x":"` + base64.RawURLEncoding.EncodeToString(key) + `"}`
+	sum := sha256.Sum256([]byte(jwk))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
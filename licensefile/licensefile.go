@@ -0,0 +1,156 @@
+// Package licensefile reads and writes signed license files, which let a
+// client check its license without reaching the whitelist server.
+//
+// A license file is a single line: the base64url-encoded JSON payload, a dot,
+// and the base64url-encoded Ed25519 signature of the payload bytes. The server
+// signs them with its private key (ExportLicenseFile); clients embed the
+// matching public key and call Verify, then Check.
+//
+// Files are only good offline until ValidUntil. Clients should validate online
+// whenever they can and fetch a fresh file before that point; Check returns
+// ErrStale once it has passed.
+package licensefile
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	hwidpkg "github.com/mkseven15/whitelist-server/internal/hwid"
+)
+
+// License is the signed content of a license file.
+type License struct {
+	Key       string `json:"key"`
+	ProductID string `json:"product_id"`
+	// Device the file was issued for, hashed as the server stores it (older
+	// files hold it as sent); empty means any device
+	HWID string `json:"hwid,omitempty"`
+	// When the license itself ends; nil for lifetime licenses
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	IssuedAt  time.Time  `json:"issued_at"`
+	// End of the offline window; check online before this
+	ValidUntil time.Time `json:"valid_until"`
+}
+
+var (
+	ErrMalformed     = errors.New("licensefile: malformed license file")
+	ErrBadSignature  = errors.New("licensefile: invalid signature")
+	ErrWrongProduct  = errors.New("licensefile: license is for another product")
+	ErrWrongDevice   = errors.New("licensefile: license is for another device")
+	ErrExpired       = errors.New("licensefile: license expired")
+	ErrStale         = errors.New("licensefile: offline validity ended, check online")
+	ErrNotYetIssued  = errors.New("licensefile: issued in the future, check the system clock")
+	errKeyNotEd25519 = errors.New("licensefile: not an Ed25519 key")
+)
+
+// Sign encodes l and signs it with key.
+func Sign(key ed25519.PrivateKey, l *License) (string, error) {
+	payload, err := json.Marshal(l)
+	if err != nil {
+		return "", err
+	}
+	sig := ed25519.Sign(key, payload)
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
+
+// Verify checks the signature on a license file and returns its content.
+// It doesn't look at the content; call Check for that.
+func Verify(key ed25519.PublicKey, file []byte) (*License, error) {
+	payloadPart, sigPart, ok := strings.Cut(string(bytes.TrimSpace(file)), ".")
+	if !ok {
+		return nil, ErrMalformed
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(payloadPart)
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(sigPart)
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	if len(key) != ed25519.PublicKeySize || !ed25519.Verify(key, payload, sig) {
+		return nil, ErrBadSignature
+	}
+
+	var l License
+	if err := json.Unmarshal(payload, &l); err != nil {
+		return nil, ErrMalformed
+	}
+	return &l, nil
+}
+
+// Check reports whether the license may be used for productID on hwid at now.
+// hwid is what the client sends the server, not its hash. Pass an empty hwid
+// to skip the device check.
+func (l *License) Check(productID, hwid string, now time.Time) error {
+	switch {
+	case l.ProductID != productID:
+		return ErrWrongProduct
+	case l.HWID != "" && hwid != "" && l.HWID != hwid && l.HWID != hwidpkg.Hash(hwid):
+		return ErrWrongDevice
+	case l.ExpiresAt != nil && !now.Before(*l.ExpiresAt):
+		return ErrExpired
+	// A clock set back to before issue would otherwise stretch the window
+	case now.Before(l.IssuedAt.Add(-5 * time.Minute)):
+		return ErrNotYetIssued
+	case !now.Before(l.ValidUntil):
+		return ErrStale
+	}
+	return nil
+}
+
+// ParsePublicKey reads an Ed25519 public key, either PEM ("PUBLIC KEY") or
+// the raw 32 bytes in standard base64.
+func ParsePublicKey(s string) (ed25519.PublicKey, error) {
+	if block, _ := pem.Decode([]byte(s)); block != nil {
+		k, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("licensefile: %w", err)
+		}
+		pub, ok := k.(ed25519.PublicKey)
+		if !ok {
+			return nil, errKeyNotEd25519
+		}
+		return pub, nil
+	}
+	b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(s))
+	if err != nil || len(b) != ed25519.PublicKeySize {
+		return nil, errKeyNotEd25519
+	}
+	return ed25519.PublicKey(b), nil
+}
+
+// ParsePrivateKey reads an Ed25519 private key, either PEM ("PRIVATE KEY",
+// as written by `openssl genpkey -algorithm ed25519`) or the 32-byte seed in
+// standard base64.
+func ParsePrivateKey(s string) (ed25519.PrivateKey, error) {
+	if block, _ := pem.Decode([]byte(s)); block != nil {
+		k, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("licensefile: %w", err)
+		}
+		priv, ok := k.(ed25519.PrivateKey)
+		if !ok {
+			return nil, errKeyNotEd25519
+		}
+		return priv, nil
+	}
+	b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(s))
+	if err != nil || len(b) != ed25519.SeedSize {
+		return nil, errKeyNotEd25519
+	}
+	return ed25519.NewKeyFromSeed(b), nil
+}
+
+// EncodePublicKey formats key the way ParsePublicKey's base64 form expects.
+func EncodePublicKey(key ed25519.PublicKey) string {
+	return base64.StdEncoding.EncodeToString(key)
+}
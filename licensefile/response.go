@@ -0,0 +1,52 @@
+package licensefile
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"strconv"
+	"time"
+)
+
+// Response is what the server vouches for when it signs a ValidateLicense
+// answer with the license file key, so a proxy between client and server
+// can't pass off an answer of its own.
+//
+// The signature is Ed25519 over
+//
+//	key + "\n" + product_id + "\n" + hwid + "\n" + unix(server_time) + "\n" + ("valid" or "invalid")
+//
+// in standard base64, where hwid is as the client sent it and server_time is
+// the answer's, in whole seconds.
+type Response struct {
+	Key        string
+	ProductID  string
+	HWID       string
+	ServerTime time.Time
+	Valid      bool
+}
+
+func (r Response) message() []byte {
+	result := "invalid"
+	if r.Valid {
+		result = "valid"
+	}
+	return []byte(r.Key + "\n" + r.ProductID + "\n" + r.HWID + "\n" + strconv.FormatInt(r.ServerTime.Unix(), 10) + "\n" + result)
+}
+
+// SignResponse returns the signature of r.
+func SignResponse(key ed25519.PrivateKey, r Response) string {
+	return base64.StdEncoding.EncodeToString(ed25519.Sign(key, r.message()))
+}
+
+// VerifyResponse checks an answer's signature against what the client asked
+// and the answer said. It returns ErrBadSignature if the signature doesn't
+// match. A valid signature only proves the server said so at ServerTime;
+// compare that to the client's clock, or send a challenge, to turn away
+// answers recorded earlier.
+func VerifyResponse(key ed25519.PublicKey, r Response, signature string) error {
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil || len(key) != ed25519.PublicKeySize || !ed25519.Verify(key, r.message(), sig) {
+		return ErrBadSignature
+	}
+	return nil
+}
@@ -0,0 +1,9 @@
+package proto
+
+import _ "embed"
+
+// OpenAPI is the OpenAPI v2 description of the HTTP gateway, generated from
+// whitelist.proto with the options in whitelist.openapi.yaml.
+//
+//go:embed whitelist.swagger.json
+var OpenAPI []byte
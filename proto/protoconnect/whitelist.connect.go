@@ -0,0 +1,3293 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/whitelist.proto
+
+package protoconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	proto "github.com/mkseven15/whitelist-server/proto"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// WhitelistServiceName is the fully-qualified name of the WhitelistService service.
+	WhitelistServiceName = "whitelist.WhitelistService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// WhitelistServiceGetAuthTokenProcedure is the fully-qualified name of the WhitelistService's
+	// GetAuthToken RPC.
+	WhitelistServiceGetAuthTokenProcedure = "/whitelist.WhitelistService/GetAuthToken"
+	// WhitelistServiceValidateLicenseProcedure is the fully-qualified name of the WhitelistService's
+	// ValidateLicense RPC.
+	WhitelistServiceValidateLicenseProcedure = "/whitelist.WhitelistService/ValidateLicense"
+	// WhitelistServiceUpdateLicenseProcedure is the fully-qualified name of the WhitelistService's
+	// UpdateLicense RPC.
+	WhitelistServiceUpdateLicenseProcedure = "/whitelist.WhitelistService/UpdateLicense"
+	// WhitelistServiceDeleteLicenseProcedure is the fully-qualified name of the WhitelistService's
+	// DeleteLicense RPC.
+	WhitelistServiceDeleteLicenseProcedure = "/whitelist.WhitelistService/DeleteLicense"
+	// WhitelistServiceGetLicenseProcedure is the fully-qualified name of the WhitelistService's
+	// GetLicense RPC.
+	WhitelistServiceGetLicenseProcedure = "/whitelist.WhitelistService/GetLicense"
+	// WhitelistServiceListLicensesProcedure is the fully-qualified name of the WhitelistService's
+	// ListLicenses RPC.
+	WhitelistServiceListLicensesProcedure = "/whitelist.WhitelistService/ListLicenses"
+	// WhitelistServiceResetHwidProcedure is the fully-qualified name of the WhitelistService's
+	// ResetHwid RPC.
+	WhitelistServiceResetHwidProcedure = "/whitelist.WhitelistService/ResetHwid"
+	// WhitelistServiceGenerateLicensesProcedure is the fully-qualified name of the WhitelistService's
+	// GenerateLicenses RPC.
+	WhitelistServiceGenerateLicensesProcedure = "/whitelist.WhitelistService/GenerateLicenses"
+	// WhitelistServiceBatchUpsertLicensesProcedure is the fully-qualified name of the
+	// WhitelistService's BatchUpsertLicenses RPC.
+	WhitelistServiceBatchUpsertLicensesProcedure = "/whitelist.WhitelistService/BatchUpsertLicenses"
+	// WhitelistServiceExportLicensesProcedure is the fully-qualified name of the WhitelistService's
+	// ExportLicenses RPC.
+	WhitelistServiceExportLicensesProcedure = "/whitelist.WhitelistService/ExportLicenses"
+	// WhitelistServiceImportLicensesProcedure is the fully-qualified name of the WhitelistService's
+	// ImportLicenses RPC.
+	WhitelistServiceImportLicensesProcedure = "/whitelist.WhitelistService/ImportLicenses"
+	// WhitelistServiceListAuditEventsProcedure is the fully-qualified name of the WhitelistService's
+	// ListAuditEvents RPC.
+	WhitelistServiceListAuditEventsProcedure = "/whitelist.WhitelistService/ListAuditEvents"
+	// WhitelistServiceResellerGenerateLicenseProcedure is the fully-qualified name of the
+	// WhitelistService's ResellerGenerateLicense RPC.
+	WhitelistServiceResellerGenerateLicenseProcedure = "/whitelist.WhitelistService/ResellerGenerateLicense"
+	// WhitelistServiceCreateResellerProcedure is the fully-qualified name of the WhitelistService's
+	// CreateReseller RPC.
+	WhitelistServiceCreateResellerProcedure = "/whitelist.WhitelistService/CreateReseller"
+	// WhitelistServiceTopUpResellerCreditsProcedure is the fully-qualified name of the
+	// WhitelistService's TopUpResellerCredits RPC.
+	WhitelistServiceTopUpResellerCreditsProcedure = "/whitelist.WhitelistService/TopUpResellerCredits"
+	// WhitelistServiceListResellerActivityProcedure is the fully-qualified name of the
+	// WhitelistService's ListResellerActivity RPC.
+	WhitelistServiceListResellerActivityProcedure = "/whitelist.WhitelistService/ListResellerActivity"
+	// WhitelistServiceAdminLoginProcedure is the fully-qualified name of the WhitelistService's
+	// AdminLogin RPC.
+	WhitelistServiceAdminLoginProcedure = "/whitelist.WhitelistService/AdminLogin"
+	// WhitelistServiceCreateAdminProcedure is the fully-qualified name of the WhitelistService's
+	// CreateAdmin RPC.
+	WhitelistServiceCreateAdminProcedure = "/whitelist.WhitelistService/CreateAdmin"
+	// WhitelistServiceUpdateAdminProcedure is the fully-qualified name of the WhitelistService's
+	// UpdateAdmin RPC.
+	WhitelistServiceUpdateAdminProcedure = "/whitelist.WhitelistService/UpdateAdmin"
+	// WhitelistServiceListAdminsProcedure is the fully-qualified name of the WhitelistService's
+	// ListAdmins RPC.
+	WhitelistServiceListAdminsProcedure = "/whitelist.WhitelistService/ListAdmins"
+	// WhitelistServiceAdminLogoutProcedure is the fully-qualified name of the WhitelistService's
+	// AdminLogout RPC.
+	WhitelistServiceAdminLogoutProcedure = "/whitelist.WhitelistService/AdminLogout"
+	// WhitelistServiceListAdminSessionsProcedure is the fully-qualified name of the WhitelistService's
+	// ListAdminSessions RPC.
+	WhitelistServiceListAdminSessionsProcedure = "/whitelist.WhitelistService/ListAdminSessions"
+	// WhitelistServiceRevokeAdminSessionProcedure is the fully-qualified name of the WhitelistService's
+	// RevokeAdminSession RPC.
+	WhitelistServiceRevokeAdminSessionProcedure = "/whitelist.WhitelistService/RevokeAdminSession"
+	// WhitelistServiceExportLicenseFileProcedure is the fully-qualified name of the WhitelistService's
+	// ExportLicenseFile RPC.
+	WhitelistServiceExportLicenseFileProcedure = "/whitelist.WhitelistService/ExportLicenseFile"
+	// WhitelistServiceStartSessionProcedure is the fully-qualified name of the WhitelistService's
+	// StartSession RPC.
+	WhitelistServiceStartSessionProcedure = "/whitelist.WhitelistService/StartSession"
+	// WhitelistServiceHeartbeatProcedure is the fully-qualified name of the WhitelistService's
+	// Heartbeat RPC.
+	WhitelistServiceHeartbeatProcedure = "/whitelist.WhitelistService/Heartbeat"
+	// WhitelistServiceEndSessionProcedure is the fully-qualified name of the WhitelistService's
+	// EndSession RPC.
+	WhitelistServiceEndSessionProcedure = "/whitelist.WhitelistService/EndSession"
+	// WhitelistServiceWatchLicenseProcedure is the fully-qualified name of the WhitelistService's
+	// WatchLicense RPC.
+	WhitelistServiceWatchLicenseProcedure = "/whitelist.WhitelistService/WatchLicense"
+	// WhitelistServiceValidateLicensesProcedure is the fully-qualified name of the WhitelistService's
+	// ValidateLicenses RPC.
+	WhitelistServiceValidateLicensesProcedure = "/whitelist.WhitelistService/ValidateLicenses"
+	// WhitelistServiceCreateProductProcedure is the fully-qualified name of the WhitelistService's
+	// CreateProduct RPC.
+	WhitelistServiceCreateProductProcedure = "/whitelist.WhitelistService/CreateProduct"
+	// WhitelistServiceUpdateProductProcedure is the fully-qualified name of the WhitelistService's
+	// UpdateProduct RPC.
+	WhitelistServiceUpdateProductProcedure = "/whitelist.WhitelistService/UpdateProduct"
+	// WhitelistServiceListProductsProcedure is the fully-qualified name of the WhitelistService's
+	// ListProducts RPC.
+	WhitelistServiceListProductsProcedure = "/whitelist.WhitelistService/ListProducts"
+	// WhitelistServiceDeleteProductProcedure is the fully-qualified name of the WhitelistService's
+	// DeleteProduct RPC.
+	WhitelistServiceDeleteProductProcedure = "/whitelist.WhitelistService/DeleteProduct"
+	// WhitelistServiceGetLatestVersionProcedure is the fully-qualified name of the WhitelistService's
+	// GetLatestVersion RPC.
+	WhitelistServiceGetLatestVersionProcedure = "/whitelist.WhitelistService/GetLatestVersion"
+	// WhitelistServicePublishReleaseProcedure is the fully-qualified name of the WhitelistService's
+	// PublishRelease RPC.
+	WhitelistServicePublishReleaseProcedure = "/whitelist.WhitelistService/PublishRelease"
+	// WhitelistServiceSetLicenseChannelProcedure is the fully-qualified name of the WhitelistService's
+	// SetLicenseChannel RPC.
+	WhitelistServiceSetLicenseChannelProcedure = "/whitelist.WhitelistService/SetLicenseChannel"
+	// WhitelistServiceCreateCustomerProcedure is the fully-qualified name of the WhitelistService's
+	// CreateCustomer RPC.
+	WhitelistServiceCreateCustomerProcedure = "/whitelist.WhitelistService/CreateCustomer"
+	// WhitelistServiceListCustomersProcedure is the fully-qualified name of the WhitelistService's
+	// ListCustomers RPC.
+	WhitelistServiceListCustomersProcedure = "/whitelist.WhitelistService/ListCustomers"
+	// WhitelistServiceAttachLicenseProcedure is the fully-qualified name of the WhitelistService's
+	// AttachLicense RPC.
+	WhitelistServiceAttachLicenseProcedure = "/whitelist.WhitelistService/AttachLicense"
+	// WhitelistServiceDetachLicenseProcedure is the fully-qualified name of the WhitelistService's
+	// DetachLicense RPC.
+	WhitelistServiceDetachLicenseProcedure = "/whitelist.WhitelistService/DetachLicense"
+	// WhitelistServiceIssueLicenseToEmailProcedure is the fully-qualified name of the
+	// WhitelistService's IssueLicenseToEmail RPC.
+	WhitelistServiceIssueLicenseToEmailProcedure = "/whitelist.WhitelistService/IssueLicenseToEmail"
+	// WhitelistServiceListLicenseDeliveriesProcedure is the fully-qualified name of the
+	// WhitelistService's ListLicenseDeliveries RPC.
+	WhitelistServiceListLicenseDeliveriesProcedure = "/whitelist.WhitelistService/ListLicenseDeliveries"
+	// WhitelistServiceCreateTrialLicenseProcedure is the fully-qualified name of the WhitelistService's
+	// CreateTrialLicense RPC.
+	WhitelistServiceCreateTrialLicenseProcedure = "/whitelist.WhitelistService/CreateTrialLicense"
+	// WhitelistServiceExtendLicenseProcedure is the fully-qualified name of the WhitelistService's
+	// ExtendLicense RPC.
+	WhitelistServiceExtendLicenseProcedure = "/whitelist.WhitelistService/ExtendLicense"
+	// WhitelistServiceResellerExtendLicenseProcedure is the fully-qualified name of the
+	// WhitelistService's ResellerExtendLicense RPC.
+	WhitelistServiceResellerExtendLicenseProcedure = "/whitelist.WhitelistService/ResellerExtendLicense"
+	// WhitelistServiceSuspendLicenseProcedure is the fully-qualified name of the WhitelistService's
+	// SuspendLicense RPC.
+	WhitelistServiceSuspendLicenseProcedure = "/whitelist.WhitelistService/SuspendLicense"
+	// WhitelistServiceUnsuspendLicenseProcedure is the fully-qualified name of the WhitelistService's
+	// UnsuspendLicense RPC.
+	WhitelistServiceUnsuspendLicenseProcedure = "/whitelist.WhitelistService/UnsuspendLicense"
+	// WhitelistServiceBanHwidProcedure is the fully-qualified name of the WhitelistService's BanHwid
+	// RPC.
+	WhitelistServiceBanHwidProcedure = "/whitelist.WhitelistService/BanHwid"
+	// WhitelistServiceUnbanHwidProcedure is the fully-qualified name of the WhitelistService's
+	// UnbanHwid RPC.
+	WhitelistServiceUnbanHwidProcedure = "/whitelist.WhitelistService/UnbanHwid"
+	// WhitelistServiceListHwidBansProcedure is the fully-qualified name of the WhitelistService's
+	// ListHwidBans RPC.
+	WhitelistServiceListHwidBansProcedure = "/whitelist.WhitelistService/ListHwidBans"
+	// WhitelistServiceBanIpProcedure is the fully-qualified name of the WhitelistService's BanIp RPC.
+	WhitelistServiceBanIpProcedure = "/whitelist.WhitelistService/BanIp"
+	// WhitelistServiceUnbanIpProcedure is the fully-qualified name of the WhitelistService's UnbanIp
+	// RPC.
+	WhitelistServiceUnbanIpProcedure = "/whitelist.WhitelistService/UnbanIp"
+	// WhitelistServiceListIpBansProcedure is the fully-qualified name of the WhitelistService's
+	// ListIpBans RPC.
+	WhitelistServiceListIpBansProcedure = "/whitelist.WhitelistService/ListIpBans"
+	// WhitelistServiceSetLicenseCountriesProcedure is the fully-qualified name of the
+	// WhitelistService's SetLicenseCountries RPC.
+	WhitelistServiceSetLicenseCountriesProcedure = "/whitelist.WhitelistService/SetLicenseCountries"
+	// WhitelistServiceClearLockoutsProcedure is the fully-qualified name of the WhitelistService's
+	// ClearLockouts RPC.
+	WhitelistServiceClearLockoutsProcedure = "/whitelist.WhitelistService/ClearLockouts"
+	// WhitelistServiceRotateProductSigningSecretProcedure is the fully-qualified name of the
+	// WhitelistService's RotateProductSigningSecret RPC.
+	WhitelistServiceRotateProductSigningSecretProcedure = "/whitelist.WhitelistService/RotateProductSigningSecret"
+	// WhitelistServiceRemoveProductSigningSecretProcedure is the fully-qualified name of the
+	// WhitelistService's RemoveProductSigningSecret RPC.
+	WhitelistServiceRemoveProductSigningSecretProcedure = "/whitelist.WhitelistService/RemoveProductSigningSecret"
+	// WhitelistServiceGetChallengeProcedure is the fully-qualified name of the WhitelistService's
+	// GetChallenge RPC.
+	WhitelistServiceGetChallengeProcedure = "/whitelist.WhitelistService/GetChallenge"
+	// WhitelistServiceRevokeRefreshTokensProcedure is the fully-qualified name of the
+	// WhitelistService's RevokeRefreshTokens RPC.
+	WhitelistServiceRevokeRefreshTokensProcedure = "/whitelist.WhitelistService/RevokeRefreshTokens"
+	// WhitelistServiceRotateAdminSecretProcedure is the fully-qualified name of the WhitelistService's
+	// RotateAdminSecret RPC.
+	WhitelistServiceRotateAdminSecretProcedure = "/whitelist.WhitelistService/RotateAdminSecret"
+	// WhitelistServiceEnrollAdminTotpProcedure is the fully-qualified name of the WhitelistService's
+	// EnrollAdminTotp RPC.
+	WhitelistServiceEnrollAdminTotpProcedure = "/whitelist.WhitelistService/EnrollAdminTotp"
+	// WhitelistServiceExportAuditLogProcedure is the fully-qualified name of the WhitelistService's
+	// ExportAuditLog RPC.
+	WhitelistServiceExportAuditLogProcedure = "/whitelist.WhitelistService/ExportAuditLog"
+	// WhitelistServiceGetStatsProcedure is the fully-qualified name of the WhitelistService's GetStats
+	// RPC.
+	WhitelistServiceGetStatsProcedure = "/whitelist.WhitelistService/GetStats"
+	// WhitelistServiceListValidationEventsProcedure is the fully-qualified name of the
+	// WhitelistService's ListValidationEvents RPC.
+	WhitelistServiceListValidationEventsProcedure = "/whitelist.WhitelistService/ListValidationEvents"
+	// WhitelistServiceSearchLicensesProcedure is the fully-qualified name of the WhitelistService's
+	// SearchLicenses RPC.
+	WhitelistServiceSearchLicensesProcedure = "/whitelist.WhitelistService/SearchLicenses"
+	// WhitelistServiceRestoreLicenseProcedure is the fully-qualified name of the WhitelistService's
+	// RestoreLicense RPC.
+	WhitelistServiceRestoreLicenseProcedure = "/whitelist.WhitelistService/RestoreLicense"
+	// WhitelistServicePurgeLicenseProcedure is the fully-qualified name of the WhitelistService's
+	// PurgeLicense RPC.
+	WhitelistServicePurgeLicenseProcedure = "/whitelist.WhitelistService/PurgeLicense"
+	// WhitelistServiceGetLicenseHistoryProcedure is the fully-qualified name of the WhitelistService's
+	// GetLicenseHistory RPC.
+	WhitelistServiceGetLicenseHistoryProcedure = "/whitelist.WhitelistService/GetLicenseHistory"
+	// WhitelistServiceImportExternalLicensesProcedure is the fully-qualified name of the
+	// WhitelistService's ImportExternalLicenses RPC.
+	WhitelistServiceImportExternalLicensesProcedure = "/whitelist.WhitelistService/ImportExternalLicenses"
+	// WhitelistServiceBulkSuspendByProductProcedure is the fully-qualified name of the
+	// WhitelistService's BulkSuspendByProduct RPC.
+	WhitelistServiceBulkSuspendByProductProcedure = "/whitelist.WhitelistService/BulkSuspendByProduct"
+	// WhitelistServiceBulkDeleteByProductProcedure is the fully-qualified name of the
+	// WhitelistService's BulkDeleteByProduct RPC.
+	WhitelistServiceBulkDeleteByProductProcedure = "/whitelist.WhitelistService/BulkDeleteByProduct"
+	// WhitelistServiceBulkExtendByProductProcedure is the fully-qualified name of the
+	// WhitelistService's BulkExtendByProduct RPC.
+	WhitelistServiceBulkExtendByProductProcedure = "/whitelist.WhitelistService/BulkExtendByProduct"
+	// WhitelistServiceSetMaintenanceModeProcedure is the fully-qualified name of the WhitelistService's
+	// SetMaintenanceMode RPC.
+	WhitelistServiceSetMaintenanceModeProcedure = "/whitelist.WhitelistService/SetMaintenanceMode"
+	// WhitelistServiceGetMaintenanceModeProcedure is the fully-qualified name of the WhitelistService's
+	// GetMaintenanceMode RPC.
+	WhitelistServiceGetMaintenanceModeProcedure = "/whitelist.WhitelistService/GetMaintenanceMode"
+	// WhitelistServiceSetProductMessagesProcedure is the fully-qualified name of the WhitelistService's
+	// SetProductMessages RPC.
+	WhitelistServiceSetProductMessagesProcedure = "/whitelist.WhitelistService/SetProductMessages"
+	// WhitelistServiceGetProductMessagesProcedure is the fully-qualified name of the WhitelistService's
+	// GetProductMessages RPC.
+	WhitelistServiceGetProductMessagesProcedure = "/whitelist.WhitelistService/GetProductMessages"
+	// WhitelistServiceListMyDevicesProcedure is the fully-qualified name of the WhitelistService's
+	// ListMyDevices RPC.
+	WhitelistServiceListMyDevicesProcedure = "/whitelist.WhitelistService/ListMyDevices"
+	// WhitelistServiceDeactivateDeviceProcedure is the fully-qualified name of the WhitelistService's
+	// DeactivateDevice RPC.
+	WhitelistServiceDeactivateDeviceProcedure = "/whitelist.WhitelistService/DeactivateDevice"
+	// WhitelistServiceSetLicenseFloatingSeatsProcedure is the fully-qualified name of the
+	// WhitelistService's SetLicenseFloatingSeats RPC.
+	WhitelistServiceSetLicenseFloatingSeatsProcedure = "/whitelist.WhitelistService/SetLicenseFloatingSeats"
+	// WhitelistServiceCheckoutLicenseProcedure is the fully-qualified name of the WhitelistService's
+	// CheckoutLicense RPC.
+	WhitelistServiceCheckoutLicenseProcedure = "/whitelist.WhitelistService/CheckoutLicense"
+	// WhitelistServiceCheckinLicenseProcedure is the fully-qualified name of the WhitelistService's
+	// CheckinLicense RPC.
+	WhitelistServiceCheckinLicenseProcedure = "/whitelist.WhitelistService/CheckinLicense"
+	// WhitelistServiceConsumeCreditsProcedure is the fully-qualified name of the WhitelistService's
+	// ConsumeCredits RPC.
+	WhitelistServiceConsumeCreditsProcedure = "/whitelist.WhitelistService/ConsumeCredits"
+	// WhitelistServiceTopUpLicenseCreditsProcedure is the fully-qualified name of the
+	// WhitelistService's TopUpLicenseCredits RPC.
+	WhitelistServiceTopUpLicenseCreditsProcedure = "/whitelist.WhitelistService/TopUpLicenseCredits"
+	// WhitelistServiceListLicenseCreditActivityProcedure is the fully-qualified name of the
+	// WhitelistService's ListLicenseCreditActivity RPC.
+	WhitelistServiceListLicenseCreditActivityProcedure = "/whitelist.WhitelistService/ListLicenseCreditActivity"
+	// WhitelistServiceSetLicenseFeaturesProcedure is the fully-qualified name of the WhitelistService's
+	// SetLicenseFeatures RPC.
+	WhitelistServiceSetLicenseFeaturesProcedure = "/whitelist.WhitelistService/SetLicenseFeatures"
+	// WhitelistServiceCreatePlanProcedure is the fully-qualified name of the WhitelistService's
+	// CreatePlan RPC.
+	WhitelistServiceCreatePlanProcedure = "/whitelist.WhitelistService/CreatePlan"
+	// WhitelistServiceUpdatePlanProcedure is the fully-qualified name of the WhitelistService's
+	// UpdatePlan RPC.
+	WhitelistServiceUpdatePlanProcedure = "/whitelist.WhitelistService/UpdatePlan"
+	// WhitelistServiceListPlansProcedure is the fully-qualified name of the WhitelistService's
+	// ListPlans RPC.
+	WhitelistServiceListPlansProcedure = "/whitelist.WhitelistService/ListPlans"
+	// WhitelistServiceDeletePlanProcedure is the fully-qualified name of the WhitelistService's
+	// DeletePlan RPC.
+	WhitelistServiceDeletePlanProcedure = "/whitelist.WhitelistService/DeletePlan"
+	// WhitelistServiceGetServerTimeProcedure is the fully-qualified name of the WhitelistService's
+	// GetServerTime RPC.
+	WhitelistServiceGetServerTimeProcedure = "/whitelist.WhitelistService/GetServerTime"
+	// WhitelistServiceGetPublicKeyProcedure is the fully-qualified name of the WhitelistService's
+	// GetPublicKey RPC.
+	WhitelistServiceGetPublicKeyProcedure = "/whitelist.WhitelistService/GetPublicKey"
+	// WhitelistServiceGetSigningKeysProcedure is the fully-qualified name of the WhitelistService's
+	// GetSigningKeys RPC.
+	WhitelistServiceGetSigningKeysProcedure = "/whitelist.WhitelistService/GetSigningKeys"
+)
+
+// WhitelistServiceClient is a client for the whitelist.WhitelistService service.
+type WhitelistServiceClient interface {
+	// 1. Get Token (Now requires API Key)
+	GetAuthToken(context.Context, *proto.GetTokenRequest) (*proto.AuthTokenResponse, error)
+	// 2. Validate License
+	ValidateLicense(context.Context, *proto.ValidateRequest) (*proto.ValidateResponse, error)
+	// 3. Create/Update License (Admin)
+	UpdateLicense(context.Context, *proto.UpdateLicenseRequest) (*emptypb.Empty, error)
+	// 4. Delete License (Admin). Restorable until purged.
+	DeleteLicense(context.Context, *proto.DeleteLicenseRequest) (*emptypb.Empty, error)
+	// 5. Get License (Admin)
+	GetLicense(context.Context, *proto.GetLicenseRequest) (*proto.License, error)
+	// 6. List Licenses (Admin)
+	ListLicenses(context.Context, *proto.ListLicensesRequest) (*proto.ListLicensesResponse, error)
+	// 7. Reset HWID bindings (Admin)
+	ResetHwid(context.Context, *proto.ResetHwidRequest) (*emptypb.Empty, error)
+	// 8. Generate License Keys (Admin)
+	GenerateLicenses(context.Context, *proto.GenerateLicensesRequest) (*proto.GenerateLicensesResponse, error)
+	// 9. Create/Update many licenses in one transaction (Admin)
+	BatchUpsertLicenses(context.Context, *proto.BatchUpsertLicensesRequest) (*proto.BatchUpsertLicensesResponse, error)
+	// 10. Export licenses as CSV (Admin)
+	ExportLicenses(context.Context, *proto.ExportLicensesRequest) (*connect.ServerStreamForClient[httpbody.HttpBody], error)
+	// 11. Import licenses from CSV (Admin)
+	ImportLicenses(context.Context, *proto.ImportLicensesRequest) (*proto.ImportLicensesResponse, error)
+	// 12. List Audit Log (Admin)
+	ListAuditEvents(context.Context, *proto.ListAuditEventsRequest) (*proto.ListAuditEventsResponse, error)
+	// 13. Generate License Keys against a credit balance (Reseller, x-reseller-key header)
+	ResellerGenerateLicense(context.Context, *proto.ResellerGenerateLicenseRequest) (*proto.ResellerGenerateLicenseResponse, error)
+	// 14. Create Reseller (Admin)
+	CreateReseller(context.Context, *proto.CreateResellerRequest) (*proto.CreateResellerResponse, error)
+	// 15. Add (or remove) Reseller Credits (Admin)
+	TopUpResellerCredits(context.Context, *proto.TopUpResellerCreditsRequest) (*proto.Reseller, error)
+	// 16. List Reseller Credit Activity (Admin)
+	ListResellerActivity(context.Context, *proto.ListResellerActivityRequest) (*proto.ListResellerActivityResponse, error)
+	// 17. Admin Login, returns a bearer token for the Authorization header
+	AdminLogin(context.Context, *proto.AdminLoginRequest) (*proto.AdminLoginResponse, error)
+	// 18. Create Admin Account (Owner)
+	CreateAdmin(context.Context, *proto.CreateAdminRequest) (*proto.Admin, error)
+	// 19. Change role, password or disabled flag of an Admin Account (Owner)
+	UpdateAdmin(context.Context, *proto.UpdateAdminRequest) (*proto.Admin, error)
+	// 20. List Admin Accounts (Owner)
+	ListAdmins(context.Context, *proto.ListAdminsRequest) (*proto.ListAdminsResponse, error)
+	// 21. Admin Logout, ends the session of the calling token
+	AdminLogout(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// 22. List Admin Sessions (own sessions, or anyone's for owners)
+	ListAdminSessions(context.Context, *proto.ListAdminSessionsRequest) (*proto.ListAdminSessionsResponse, error)
+	// 23. Revoke an Admin Session (own sessions, or anyone's for owners)
+	RevokeAdminSession(context.Context, *proto.RevokeAdminSessionRequest) (*emptypb.Empty, error)
+	// 24. Export a signed license file for offline validation (Support)
+	ExportLicenseFile(context.Context, *proto.ExportLicenseFileRequest) (*proto.ExportLicenseFileResponse, error)
+	// 25. Start a Session, validating the license and taking a concurrent-use slot
+	StartSession(context.Context, *proto.StartSessionRequest) (*proto.StartSessionResponse, error)
+	// 26. Heartbeat keeps a Session alive
+	Heartbeat(context.Context, *proto.HeartbeatRequest) (*proto.HeartbeatResponse, error)
+	// 27. End a Session, freeing its slot
+	EndSession(context.Context, *proto.EndSessionRequest) (*emptypb.Empty, error)
+	// 28. Watch a License, streaming its status whenever it changes
+	WatchLicense(context.Context, *proto.WatchLicenseRequest) (*connect.ServerStreamForClient[proto.LicenseStatusEvent], error)
+	// 29. Validate several Licenses with one access token
+	ValidateLicenses(context.Context, *proto.ValidateLicensesRequest) (*proto.ValidateLicensesResponse, error)
+	// 30. Create a Product (Owner)
+	CreateProduct(context.Context, *proto.CreateProductRequest) (*proto.Product, error)
+	// 31. Update a Product's name, description or disabled flag (Owner)
+	UpdateProduct(context.Context, *proto.UpdateProductRequest) (*proto.Product, error)
+	// 32. List Products (Admin)
+	ListProducts(context.Context, *proto.ListProductsRequest) (*proto.ListProductsResponse, error)
+	// 33. Delete a Product without licenses (Owner)
+	DeleteProduct(context.Context, *proto.DeleteProductRequest) (*emptypb.Empty, error)
+	// 34. Get the latest Release of a Product for self-updating clients
+	GetLatestVersion(context.Context, *proto.GetLatestVersionRequest) (*proto.Release, error)
+	// 35. Publish a Release on one of a Product's channels (Owner)
+	PublishRelease(context.Context, *proto.PublishReleaseRequest) (*proto.Release, error)
+	// 36. Pin a License to an update channel (Admin)
+	SetLicenseChannel(context.Context, *proto.SetLicenseChannelRequest) (*proto.License, error)
+	// 37. Create a Customer (Admin)
+	CreateCustomer(context.Context, *proto.CreateCustomerRequest) (*proto.Customer, error)
+	// 38. List or look up Customers (Admin)
+	ListCustomers(context.Context, *proto.ListCustomersRequest) (*proto.ListCustomersResponse, error)
+	// 39. Attach a License to a Customer (Admin)
+	AttachLicense(context.Context, *proto.AttachLicenseRequest) (*proto.License, error)
+	// 40. Detach a License from its Customer (Admin)
+	DetachLicense(context.Context, *proto.DetachLicenseRequest) (*proto.License, error)
+	// 41. Email a new or existing License key to a customer (Admin)
+	IssueLicenseToEmail(context.Context, *proto.IssueLicenseToEmailRequest) (*proto.IssueLicenseToEmailResponse, error)
+	// 42. List the emails sent for a License (Admin)
+	ListLicenseDeliveries(context.Context, *proto.ListLicenseDeliveriesRequest) (*proto.ListLicenseDeliveriesResponse, error)
+	// 43. Start a self-service trial of a Product on this device
+	CreateTrialLicense(context.Context, *proto.CreateTrialLicenseRequest) (*proto.CreateTrialLicenseResponse, error)
+	// 44. Push a License's expiry forward (Admin)
+	ExtendLicense(context.Context, *proto.ExtendLicenseRequest) (*proto.License, error)
+	// 45. Extend a License the reseller generated, for one credit (Reseller, x-reseller-key header)
+	ResellerExtendLicense(context.Context, *proto.ExtendLicenseRequest) (*proto.ResellerExtendLicenseResponse, error)
+	// 46. Suspend a License, with a reason shown to its users (Admin)
+	SuspendLicense(context.Context, *proto.SuspendLicenseRequest) (*proto.License, error)
+	// 47. Reactivate a suspended License (Admin)
+	UnsuspendLicense(context.Context, *proto.UnsuspendLicenseRequest) (*proto.License, error)
+	// 48. Ban a HWID from validating any License (Admin)
+	BanHwid(context.Context, *proto.BanHwidRequest) (*proto.HwidBan, error)
+	// 49. Lift a HWID ban (Admin)
+	UnbanHwid(context.Context, *proto.UnbanHwidRequest) (*emptypb.Empty, error)
+	// 50. List banned HWIDs (Admin)
+	ListHwidBans(context.Context, *proto.ListHwidBansRequest) (*proto.ListHwidBansResponse, error)
+	// 51. Ban an IP address or CIDR range from the public endpoints (Admin)
+	BanIp(context.Context, *proto.BanIpRequest) (*proto.IpBan, error)
+	// 52. Lift an IP ban (Admin)
+	UnbanIp(context.Context, *proto.UnbanIpRequest) (*emptypb.Empty, error)
+	// 53. List IP bans in force (Admin)
+	ListIpBans(context.Context, *proto.ListIpBansRequest) (*proto.ListIpBansResponse, error)
+	// 54. Set the countries a License may be used from (Admin)
+	SetLicenseCountries(context.Context, *proto.SetLicenseCountriesRequest) (*proto.License, error)
+	// 55. Lift validation lockouts of a License key and/or a client IP (Admin)
+	ClearLockouts(context.Context, *proto.ClearLockoutsRequest) (*proto.ClearLockoutsResponse, error)
+	// 56. Turn on signed requests for a Product, or replace its secret (Admin)
+	RotateProductSigningSecret(context.Context, *proto.RotateProductSigningSecretRequest) (*proto.RotateProductSigningSecretResponse, error)
+	// 57. Turn off signed requests for a Product (Admin)
+	RemoveProductSigningSecret(context.Context, *proto.RemoveProductSigningSecretRequest) (*proto.Product, error)
+	// 58. Get a single-use challenge to send with ValidateLicense (Public)
+	GetChallenge(context.Context, *proto.GetChallengeRequest) (*proto.GetChallengeResponse, error)
+	// 59. Revoke every refresh token issued for an API key (Admin)
+	RevokeRefreshTokens(context.Context, *proto.RevokeRefreshTokensRequest) (*proto.RevokeRefreshTokensResponse, error)
+	// 60. Issue a new shared admin secret and expire the old ones (Admin)
+	RotateAdminSecret(context.Context, *proto.RotateAdminSecretRequest) (*proto.RotateAdminSecretResponse, error)
+	// 61. Set up a TOTP second factor for the caller (Read-only)
+	EnrollAdminTotp(context.Context, *proto.EnrollAdminTotpRequest) (*proto.EnrollAdminTotpResponse, error)
+	// 62. Export the audit log as NDJSON or CSV (Admin)
+	ExportAuditLog(context.Context, *proto.ExportAuditLogRequest) (*connect.ServerStreamForClient[httpbody.HttpBody], error)
+	// 63. Validation and token statistics per day (Admin)
+	GetStats(context.Context, *proto.GetStatsRequest) (*proto.GetStatsResponse, error)
+	// 64. List ValidateLicense attempts (Admin)
+	ListValidationEvents(context.Context, *proto.ListValidationEventsRequest) (*proto.ListValidationEventsResponse, error)
+	// 65. Find licenses from partial details (Admin)
+	SearchLicenses(context.Context, *proto.SearchLicensesRequest) (*proto.SearchLicensesResponse, error)
+	// 66. Undo DeleteLicense (Admin)
+	RestoreLicense(context.Context, *proto.RestoreLicenseRequest) (*proto.License, error)
+	// 67. Remove a deleted license for good (Admin)
+	PurgeLicense(context.Context, *proto.PurgeLicenseRequest) (*emptypb.Empty, error)
+	// 68. Changes to a license, newest first (Admin)
+	GetLicenseHistory(context.Context, *proto.GetLicenseHistoryRequest) (*proto.GetLicenseHistoryResponse, error)
+	// 69. Import licenses, devices and customers from another license system (Admin)
+	ImportExternalLicenses(context.Context, *proto.ImportExternalLicensesRequest) (*proto.ImportLicensesResponse, error)
+	// 70. Suspend every active license of a product (Admin)
+	BulkSuspendByProduct(context.Context, *proto.BulkSuspendByProductRequest) (*proto.BulkOperationResponse, error)
+	// 71. Delete every license of a product (Admin). Restorable until purged.
+	BulkDeleteByProduct(context.Context, *proto.BulkDeleteByProductRequest) (*proto.BulkOperationResponse, error)
+	// 72. Extend every expiring license of a product (Admin)
+	BulkExtendByProduct(context.Context, *proto.BulkExtendByProductRequest) (*proto.BulkOperationResponse, error)
+	// 73. Turn maintenance mode on or off (Admin)
+	SetMaintenanceMode(context.Context, *proto.SetMaintenanceModeRequest) (*proto.MaintenanceMode, error)
+	// 74. Show whether maintenance mode is on (Admin)
+	GetMaintenanceMode(context.Context, *emptypb.Empty) (*proto.MaintenanceMode, error)
+	// 75. Replace a Product's wording of validation messages (Owner)
+	SetProductMessages(context.Context, *proto.SetProductMessagesRequest) (*proto.ProductMessages, error)
+	// 76. Show a Product's wording of validation messages (Admin)
+	GetProductMessages(context.Context, *proto.GetProductMessagesRequest) (*proto.ProductMessages, error)
+	// 77. List the devices bound to a License, for its owner (Public)
+	ListMyDevices(context.Context, *proto.ListMyDevicesRequest) (*proto.ListMyDevicesResponse, error)
+	// 78. Unbind one of a License's devices, freeing its seat, for its owner
+	// (Public)
+	DeactivateDevice(context.Context, *proto.DeactivateDeviceRequest) (*emptypb.Empty, error)
+	// 79. Set the size of a License's floating pool (Admin)
+	SetLicenseFloatingSeats(context.Context, *proto.SetLicenseFloatingSeatsRequest) (*proto.License, error)
+	// 80. Check out a seat of a floating License for this machine, validating
+	// the license
+	CheckoutLicense(context.Context, *proto.CheckoutLicenseRequest) (*proto.CheckoutLicenseResponse, error)
+	// 81. Check a floating seat back in, freeing it for another machine
+	CheckinLicense(context.Context, *proto.CheckinLicenseRequest) (*emptypb.Empty, error)
+	// 82. Spend credits of a pay-per-use License, validating it
+	ConsumeCredits(context.Context, *proto.ConsumeCreditsRequest) (*proto.ConsumeCreditsResponse, error)
+	// 83. Add credits to a License, or take them back (Admin)
+	TopUpLicenseCredits(context.Context, *proto.TopUpLicenseCreditsRequest) (*proto.License, error)
+	// 84. List License Credit Activity (Admin)
+	ListLicenseCreditActivity(context.Context, *proto.ListLicenseCreditActivityRequest) (*proto.ListLicenseCreditActivityResponse, error)
+	// 85. Set the feature flags of a License, on top of its Product's (Admin)
+	SetLicenseFeatures(context.Context, *proto.SetLicenseFeaturesRequest) (*proto.License, error)
+	// 86. Create a Plan licenses can be put on (Owner)
+	CreatePlan(context.Context, *proto.CreatePlanRequest) (*proto.Plan, error)
+	// 87. Update a Plan (Owner)
+	UpdatePlan(context.Context, *proto.UpdatePlanRequest) (*proto.Plan, error)
+	// 88. List Plans (Admin)
+	ListPlans(context.Context, *proto.ListPlansRequest) (*proto.ListPlansResponse, error)
+	// 89. Delete a Plan without licenses (Owner)
+	DeletePlan(context.Context, *proto.DeletePlanRequest) (*emptypb.Empty, error)
+	// 90. Get the server's clock, e.g. to correct signed request timestamps (Public)
+	GetServerTime(context.Context, *proto.GetServerTimeRequest) (*proto.GetServerTimeResponse, error)
+	// 91. Get the public key that validation answers and license files are signed with (Public)
+	GetPublicKey(context.Context, *proto.GetPublicKeyRequest) (*proto.GetPublicKeyResponse, error)
+	// 92. List the public keys clients should accept, current and retired, JWKS-style (Public)
+	GetSigningKeys(context.Context, *proto.GetSigningKeysRequest) (*proto.GetSigningKeysResponse, error)
+}
+
+// NewWhitelistServiceClient constructs a client for the whitelist.WhitelistService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewWhitelistServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) WhitelistServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	whitelistServiceMethods := proto.File_proto_whitelist_proto.Services().ByName("WhitelistService").Methods()
+	return &whitelistServiceClient{
+		getAuthToken: connect.NewClient[proto.GetTokenRequest, proto.AuthTokenResponse](
+			httpClient,
+			baseURL+WhitelistServiceGetAuthTokenProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("GetAuthToken")),
+			connect.WithClientOptions(opts...),
+		),
+		validateLicense: connect.NewClient[proto.ValidateRequest, proto.ValidateResponse](
+			httpClient,
+			baseURL+WhitelistServiceValidateLicenseProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ValidateLicense")),
+			connect.WithClientOptions(opts...),
+		),
+		updateLicense: connect.NewClient[proto.UpdateLicenseRequest, emptypb.Empty](
+			httpClient,
+			baseURL+WhitelistServiceUpdateLicenseProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("UpdateLicense")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteLicense: connect.NewClient[proto.DeleteLicenseRequest, emptypb.Empty](
+			httpClient,
+			baseURL+WhitelistServiceDeleteLicenseProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("DeleteLicense")),
+			connect.WithClientOptions(opts...),
+		),
+		getLicense: connect.NewClient[proto.GetLicenseRequest, proto.License](
+			httpClient,
+			baseURL+WhitelistServiceGetLicenseProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("GetLicense")),
+			connect.WithClientOptions(opts...),
+		),
+		listLicenses: connect.NewClient[proto.ListLicensesRequest, proto.ListLicensesResponse](
+			httpClient,
+			baseURL+WhitelistServiceListLicensesProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ListLicenses")),
+			connect.WithClientOptions(opts...),
+		),
+		resetHwid: connect.NewClient[proto.ResetHwidRequest, emptypb.Empty](
+			httpClient,
+			baseURL+WhitelistServiceResetHwidProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ResetHwid")),
+			connect.WithClientOptions(opts...),
+		),
+		generateLicenses: connect.NewClient[proto.GenerateLicensesRequest, proto.GenerateLicensesResponse](
+			httpClient,
+			baseURL+WhitelistServiceGenerateLicensesProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("GenerateLicenses")),
+			connect.WithClientOptions(opts...),
+		),
+		batchUpsertLicenses: connect.NewClient[proto.BatchUpsertLicensesRequest, proto.BatchUpsertLicensesResponse](
+			httpClient,
+			baseURL+WhitelistServiceBatchUpsertLicensesProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("BatchUpsertLicenses")),
+			connect.WithClientOptions(opts...),
+		),
+		exportLicenses: connect.NewClient[proto.ExportLicensesRequest, httpbody.HttpBody](
+			httpClient,
+			baseURL+WhitelistServiceExportLicensesProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ExportLicenses")),
+			connect.WithClientOptions(opts...),
+		),
+		importLicenses: connect.NewClient[proto.ImportLicensesRequest, proto.ImportLicensesResponse](
+			httpClient,
+			baseURL+WhitelistServiceImportLicensesProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ImportLicenses")),
+			connect.WithClientOptions(opts...),
+		),
+		listAuditEvents: connect.NewClient[proto.ListAuditEventsRequest, proto.ListAuditEventsResponse](
+			httpClient,
+			baseURL+WhitelistServiceListAuditEventsProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ListAuditEvents")),
+			connect.WithClientOptions(opts...),
+		),
+		resellerGenerateLicense: connect.NewClient[proto.ResellerGenerateLicenseRequest, proto.ResellerGenerateLicenseResponse](
+			httpClient,
+			baseURL+WhitelistServiceResellerGenerateLicenseProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ResellerGenerateLicense")),
+			connect.WithClientOptions(opts...),
+		),
+		createReseller: connect.NewClient[proto.CreateResellerRequest, proto.CreateResellerResponse](
+			httpClient,
+			baseURL+WhitelistServiceCreateResellerProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("CreateReseller")),
+			connect.WithClientOptions(opts...),
+		),
+		topUpResellerCredits: connect.NewClient[proto.TopUpResellerCreditsRequest, proto.Reseller](
+			httpClient,
+			baseURL+WhitelistServiceTopUpResellerCreditsProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("TopUpResellerCredits")),
+			connect.WithClientOptions(opts...),
+		),
+		listResellerActivity: connect.NewClient[proto.ListResellerActivityRequest, proto.ListResellerActivityResponse](
+			httpClient,
+			baseURL+WhitelistServiceListResellerActivityProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ListResellerActivity")),
+			connect.WithClientOptions(opts...),
+		),
+		adminLogin: connect.NewClient[proto.AdminLoginRequest, proto.AdminLoginResponse](
+			httpClient,
+			baseURL+WhitelistServiceAdminLoginProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("AdminLogin")),
+			connect.WithClientOptions(opts...),
+		),
+		createAdmin: connect.NewClient[proto.CreateAdminRequest, proto.Admin](
+			httpClient,
+			baseURL+WhitelistServiceCreateAdminProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("CreateAdmin")),
+			connect.WithClientOptions(opts...),
+		),
+		updateAdmin: connect.NewClient[proto.UpdateAdminRequest, proto.Admin](
+			httpClient,
+			baseURL+WhitelistServiceUpdateAdminProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("UpdateAdmin")),
+			connect.WithClientOptions(opts...),
+		),
+		listAdmins: connect.NewClient[proto.ListAdminsRequest, proto.ListAdminsResponse](
+			httpClient,
+			baseURL+WhitelistServiceListAdminsProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ListAdmins")),
+			connect.WithClientOptions(opts...),
+		),
+		adminLogout: connect.NewClient[emptypb.Empty, emptypb.Empty](
+			httpClient,
+			baseURL+WhitelistServiceAdminLogoutProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("AdminLogout")),
+			connect.WithClientOptions(opts...),
+		),
+		listAdminSessions: connect.NewClient[proto.ListAdminSessionsRequest, proto.ListAdminSessionsResponse](
+			httpClient,
+			baseURL+WhitelistServiceListAdminSessionsProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ListAdminSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeAdminSession: connect.NewClient[proto.RevokeAdminSessionRequest, emptypb.Empty](
+			httpClient,
+			baseURL+WhitelistServiceRevokeAdminSessionProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("RevokeAdminSession")),
+			connect.WithClientOptions(opts...),
+		),
+		exportLicenseFile: connect.NewClient[proto.ExportLicenseFileRequest, proto.ExportLicenseFileResponse](
+			httpClient,
+			baseURL+WhitelistServiceExportLicenseFileProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ExportLicenseFile")),
+			connect.WithClientOptions(opts...),
+		),
+		startSession: connect.NewClient[proto.StartSessionRequest, proto.StartSessionResponse](
+			httpClient,
+			baseURL+WhitelistServiceStartSessionProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("StartSession")),
+			connect.WithClientOptions(opts...),
+		),
+		heartbeat: connect.NewClient[proto.HeartbeatRequest, proto.HeartbeatResponse](
+			httpClient,
+			baseURL+WhitelistServiceHeartbeatProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("Heartbeat")),
+			connect.WithClientOptions(opts...),
+		),
+		endSession: connect.NewClient[proto.EndSessionRequest, emptypb.Empty](
+			httpClient,
+			baseURL+WhitelistServiceEndSessionProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("EndSession")),
+			connect.WithClientOptions(opts...),
+		),
+		watchLicense: connect.NewClient[proto.WatchLicenseRequest, proto.LicenseStatusEvent](
+			httpClient,
+			baseURL+WhitelistServiceWatchLicenseProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("WatchLicense")),
+			connect.WithClientOptions(opts...),
+		),
+		validateLicenses: connect.NewClient[proto.ValidateLicensesRequest, proto.ValidateLicensesResponse](
+			httpClient,
+			baseURL+WhitelistServiceValidateLicensesProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ValidateLicenses")),
+			connect.WithClientOptions(opts...),
+		),
+		createProduct: connect.NewClient[proto.CreateProductRequest, proto.Product](
+			httpClient,
+			baseURL+WhitelistServiceCreateProductProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("CreateProduct")),
+			connect.WithClientOptions(opts...),
+		),
+		updateProduct: connect.NewClient[proto.UpdateProductRequest, proto.Product](
+			httpClient,
+			baseURL+WhitelistServiceUpdateProductProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("UpdateProduct")),
+			connect.WithClientOptions(opts...),
+		),
+		listProducts: connect.NewClient[proto.ListProductsRequest, proto.ListProductsResponse](
+			httpClient,
+			baseURL+WhitelistServiceListProductsProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ListProducts")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteProduct: connect.NewClient[proto.DeleteProductRequest, emptypb.Empty](
+			httpClient,
+			baseURL+WhitelistServiceDeleteProductProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("DeleteProduct")),
+			connect.WithClientOptions(opts...),
+		),
+		getLatestVersion: connect.NewClient[proto.GetLatestVersionRequest, proto.Release](
+			httpClient,
+			baseURL+WhitelistServiceGetLatestVersionProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("GetLatestVersion")),
+			connect.WithClientOptions(opts...),
+		),
+		publishRelease: connect.NewClient[proto.PublishReleaseRequest, proto.Release](
+			httpClient,
+			baseURL+WhitelistServicePublishReleaseProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("PublishRelease")),
+			connect.WithClientOptions(opts...),
+		),
+		setLicenseChannel: connect.NewClient[proto.SetLicenseChannelRequest, proto.License](
+			httpClient,
+			baseURL+WhitelistServiceSetLicenseChannelProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("SetLicenseChannel")),
+			connect.WithClientOptions(opts...),
+		),
+		createCustomer: connect.NewClient[proto.CreateCustomerRequest, proto.Customer](
+			httpClient,
+			baseURL+WhitelistServiceCreateCustomerProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("CreateCustomer")),
+			connect.WithClientOptions(opts...),
+		),
+		listCustomers: connect.NewClient[proto.ListCustomersRequest, proto.ListCustomersResponse](
+			httpClient,
+			baseURL+WhitelistServiceListCustomersProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ListCustomers")),
+			connect.WithClientOptions(opts...),
+		),
+		attachLicense: connect.NewClient[proto.AttachLicenseRequest, proto.License](
+			httpClient,
+			baseURL+WhitelistServiceAttachLicenseProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("AttachLicense")),
+			connect.WithClientOptions(opts...),
+		),
+		detachLicense: connect.NewClient[proto.DetachLicenseRequest, proto.License](
+			httpClient,
+			baseURL+WhitelistServiceDetachLicenseProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("DetachLicense")),
+			connect.WithClientOptions(opts...),
+		),
+		issueLicenseToEmail: connect.NewClient[proto.IssueLicenseToEmailRequest, proto.IssueLicenseToEmailResponse](
+			httpClient,
+			baseURL+WhitelistServiceIssueLicenseToEmailProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("IssueLicenseToEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		listLicenseDeliveries: connect.NewClient[proto.ListLicenseDeliveriesRequest, proto.ListLicenseDeliveriesResponse](
+			httpClient,
+			baseURL+WhitelistServiceListLicenseDeliveriesProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ListLicenseDeliveries")),
+			connect.WithClientOptions(opts...),
+		),
+		createTrialLicense: connect.NewClient[proto.CreateTrialLicenseRequest, proto.CreateTrialLicenseResponse](
+			httpClient,
+			baseURL+WhitelistServiceCreateTrialLicenseProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("CreateTrialLicense")),
+			connect.WithClientOptions(opts...),
+		),
+		extendLicense: connect.NewClient[proto.ExtendLicenseRequest, proto.License](
+			httpClient,
+			baseURL+WhitelistServiceExtendLicenseProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ExtendLicense")),
+			connect.WithClientOptions(opts...),
+		),
+		resellerExtendLicense: connect.NewClient[proto.ExtendLicenseRequest, proto.ResellerExtendLicenseResponse](
+			httpClient,
+			baseURL+WhitelistServiceResellerExtendLicenseProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ResellerExtendLicense")),
+			connect.WithClientOptions(opts...),
+		),
+		suspendLicense: connect.NewClient[proto.SuspendLicenseRequest, proto.License](
+			httpClient,
+			baseURL+WhitelistServiceSuspendLicenseProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("SuspendLicense")),
+			connect.WithClientOptions(opts...),
+		),
+		unsuspendLicense: connect.NewClient[proto.UnsuspendLicenseRequest, proto.License](
+			httpClient,
+			baseURL+WhitelistServiceUnsuspendLicenseProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("UnsuspendLicense")),
+			connect.WithClientOptions(opts...),
+		),
+		banHwid: connect.NewClient[proto.BanHwidRequest, proto.HwidBan](
+			httpClient,
+			baseURL+WhitelistServiceBanHwidProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("BanHwid")),
+			connect.WithClientOptions(opts...),
+		),
+		unbanHwid: connect.NewClient[proto.UnbanHwidRequest, emptypb.Empty](
+			httpClient,
+			baseURL+WhitelistServiceUnbanHwidProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("UnbanHwid")),
+			connect.WithClientOptions(opts...),
+		),
+		listHwidBans: connect.NewClient[proto.ListHwidBansRequest, proto.ListHwidBansResponse](
+			httpClient,
+			baseURL+WhitelistServiceListHwidBansProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ListHwidBans")),
+			connect.WithClientOptions(opts...),
+		),
+		banIp: connect.NewClient[proto.BanIpRequest, proto.IpBan](
+			httpClient,
+			baseURL+WhitelistServiceBanIpProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("BanIp")),
+			connect.WithClientOptions(opts...),
+		),
+		unbanIp: connect.NewClient[proto.UnbanIpRequest, emptypb.Empty](
+			httpClient,
+			baseURL+WhitelistServiceUnbanIpProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("UnbanIp")),
+			connect.WithClientOptions(opts...),
+		),
+		listIpBans: connect.NewClient[proto.ListIpBansRequest, proto.ListIpBansResponse](
+			httpClient,
+			baseURL+WhitelistServiceListIpBansProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ListIpBans")),
+			connect.WithClientOptions(opts...),
+		),
+		setLicenseCountries: connect.NewClient[proto.SetLicenseCountriesRequest, proto.License](
+			httpClient,
+			baseURL+WhitelistServiceSetLicenseCountriesProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("SetLicenseCountries")),
+			connect.WithClientOptions(opts...),
+		),
+		clearLockouts: connect.NewClient[proto.ClearLockoutsRequest, proto.ClearLockoutsResponse](
+			httpClient,
+			baseURL+WhitelistServiceClearLockoutsProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ClearLockouts")),
+			connect.WithClientOptions(opts...),
+		),
+		rotateProductSigningSecret: connect.NewClient[proto.RotateProductSigningSecretRequest, proto.RotateProductSigningSecretResponse](
+			httpClient,
+			baseURL+WhitelistServiceRotateProductSigningSecretProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("RotateProductSigningSecret")),
+			connect.WithClientOptions(opts...),
+		),
+		removeProductSigningSecret: connect.NewClient[proto.RemoveProductSigningSecretRequest, proto.Product](
+			httpClient,
+			baseURL+WhitelistServiceRemoveProductSigningSecretProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("RemoveProductSigningSecret")),
+			connect.WithClientOptions(opts...),
+		),
+		getChallenge: connect.NewClient[proto.GetChallengeRequest, proto.GetChallengeResponse](
+			httpClient,
+			baseURL+WhitelistServiceGetChallengeProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("GetChallenge")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeRefreshTokens: connect.NewClient[proto.RevokeRefreshTokensRequest, proto.RevokeRefreshTokensResponse](
+			httpClient,
+			baseURL+WhitelistServiceRevokeRefreshTokensProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("RevokeRefreshTokens")),
+			connect.WithClientOptions(opts...),
+		),
+		rotateAdminSecret: connect.NewClient[proto.RotateAdminSecretRequest, proto.RotateAdminSecretResponse](
+			httpClient,
+			baseURL+WhitelistServiceRotateAdminSecretProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("RotateAdminSecret")),
+			connect.WithClientOptions(opts...),
+		),
+		enrollAdminTotp: connect.NewClient[proto.EnrollAdminTotpRequest, proto.EnrollAdminTotpResponse](
+			httpClient,
+			baseURL+WhitelistServiceEnrollAdminTotpProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("EnrollAdminTotp")),
+			connect.WithClientOptions(opts...),
+		),
+		exportAuditLog: connect.NewClient[proto.ExportAuditLogRequest, httpbody.HttpBody](
+			httpClient,
+			baseURL+WhitelistServiceExportAuditLogProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ExportAuditLog")),
+			connect.WithClientOptions(opts...),
+		),
+		getStats: connect.NewClient[proto.GetStatsRequest, proto.GetStatsResponse](
+			httpClient,
+			baseURL+WhitelistServiceGetStatsProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("GetStats")),
+			connect.WithClientOptions(opts...),
+		),
+		listValidationEvents: connect.NewClient[proto.ListValidationEventsRequest, proto.ListValidationEventsResponse](
+			httpClient,
+			baseURL+WhitelistServiceListValidationEventsProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ListValidationEvents")),
+			connect.WithClientOptions(opts...),
+		),
+		searchLicenses: connect.NewClient[proto.SearchLicensesRequest, proto.SearchLicensesResponse](
+			httpClient,
+			baseURL+WhitelistServiceSearchLicensesProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("SearchLicenses")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreLicense: connect.NewClient[proto.RestoreLicenseRequest, proto.License](
+			httpClient,
+			baseURL+WhitelistServiceRestoreLicenseProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("RestoreLicense")),
+			connect.WithClientOptions(opts...),
+		),
+		purgeLicense: connect.NewClient[proto.PurgeLicenseRequest, emptypb.Empty](
+			httpClient,
+			baseURL+WhitelistServicePurgeLicenseProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("PurgeLicense")),
+			connect.WithClientOptions(opts...),
+		),
+		getLicenseHistory: connect.NewClient[proto.GetLicenseHistoryRequest, proto.GetLicenseHistoryResponse](
+			httpClient,
+			baseURL+WhitelistServiceGetLicenseHistoryProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("GetLicenseHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		importExternalLicenses: connect.NewClient[proto.ImportExternalLicensesRequest, proto.ImportLicensesResponse](
+			httpClient,
+			baseURL+WhitelistServiceImportExternalLicensesProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ImportExternalLicenses")),
+			connect.WithClientOptions(opts...),
+		),
+		bulkSuspendByProduct: connect.NewClient[proto.BulkSuspendByProductRequest, proto.BulkOperationResponse](
+			httpClient,
+			baseURL+WhitelistServiceBulkSuspendByProductProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("BulkSuspendByProduct")),
+			connect.WithClientOptions(opts...),
+		),
+		bulkDeleteByProduct: connect.NewClient[proto.BulkDeleteByProductRequest, proto.BulkOperationResponse](
+			httpClient,
+			baseURL+WhitelistServiceBulkDeleteByProductProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("BulkDeleteByProduct")),
+			connect.WithClientOptions(opts...),
+		),
+		bulkExtendByProduct: connect.NewClient[proto.BulkExtendByProductRequest, proto.BulkOperationResponse](
+			httpClient,
+			baseURL+WhitelistServiceBulkExtendByProductProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("BulkExtendByProduct")),
+			connect.WithClientOptions(opts...),
+		),
+		setMaintenanceMode: connect.NewClient[proto.SetMaintenanceModeRequest, proto.MaintenanceMode](
+			httpClient,
+			baseURL+WhitelistServiceSetMaintenanceModeProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("SetMaintenanceMode")),
+			connect.WithClientOptions(opts...),
+		),
+		getMaintenanceMode: connect.NewClient[emptypb.Empty, proto.MaintenanceMode](
+			httpClient,
+			baseURL+WhitelistServiceGetMaintenanceModeProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("GetMaintenanceMode")),
+			connect.WithClientOptions(opts...),
+		),
+		setProductMessages: connect.NewClient[proto.SetProductMessagesRequest, proto.ProductMessages](
+			httpClient,
+			baseURL+WhitelistServiceSetProductMessagesProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("SetProductMessages")),
+			connect.WithClientOptions(opts...),
+		),
+		getProductMessages: connect.NewClient[proto.GetProductMessagesRequest, proto.ProductMessages](
+			httpClient,
+			baseURL+WhitelistServiceGetProductMessagesProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("GetProductMessages")),
+			connect.WithClientOptions(opts...),
+		),
+		listMyDevices: connect.NewClient[proto.ListMyDevicesRequest, proto.ListMyDevicesResponse](
+			httpClient,
+			baseURL+WhitelistServiceListMyDevicesProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ListMyDevices")),
+			connect.WithClientOptions(opts...),
+		),
+		deactivateDevice: connect.NewClient[proto.DeactivateDeviceRequest, emptypb.Empty](
+			httpClient,
+			baseURL+WhitelistServiceDeactivateDeviceProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("DeactivateDevice")),
+			connect.WithClientOptions(opts...),
+		),
+		setLicenseFloatingSeats: connect.NewClient[proto.SetLicenseFloatingSeatsRequest, proto.License](
+			httpClient,
+			baseURL+WhitelistServiceSetLicenseFloatingSeatsProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("SetLicenseFloatingSeats")),
+			connect.WithClientOptions(opts...),
+		),
+		checkoutLicense: connect.NewClient[proto.CheckoutLicenseRequest, proto.CheckoutLicenseResponse](
+			httpClient,
+			baseURL+WhitelistServiceCheckoutLicenseProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("CheckoutLicense")),
+			connect.WithClientOptions(opts...),
+		),
+		checkinLicense: connect.NewClient[proto.CheckinLicenseRequest, emptypb.Empty](
+			httpClient,
+			baseURL+WhitelistServiceCheckinLicenseProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("CheckinLicense")),
+			connect.WithClientOptions(opts...),
+		),
+		consumeCredits: connect.NewClient[proto.ConsumeCreditsRequest, proto.ConsumeCreditsResponse](
+			httpClient,
+			baseURL+WhitelistServiceConsumeCreditsProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ConsumeCredits")),
+			connect.WithClientOptions(opts...),
+		),
+		topUpLicenseCredits: connect.NewClient[proto.TopUpLicenseCreditsRequest, proto.License](
+			httpClient,
+			baseURL+WhitelistServiceTopUpLicenseCreditsProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("TopUpLicenseCredits")),
+			connect.WithClientOptions(opts...),
+		),
+		listLicenseCreditActivity: connect.NewClient[proto.ListLicenseCreditActivityRequest, proto.ListLicenseCreditActivityResponse](
+			httpClient,
+			baseURL+WhitelistServiceListLicenseCreditActivityProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ListLicenseCreditActivity")),
+			connect.WithClientOptions(opts...),
+		),
+		setLicenseFeatures: connect.NewClient[proto.SetLicenseFeaturesRequest, proto.License](
+			httpClient,
+			baseURL+WhitelistServiceSetLicenseFeaturesProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("SetLicenseFeatures")),
+			connect.WithClientOptions(opts...),
+		),
+		createPlan: connect.NewClient[proto.CreatePlanRequest, proto.Plan](
+			httpClient,
+			baseURL+WhitelistServiceCreatePlanProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("CreatePlan")),
+			connect.WithClientOptions(opts...),
+		),
+		updatePlan: connect.NewClient[proto.UpdatePlanRequest, proto.Plan](
+			httpClient,
+			baseURL+WhitelistServiceUpdatePlanProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("UpdatePlan")),
+			connect.WithClientOptions(opts...),
+		),
+		listPlans: connect.NewClient[proto.ListPlansRequest, proto.ListPlansResponse](
+			httpClient,
+			baseURL+WhitelistServiceListPlansProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ListPlans")),
+			connect.WithClientOptions(opts...),
+		),
+		deletePlan: connect.NewClient[proto.DeletePlanRequest, emptypb.Empty](
+			httpClient,
+			baseURL+WhitelistServiceDeletePlanProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("DeletePlan")),
+			connect.WithClientOptions(opts...),
+		),
+		getServerTime: connect.NewClient[proto.GetServerTimeRequest, proto.GetServerTimeResponse](
+			httpClient,
+			baseURL+WhitelistServiceGetServerTimeProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("GetServerTime")),
+			connect.WithClientOptions(opts...),
+		),
+		getPublicKey: connect.NewClient[proto.GetPublicKeyRequest, proto.GetPublicKeyResponse](
+			httpClient,
+			baseURL+WhitelistServiceGetPublicKeyProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("GetPublicKey")),
+			connect.WithClientOptions(opts...),
+		),
+		getSigningKeys: connect.NewClient[proto.GetSigningKeysRequest, proto.GetSigningKeysResponse](
+			httpClient,
+			baseURL+WhitelistServiceGetSigningKeysProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("GetSigningKeys")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// whitelistServiceClient implements WhitelistServiceClient.
+type whitelistServiceClient struct {
+	getAuthToken               *connect.Client[proto.GetTokenRequest, proto.AuthTokenResponse]
+	validateLicense            *connect.Client[proto.ValidateRequest, proto.ValidateResponse]
+	updateLicense              *connect.Client[proto.UpdateLicenseRequest, emptypb.Empty]
+	deleteLicense              *connect.Client[proto.DeleteLicenseRequest, emptypb.Empty]
+	getLicense                 *connect.Client[proto.GetLicenseRequest, proto.License]
+	listLicenses               *connect.Client[proto.ListLicensesRequest, proto.ListLicensesResponse]
+	resetHwid                  *connect.Client[proto.ResetHwidRequest, emptypb.Empty]
+	generateLicenses           *connect.Client[proto.GenerateLicensesRequest, proto.GenerateLicensesResponse]
+	batchUpsertLicenses        *connect.Client[proto.BatchUpsertLicensesRequest, proto.BatchUpsertLicensesResponse]
+	exportLicenses             *connect.Client[proto.ExportLicensesRequest, httpbody.HttpBody]
+	importLicenses             *connect.Client[proto.ImportLicensesRequest, proto.ImportLicensesResponse]
+	listAuditEvents            *connect.Client[proto.ListAuditEventsRequest, proto.ListAuditEventsResponse]
+	resellerGenerateLicense    *connect.Client[proto.ResellerGenerateLicenseRequest, proto.ResellerGenerateLicenseResponse]
+	createReseller             *connect.Client[proto.CreateResellerRequest, proto.CreateResellerResponse]
+	topUpResellerCredits       *connect.Client[proto.TopUpResellerCreditsRequest, proto.Reseller]
+	listResellerActivity       *connect.Client[proto.ListResellerActivityRequest, proto.ListResellerActivityResponse]
+	adminLogin                 *connect.Client[proto.AdminLoginRequest, proto.AdminLoginResponse]
+	createAdmin                *connect.Client[proto.CreateAdminRequest, proto.Admin]
+	updateAdmin                *connect.Client[proto.UpdateAdminRequest, proto.Admin]
+	listAdmins                 *connect.Client[proto.ListAdminsRequest, proto.ListAdminsResponse]
+	adminLogout                *connect.Client[emptypb.Empty, emptypb.Empty]
+	listAdminSessions          *connect.Client[proto.ListAdminSessionsRequest, proto.ListAdminSessionsResponse]
+	revokeAdminSession         *connect.Client[proto.RevokeAdminSessionRequest, emptypb.Empty]
+	exportLicenseFile          *connect.Client[proto.ExportLicenseFileRequest, proto.ExportLicenseFileResponse]
+	startSession               *connect.Client[proto.StartSessionRequest, proto.StartSessionResponse]
+	heartbeat                  *connect.Client[proto.HeartbeatRequest, proto.HeartbeatResponse]
+	endSession                 *connect.Client[proto.EndSessionRequest, emptypb.Empty]
+	watchLicense               *connect.Client[proto.WatchLicenseRequest, proto.LicenseStatusEvent]
+	validateLicenses           *connect.Client[proto.ValidateLicensesRequest, proto.ValidateLicensesResponse]
+	createProduct              *connect.Client[proto.CreateProductRequest, proto.Product]
+	updateProduct              *connect.Client[proto.UpdateProductRequest, proto.Product]
+	listProducts               *connect.Client[proto.ListProductsRequest, proto.ListProductsResponse]
+	deleteProduct              *connect.Client[proto.DeleteProductRequest, emptypb.Empty]
+	getLatestVersion           *connect.Client[proto.GetLatestVersionRequest, proto.Release]
+	publishRelease             *connect.Client[proto.PublishReleaseRequest, proto.Release]
+	setLicenseChannel          *connect.Client[proto.SetLicenseChannelRequest, proto.License]
+	createCustomer             *connect.Client[proto.CreateCustomerRequest, proto.Customer]
+	listCustomers              *connect.Client[proto.ListCustomersRequest, proto.ListCustomersResponse]
+	attachLicense              *connect.Client[proto.AttachLicenseRequest, proto.License]
+	detachLicense              *connect.Client[proto.DetachLicenseRequest, proto.License]
+	issueLicenseToEmail        *connect.Client[proto.IssueLicenseToEmailRequest, proto.IssueLicenseToEmailResponse]
+	listLicenseDeliveries      *connect.Client[proto.ListLicenseDeliveriesRequest, proto.ListLicenseDeliveriesResponse]
+	createTrialLicense         *connect.Client[proto.CreateTrialLicenseRequest, proto.CreateTrialLicenseResponse]
+	extendLicense              *connect.Client[proto.ExtendLicenseRequest, proto.License]
+	resellerExtendLicense      *connect.Client[proto.ExtendLicenseRequest, proto.ResellerExtendLicenseResponse]
+	suspendLicense             *connect.Client[proto.SuspendLicenseRequest, proto.License]
+	unsuspendLicense           *connect.Client[proto.UnsuspendLicenseRequest, proto.License]
+	banHwid                    *connect.Client[proto.BanHwidRequest, proto.HwidBan]
+	unbanHwid                  *connect.Client[proto.UnbanHwidRequest, emptypb.Empty]
+	listHwidBans               *connect.Client[proto.ListHwidBansRequest, proto.ListHwidBansResponse]
+	banIp                      *connect.Client[proto.BanIpRequest, proto.IpBan]
+	unbanIp                    *connect.Client[proto.UnbanIpRequest, emptypb.Empty]
+	listIpBans                 *connect.Client[proto.ListIpBansRequest, proto.ListIpBansResponse]
+	setLicenseCountries        *connect.Client[proto.SetLicenseCountriesRequest, proto.License]
+	clearLockouts              *connect.Client[proto.ClearLockoutsRequest, proto.ClearLockoutsResponse]
+	rotateProductSigningSecret *connect.Client[proto.RotateProductSigningSecretRequest, proto.RotateProductSigningSecretResponse]
+	removeProductSigningSecret *connect.Client[proto.RemoveProductSigningSecretRequest, proto.Product]
+	getChallenge               *connect.Client[proto.GetChallengeRequest, proto.GetChallengeResponse]
+	revokeRefreshTokens        *connect.Client[proto.RevokeRefreshTokensRequest, proto.RevokeRefreshTokensResponse]
+	rotateAdminSecret          *connect.Client[proto.RotateAdminSecretRequest, proto.RotateAdminSecretResponse]
+	enrollAdminTotp            *connect.Client[proto.EnrollAdminTotpRequest, proto.EnrollAdminTotpResponse]
+	exportAuditLog             *connect.Client[proto.ExportAuditLogRequest, httpbody.HttpBody]
+	getStats                   *connect.Client[proto.GetStatsRequest, proto.GetStatsResponse]
+	listValidationEvents       *connect.Client[proto.ListValidationEventsRequest, proto.ListValidationEventsResponse]
+	searchLicenses             *connect.Client[proto.SearchLicensesRequest, proto.SearchLicensesResponse]
+	restoreLicense             *connect.Client[proto.RestoreLicenseRequest, proto.License]
+	purgeLicense               *connect.Client[proto.PurgeLicenseRequest, emptypb.Empty]
+	getLicenseHistory          *connect.Client[proto.GetLicenseHistoryRequest, proto.GetLicenseHistoryResponse]
+	importExternalLicenses     *connect.Client[proto.ImportExternalLicensesRequest, proto.ImportLicensesResponse]
+	bulkSuspendByProduct       *connect.Client[proto.BulkSuspendByProductRequest, proto.BulkOperationResponse]
+	bulkDeleteByProduct        *connect.Client[proto.BulkDeleteByProductRequest, proto.BulkOperationResponse]
+	bulkExtendByProduct        *connect.Client[proto.BulkExtendByProductRequest, proto.BulkOperationResponse]
+	setMaintenanceMode         *connect.Client[proto.SetMaintenanceModeRequest, proto.MaintenanceMode]
+	getMaintenanceMode         *connect.Client[emptypb.Empty, proto.MaintenanceMode]
+	setProductMessages         *connect.Client[proto.SetProductMessagesRequest, proto.ProductMessages]
+	getProductMessages         *connect.Client[proto.GetProductMessagesRequest, proto.ProductMessages]
+	listMyDevices              *connect.Client[proto.ListMyDevicesRequest, proto.ListMyDevicesResponse]
+	deactivateDevice           *connect.Client[proto.DeactivateDeviceRequest, emptypb.Empty]
+	setLicenseFloatingSeats    *connect.Client[proto.SetLicenseFloatingSeatsRequest, proto.License]
+	checkoutLicense            *connect.Client[proto.CheckoutLicenseRequest, proto.CheckoutLicenseResponse]
+	checkinLicense             *connect.Client[proto.CheckinLicenseRequest, emptypb.Empty]
+	consumeCredits             *connect.Client[proto.ConsumeCreditsRequest, proto.ConsumeCreditsResponse]
+	topUpLicenseCredits        *connect.Client[proto.TopUpLicenseCreditsRequest, proto.License]
+	listLicenseCreditActivity  *connect.Client[proto.ListLicenseCreditActivityRequest, proto.ListLicenseCreditActivityResponse]
+	setLicenseFeatures         *connect.Client[proto.SetLicenseFeaturesRequest, proto.License]
+	createPlan                 *connect.Client[proto.CreatePlanRequest, proto.Plan]
+	updatePlan                 *connect.Client[proto.UpdatePlanRequest, proto.Plan]
+	listPlans                  *connect.Client[proto.ListPlansRequest, proto.ListPlansResponse]
+	deletePlan                 *connect.Client[proto.DeletePlanRequest, emptypb.Empty]
+	getServerTime              *connect.Client[proto.GetServerTimeRequest, proto.GetServerTimeResponse]
+	getPublicKey               *connect.Client[proto.GetPublicKeyRequest, proto.GetPublicKeyResponse]
+	getSigningKeys             *connect.Client[proto.GetSigningKeysRequest, proto.GetSigningKeysResponse]
+}
+
+// GetAuthToken calls whitelist.WhitelistService.GetAuthToken.
+func (c *whitelistServiceClient) GetAuthToken(ctx context.Context, req *proto.GetTokenRequest) (*proto.AuthTokenResponse, error) {
+	response, err := c.getAuthToken.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ValidateLicense calls whitelist.WhitelistService.ValidateLicense.
+func (c *whitelistServiceClient) ValidateLicense(ctx context.Context, req *proto.ValidateRequest) (*proto.ValidateResponse, error) {
+	response, err := c.validateLicense.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateLicense calls whitelist.WhitelistService.UpdateLicense.
+func (c *whitelistServiceClient) UpdateLicense(ctx context.Context, req *proto.UpdateLicenseRequest) (*emptypb.Empty, error) {
+	response, err := c.updateLicense.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteLicense calls whitelist.WhitelistService.DeleteLicense.
+func (c *whitelistServiceClient) DeleteLicense(ctx context.Context, req *proto.DeleteLicenseRequest) (*emptypb.Empty, error) {
+	response, err := c.deleteLicense.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetLicense calls whitelist.WhitelistService.GetLicense.
+func (c *whitelistServiceClient) GetLicense(ctx context.Context, req *proto.GetLicenseRequest) (*proto.License, error) {
+	response, err := c.getLicense.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListLicenses calls whitelist.WhitelistService.ListLicenses.
+func (c *whitelistServiceClient) ListLicenses(ctx context.Context, req *proto.ListLicensesRequest) (*proto.ListLicensesResponse, error) {
+	response, err := c.listLicenses.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ResetHwid calls whitelist.WhitelistService.ResetHwid.
+func (c *whitelistServiceClient) ResetHwid(ctx context.Context, req *proto.ResetHwidRequest) (*emptypb.Empty, error) {
+	response, err := c.resetHwid.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GenerateLicenses calls whitelist.WhitelistService.GenerateLicenses.
+func (c *whitelistServiceClient) GenerateLicenses(ctx context.Context, req *proto.GenerateLicensesRequest) (*proto.GenerateLicensesResponse, error) {
+	response, err := c.generateLicenses.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// BatchUpsertLicenses calls whitelist.WhitelistService.BatchUpsertLicenses.
+func (c *whitelistServiceClient) BatchUpsertLicenses(ctx context.Context, req *proto.BatchUpsertLicensesRequest) (*proto.BatchUpsertLicensesResponse, error) {
+	response, err := c.batchUpsertLicenses.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ExportLicenses calls whitelist.WhitelistService.ExportLicenses.
+func (c *whitelistServiceClient) ExportLicenses(ctx context.Context, req *proto.ExportLicensesRequest) (*connect.ServerStreamForClient[httpbody.HttpBody], error) {
+	return c.exportLicenses.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// ImportLicenses calls whitelist.WhitelistService.ImportLicenses.
+func (c *whitelistServiceClient) ImportLicenses(ctx context.Context, req *proto.ImportLicensesRequest) (*proto.ImportLicensesResponse, error) {
+	response, err := c.importLicenses.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListAuditEvents calls whitelist.WhitelistService.ListAuditEvents.
+func (c *whitelistServiceClient) ListAuditEvents(ctx context.Context, req *proto.ListAuditEventsRequest) (*proto.ListAuditEventsResponse, error) {
+	response, err := c.listAuditEvents.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ResellerGenerateLicense calls whitelist.WhitelistService.ResellerGenerateLicense.
+func (c *whitelistServiceClient) ResellerGenerateLicense(ctx context.Context, req *proto.ResellerGenerateLicenseRequest) (*proto.ResellerGenerateLicenseResponse, error) {
+	response, err := c.resellerGenerateLicense.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateReseller calls whitelist.WhitelistService.CreateReseller.
+func (c *whitelistServiceClient) CreateReseller(ctx context.Context, req *proto.CreateResellerRequest) (*proto.CreateResellerResponse, error) {
+	response, err := c.createReseller.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// TopUpResellerCredits calls whitelist.WhitelistService.TopUpResellerCredits.
+func (c *whitelistServiceClient) TopUpResellerCredits(ctx context.Context, req *proto.TopUpResellerCreditsRequest) (*proto.Reseller, error) {
+	response, err := c.topUpResellerCredits.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListResellerActivity calls whitelist.WhitelistService.ListResellerActivity.
+func (c *whitelistServiceClient) ListResellerActivity(ctx context.Context, req *proto.ListResellerActivityRequest) (*proto.ListResellerActivityResponse, error) {
+	response, err := c.listResellerActivity.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AdminLogin calls whitelist.WhitelistService.AdminLogin.
+func (c *whitelistServiceClient) AdminLogin(ctx context.Context, req *proto.AdminLoginRequest) (*proto.AdminLoginResponse, error) {
+	response, err := c.adminLogin.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateAdmin calls whitelist.WhitelistService.CreateAdmin.
+func (c *whitelistServiceClient) CreateAdmin(ctx context.Context, req *proto.CreateAdminRequest) (*proto.Admin, error) {
+	response, err := c.createAdmin.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateAdmin calls whitelist.WhitelistService.UpdateAdmin.
+func (c *whitelistServiceClient) UpdateAdmin(ctx context.Context, req *proto.UpdateAdminRequest) (*proto.Admin, error) {
+	response, err := c.updateAdmin.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListAdmins calls whitelist.WhitelistService.ListAdmins.
+func (c *whitelistServiceClient) ListAdmins(ctx context.Context, req *proto.ListAdminsRequest) (*proto.ListAdminsResponse, error) {
+	response, err := c.listAdmins.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AdminLogout calls whitelist.WhitelistService.AdminLogout.
+func (c *whitelistServiceClient) AdminLogout(ctx context.Context, req *emptypb.Empty) (*emptypb.Empty, error) {
+	response, err := c.adminLogout.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListAdminSessions calls whitelist.WhitelistService.ListAdminSessions.
+func (c *whitelistServiceClient) ListAdminSessions(ctx context.Context, req *proto.ListAdminSessionsRequest) (*proto.ListAdminSessionsResponse, error) {
+	response, err := c.listAdminSessions.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RevokeAdminSession calls whitelist.WhitelistService.RevokeAdminSession.
+func (c *whitelistServiceClient) RevokeAdminSession(ctx context.Context, req *proto.RevokeAdminSessionRequest) (*emptypb.Empty, error) {
+	response, err := c.revokeAdminSession.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ExportLicenseFile calls whitelist.WhitelistService.ExportLicenseFile.
+func (c *whitelistServiceClient) ExportLicenseFile(ctx context.Context, req *proto.ExportLicenseFileRequest) (*proto.ExportLicenseFileResponse, error) {
+	response, err := c.exportLicenseFile.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// StartSession calls whitelist.WhitelistService.StartSession.
+func (c *whitelistServiceClient) StartSession(ctx context.Context, req *proto.StartSessionRequest) (*proto.StartSessionResponse, error) {
+	response, err := c.startSession.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// Heartbeat calls whitelist.WhitelistService.Heartbeat.
+func (c *whitelistServiceClient) Heartbeat(ctx context.Context, req *proto.HeartbeatRequest) (*proto.HeartbeatResponse, error) {
+	response, err := c.heartbeat.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// EndSession calls whitelist.WhitelistService.EndSession.
+func (c *whitelistServiceClient) EndSession(ctx context.Context, req *proto.EndSessionRequest) (*emptypb.Empty, error) {
+	response, err := c.endSession.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// WatchLicense calls whitelist.WhitelistService.WatchLicense.
+func (c *whitelistServiceClient) WatchLicense(ctx context.Context, req *proto.WatchLicenseRequest) (*connect.ServerStreamForClient[proto.LicenseStatusEvent], error) {
+	return c.watchLicense.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// ValidateLicenses calls whitelist.WhitelistService.ValidateLicenses.
+func (c *whitelistServiceClient) ValidateLicenses(ctx context.Context, req *proto.ValidateLicensesRequest) (*proto.ValidateLicensesResponse, error) {
+	response, err := c.validateLicenses.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateProduct calls whitelist.WhitelistService.CreateProduct.
+func (c *whitelistServiceClient) CreateProduct(ctx context.Context, req *proto.CreateProductRequest) (*proto.Product, error) {
+	response, err := c.createProduct.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateProduct calls whitelist.WhitelistService.UpdateProduct.
+func (c *whitelistServiceClient) UpdateProduct(ctx context.Context, req *proto.UpdateProductRequest) (*proto.Product, error) {
+	response, err := c.updateProduct.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListProducts calls whitelist.WhitelistService.ListProducts.
+func (c *whitelistServiceClient) ListProducts(ctx context.Context, req *proto.ListProductsRequest) (*proto.ListProductsResponse, error) {
+	response, err := c.listProducts.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteProduct calls whitelist.WhitelistService.DeleteProduct.
+func (c *whitelistServiceClient) DeleteProduct(ctx context.Context, req *proto.DeleteProductRequest) (*emptypb.Empty, error) {
+	response, err := c.deleteProduct.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetLatestVersion calls whitelist.WhitelistService.GetLatestVersion.
+func (c *whitelistServiceClient) GetLatestVersion(ctx context.Context, req *proto.GetLatestVersionRequest) (*proto.Release, error) {
+	response, err := c.getLatestVersion.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// PublishRelease calls whitelist.WhitelistService.PublishRelease.
+func (c *whitelistServiceClient) PublishRelease(ctx context.Context, req *proto.PublishReleaseRequest) (*proto.Release, error) {
+	response, err := c.publishRelease.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SetLicenseChannel calls whitelist.WhitelistService.SetLicenseChannel.
+func (c *whitelistServiceClient) SetLicenseChannel(ctx context.Context, req *proto.SetLicenseChannelRequest) (*proto.License, error) {
+	response, err := c.setLicenseChannel.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateCustomer calls whitelist.WhitelistService.CreateCustomer.
+func (c *whitelistServiceClient) CreateCustomer(ctx context.Context, req *proto.CreateCustomerRequest) (*proto.Customer, error) {
+	response, err := c.createCustomer.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListCustomers calls whitelist.WhitelistService.ListCustomers.
+func (c *whitelistServiceClient) ListCustomers(ctx context.Context, req *proto.ListCustomersRequest) (*proto.ListCustomersResponse, error) {
+	response, err := c.listCustomers.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AttachLicense calls whitelist.WhitelistService.AttachLicense.
+func (c *whitelistServiceClient) AttachLicense(ctx context.Context, req *proto.AttachLicenseRequest) (*proto.License, error) {
+	response, err := c.attachLicense.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DetachLicense calls whitelist.WhitelistService.DetachLicense.
+func (c *whitelistServiceClient) DetachLicense(ctx context.Context, req *proto.DetachLicenseRequest) (*proto.License, error) {
+	response, err := c.detachLicense.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// IssueLicenseToEmail calls whitelist.WhitelistService.IssueLicenseToEmail.
+func (c *whitelistServiceClient) IssueLicenseToEmail(ctx context.Context, req *proto.IssueLicenseToEmailRequest) (*proto.IssueLicenseToEmailResponse, error) {
+	response, err := c.issueLicenseToEmail.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListLicenseDeliveries calls whitelist.WhitelistService.ListLicenseDeliveries.
+func (c *whitelistServiceClient) ListLicenseDeliveries(ctx context.Context, req *proto.ListLicenseDeliveriesRequest) (*proto.ListLicenseDeliveriesResponse, error) {
+	response, err := c.listLicenseDeliveries.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateTrialLicense calls whitelist.WhitelistService.CreateTrialLicense.
+func (c *whitelistServiceClient) CreateTrialLicense(ctx context.Context, req *proto.CreateTrialLicenseRequest) (*proto.CreateTrialLicenseResponse, error) {
+	response, err := c.createTrialLicense.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ExtendLicense calls whitelist.WhitelistService.ExtendLicense.
+func (c *whitelistServiceClient) ExtendLicense(ctx context.Context, req *proto.ExtendLicenseRequest) (*proto.License, error) {
+	response, err := c.extendLicense.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ResellerExtendLicense calls whitelist.WhitelistService.ResellerExtendLicense.
+func (c *whitelistServiceClient) ResellerExtendLicense(ctx context.Context, req *proto.ExtendLicenseRequest) (*proto.ResellerExtendLicenseResponse, error) {
+	response, err := c.resellerExtendLicense.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SuspendLicense calls whitelist.WhitelistService.SuspendLicense.
+func (c *whitelistServiceClient) SuspendLicense(ctx context.Context, req *proto.SuspendLicenseRequest) (*proto.License, error) {
+	response, err := c.suspendLicense.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UnsuspendLicense calls whitelist.WhitelistService.UnsuspendLicense.
+func (c *whitelistServiceClient) UnsuspendLicense(ctx context.Context, req *proto.UnsuspendLicenseRequest) (*proto.License, error) {
+	response, err := c.unsuspendLicense.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// BanHwid calls whitelist.WhitelistService.BanHwid.
+func (c *whitelistServiceClient) BanHwid(ctx context.Context, req *proto.BanHwidRequest) (*proto.HwidBan, error) {
+	response, err := c.banHwid.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UnbanHwid calls whitelist.WhitelistService.UnbanHwid.
+func (c *whitelistServiceClient) UnbanHwid(ctx context.Context, req *proto.UnbanHwidRequest) (*emptypb.Empty, error) {
+	response, err := c.unbanHwid.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListHwidBans calls whitelist.WhitelistService.ListHwidBans.
+func (c *whitelistServiceClient) ListHwidBans(ctx context.Context, req *proto.ListHwidBansRequest) (*proto.ListHwidBansResponse, error) {
+	response, err := c.listHwidBans.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// BanIp calls whitelist.WhitelistService.BanIp.
+func (c *whitelistServiceClient) BanIp(ctx context.Context, req *proto.BanIpRequest) (*proto.IpBan, error) {
+	response, err := c.banIp.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UnbanIp calls whitelist.WhitelistService.UnbanIp.
+func (c *whitelistServiceClient) UnbanIp(ctx context.Context, req *proto.UnbanIpRequest) (*emptypb.Empty, error) {
+	response, err := c.unbanIp.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListIpBans calls whitelist.WhitelistService.ListIpBans.
+func (c *whitelistServiceClient) ListIpBans(ctx context.Context, req *proto.ListIpBansRequest) (*proto.ListIpBansResponse, error) {
+	response, err := c.listIpBans.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SetLicenseCountries calls whitelist.WhitelistService.SetLicenseCountries.
+func (c *whitelistServiceClient) SetLicenseCountries(ctx context.Context, req *proto.SetLicenseCountriesRequest) (*proto.License, error) {
+	response, err := c.setLicenseCountries.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ClearLockouts calls whitelist.WhitelistService.ClearLockouts.
+func (c *whitelistServiceClient) ClearLockouts(ctx context.Context, req *proto.ClearLockoutsRequest) (*proto.ClearLockoutsResponse, error) {
+	response, err := c.clearLockouts.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RotateProductSigningSecret calls whitelist.WhitelistService.RotateProductSigningSecret.
+func (c *whitelistServiceClient) RotateProductSigningSecret(ctx context.Context, req *proto.RotateProductSigningSecretRequest) (*proto.RotateProductSigningSecretResponse, error) {
+	response, err := c.rotateProductSigningSecret.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RemoveProductSigningSecret calls whitelist.WhitelistService.RemoveProductSigningSecret.
+func (c *whitelistServiceClient) RemoveProductSigningSecret(ctx context.Context, req *proto.RemoveProductSigningSecretRequest) (*proto.Product, error) {
+	response, err := c.removeProductSigningSecret.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetChallenge calls whitelist.WhitelistService.GetChallenge.
+func (c *whitelistServiceClient) GetChallenge(ctx context.Context, req *proto.GetChallengeRequest) (*proto.GetChallengeResponse, error) {
+	response, err := c.getChallenge.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RevokeRefreshTokens calls whitelist.WhitelistService.RevokeRefreshTokens.
+func (c *whitelistServiceClient) RevokeRefreshTokens(ctx context.Context, req *proto.RevokeRefreshTokensRequest) (*proto.RevokeRefreshTokensResponse, error) {
+	response, err := c.revokeRefreshTokens.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RotateAdminSecret calls whitelist.WhitelistService.RotateAdminSecret.
+func (c *whitelistServiceClient) RotateAdminSecret(ctx context.Context, req *proto.RotateAdminSecretRequest) (*proto.RotateAdminSecretResponse, error) {
+	response, err := c.rotateAdminSecret.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// EnrollAdminTotp calls whitelist.WhitelistService.EnrollAdminTotp.
+func (c *whitelistServiceClient) EnrollAdminTotp(ctx context.Context, req *proto.EnrollAdminTotpRequest) (*proto.EnrollAdminTotpResponse, error) {
+	response, err := c.enrollAdminTotp.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ExportAuditLog calls whitelist.WhitelistService.ExportAuditLog.
+func (c *whitelistServiceClient) ExportAuditLog(ctx context.Context, req *proto.ExportAuditLogRequest) (*connect.ServerStreamForClient[httpbody.HttpBody], error) {
+	return c.exportAuditLog.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// GetStats calls whitelist.WhitelistService.GetStats.
+func (c *whitelistServiceClient) GetStats(ctx context.Context, req *proto.GetStatsRequest) (*proto.GetStatsResponse, error) {
+	response, err := c.getStats.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListValidationEvents calls whitelist.WhitelistService.ListValidationEvents.
+func (c *whitelistServiceClient) ListValidationEvents(ctx context.Context, req *proto.ListValidationEventsRequest) (*proto.ListValidationEventsResponse, error) {
+	response, err := c.listValidationEvents.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SearchLicenses calls whitelist.WhitelistService.SearchLicenses.
+func (c *whitelistServiceClient) SearchLicenses(ctx context.Context, req *proto.SearchLicensesRequest) (*proto.SearchLicensesResponse, error) {
+	response, err := c.searchLicenses.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RestoreLicense calls whitelist.WhitelistService.RestoreLicense.
+func (c *whitelistServiceClient) RestoreLicense(ctx context.Context, req *proto.RestoreLicenseRequest) (*proto.License, error) {
+	response, err := c.restoreLicense.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// PurgeLicense calls whitelist.WhitelistService.PurgeLicense.
+func (c *whitelistServiceClient) PurgeLicense(ctx context.Context, req *proto.PurgeLicenseRequest) (*emptypb.Empty, error) {
+	response, err := c.purgeLicense.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetLicenseHistory calls whitelist.WhitelistService.GetLicenseHistory.
+func (c *whitelistServiceClient) GetLicenseHistory(ctx context.Context, req *proto.GetLicenseHistoryRequest) (*proto.GetLicenseHistoryResponse, error) {
+	response, err := c.getLicenseHistory.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ImportExternalLicenses calls whitelist.WhitelistService.ImportExternalLicenses.
+func (c *whitelistServiceClient) ImportExternalLicenses(ctx context.Context, req *proto.ImportExternalLicensesRequest) (*proto.ImportLicensesResponse, error) {
+	response, err := c.importExternalLicenses.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// BulkSuspendByProduct calls whitelist.WhitelistService.BulkSuspendByProduct.
+func (c *whitelistServiceClient) BulkSuspendByProduct(ctx context.Context, req *proto.BulkSuspendByProductRequest) (*proto.BulkOperationResponse, error) {
+	response, err := c.bulkSuspendByProduct.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// BulkDeleteByProduct calls whitelist.WhitelistService.BulkDeleteByProduct.
+func (c *whitelistServiceClient) BulkDeleteByProduct(ctx context.Context, req *proto.BulkDeleteByProductRequest) (*proto.BulkOperationResponse, error) {
+	response, err := c.bulkDeleteByProduct.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// BulkExtendByProduct calls whitelist.WhitelistService.BulkExtendByProduct.
+func (c *whitelistServiceClient) BulkExtendByProduct(ctx context.Context, req *proto.BulkExtendByProductRequest) (*proto.BulkOperationResponse, error) {
+	response, err := c.bulkExtendByProduct.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SetMaintenanceMode calls whitelist.WhitelistService.SetMaintenanceMode.
+func (c *whitelistServiceClient) SetMaintenanceMode(ctx context.Context, req *proto.SetMaintenanceModeRequest) (*proto.MaintenanceMode, error) {
+	response, err := c.setMaintenanceMode.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetMaintenanceMode calls whitelist.WhitelistService.GetMaintenanceMode.
+func (c *whitelistServiceClient) GetMaintenanceMode(ctx context.Context, req *emptypb.Empty) (*proto.MaintenanceMode, error) {
+	response, err := c.getMaintenanceMode.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SetProductMessages calls whitelist.WhitelistService.SetProductMessages.
+func (c *whitelistServiceClient) SetProductMessages(ctx context.Context, req *proto.SetProductMessagesRequest) (*proto.ProductMessages, error) {
+	response, err := c.setProductMessages.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetProductMessages calls whitelist.WhitelistService.GetProductMessages.
+func (c *whitelistServiceClient) GetProductMessages(ctx context.Context, req *proto.GetProductMessagesRequest) (*proto.ProductMessages, error) {
+	response, err := c.getProductMessages.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListMyDevices calls whitelist.WhitelistService.ListMyDevices.
+func (c *whitelistServiceClient) ListMyDevices(ctx context.Context, req *proto.ListMyDevicesRequest) (*proto.ListMyDevicesResponse, error) {
+	response, err := c.listMyDevices.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeactivateDevice calls whitelist.WhitelistService.DeactivateDevice.
+func (c *whitelistServiceClient) DeactivateDevice(ctx context.Context, req *proto.DeactivateDeviceRequest) (*emptypb.Empty, error) {
+	response, err := c.deactivateDevice.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SetLicenseFloatingSeats calls whitelist.WhitelistService.SetLicenseFloatingSeats.
+func (c *whitelistServiceClient) SetLicenseFloatingSeats(ctx context.Context, req *proto.SetLicenseFloatingSeatsRequest) (*proto.License, error) {
+	response, err := c.setLicenseFloatingSeats.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CheckoutLicense calls whitelist.WhitelistService.CheckoutLicense.
+func (c *whitelistServiceClient) CheckoutLicense(ctx context.Context, req *proto.CheckoutLicenseRequest) (*proto.CheckoutLicenseResponse, error) {
+	response, err := c.checkoutLicense.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CheckinLicense calls whitelist.WhitelistService.CheckinLicense.
+func (c *whitelistServiceClient) CheckinLicense(ctx context.Context, req *proto.CheckinLicenseRequest) (*emptypb.Empty, error) {
+	response, err := c.checkinLicense.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ConsumeCredits calls whitelist.WhitelistService.ConsumeCredits.
+func (c *whitelistServiceClient) ConsumeCredits(ctx context.Context, req *proto.ConsumeCreditsRequest) (*proto.ConsumeCreditsResponse, error) {
+	response, err := c.consumeCredits.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// TopUpLicenseCredits calls whitelist.WhitelistService.TopUpLicenseCredits.
+func (c *whitelistServiceClient) TopUpLicenseCredits(ctx context.Context, req *proto.TopUpLicenseCreditsRequest) (*proto.License, error) {
+	response, err := c.topUpLicenseCredits.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListLicenseCreditActivity calls whitelist.WhitelistService.ListLicenseCreditActivity.
+func (c *whitelistServiceClient) ListLicenseCreditActivity(ctx context.Context, req *proto.ListLicenseCreditActivityRequest) (*proto.ListLicenseCreditActivityResponse, error) {
+	response, err := c.listLicenseCreditActivity.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SetLicenseFeatures calls whitelist.WhitelistService.SetLicenseFeatures.
+func (c *whitelistServiceClient) SetLicenseFeatures(ctx context.Context, req *proto.SetLicenseFeaturesRequest) (*proto.License, error) {
+	response, err := c.setLicenseFeatures.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreatePlan calls whitelist.WhitelistService.CreatePlan.
+func (c *whitelistServiceClient) CreatePlan(ctx context.Context, req *proto.CreatePlanRequest) (*proto.Plan, error) {
+	response, err := c.createPlan.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdatePlan calls whitelist.WhitelistService.UpdatePlan.
+func (c *whitelistServiceClient) UpdatePlan(ctx context.Context, req *proto.UpdatePlanRequest) (*proto.Plan, error) {
+	response, err := c.updatePlan.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListPlans calls whitelist.WhitelistService.ListPlans.
+func (c *whitelistServiceClient) ListPlans(ctx context.Context, req *proto.ListPlansRequest) (*proto.ListPlansResponse, error) {
+	response, err := c.listPlans.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeletePlan calls whitelist.WhitelistService.DeletePlan.
+func (c *whitelistServiceClient) DeletePlan(ctx context.Context, req *proto.DeletePlanRequest) (*emptypb.Empty, error) {
+	response, err := c.deletePlan.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetServerTime calls whitelist.WhitelistService.GetServerTime.
+func (c *whitelistServiceClient) GetServerTime(ctx context.Context, req *proto.GetServerTimeRequest) (*proto.GetServerTimeResponse, error) {
+	response, err := c.getServerTime.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetPublicKey calls whitelist.WhitelistService.GetPublicKey.
+func (c *whitelistServiceClient) GetPublicKey(ctx context.Context, req *proto.GetPublicKeyRequest) (*proto.GetPublicKeyResponse, error) {
+	response, err := c.getPublicKey.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetSigningKeys calls whitelist.WhitelistService.GetSigningKeys.
+func (c *whitelistServiceClient) GetSigningKeys(ctx context.Context, req *proto.GetSigningKeysRequest) (*proto.GetSigningKeysResponse, error) {
+	response, err := c.getSigningKeys.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// WhitelistServiceHandler is an implementation of the whitelist.WhitelistService service.
+type WhitelistServiceHandler interface {
+	// 1. Get Token (Now requires API Key)
+	GetAuthToken(context.Context, *proto.GetTokenRequest) (*proto.AuthTokenResponse, error)
+	// 2. Validate License
+	ValidateLicense(context.Context, *proto.ValidateRequest) (*proto.ValidateResponse, error)
+	// 3. Create/Update License (Admin)
+	UpdateLicense(context.Context, *proto.UpdateLicenseRequest) (*emptypb.Empty, error)
+	// 4. Delete License (Admin). Restorable until purged.
+	DeleteLicense(context.Context, *proto.DeleteLicenseRequest) (*emptypb.Empty, error)
+	// 5. Get License (Admin)
+	GetLicense(context.Context, *proto.GetLicenseRequest) (*proto.License, error)
+	// 6. List Licenses (Admin)
+	ListLicenses(context.Context, *proto.ListLicensesRequest) (*proto.ListLicensesResponse, error)
+	// 7. Reset HWID bindings (Admin)
+	ResetHwid(context.Context, *proto.ResetHwidRequest) (*emptypb.Empty, error)
+	// 8. Generate License Keys (Admin)
+	GenerateLicenses(context.Context, *proto.GenerateLicensesRequest) (*proto.GenerateLicensesResponse, error)
+	// 9. Create/Update many licenses in one transaction (Admin)
+	BatchUpsertLicenses(context.Context, *proto.BatchUpsertLicensesRequest) (*proto.BatchUpsertLicensesResponse, error)
+	// 10. Export licenses as CSV (Admin)
+	ExportLicenses(context.Context, *proto.ExportLicensesRequest, *connect.ServerStream[httpbody.HttpBody]) error
+	// 11. Import licenses from CSV (Admin)
+	ImportLicenses(context.Context, *proto.ImportLicensesRequest) (*proto.ImportLicensesResponse, error)
+	// 12. List Audit Log (Admin)
+	ListAuditEvents(context.Context, *proto.ListAuditEventsRequest) (*proto.ListAuditEventsResponse, error)
+	// 13. Generate License Keys against a credit balance (Reseller, x-reseller-key header)
+	ResellerGenerateLicense(context.Context, *proto.ResellerGenerateLicenseRequest) (*proto.ResellerGenerateLicenseResponse, error)
+	// 14. Create Reseller (Admin)
+	CreateReseller(context.Context, *proto.CreateResellerRequest) (*proto.CreateResellerResponse, error)
+	// 15. Add (or remove) Reseller Credits (Admin)
+	TopUpResellerCredits(context.Context, *proto.TopUpResellerCreditsRequest) (*proto.Reseller, error)
+	// 16. List Reseller Credit Activity (Admin)
+	ListResellerActivity(context.Context, *proto.ListResellerActivityRequest) (*proto.ListResellerActivityResponse, error)
+	// 17. Admin Login, returns a bearer token for the Authorization header
+	AdminLogin(context.Context, *proto.AdminLoginRequest) (*proto.AdminLoginResponse, error)
+	// 18. Create Admin Account (Owner)
+	CreateAdmin(context.Context, *proto.CreateAdminRequest) (*proto.Admin, error)
+	// 19. Change role, password or disabled flag of an Admin Account (Owner)
+	UpdateAdmin(context.Context, *proto.UpdateAdminRequest) (*proto.Admin, error)
+	// 20. List Admin Accounts (Owner)
+	ListAdmins(context.Context, *proto.ListAdminsRequest) (*proto.ListAdminsResponse, error)
+	// 21. Admin Logout, ends the session of the calling token
+	AdminLogout(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// 22. List Admin Sessions (own sessions, or anyone's for owners)
+	ListAdminSessions(context.Context, *proto.ListAdminSessionsRequest) (*proto.ListAdminSessionsResponse, error)
+	// 23. Revoke an Admin Session (own sessions, or anyone's for owners)
+	RevokeAdminSession(context.Context, *proto.RevokeAdminSessionRequest) (*emptypb.Empty, error)
+	// 24. Export a signed license file for offline validation (Support)
+	ExportLicenseFile(context.Context, *proto.ExportLicenseFileRequest) (*proto.ExportLicenseFileResponse, error)
+	// 25. Start a Session, validating the license and taking a concurrent-use slot
+	StartSession(context.Context, *proto.StartSessionRequest) (*proto.StartSessionResponse, error)
+	// 26. Heartbeat keeps a Session alive
+	Heartbeat(context.Context, *proto.HeartbeatRequest) (*proto.HeartbeatResponse, error)
+	// 27. End a Session, freeing its slot
+	EndSession(context.Context, *proto.EndSessionRequest) (*emptypb.Empty, error)
+	// 28. Watch a License, streaming its status whenever it changes
+	WatchLicense(context.Context, *proto.WatchLicenseRequest, *connect.ServerStream[proto.LicenseStatusEvent]) error
+	// 29. Validate several Licenses with one access token
+	ValidateLicenses(context.Context, *proto.ValidateLicensesRequest) (*proto.ValidateLicensesResponse, error)
+	// 30. Create a Product (Owner)
+	CreateProduct(context.Context, *proto.CreateProductRequest) (*proto.Product, error)
+	// 31. Update a Product's name, description or disabled flag (Owner)
+	UpdateProduct(context.Context, *proto.UpdateProductRequest) (*proto.Product, error)
+	// 32. List Products (Admin)
+	ListProducts(context.Context, *proto.ListProductsRequest) (*proto.ListProductsResponse, error)
+	// 33. Delete a Product without licenses (Owner)
+	DeleteProduct(context.Context, *proto.DeleteProductRequest) (*emptypb.Empty, error)
+	// 34. Get the latest Release of a Product for self-updating clients
+	GetLatestVersion(context.Context, *proto.GetLatestVersionRequest) (*proto.Release, error)
+	// 35. Publish a Release on one of a Product's channels (Owner)
+	PublishRelease(context.Context, *proto.PublishReleaseRequest) (*proto.Release, error)
+	// 36. Pin a License to an update channel (Admin)
+	SetLicenseChannel(context.Context, *proto.SetLicenseChannelRequest) (*proto.License, error)
+	// 37. Create a Customer (Admin)
+	CreateCustomer(context.Context, *proto.CreateCustomerRequest) (*proto.Customer, error)
+	// 38. List or look up Customers (Admin)
+	ListCustomers(context.Context, *proto.ListCustomersRequest) (*proto.ListCustomersResponse, error)
+	// 39. Attach a License to a Customer (Admin)
+	AttachLicense(context.Context, *proto.AttachLicenseRequest) (*proto.License, error)
+	// 40. Detach a License from its Customer (Admin)
+	DetachLicense(context.Context, *proto.DetachLicenseRequest) (*proto.License, error)
+	// 41. Email a new or existing License key to a customer (Admin)
+	IssueLicenseToEmail(context.Context, *proto.IssueLicenseToEmailRequest) (*proto.IssueLicenseToEmailResponse, error)
+	// 42. List the emails sent for a License (Admin)
+	ListLicenseDeliveries(context.Context, *proto.ListLicenseDeliveriesRequest) (*proto.ListLicenseDeliveriesResponse, error)
+	// 43. Start a self-service trial of a Product on this device
+	CreateTrialLicense(context.Context, *proto.CreateTrialLicenseRequest) (*proto.CreateTrialLicenseResponse, error)
+	// 44. Push a License's expiry forward (Admin)
+	ExtendLicense(context.Context, *proto.ExtendLicenseRequest) (*proto.License, error)
+	// 45. Extend a License the reseller generated, for one credit (Reseller, x-reseller-key header)
+	ResellerExtendLicense(context.Context, *proto.ExtendLicenseRequest) (*proto.ResellerExtendLicenseResponse, error)
+	// 46. Suspend a License, with a reason shown to its users (Admin)
+	SuspendLicense(context.Context, *proto.SuspendLicenseRequest) (*proto.License, error)
+	// 47. Reactivate a suspended License (Admin)
+	UnsuspendLicense(context.Context, *proto.UnsuspendLicenseRequest) (*proto.License, error)
+	// 48. Ban a HWID from validating any License (Admin)
+	BanHwid(context.Context, *proto.BanHwidRequest) (*proto.HwidBan, error)
+	// 49. Lift a HWID ban (Admin)
+	UnbanHwid(context.Context, *proto.UnbanHwidRequest) (*emptypb.Empty, error)
+	// 50. List banned HWIDs (Admin)
+	ListHwidBans(context.Context, *proto.ListHwidBansRequest) (*proto.ListHwidBansResponse, error)
+	// 51. Ban an IP address or CIDR range from the public endpoints (Admin)
+	BanIp(context.Context, *proto.BanIpRequest) (*proto.IpBan, error)
+	// 52. Lift an IP ban (Admin)
+	UnbanIp(context.Context, *proto.UnbanIpRequest) (*emptypb.Empty, error)
+	// 53. List IP bans in force (Admin)
+	ListIpBans(context.Context, *proto.ListIpBansRequest) (*proto.ListIpBansResponse, error)
+	// 54. Set the countries a License may be used from (Admin)
+	SetLicenseCountries(context.Context, *proto.SetLicenseCountriesRequest) (*proto.License, error)
+	// 55. Lift validation lockouts of a License key and/or a client IP (Admin)
+	ClearLockouts(context.Context, *proto.ClearLockoutsRequest) (*proto.ClearLockoutsResponse, error)
+	// 56. Turn on signed requests for a Product, or replace its secret (Admin)
+	RotateProductSigningSecret(context.Context, *proto.RotateProductSigningSecretRequest) (*proto.RotateProductSigningSecretResponse, error)
+	// 57. Turn off signed requests for a Product (Admin)
+	RemoveProductSigningSecret(context.Context, *proto.RemoveProductSigningSecretRequest) (*proto.Product, error)
+	// 58. Get a single-use challenge to send with ValidateLicense (Public)
+	GetChallenge(context.Context, *proto.GetChallengeRequest) (*proto.GetChallengeResponse, error)
+	// 59. Revoke every refresh token issued for an API key (Admin)
+	RevokeRefreshTokens(context.Context, *proto.RevokeRefreshTokensRequest) (*proto.RevokeRefreshTokensResponse, error)
+	// 60. Issue a new shared admin secret and expire the old ones (Admin)
+	RotateAdminSecret(context.Context, *proto.RotateAdminSecretRequest) (*proto.RotateAdminSecretResponse, error)
+	// 61. Set up a TOTP second factor for the caller (Read-only)
+	EnrollAdminTotp(context.Context, *proto.EnrollAdminTotpRequest) (*proto.EnrollAdminTotpResponse, error)
+	// 62. Export the audit log as NDJSON or CSV (Admin)
+	ExportAuditLog(context.Context, *proto.ExportAuditLogRequest, *connect.ServerStream[httpbody.HttpBody]) error
+	// 63. Validation and token statistics per day (Admin)
+	GetStats(context.Context, *proto.GetStatsRequest) (*proto.GetStatsResponse, error)
+	// 64. List ValidateLicense attempts (Admin)
+	ListValidationEvents(context.Context, *proto.ListValidationEventsRequest) (*proto.ListValidationEventsResponse, error)
+	// 65. Find licenses from partial details (Admin)
+	SearchLicenses(context.Context, *proto.SearchLicensesRequest) (*proto.SearchLicensesResponse, error)
+	// 66. Undo DeleteLicense (Admin)
+	RestoreLicense(context.Context, *proto.RestoreLicenseRequest) (*proto.License, error)
+	// 67. Remove a deleted license for good (Admin)
+	PurgeLicense(context.Context, *proto.PurgeLicenseRequest) (*emptypb.Empty, error)
+	// 68. Changes to a license, newest first (Admin)
+	GetLicenseHistory(context.Context, *proto.GetLicenseHistoryRequest) (*proto.GetLicenseHistoryResponse, error)
+	// 69. Import licenses, devices and customers from another license system (Admin)
+	ImportExternalLicenses(context.Context, *proto.ImportExternalLicensesRequest) (*proto.ImportLicensesResponse, error)
+	// 70. Suspend every active license of a product (Admin)
+	BulkSuspendByProduct(context.Context, *proto.BulkSuspendByProductRequest) (*proto.BulkOperationResponse, error)
+	// 71. Delete every license of a product (Admin). Restorable until purged.
+	BulkDeleteByProduct(context.Context, *proto.BulkDeleteByProductRequest) (*proto.BulkOperationResponse, error)
+	// 72. Extend every expiring license of a product (Admin)
+	BulkExtendByProduct(context.Context, *proto.BulkExtendByProductRequest) (*proto.BulkOperationResponse, error)
+	// 73. Turn maintenance mode on or off (Admin)
+	SetMaintenanceMode(context.Context, *proto.SetMaintenanceModeRequest) (*proto.MaintenanceMode, error)
+	// 74. Show whether maintenance mode is on (Admin)
+	GetMaintenanceMode(context.Context, *emptypb.Empty) (*proto.MaintenanceMode, error)
+	// 75. Replace a Product's wording of validation messages (Owner)
+	SetProductMessages(context.Context, *proto.SetProductMessagesRequest) (*proto.ProductMessages, error)
+	// 76. Show a Product's wording of validation messages (Admin)
+	GetProductMessages(context.Context, *proto.GetProductMessagesRequest) (*proto.ProductMessages, error)
+	// 77. List the devices bound to a License, for its owner (Public)
+	ListMyDevices(context.Context, *proto.ListMyDevicesRequest) (*proto.ListMyDevicesResponse, error)
+	// 78. Unbind one of a License's devices, freeing its seat, for its owner
+	// (Public)
+	DeactivateDevice(context.Context, *proto.DeactivateDeviceRequest) (*emptypb.Empty, error)
+	// 79. Set the size of a License's floating pool (Admin)
+	SetLicenseFloatingSeats(context.Context, *proto.SetLicenseFloatingSeatsRequest) (*proto.License, error)
+	// 80. Check out a seat of a floating License for this machine, validating
+	// the license
+	CheckoutLicense(context.Context, *proto.CheckoutLicenseRequest) (*proto.CheckoutLicenseResponse, error)
+	// 81. Check a floating seat back in, freeing it for another machine
+	CheckinLicense(context.Context, *proto.CheckinLicenseRequest) (*emptypb.Empty, error)
+	// 82. Spend credits of a pay-per-use License, validating it
+	ConsumeCredits(context.Context, *proto.ConsumeCreditsRequest) (*proto.ConsumeCreditsResponse, error)
+	// 83. Add credits to a License, or take them back (Admin)
+	TopUpLicenseCredits(context.Context, *proto.TopUpLicenseCreditsRequest) (*proto.License, error)
+	// 84. List License Credit Activity (Admin)
+	ListLicenseCreditActivity(context.Context, *proto.ListLicenseCreditActivityRequest) (*proto.ListLicenseCreditActivityResponse, error)
+	// 85. Set the feature flags of a License, on top of its Product's (Admin)
+	SetLicenseFeatures(context.Context, *proto.SetLicenseFeaturesRequest) (*proto.License, error)
+	// 86. Create a Plan licenses can be put on (Owner)
+	CreatePlan(context.Context, *proto.CreatePlanRequest) (*proto.Plan, error)
+	// 87. Update a Plan (Owner)
+	UpdatePlan(context.Context, *proto.UpdatePlanRequest) (*proto.Plan, error)
+	// 88. List Plans (Admin)
+	ListPlans(context.Context, *proto.ListPlansRequest) (*proto.ListPlansResponse, error)
+	// 89. Delete a Plan without licenses (Owner)
+	DeletePlan(context.Context, *proto.DeletePlanRequest) (*emptypb.Empty, error)
+	// 90. Get the server's clock, e.g. to correct signed request timestamps (Public)
+	GetServerTime(context.Context, *proto.GetServerTimeRequest) (*proto.GetServerTimeResponse, error)
+	// 91. Get the public key that validation answers and license files are signed with (Public)
+	GetPublicKey(context.Context, *proto.GetPublicKeyRequest) (*proto.GetPublicKeyResponse, error)
+	// 92. List the public keys clients should accept, current and retired, JWKS-style (Public)
+	GetSigningKeys(context.Context, *proto.GetSigningKeysRequest) (*proto.GetSigningKeysResponse, error)
+}
+
+// NewWhitelistServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewWhitelistServiceHandler(svc WhitelistServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	whitelistServiceMethods := proto.File_proto_whitelist_proto.Services().ByName("WhitelistService").Methods()
+	whitelistServiceGetAuthTokenHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceGetAuthTokenProcedure,
+		svc.GetAuthToken,
+		connect.WithSchema(whitelistServiceMethods.ByName("GetAuthToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceValidateLicenseHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceValidateLicenseProcedure,
+		svc.ValidateLicense,
+		connect.WithSchema(whitelistServiceMethods.ByName("ValidateLicense")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceUpdateLicenseHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceUpdateLicenseProcedure,
+		svc.UpdateLicense,
+		connect.WithSchema(whitelistServiceMethods.ByName("UpdateLicense")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceDeleteLicenseHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceDeleteLicenseProcedure,
+		svc.DeleteLicense,
+		connect.WithSchema(whitelistServiceMethods.ByName("DeleteLicense")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceGetLicenseHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceGetLicenseProcedure,
+		svc.GetLicense,
+		connect.WithSchema(whitelistServiceMethods.ByName("GetLicense")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceListLicensesHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceListLicensesProcedure,
+		svc.ListLicenses,
+		connect.WithSchema(whitelistServiceMethods.ByName("ListLicenses")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceResetHwidHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceResetHwidProcedure,
+		svc.ResetHwid,
+		connect.WithSchema(whitelistServiceMethods.ByName("ResetHwid")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceGenerateLicensesHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceGenerateLicensesProcedure,
+		svc.GenerateLicenses,
+		connect.WithSchema(whitelistServiceMethods.ByName("GenerateLicenses")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceBatchUpsertLicensesHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceBatchUpsertLicensesProcedure,
+		svc.BatchUpsertLicenses,
+		connect.WithSchema(whitelistServiceMethods.ByName("BatchUpsertLicenses")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceExportLicensesHandler := connect.NewServerStreamHandlerSimple(
+		WhitelistServiceExportLicensesProcedure,
+		svc.ExportLicenses,
+		connect.WithSchema(whitelistServiceMethods.ByName("ExportLicenses")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceImportLicensesHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceImportLicensesProcedure,
+		svc.ImportLicenses,
+		connect.WithSchema(whitelistServiceMethods.ByName("ImportLicenses")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceListAuditEventsHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceListAuditEventsProcedure,
+		svc.ListAuditEvents,
+		connect.WithSchema(whitelistServiceMethods.ByName("ListAuditEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceResellerGenerateLicenseHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceResellerGenerateLicenseProcedure,
+		svc.ResellerGenerateLicense,
+		connect.WithSchema(whitelistServiceMethods.ByName("ResellerGenerateLicense")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceCreateResellerHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceCreateResellerProcedure,
+		svc.CreateReseller,
+		connect.WithSchema(whitelistServiceMethods.ByName("CreateReseller")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceTopUpResellerCreditsHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceTopUpResellerCreditsProcedure,
+		svc.TopUpResellerCredits,
+		connect.WithSchema(whitelistServiceMethods.ByName("TopUpResellerCredits")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceListResellerActivityHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceListResellerActivityProcedure,
+		svc.ListResellerActivity,
+		connect.WithSchema(whitelistServiceMethods.ByName("ListResellerActivity")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceAdminLoginHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceAdminLoginProcedure,
+		svc.AdminLogin,
+		connect.WithSchema(whitelistServiceMethods.ByName("AdminLogin")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceCreateAdminHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceCreateAdminProcedure,
+		svc.CreateAdmin,
+		connect.WithSchema(whitelistServiceMethods.ByName("CreateAdmin")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceUpdateAdminHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceUpdateAdminProcedure,
+		svc.UpdateAdmin,
+		connect.WithSchema(whitelistServiceMethods.ByName("UpdateAdmin")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceListAdminsHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceListAdminsProcedure,
+		svc.ListAdmins,
+		connect.WithSchema(whitelistServiceMethods.ByName("ListAdmins")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceAdminLogoutHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceAdminLogoutProcedure,
+		svc.AdminLogout,
+		connect.WithSchema(whitelistServiceMethods.ByName("AdminLogout")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceListAdminSessionsHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceListAdminSessionsProcedure,
+		svc.ListAdminSessions,
+		connect.WithSchema(whitelistServiceMethods.ByName("ListAdminSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceRevokeAdminSessionHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceRevokeAdminSessionProcedure,
+		svc.RevokeAdminSession,
+		connect.WithSchema(whitelistServiceMethods.ByName("RevokeAdminSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceExportLicenseFileHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceExportLicenseFileProcedure,
+		svc.ExportLicenseFile,
+		connect.WithSchema(whitelistServiceMethods.ByName("ExportLicenseFile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceStartSessionHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceStartSessionProcedure,
+		svc.StartSession,
+		connect.WithSchema(whitelistServiceMethods.ByName("StartSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceHeartbeatHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceHeartbeatProcedure,
+		svc.Heartbeat,
+		connect.WithSchema(whitelistServiceMethods.ByName("Heartbeat")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceEndSessionHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceEndSessionProcedure,
+		svc.EndSession,
+		connect.WithSchema(whitelistServiceMethods.ByName("EndSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceWatchLicenseHandler := connect.NewServerStreamHandlerSimple(
+		WhitelistServiceWatchLicenseProcedure,
+		svc.WatchLicense,
+		connect.WithSchema(whitelistServiceMethods.ByName("WatchLicense")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceValidateLicensesHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceValidateLicensesProcedure,
+		svc.ValidateLicenses,
+		connect.WithSchema(whitelistServiceMethods.ByName("ValidateLicenses")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceCreateProductHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceCreateProductProcedure,
+		svc.CreateProduct,
+		connect.WithSchema(whitelistServiceMethods.ByName("CreateProduct")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceUpdateProductHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceUpdateProductProcedure,
+		svc.UpdateProduct,
+		connect.WithSchema(whitelistServiceMethods.ByName("UpdateProduct")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceListProductsHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceListProductsProcedure,
+		svc.ListProducts,
+		connect.WithSchema(whitelistServiceMethods.ByName("ListProducts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceDeleteProductHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceDeleteProductProcedure,
+		svc.DeleteProduct,
+		connect.WithSchema(whitelistServiceMethods.ByName("DeleteProduct")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceGetLatestVersionHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceGetLatestVersionProcedure,
+		svc.GetLatestVersion,
+		connect.WithSchema(whitelistServiceMethods.ByName("GetLatestVersion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServicePublishReleaseHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServicePublishReleaseProcedure,
+		svc.PublishRelease,
+		connect.WithSchema(whitelistServiceMethods.ByName("PublishRelease")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceSetLicenseChannelHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceSetLicenseChannelProcedure,
+		svc.SetLicenseChannel,
+		connect.WithSchema(whitelistServiceMethods.ByName("SetLicenseChannel")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceCreateCustomerHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceCreateCustomerProcedure,
+		svc.CreateCustomer,
+		connect.WithSchema(whitelistServiceMethods.ByName("CreateCustomer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceListCustomersHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceListCustomersProcedure,
+		svc.ListCustomers,
+		connect.WithSchema(whitelistServiceMethods.ByName("ListCustomers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceAttachLicenseHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceAttachLicenseProcedure,
+		svc.AttachLicense,
+		connect.WithSchema(whitelistServiceMethods.ByName("AttachLicense")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceDetachLicenseHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceDetachLicenseProcedure,
+		svc.DetachLicense,
+		connect.WithSchema(whitelistServiceMethods.ByName("DetachLicense")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceIssueLicenseToEmailHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceIssueLicenseToEmailProcedure,
+		svc.IssueLicenseToEmail,
+		connect.WithSchema(whitelistServiceMethods.ByName("IssueLicenseToEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceListLicenseDeliveriesHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceListLicenseDeliveriesProcedure,
+		svc.ListLicenseDeliveries,
+		connect.WithSchema(whitelistServiceMethods.ByName("ListLicenseDeliveries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceCreateTrialLicenseHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceCreateTrialLicenseProcedure,
+		svc.CreateTrialLicense,
+		connect.WithSchema(whitelistServiceMethods.ByName("CreateTrialLicense")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceExtendLicenseHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceExtendLicenseProcedure,
+		svc.ExtendLicense,
+		connect.WithSchema(whitelistServiceMethods.ByName("ExtendLicense")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceResellerExtendLicenseHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceResellerExtendLicenseProcedure,
+		svc.ResellerExtendLicense,
+		connect.WithSchema(whitelistServiceMethods.ByName("ResellerExtendLicense")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceSuspendLicenseHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceSuspendLicenseProcedure,
+		svc.SuspendLicense,
+		connect.WithSchema(whitelistServiceMethods.ByName("SuspendLicense")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceUnsuspendLicenseHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceUnsuspendLicenseProcedure,
+		svc.UnsuspendLicense,
+		connect.WithSchema(whitelistServiceMethods.ByName("UnsuspendLicense")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceBanHwidHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceBanHwidProcedure,
+		svc.BanHwid,
+		connect.WithSchema(whitelistServiceMethods.ByName("BanHwid")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceUnbanHwidHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceUnbanHwidProcedure,
+		svc.UnbanHwid,
+		connect.WithSchema(whitelistServiceMethods.ByName("UnbanHwid")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceListHwidBansHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceListHwidBansProcedure,
+		svc.ListHwidBans,
+		connect.WithSchema(whitelistServiceMethods.ByName("ListHwidBans")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceBanIpHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceBanIpProcedure,
+		svc.BanIp,
+		connect.WithSchema(whitelistServiceMethods.ByName("BanIp")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceUnbanIpHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceUnbanIpProcedure,
+		svc.UnbanIp,
+		connect.WithSchema(whitelistServiceMethods.ByName("UnbanIp")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceListIpBansHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceListIpBansProcedure,
+		svc.ListIpBans,
+		connect.WithSchema(whitelistServiceMethods.ByName("ListIpBans")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceSetLicenseCountriesHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceSetLicenseCountriesProcedure,
+		svc.SetLicenseCountries,
+		connect.WithSchema(whitelistServiceMethods.ByName("SetLicenseCountries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceClearLockoutsHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceClearLockoutsProcedure,
+		svc.ClearLockouts,
+		connect.WithSchema(whitelistServiceMethods.ByName("ClearLockouts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceRotateProductSigningSecretHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceRotateProductSigningSecretProcedure,
+		svc.RotateProductSigningSecret,
+		connect.WithSchema(whitelistServiceMethods.ByName("RotateProductSigningSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceRemoveProductSigningSecretHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceRemoveProductSigningSecretProcedure,
+		svc.RemoveProductSigningSecret,
+		connect.WithSchema(whitelistServiceMethods.ByName("RemoveProductSigningSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceGetChallengeHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceGetChallengeProcedure,
+		svc.GetChallenge,
+		connect.WithSchema(whitelistServiceMethods.ByName("GetChallenge")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceRevokeRefreshTokensHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceRevokeRefreshTokensProcedure,
+		svc.RevokeRefreshTokens,
+		connect.WithSchema(whitelistServiceMethods.ByName("RevokeRefreshTokens")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceRotateAdminSecretHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceRotateAdminSecretProcedure,
+		svc.RotateAdminSecret,
+		connect.WithSchema(whitelistServiceMethods.ByName("RotateAdminSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceEnrollAdminTotpHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceEnrollAdminTotpProcedure,
+		svc.EnrollAdminTotp,
+		connect.WithSchema(whitelistServiceMethods.ByName("EnrollAdminTotp")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceExportAuditLogHandler := connect.NewServerStreamHandlerSimple(
+		WhitelistServiceExportAuditLogProcedure,
+		svc.ExportAuditLog,
+		connect.WithSchema(whitelistServiceMethods.ByName("ExportAuditLog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceGetStatsHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceGetStatsProcedure,
+		svc.GetStats,
+		connect.WithSchema(whitelistServiceMethods.ByName("GetStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceListValidationEventsHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceListValidationEventsProcedure,
+		svc.ListValidationEvents,
+		connect.WithSchema(whitelistServiceMethods.ByName("ListValidationEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceSearchLicensesHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceSearchLicensesProcedure,
+		svc.SearchLicenses,
+		connect.WithSchema(whitelistServiceMethods.ByName("SearchLicenses")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceRestoreLicenseHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceRestoreLicenseProcedure,
+		svc.RestoreLicense,
+		connect.WithSchema(whitelistServiceMethods.ByName("RestoreLicense")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServicePurgeLicenseHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServicePurgeLicenseProcedure,
+		svc.PurgeLicense,
+		connect.WithSchema(whitelistServiceMethods.ByName("PurgeLicense")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceGetLicenseHistoryHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceGetLicenseHistoryProcedure,
+		svc.GetLicenseHistory,
+		connect.WithSchema(whitelistServiceMethods.ByName("GetLicenseHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceImportExternalLicensesHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceImportExternalLicensesProcedure,
+		svc.ImportExternalLicenses,
+		connect.WithSchema(whitelistServiceMethods.ByName("ImportExternalLicenses")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceBulkSuspendByProductHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceBulkSuspendByProductProcedure,
+		svc.BulkSuspendByProduct,
+		connect.WithSchema(whitelistServiceMethods.ByName("BulkSuspendByProduct")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceBulkDeleteByProductHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceBulkDeleteByProductProcedure,
+		svc.BulkDeleteByProduct,
+		connect.WithSchema(whitelistServiceMethods.ByName("BulkDeleteByProduct")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceBulkExtendByProductHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceBulkExtendByProductProcedure,
+		svc.BulkExtendByProduct,
+		connect.WithSchema(whitelistServiceMethods.ByName("BulkExtendByProduct")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceSetMaintenanceModeHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceSetMaintenanceModeProcedure,
+		svc.SetMaintenanceMode,
+		connect.WithSchema(whitelistServiceMethods.ByName("SetMaintenanceMode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceGetMaintenanceModeHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceGetMaintenanceModeProcedure,
+		svc.GetMaintenanceMode,
+		connect.WithSchema(whitelistServiceMethods.ByName("GetMaintenanceMode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceSetProductMessagesHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceSetProductMessagesProcedure,
+		svc.SetProductMessages,
+		connect.WithSchema(whitelistServiceMethods.ByName("SetProductMessages")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceGetProductMessagesHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceGetProductMessagesProcedure,
+		svc.GetProductMessages,
+		connect.WithSchema(whitelistServiceMethods.ByName("GetProductMessages")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceListMyDevicesHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceListMyDevicesProcedure,
+		svc.ListMyDevices,
+		connect.WithSchema(whitelistServiceMethods.ByName("ListMyDevices")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceDeactivateDeviceHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceDeactivateDeviceProcedure,
+		svc.DeactivateDevice,
+		connect.WithSchema(whitelistServiceMethods.ByName("DeactivateDevice")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceSetLicenseFloatingSeatsHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceSetLicenseFloatingSeatsProcedure,
+		svc.SetLicenseFloatingSeats,
+		connect.WithSchema(whitelistServiceMethods.ByName("SetLicenseFloatingSeats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceCheckoutLicenseHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceCheckoutLicenseProcedure,
+		svc.CheckoutLicense,
+		connect.WithSchema(whitelistServiceMethods.ByName("CheckoutLicense")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceCheckinLicenseHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceCheckinLicenseProcedure,
+		svc.CheckinLicense,
+		connect.WithSchema(whitelistServiceMethods.ByName("CheckinLicense")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceConsumeCreditsHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceConsumeCreditsProcedure,
+		svc.ConsumeCredits,
+		connect.WithSchema(whitelistServiceMethods.ByName("ConsumeCredits")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceTopUpLicenseCreditsHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceTopUpLicenseCreditsProcedure,
+		svc.TopUpLicenseCredits,
+		connect.WithSchema(whitelistServiceMethods.ByName("TopUpLicenseCredits")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceListLicenseCreditActivityHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceListLicenseCreditActivityProcedure,
+		svc.ListLicenseCreditActivity,
+		connect.WithSchema(whitelistServiceMethods.ByName("ListLicenseCreditActivity")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceSetLicenseFeaturesHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceSetLicenseFeaturesProcedure,
+		svc.SetLicenseFeatures,
+		connect.WithSchema(whitelistServiceMethods.ByName("SetLicenseFeatures")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceCreatePlanHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceCreatePlanProcedure,
+		svc.CreatePlan,
+		connect.WithSchema(whitelistServiceMethods.ByName("CreatePlan")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceUpdatePlanHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceUpdatePlanProcedure,
+		svc.UpdatePlan,
+		connect.WithSchema(whitelistServiceMethods.ByName("UpdatePlan")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceListPlansHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceListPlansProcedure,
+		svc.ListPlans,
+		connect.WithSchema(whitelistServiceMethods.ByName("ListPlans")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceDeletePlanHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceDeletePlanProcedure,
+		svc.DeletePlan,
+		connect.WithSchema(whitelistServiceMethods.ByName("DeletePlan")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceGetServerTimeHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceGetServerTimeProcedure,
+		svc.GetServerTime,
+		connect.WithSchema(whitelistServiceMethods.ByName("GetServerTime")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceGetPublicKeyHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceGetPublicKeyProcedure,
+		svc.GetPublicKey,
+		connect.WithSchema(whitelistServiceMethods.ByName("GetPublicKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceGetSigningKeysHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceGetSigningKeysProcedure,
+		svc.GetSigningKeys,
+		connect.WithSchema(whitelistServiceMethods.ByName("GetSigningKeys")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/whitelist.WhitelistService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case WhitelistServiceGetAuthTokenProcedure:
+			whitelistServiceGetAuthTokenHandler.ServeHTTP(w, r)
+		case WhitelistServiceValidateLicenseProcedure:
+			whitelistServiceValidateLicenseHandler.ServeHTTP(w, r)
+		case WhitelistServiceUpdateLicenseProcedure:
+			whitelistServiceUpdateLicenseHandler.ServeHTTP(w, r)
+		case WhitelistServiceDeleteLicenseProcedure:
+			whitelistServiceDeleteLicenseHandler.ServeHTTP(w, r)
+		case WhitelistServiceGetLicenseProcedure:
+			whitelistServiceGetLicenseHandler.ServeHTTP(w, r)
+		case WhitelistServiceListLicensesProcedure:
+			whitelistServiceListLicensesHandler.ServeHTTP(w, r)
+		case WhitelistServiceResetHwidProcedure:
+			whitelistServiceResetHwidHandler.ServeHTTP(w, r)
+		case WhitelistServiceGenerateLicensesProcedure:
+			whitelistServiceGenerateLicensesHandler.ServeHTTP(w, r)
+		case WhitelistServiceBatchUpsertLicensesProcedure:
+			whitelistServiceBatchUpsertLicensesHandler.ServeHTTP(w, r)
+		case WhitelistServiceExportLicensesProcedure:
+			whitelistServiceExportLicensesHandler.ServeHTTP(w, r)
+		case WhitelistServiceImportLicensesProcedure:
+			whitelistServiceImportLicensesHandler.ServeHTTP(w, r)
+		case WhitelistServiceListAuditEventsProcedure:
+			whitelistServiceListAuditEventsHandler.ServeHTTP(w, r)
+		case WhitelistServiceResellerGenerateLicenseProcedure:
+			whitelistServiceResellerGenerateLicenseHandler.ServeHTTP(w, r)
+		case WhitelistServiceCreateResellerProcedure:
+			whitelistServiceCreateResellerHandler.ServeHTTP(w, r)
+		case WhitelistServiceTopUpResellerCreditsProcedure:
+			whitelistServiceTopUpResellerCreditsHandler.ServeHTTP(w, r)
+		case WhitelistServiceListResellerActivityProcedure:
+			whitelistServiceListResellerActivityHandler.ServeHTTP(w, r)
+		case WhitelistServiceAdminLoginProcedure:
+			whitelistServiceAdminLoginHandler.ServeHTTP(w, r)
+		case WhitelistServiceCreateAdminProcedure:
+			whitelistServiceCreateAdminHandler.ServeHTTP(w, r)
+		case WhitelistServiceUpdateAdminProcedure:
+			whitelistServiceUpdateAdminHandler.ServeHTTP(w, r)
+		case WhitelistServiceListAdminsProcedure:
+			whitelistServiceListAdminsHandler.ServeHTTP(w, r)
+		case WhitelistServiceAdminLogoutProcedure:
+			whitelistServiceAdminLogoutHandler.ServeHTTP(w, r)
+		case WhitelistServiceListAdminSessionsProcedure:
+			whitelistServiceListAdminSessionsHandler.ServeHTTP(w, r)
+		case WhitelistServiceRevokeAdminSessionProcedure:
+			whitelistServiceRevokeAdminSessionHandler.ServeHTTP(w, r)
+		case WhitelistServiceExportLicenseFileProcedure:
+			whitelistServiceExportLicenseFileHandler.ServeHTTP(w, r)
+		case WhitelistServiceStartSessionProcedure:
+			whitelistServiceStartSessionHandler.ServeHTTP(w, r)
+		case WhitelistServiceHeartbeatProcedure:
+			whitelistServiceHeartbeatHandler.ServeHTTP(w, r)
+		case WhitelistServiceEndSessionProcedure:
+			whitelistServiceEndSessionHandler.ServeHTTP(w, r)
+		case WhitelistServiceWatchLicenseProcedure:
+			whitelistServiceWatchLicenseHandler.ServeHTTP(w, r)
+		case WhitelistServiceValidateLicensesProcedure:
+			whitelistServiceValidateLicensesHandler.ServeHTTP(w, r)
+		case WhitelistServiceCreateProductProcedure:
+			whitelistServiceCreateProductHandler.ServeHTTP(w, r)
+		case WhitelistServiceUpdateProductProcedure:
+			whitelistServiceUpdateProductHandler.ServeHTTP(w, r)
+		case WhitelistServiceListProductsProcedure:
+			whitelistServiceListProductsHandler.ServeHTTP(w, r)
+		case WhitelistServiceDeleteProductProcedure:
+			whitelistServiceDeleteProductHandler.ServeHTTP(w, r)
+		case WhitelistServiceGetLatestVersionProcedure:
+			whitelistServiceGetLatestVersionHandler.ServeHTTP(w, r)
+		case WhitelistServicePublishReleaseProcedure:
+			whitelistServicePublishReleaseHandler.ServeHTTP(w, r)
+		case WhitelistServiceSetLicenseChannelProcedure:
+			whitelistServiceSetLicenseChannelHandler.ServeHTTP(w, r)
+		case WhitelistServiceCreateCustomerProcedure:
+			whitelistServiceCreateCustomerHandler.ServeHTTP(w, r)
+		case WhitelistServiceListCustomersProcedure:
+			whitelistServiceListCustomersHandler.ServeHTTP(w, r)
+		case WhitelistServiceAttachLicenseProcedure:
+			whitelistServiceAttachLicenseHandler.ServeHTTP(w, r)
+		case WhitelistServiceDetachLicenseProcedure:
+			whitelistServiceDetachLicenseHandler.ServeHTTP(w, r)
+		case WhitelistServiceIssueLicenseToEmailProcedure:
+			whitelistServiceIssueLicenseToEmailHandler.ServeHTTP(w, r)
+		case WhitelistServiceListLicenseDeliveriesProcedure:
+			whitelistServiceListLicenseDeliveriesHandler.ServeHTTP(w, r)
+		case WhitelistServiceCreateTrialLicenseProcedure:
+			whitelistServiceCreateTrialLicenseHandler.ServeHTTP(w, r)
+		case WhitelistServiceExtendLicenseProcedure:
+			whitelistServiceExtendLicenseHandler.ServeHTTP(w, r)
+		case WhitelistServiceResellerExtendLicenseProcedure:
+			whitelistServiceResellerExtendLicenseHandler.ServeHTTP(w, r)
+		case WhitelistServiceSuspendLicenseProcedure:
+			whitelistServiceSuspendLicenseHandler.ServeHTTP(w, r)
+		case WhitelistServiceUnsuspendLicenseProcedure:
+			whitelistServiceUnsuspendLicenseHandler.ServeHTTP(w, r)
+		case WhitelistServiceBanHwidProcedure:
+			whitelistServiceBanHwidHandler.ServeHTTP(w, r)
+		case WhitelistServiceUnbanHwidProcedure:
+			whitelistServiceUnbanHwidHandler.ServeHTTP(w, r)
+		case WhitelistServiceListHwidBansProcedure:
+			whitelistServiceListHwidBansHandler.ServeHTTP(w, r)
+		case WhitelistServiceBanIpProcedure:
+			whitelistServiceBanIpHandler.ServeHTTP(w, r)
+		case WhitelistServiceUnbanIpProcedure:
+			whitelistServiceUnbanIpHandler.ServeHTTP(w, r)
+		case WhitelistServiceListIpBansProcedure:
+			whitelistServiceListIpBansHandler.ServeHTTP(w, r)
+		case WhitelistServiceSetLicenseCountriesProcedure:
+			whitelistServiceSetLicenseCountriesHandler.ServeHTTP(w, r)
+		case WhitelistServiceClearLockoutsProcedure:
+			whitelistServiceClearLockoutsHandler.ServeHTTP(w, r)
+		case WhitelistServiceRotateProductSigningSecretProcedure:
+			whitelistServiceRotateProductSigningSecretHandler.ServeHTTP(w, r)
+		case WhitelistServiceRemoveProductSigningSecretProcedure:
+			whitelistServiceRemoveProductSigningSecretHandler.ServeHTTP(w, r)
+		case WhitelistServiceGetChallengeProcedure:
+			whitelistServiceGetChallengeHandler.ServeHTTP(w, r)
+		case WhitelistServiceRevokeRefreshTokensProcedure:
+			whitelistServiceRevokeRefreshTokensHandler.ServeHTTP(w, r)
+		case WhitelistServiceRotateAdminSecretProcedure:
+			whitelistServiceRotateAdminSecretHandler.ServeHTTP(w, r)
+		case WhitelistServiceEnrollAdminTotpProcedure:
+			whitelistServiceEnrollAdminTotpHandler.ServeHTTP(w, r)
+		case WhitelistServiceExportAuditLogProcedure:
+			whitelistServiceExportAuditLogHandler.ServeHTTP(w, r)
+		case WhitelistServiceGetStatsProcedure:
+			whitelistServiceGetStatsHandler.ServeHTTP(w, r)
+		case WhitelistServiceListValidationEventsProcedure:
+			whitelistServiceListValidationEventsHandler.ServeHTTP(w, r)
+		case WhitelistServiceSearchLicensesProcedure:
+			whitelistServiceSearchLicensesHandler.ServeHTTP(w, r)
+		case WhitelistServiceRestoreLicenseProcedure:
+			whitelistServiceRestoreLicenseHandler.ServeHTTP(w, r)
+		case WhitelistServicePurgeLicenseProcedure:
+			whitelistServicePurgeLicenseHandler.ServeHTTP(w, r)
+		case WhitelistServiceGetLicenseHistoryProcedure:
+			whitelistServiceGetLicenseHistoryHandler.ServeHTTP(w, r)
+		case WhitelistServiceImportExternalLicensesProcedure:
+			whitelistServiceImportExternalLicensesHandler.ServeHTTP(w, r)
+		case WhitelistServiceBulkSuspendByProductProcedure:
+			whitelistServiceBulkSuspendByProductHandler.ServeHTTP(w, r)
+		case WhitelistServiceBulkDeleteByProductProcedure:
+			whitelistServiceBulkDeleteByProductHandler.ServeHTTP(w, r)
+		case WhitelistServiceBulkExtendByProductProcedure:
+			whitelistServiceBulkExtendByProductHandler.ServeHTTP(w, r)
+		case WhitelistServiceSetMaintenanceModeProcedure:
+			whitelistServiceSetMaintenanceModeHandler.ServeHTTP(w, r)
+		case WhitelistServiceGetMaintenanceModeProcedure:
+			whitelistServiceGetMaintenanceModeHandler.ServeHTTP(w, r)
+		case WhitelistServiceSetProductMessagesProcedure:
+			whitelistServiceSetProductMessagesHandler.ServeHTTP(w, r)
+		case WhitelistServiceGetProductMessagesProcedure:
+			whitelistServiceGetProductMessagesHandler.ServeHTTP(w, r)
+		case WhitelistServiceListMyDevicesProcedure:
+			whitelistServiceListMyDevicesHandler.ServeHTTP(w, r)
+		case WhitelistServiceDeactivateDeviceProcedure:
+			whitelistServiceDeactivateDeviceHandler.ServeHTTP(w, r)
+		case WhitelistServiceSetLicenseFloatingSeatsProcedure:
+			whitelistServiceSetLicenseFloatingSeatsHandler.ServeHTTP(w, r)
+		case WhitelistServiceCheckoutLicenseProcedure:
+			whitelistServiceCheckoutLicenseHandler.ServeHTTP(w, r)
+		case WhitelistServiceCheckinLicenseProcedure:
+			whitelistServiceCheckinLicenseHandler.ServeHTTP(w, r)
+		case WhitelistServiceConsumeCreditsProcedure:
+			whitelistServiceConsumeCreditsHandler.ServeHTTP(w, r)
+		case WhitelistServiceTopUpLicenseCreditsProcedure:
+			whitelistServiceTopUpLicenseCreditsHandler.ServeHTTP(w, r)
+		case WhitelistServiceListLicenseCreditActivityProcedure:
+			whitelistServiceListLicenseCreditActivityHandler.ServeHTTP(w, r)
+		case WhitelistServiceSetLicenseFeaturesProcedure:
+			whitelistServiceSetLicenseFeaturesHandler.ServeHTTP(w, r)
+		case WhitelistServiceCreatePlanProcedure:
+			whitelistServiceCreatePlanHandler.ServeHTTP(w, r)
+		case WhitelistServiceUpdatePlanProcedure:
+			whitelistServiceUpdatePlanHandler.ServeHTTP(w, r)
+		case WhitelistServiceListPlansProcedure:
+			whitelistServiceListPlansHandler.ServeHTTP(w, r)
+		case WhitelistServiceDeletePlanProcedure:
+			whitelistServiceDeletePlanHandler.ServeHTTP(w, r)
+		case WhitelistServiceGetServerTimeProcedure:
+			whitelistServiceGetServerTimeHandler.ServeHTTP(w, r)
+		case WhitelistServiceGetPublicKeyProcedure:
+			whitelistServiceGetPublicKeyHandler.ServeHTTP(w, r)
+		case WhitelistServiceGetSigningKeysProcedure:
+			whitelistServiceGetSigningKeysHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedWhitelistServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedWhitelistServiceHandler struct{}
+
+func (UnimplementedWhitelistServiceHandler) GetAuthToken(context.Context, *proto.GetTokenRequest) (*proto.AuthTokenResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.GetAuthToken is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ValidateLicense(context.Context, *proto.ValidateRequest) (*proto.ValidateResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ValidateLicense is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) UpdateLicense(context.Context, *proto.UpdateLicenseRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.UpdateLicense is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) DeleteLicense(context.Context, *proto.DeleteLicenseRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.DeleteLicense is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) GetLicense(context.Context, *proto.GetLicenseRequest) (*proto.License, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.GetLicense is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ListLicenses(context.Context, *proto.ListLicensesRequest) (*proto.ListLicensesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ListLicenses is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ResetHwid(context.Context, *proto.ResetHwidRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ResetHwid is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) GenerateLicenses(context.Context, *proto.GenerateLicensesRequest) (*proto.GenerateLicensesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.GenerateLicenses is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) BatchUpsertLicenses(context.Context, *proto.BatchUpsertLicensesRequest) (*proto.BatchUpsertLicensesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.BatchUpsertLicenses is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ExportLicenses(context.Context, *proto.ExportLicensesRequest, *connect.ServerStream[httpbody.HttpBody]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ExportLicenses is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ImportLicenses(context.Context, *proto.ImportLicensesRequest) (*proto.ImportLicensesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ImportLicenses is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ListAuditEvents(context.Context, *proto.ListAuditEventsRequest) (*proto.ListAuditEventsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ListAuditEvents is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ResellerGenerateLicense(context.Context, *proto.ResellerGenerateLicenseRequest) (*proto.ResellerGenerateLicenseResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ResellerGenerateLicense is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) CreateReseller(context.Context, *proto.CreateResellerRequest) (*proto.CreateResellerResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.CreateReseller is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) TopUpResellerCredits(context.Context, *proto.TopUpResellerCreditsRequest) (*proto.Reseller, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.TopUpResellerCredits is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ListResellerActivity(context.Context, *proto.ListResellerActivityRequest) (*proto.ListResellerActivityResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ListResellerActivity is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) AdminLogin(context.Context, *proto.AdminLoginRequest) (*proto.AdminLoginResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.AdminLogin is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) CreateAdmin(context.Context, *proto.CreateAdminRequest) (*proto.Admin, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.CreateAdmin is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) UpdateAdmin(context.Context, *proto.UpdateAdminRequest) (*proto.Admin, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.UpdateAdmin is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ListAdmins(context.Context, *proto.ListAdminsRequest) (*proto.ListAdminsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ListAdmins is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) AdminLogout(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.AdminLogout is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ListAdminSessions(context.Context, *proto.ListAdminSessionsRequest) (*proto.ListAdminSessionsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ListAdminSessions is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) RevokeAdminSession(context.Context, *proto.RevokeAdminSessionRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.RevokeAdminSession is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ExportLicenseFile(context.Context, *proto.ExportLicenseFileRequest) (*proto.ExportLicenseFileResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ExportLicenseFile is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) StartSession(context.Context, *proto.StartSessionRequest) (*proto.StartSessionResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.StartSession is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) Heartbeat(context.Context, *proto.HeartbeatRequest) (*proto.HeartbeatResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.Heartbeat is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) EndSession(context.Context, *proto.EndSessionRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.EndSession is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) WatchLicense(context.Context, *proto.WatchLicenseRequest, *connect.ServerStream[proto.LicenseStatusEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.WatchLicense is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ValidateLicenses(context.Context, *proto.ValidateLicensesRequest) (*proto.ValidateLicensesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ValidateLicenses is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) CreateProduct(context.Context, *proto.CreateProductRequest) (*proto.Product, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.CreateProduct is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) UpdateProduct(context.Context, *proto.UpdateProductRequest) (*proto.Product, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.UpdateProduct is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ListProducts(context.Context, *proto.ListProductsRequest) (*proto.ListProductsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ListProducts is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) DeleteProduct(context.Context, *proto.DeleteProductRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.DeleteProduct is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) GetLatestVersion(context.Context, *proto.GetLatestVersionRequest) (*proto.Release, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.GetLatestVersion is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) PublishRelease(context.Context, *proto.PublishReleaseRequest) (*proto.Release, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.PublishRelease is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) SetLicenseChannel(context.Context, *proto.SetLicenseChannelRequest) (*proto.License, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.SetLicenseChannel is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) CreateCustomer(context.Context, *proto.CreateCustomerRequest) (*proto.Customer, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.CreateCustomer is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ListCustomers(context.Context, *proto.ListCustomersRequest) (*proto.ListCustomersResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ListCustomers is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) AttachLicense(context.Context, *proto.AttachLicenseRequest) (*proto.License, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.AttachLicense is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) DetachLicense(context.Context, *proto.DetachLicenseRequest) (*proto.License, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.DetachLicense is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) IssueLicenseToEmail(context.Context, *proto.IssueLicenseToEmailRequest) (*proto.IssueLicenseToEmailResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.IssueLicenseToEmail is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ListLicenseDeliveries(context.Context, *proto.ListLicenseDeliveriesRequest) (*proto.ListLicenseDeliveriesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ListLicenseDeliveries is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) CreateTrialLicense(context.Context, *proto.CreateTrialLicenseRequest) (*proto.CreateTrialLicenseResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.CreateTrialLicense is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ExtendLicense(context.Context, *proto.ExtendLicenseRequest) (*proto.License, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ExtendLicense is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ResellerExtendLicense(context.Context, *proto.ExtendLicenseRequest) (*proto.ResellerExtendLicenseResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ResellerExtendLicense is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) SuspendLicense(context.Context, *proto.SuspendLicenseRequest) (*proto.License, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.SuspendLicense is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) UnsuspendLicense(context.Context, *proto.UnsuspendLicenseRequest) (*proto.License, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.UnsuspendLicense is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) BanHwid(context.Context, *proto.BanHwidRequest) (*proto.HwidBan, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.BanHwid is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) UnbanHwid(context.Context, *proto.UnbanHwidRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.UnbanHwid is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ListHwidBans(context.Context, *proto.ListHwidBansRequest) (*proto.ListHwidBansResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ListHwidBans is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) BanIp(context.Context, *proto.BanIpRequest) (*proto.IpBan, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.BanIp is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) UnbanIp(context.Context, *proto.UnbanIpRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.UnbanIp is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ListIpBans(context.Context, *proto.ListIpBansRequest) (*proto.ListIpBansResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ListIpBans is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) SetLicenseCountries(context.Context, *proto.SetLicenseCountriesRequest) (*proto.License, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.SetLicenseCountries is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ClearLockouts(context.Context, *proto.ClearLockoutsRequest) (*proto.ClearLockoutsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ClearLockouts is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) RotateProductSigningSecret(context.Context, *proto.RotateProductSigningSecretRequest) (*proto.RotateProductSigningSecretResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.RotateProductSigningSecret is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) RemoveProductSigningSecret(context.Context, *proto.RemoveProductSigningSecretRequest) (*proto.Product, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.RemoveProductSigningSecret is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) GetChallenge(context.Context, *proto.GetChallengeRequest) (*proto.GetChallengeResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.GetChallenge is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) RevokeRefreshTokens(context.Context, *proto.RevokeRefreshTokensRequest) (*proto.RevokeRefreshTokensResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.RevokeRefreshTokens is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) RotateAdminSecret(context.Context, *proto.RotateAdminSecretRequest) (*proto.RotateAdminSecretResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.RotateAdminSecret is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) EnrollAdminTotp(context.Context, *proto.EnrollAdminTotpRequest) (*proto.EnrollAdminTotpResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.EnrollAdminTotp is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ExportAuditLog(context.Context, *proto.ExportAuditLogRequest, *connect.ServerStream[httpbody.HttpBody]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ExportAuditLog is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) GetStats(context.Context, *proto.GetStatsRequest) (*proto.GetStatsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.GetStats is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ListValidationEvents(context.Context, *proto.ListValidationEventsRequest) (*proto.ListValidationEventsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ListValidationEvents is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) SearchLicenses(context.Context, *proto.SearchLicensesRequest) (*proto.SearchLicensesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.SearchLicenses is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) RestoreLicense(context.Context, *proto.RestoreLicenseRequest) (*proto.License, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.RestoreLicense is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) PurgeLicense(context.Context, *proto.PurgeLicenseRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.PurgeLicense is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) GetLicenseHistory(context.Context, *proto.GetLicenseHistoryRequest) (*proto.GetLicenseHistoryResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.GetLicenseHistory is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ImportExternalLicenses(context.Context, *proto.ImportExternalLicensesRequest) (*proto.ImportLicensesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ImportExternalLicenses is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) BulkSuspendByProduct(context.Context, *proto.BulkSuspendByProductRequest) (*proto.BulkOperationResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.BulkSuspendByProduct is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) BulkDeleteByProduct(context.Context, *proto.BulkDeleteByProductRequest) (*proto.BulkOperationResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.BulkDeleteByProduct is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) BulkExtendByProduct(context.Context, *proto.BulkExtendByProductRequest) (*proto.BulkOperationResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.BulkExtendByProduct is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) SetMaintenanceMode(context.Context, *proto.SetMaintenanceModeRequest) (*proto.MaintenanceMode, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.SetMaintenanceMode is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) GetMaintenanceMode(context.Context, *emptypb.Empty) (*proto.MaintenanceMode, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.GetMaintenanceMode is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) SetProductMessages(context.Context, *proto.SetProductMessagesRequest) (*proto.ProductMessages, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.SetProductMessages is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) GetProductMessages(context.Context, *proto.GetProductMessagesRequest) (*proto.ProductMessages, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.GetProductMessages is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ListMyDevices(context.Context, *proto.ListMyDevicesRequest) (*proto.ListMyDevicesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ListMyDevices is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) DeactivateDevice(context.Context, *proto.DeactivateDeviceRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.DeactivateDevice is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) SetLicenseFloatingSeats(context.Context, *proto.SetLicenseFloatingSeatsRequest) (*proto.License, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.SetLicenseFloatingSeats is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) CheckoutLicense(context.Context, *proto.CheckoutLicenseRequest) (*proto.CheckoutLicenseResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.CheckoutLicense is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) CheckinLicense(context.Context, *proto.CheckinLicenseRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.CheckinLicense is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ConsumeCredits(context.Context, *proto.ConsumeCreditsRequest) (*proto.ConsumeCreditsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ConsumeCredits is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) TopUpLicenseCredits(context.Context, *proto.TopUpLicenseCreditsRequest) (*proto.License, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.TopUpLicenseCredits is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ListLicenseCreditActivity(context.Context, *proto.ListLicenseCreditActivityRequest) (*proto.ListLicenseCreditActivityResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ListLicenseCreditActivity is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) SetLicenseFeatures(context.Context, *proto.SetLicenseFeaturesRequest) (*proto.License, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.SetLicenseFeatures is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) CreatePlan(context.Context, *proto.CreatePlanRequest) (*proto.Plan, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.CreatePlan is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) UpdatePlan(context.Context, *proto.UpdatePlanRequest) (*proto.Plan, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.UpdatePlan is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ListPlans(context.Context, *proto.ListPlansRequest) (*proto.ListPlansResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.ListPlans is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) DeletePlan(context.Context, *proto.DeletePlanRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.DeletePlan is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) GetServerTime(context.Context, *proto.GetServerTimeRequest) (*proto.GetServerTimeResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.GetServerTime is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) GetPublicKey(context.Context, *proto.GetPublicKeyRequest) (*proto.GetPublicKeyResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.GetPublicKey is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) GetSigningKeys(context.Context, *proto.GetSigningKeysRequest) (*proto.GetSigningKeysResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.WhitelistService.GetSigningKeys is not implemented"))
+}
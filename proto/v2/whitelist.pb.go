@@ -0,0 +1,1293 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.21.12
+// source: proto/v2/whitelist.proto
+
+// Version 2 of the client API. v1 (package whitelist) stays served next to
+// it on the same ports, with the same licenses and tokens, so clients can
+// move over one at a time.
+
+package whitelistv2
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Why ValidateLicense answered as it did
+type Reason int32
+
+const (
+	Reason_REASON_UNSPECIFIED          Reason = 0
+	Reason_REASON_OK                   Reason = 1
+	Reason_REASON_LICENSE_NOT_FOUND    Reason = 2
+	Reason_REASON_UNKNOWN_PRODUCT      Reason = 3
+	Reason_REASON_PRODUCT_DISABLED     Reason = 4
+	Reason_REASON_SUSPENDED            Reason = 5
+	Reason_REASON_EXPIRED              Reason = 6
+	Reason_REASON_HWID_MISMATCH        Reason = 7
+	Reason_REASON_DEVICE_LIMIT_REACHED Reason = 8
+	Reason_REASON_DEVICE_BANNED        Reason = 9
+	Reason_REASON_REGION_NOT_ALLOWED   Reason = 10
+	Reason_REASON_CLIENT_OUTDATED      Reason = 11
+	Reason_REASON_CHALLENGE_REQUIRED   Reason = 12
+	Reason_REASON_INVALID_CHALLENGE    Reason = 13
+	Reason_REASON_LOCKED_OUT           Reason = 14
+	Reason_REASON_REBIND_COOLDOWN      Reason = 15
+	// The license is floating and this machine holds no lease; check one
+	// out with the v1 CheckoutLicense
+	Reason_REASON_NOT_CHECKED_OUT Reason = 16
+)
+
+// Enum value maps for Reason.
+var (
+	Reason_name = map[int32]string{
+		0:  "REASON_UNSPECIFIED",
+		1:  "REASON_OK",
+		2:  "REASON_LICENSE_NOT_FOUND",
+		3:  "REASON_UNKNOWN_PRODUCT",
+		4:  "REASON_PRODUCT_DISABLED",
+		5:  "REASON_SUSPENDED",
+		6:  "REASON_EXPIRED",
+		7:  "REASON_HWID_MISMATCH",
+		8:  "REASON_DEVICE_LIMIT_REACHED",
+		9:  "REASON_DEVICE_BANNED",
+		10: "REASON_REGION_NOT_ALLOWED",
+		11: "REASON_CLIENT_OUTDATED",
+		12: "REASON_CHALLENGE_REQUIRED",
+		13: "REASON_INVALID_CHALLENGE",
+		14: "REASON_LOCKED_OUT",
+		15: "REASON_REBIND_COOLDOWN",
+		16: "REASON_NOT_CHECKED_OUT",
+	}
+	Reason_value = map[string]int32{
+		"REASON_UNSPECIFIED":          0,
+		"REASON_OK":                   1,
+		"REASON_LICENSE_NOT_FOUND":    2,
+		"REASON_UNKNOWN_PRODUCT":      3,
+		"REASON_PRODUCT_DISABLED":     4,
+		"REASON_SUSPENDED":            5,
+		"REASON_EXPIRED":              6,
+		"REASON_HWID_MISMATCH":        7,
+		"REASON_DEVICE_LIMIT_REACHED": 8,
+		"REASON_DEVICE_BANNED":        9,
+		"REASON_REGION_NOT_ALLOWED":   10,
+		"REASON_CLIENT_OUTDATED":      11,
+		"REASON_CHALLENGE_REQUIRED":   12,
+		"REASON_INVALID_CHALLENGE":    13,
+		"REASON_LOCKED_OUT":           14,
+		"REASON_REBIND_COOLDOWN":      15,
+		"REASON_NOT_CHECKED_OUT":      16,
+	}
+)
+
+func (x Reason) Enum() *Reason {
+	p := new(Reason)
+	*p = x
+	return p
+}
+
+func (x Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v2_whitelist_proto_enumTypes[0].Descriptor()
+}
+
+func (Reason) Type() protoreflect.EnumType {
+	return &file_proto_v2_whitelist_proto_enumTypes[0]
+}
+
+func (x Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Reason.Descriptor instead.
+func (Reason) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v2_whitelist_proto_rawDescGZIP(), []int{0}
+}
+
+type GetTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One of them
+	ApiKey string `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// From an earlier AuthTokenResponse; single use
+	RefreshToken string `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// Limits the token to calls for this Product; empty allows any
+	ProductId     string `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTokenRequest) Reset() {
+	*x = GetTokenRequest{}
+	mi := &file_proto_v2_whitelist_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTokenRequest) ProtoMessage() {}
+
+func (x *GetTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_whitelist_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v2_whitelist_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetTokenRequest) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *GetTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *GetTokenRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type AuthTokenResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Token     string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Trade it for the next token instead of the API key. Unset when refresh
+	// tokens are disabled.
+	RefreshToken     string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	RefreshExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=refresh_expires_at,json=refreshExpiresAt,proto3" json:"refresh_expires_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AuthTokenResponse) Reset() {
+	*x = AuthTokenResponse{}
+	mi := &file_proto_v2_whitelist_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthTokenResponse) ProtoMessage() {}
+
+func (x *AuthTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_whitelist_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthTokenResponse.ProtoReflect.Descriptor instead.
+func (*AuthTokenResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v2_whitelist_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AuthTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AuthTokenResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *AuthTokenResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *AuthTokenResponse) GetRefreshExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshExpiresAt
+	}
+	return nil
+}
+
+// Field for field the same as v1's, so requests sign the same way
+type ValidateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	ProductId  string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Hwid       string                 `protobuf:"bytes,3,opt,name=hwid,proto3" json:"hwid,omitempty"`
+	// Version of the calling client, e.g. "1.4.2". Checked against the
+	// product's min_version.
+	ClientVersion string `protobuf:"bytes,4,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	// From GetChallenge, single use. Required by products with
+	// require_challenge.
+	Challenge string `protobuf:"bytes,5,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// Optional proof of the key: hex HMAC-SHA256 of challenge, keyed with
+	// license_key.
+	ChallengeResponse string `protobuf:"bytes,6,opt,name=challenge_response,json=challengeResponse,proto3" json:"challenge_response,omitempty"`
+	// Language of message, as a BCP 47 tag like "pt-BR". Empty, or one the
+	// server has no messages in, answers in English.
+	Locale string `protobuf:"bytes,7,opt,name=locale,proto3" json:"locale,omitempty"`
+	// What hwid was derived from. When hwid isn't bound to the license, a
+	// bound device sharing enough of these is taken to be this one with new
+	// hardware.
+	HwidComponents *HwidComponents `protobuf:"bytes,8,opt,name=hwid_components,json=hwidComponents,proto3" json:"hwid_components,omitempty"`
+	// Optional. The client's clock as it sends the request; the response's
+	// clock_skew_seconds then tells how far off it is.
+	ClientTime    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=client_time,json=clientTime,proto3" json:"client_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateRequest) Reset() {
+	*x = ValidateRequest{}
+	mi := &file_proto_v2_whitelist_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRequest) ProtoMessage() {}
+
+func (x *ValidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_whitelist_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateRequest.ProtoReflect.Descriptor instead.
+func (*ValidateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v2_whitelist_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ValidateRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *ValidateRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ValidateRequest) GetHwid() string {
+	if x != nil {
+		return x.Hwid
+	}
+	return ""
+}
+
+func (x *ValidateRequest) GetClientVersion() string {
+	if x != nil {
+		return x.ClientVersion
+	}
+	return ""
+}
+
+func (x *ValidateRequest) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+func (x *ValidateRequest) GetChallengeResponse() string {
+	if x != nil {
+		return x.ChallengeResponse
+	}
+	return ""
+}
+
+func (x *ValidateRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *ValidateRequest) GetHwidComponents() *HwidComponents {
+	if x != nil {
+		return x.HwidComponents
+	}
+	return nil
+}
+
+func (x *ValidateRequest) GetClientTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClientTime
+	}
+	return nil
+}
+
+// Identifiers of a device's parts, each as the client reads it
+type HwidComponents struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cpu           string                 `protobuf:"bytes,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Disk          string                 `protobuf:"bytes,2,opt,name=disk,proto3" json:"disk,omitempty"`
+	Mac           string                 `protobuf:"bytes,3,opt,name=mac,proto3" json:"mac,omitempty"`
+	MachineGuid   string                 `protobuf:"bytes,4,opt,name=machine_guid,json=machineGuid,proto3" json:"machine_guid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HwidComponents) Reset() {
+	*x = HwidComponents{}
+	mi := &file_proto_v2_whitelist_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HwidComponents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HwidComponents) ProtoMessage() {}
+
+func (x *HwidComponents) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_whitelist_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HwidComponents.ProtoReflect.Descriptor instead.
+func (*HwidComponents) Descriptor() ([]byte, []int) {
+	return file_proto_v2_whitelist_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HwidComponents) GetCpu() string {
+	if x != nil {
+		return x.Cpu
+	}
+	return ""
+}
+
+func (x *HwidComponents) GetDisk() string {
+	if x != nil {
+		return x.Disk
+	}
+	return ""
+}
+
+func (x *HwidComponents) GetMac() string {
+	if x != nil {
+		return x.Mac
+	}
+	return ""
+}
+
+func (x *HwidComponents) GetMachineGuid() string {
+	if x != nil {
+		return x.MachineGuid
+	}
+	return ""
+}
+
+type ValidateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Valid bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// Switch on this rather than message
+	Reason Reason `protobuf:"varint,2,opt,name=reason,proto3,enum=whitelist.v2.Reason" json:"reason,omitempty"`
+	// For display, e.g. "License expired"
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Unset for licenses that never expire
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// The names of the features that are on, sorted, on valid responses
+	// only
+	Entitlements []string `protobuf:"bytes,5,rep,name=entitlements,proto3" json:"entitlements,omitempty"`
+	// The license's metadata, on valid responses only.
+	Metadata *structpb.Struct `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// With REASON_CLIENT_OUTDATED: the oldest version that is accepted.
+	RequiredVersion string `protobuf:"bytes,7,opt,name=required_version,json=requiredVersion,proto3" json:"required_version,omitempty"`
+	// With REASON_SUSPENDED when the admin gave a reason.
+	SuspendReason string `protobuf:"bytes,8,opt,name=suspend_reason,json=suspendReason,proto3" json:"suspend_reason,omitempty"`
+	// With REASON_LOCKED_OUT: seconds until the lockout ends. With
+	// REASON_REBIND_COOLDOWN: seconds until the HWID may change again.
+	RetryAfterSeconds int64 `protobuf:"varint,9,opt,name=retry_after_seconds,json=retryAfterSeconds,proto3" json:"retry_after_seconds,omitempty"`
+	// Set when the request carried a challenge: hex HMAC-SHA256 of
+	// challenge + "\n" + ("valid" or "invalid"), keyed with the license key.
+	ChallengeResponse string `protobuf:"bytes,10,opt,name=challenge_response,json=challengeResponse,proto3" json:"challenge_response,omitempty"`
+	// The product's feature flags with the plan's and then the license's own
+	// on top, on valid responses only. Features missing from the map are off.
+	Features map[string]bool `protobuf:"bytes,11,rep,name=features,proto3" json:"features,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// The server's clock as it answered. expires_at is on this clock, so
+	// clients with a wrong one should compare it to this rather than their own.
+	ServerTime *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+	// How far the request's client_time is ahead of server_time (negative if
+	// behind), rounded to seconds; 0 without client_time
+	ClockSkewSeconds int64 `protobuf:"varint,13,opt,name=clock_skew_seconds,json=clockSkewSeconds,proto3" json:"clock_skew_seconds,omitempty"`
+	// Signature of the answer, as in v1, with the key from GetPublicKey
+	Signature string `protobuf:"bytes,14,opt,name=signature,proto3" json:"signature,omitempty"`
+	// kid of the key that made signature, see GetSigningKeys
+	SigningKeyId string `protobuf:"bytes,15,opt,name=signing_key_id,json=signingKeyId,proto3" json:"signing_key_id,omitempty"`
+	// Set on answers repeated from memory while the database is down, as in
+	// v1; challenge_response is empty
+	Degraded      bool `protobuf:"varint,16,opt,name=degraded,proto3" json:"degraded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateResponse) Reset() {
+	*x = ValidateResponse{}
+	mi := &file_proto_v2_whitelist_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateResponse) ProtoMessage() {}
+
+func (x *ValidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_whitelist_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateResponse.ProtoReflect.Descriptor instead.
+func (*ValidateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v2_whitelist_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ValidateResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateResponse) GetReason() Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return Reason_REASON_UNSPECIFIED
+}
+
+func (x *ValidateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ValidateResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetEntitlements() []string {
+	if x != nil {
+		return x.Entitlements
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetRequiredVersion() string {
+	if x != nil {
+		return x.RequiredVersion
+	}
+	return ""
+}
+
+func (x *ValidateResponse) GetSuspendReason() string {
+	if x != nil {
+		return x.SuspendReason
+	}
+	return ""
+}
+
+func (x *ValidateResponse) GetRetryAfterSeconds() int64 {
+	if x != nil {
+		return x.RetryAfterSeconds
+	}
+	return 0
+}
+
+func (x *ValidateResponse) GetChallengeResponse() string {
+	if x != nil {
+		return x.ChallengeResponse
+	}
+	return ""
+}
+
+func (x *ValidateResponse) GetFeatures() map[string]bool {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetServerTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ServerTime
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetClockSkewSeconds() int64 {
+	if x != nil {
+		return x.ClockSkewSeconds
+	}
+	return 0
+}
+
+func (x *ValidateResponse) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *ValidateResponse) GetSigningKeyId() string {
+	if x != nil {
+		return x.SigningKeyId
+	}
+	return ""
+}
+
+func (x *ValidateResponse) GetDegraded() bool {
+	if x != nil {
+		return x.Degraded
+	}
+	return false
+}
+
+type GetChallengeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChallengeRequest) Reset() {
+	*x = GetChallengeRequest{}
+	mi := &file_proto_v2_whitelist_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChallengeRequest) ProtoMessage() {}
+
+func (x *GetChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_whitelist_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChallengeRequest.ProtoReflect.Descriptor instead.
+func (*GetChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v2_whitelist_proto_rawDescGZIP(), []int{5}
+}
+
+type GetChallengeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Challenge     string                 `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChallengeResponse) Reset() {
+	*x = GetChallengeResponse{}
+	mi := &file_proto_v2_whitelist_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChallengeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChallengeResponse) ProtoMessage() {}
+
+func (x *GetChallengeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_whitelist_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChallengeResponse.ProtoReflect.Descriptor instead.
+func (*GetChallengeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v2_whitelist_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetChallengeResponse) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+func (x *GetChallengeResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type GetServerTimeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The client's clock, to get clock_skew_seconds back
+	ClientTime    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=client_time,json=clientTime,proto3" json:"client_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServerTimeRequest) Reset() {
+	*x = GetServerTimeRequest{}
+	mi := &file_proto_v2_whitelist_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServerTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerTimeRequest) ProtoMessage() {}
+
+func (x *GetServerTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_whitelist_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerTimeRequest.ProtoReflect.Descriptor instead.
+func (*GetServerTimeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v2_whitelist_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetServerTimeRequest) GetClientTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClientTime
+	}
+	return nil
+}
+
+type GetServerTimeResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ServerTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+	// How far client_time is ahead of server_time (negative if behind),
+	// rounded to seconds; 0 without client_time
+	ClockSkewSeconds int64 `protobuf:"varint,2,opt,name=clock_skew_seconds,json=clockSkewSeconds,proto3" json:"clock_skew_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetServerTimeResponse) Reset() {
+	*x = GetServerTimeResponse{}
+	mi := &file_proto_v2_whitelist_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServerTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerTimeResponse) ProtoMessage() {}
+
+func (x *GetServerTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_whitelist_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerTimeResponse.ProtoReflect.Descriptor instead.
+func (*GetServerTimeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v2_whitelist_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetServerTimeResponse) GetServerTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ServerTime
+	}
+	return nil
+}
+
+func (x *GetServerTimeResponse) GetClockSkewSeconds() int64 {
+	if x != nil {
+		return x.ClockSkewSeconds
+	}
+	return 0
+}
+
+type GetPublicKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicKeyRequest) Reset() {
+	*x = GetPublicKeyRequest{}
+	mi := &file_proto_v2_whitelist_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicKeyRequest) ProtoMessage() {}
+
+func (x *GetPublicKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_whitelist_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v2_whitelist_proto_rawDescGZIP(), []int{9}
+}
+
+type GetPublicKeyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ed25519, standard base64
+	PublicKey string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// Its kid, see GetSigningKeys
+	KeyId         string `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicKeyResponse) Reset() {
+	*x = GetPublicKeyResponse{}
+	mi := &file_proto_v2_whitelist_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicKeyResponse) ProtoMessage() {}
+
+func (x *GetPublicKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_whitelist_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicKeyResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicKeyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v2_whitelist_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetPublicKeyResponse) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *GetPublicKeyResponse) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+type GetSigningKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSigningKeysRequest) Reset() {
+	*x = GetSigningKeysRequest{}
+	mi := &file_proto_v2_whitelist_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSigningKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSigningKeysRequest) ProtoMessage() {}
+
+func (x *GetSigningKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_whitelist_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSigningKeysRequest.ProtoReflect.Descriptor instead.
+func (*GetSigningKeysRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v2_whitelist_proto_rawDescGZIP(), []int{11}
+}
+
+// As in v1: a JWK plus the key in standard base64
+type SigningKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kid           string                 `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	Kty           string                 `protobuf:"bytes,2,opt,name=kty,proto3" json:"kty,omitempty"`
+	Crv           string                 `protobuf:"bytes,3,opt,name=crv,proto3" json:"crv,omitempty"`
+	X             string                 `protobuf:"bytes,4,opt,name=x,proto3" json:"x,omitempty"`
+	Alg           string                 `protobuf:"bytes,5,opt,name=alg,proto3" json:"alg,omitempty"`
+	PublicKey     string                 `protobuf:"bytes,6,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Active        bool                   `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SigningKey) Reset() {
+	*x = SigningKey{}
+	mi := &file_proto_v2_whitelist_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SigningKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigningKey) ProtoMessage() {}
+
+func (x *SigningKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_whitelist_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SigningKey.ProtoReflect.Descriptor instead.
+func (*SigningKey) Descriptor() ([]byte, []int) {
+	return file_proto_v2_whitelist_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SigningKey) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
+}
+
+func (x *SigningKey) GetKty() string {
+	if x != nil {
+		return x.Kty
+	}
+	return ""
+}
+
+func (x *SigningKey) GetCrv() string {
+	if x != nil {
+		return x.Crv
+	}
+	return ""
+}
+
+func (x *SigningKey) GetX() string {
+	if x != nil {
+		return x.X
+	}
+	return ""
+}
+
+func (x *SigningKey) GetAlg() string {
+	if x != nil {
+		return x.Alg
+	}
+	return ""
+}
+
+func (x *SigningKey) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *SigningKey) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type GetSigningKeysResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The active key first
+	Keys          []*SigningKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSigningKeysResponse) Reset() {
+	*x = GetSigningKeysResponse{}
+	mi := &file_proto_v2_whitelist_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSigningKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSigningKeysResponse) ProtoMessage() {}
+
+func (x *GetSigningKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_whitelist_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSigningKeysResponse.ProtoReflect.Descriptor instead.
+func (*GetSigningKeysResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v2_whitelist_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetSigningKeysResponse) GetKeys() []*SigningKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+var File_proto_v2_whitelist_proto protoreflect.FileDescriptor
+
+const file_proto_v2_whitelist_proto_rawDesc = "" +
+	"\n" +
+	"\x18proto/v2/whitelist.proto\x12\fwhitelist.v2\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\x8c\x01\n" +
+	"\x0fGetTokenRequest\x12!\n" +
+	"\aapi_key\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x06apiKey\x12-\n" +
+	"\rrefresh_token\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\frefreshToken\x12'\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x01R\tproductId\"\xd3\x01\n" +
+	"\x11AuthTokenResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x12H\n" +
+	"\x12refresh_expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x10refreshExpiresAt\"\xc7\x03\n" +
+	"\x0fValidateRequest\x125\n" +
+	"\vlicense_key\x18\x01 \x01(\tB\x14\xfaB\x11r\x0f\x10\x01\x18\x80\x012\b^[!-~]+$R\n" +
+	"licenseKey\x12)\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\tproductId\x12\x1c\n" +
+	"\x04hwid\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x04hwid\x12.\n" +
+	"\x0eclient_version\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x18@R\rclientVersion\x12&\n" +
+	"\tchallenge\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\tchallenge\x127\n" +
+	"\x12challenge_response\x18\x06 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x11challengeResponse\x12\x1f\n" +
+	"\x06locale\x18\a \x01(\tB\a\xfaB\x04r\x02\x18#R\x06locale\x12E\n" +
+	"\x0fhwid_components\x18\b \x01(\v2\x1c.whitelist.v2.HwidComponentsR\x0ehwidComponents\x12;\n" +
+	"\vclient_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"clientTime\"\x93\x01\n" +
+	"\x0eHwidComponents\x12\x1a\n" +
+	"\x03cpu\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x03cpu\x12\x1c\n" +
+	"\x04disk\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x04disk\x12\x1a\n" +
+	"\x03mac\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x03mac\x12+\n" +
+	"\fmachine_guid\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\vmachineGuid\"\x87\x06\n" +
+	"\x10ValidateResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12,\n" +
+	"\x06reason\x18\x02 \x01(\x0e2\x14.whitelist.v2.ReasonR\x06reason\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\"\n" +
+	"\fentitlements\x18\x05 \x03(\tR\fentitlements\x123\n" +
+	"\bmetadata\x18\x06 \x01(\v2\x17.google.protobuf.StructR\bmetadata\x12)\n" +
+	"\x10required_version\x18\a \x01(\tR\x0frequiredVersion\x12%\n" +
+	"\x0esuspend_reason\x18\b \x01(\tR\rsuspendReason\x12.\n" +
+	"\x13retry_after_seconds\x18\t \x01(\x03R\x11retryAfterSeconds\x12-\n" +
+	"\x12challenge_response\x18\n" +
+	" \x01(\tR\x11challengeResponse\x12H\n" +
+	"\bfeatures\x18\v \x03(\v2,.whitelist.v2.ValidateResponse.FeaturesEntryR\bfeatures\x12;\n" +
+	"\vserver_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"serverTime\x12,\n" +
+	"\x12clock_skew_seconds\x18\r \x01(\x03R\x10clockSkewSeconds\x12\x1c\n" +
+	"\tsignature\x18\x0e \x01(\tR\tsignature\x12$\n" +
+	"\x0esigning_key_id\x18\x0f \x01(\tR\fsigningKeyId\x12\x1a\n" +
+	"\bdegraded\x18\x10 \x01(\bR\bdegraded\x1a;\n" +
+	"\rFeaturesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"\x15\n" +
+	"\x13GetChallengeRequest\"o\n" +
+	"\x14GetChallengeResponse\x12\x1c\n" +
+	"\tchallenge\x18\x01 \x01(\tR\tchallenge\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"S\n" +
+	"\x14GetServerTimeRequest\x12;\n" +
+	"\vclient_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"clientTime\"\x82\x01\n" +
+	"\x15GetServerTimeResponse\x12;\n" +
+	"\vserver_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"serverTime\x12,\n" +
+	"\x12clock_skew_seconds\x18\x02 \x01(\x03R\x10clockSkewSeconds\"\x15\n" +
+	"\x13GetPublicKeyRequest\"L\n" +
+	"\x14GetPublicKeyResponse\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x01 \x01(\tR\tpublicKey\x12\x15\n" +
+	"\x06key_id\x18\x02 \x01(\tR\x05keyId\"\x17\n" +
+	"\x15GetSigningKeysRequest\"\x99\x01\n" +
+	"\n" +
+	"SigningKey\x12\x10\n" +
+	"\x03kid\x18\x01 \x01(\tR\x03kid\x12\x10\n" +
+	"\x03kty\x18\x02 \x01(\tR\x03kty\x12\x10\n" +
+	"\x03crv\x18\x03 \x01(\tR\x03crv\x12\f\n" +
+	"\x01x\x18\x04 \x01(\tR\x01x\x12\x10\n" +
+	"\x03alg\x18\x05 \x01(\tR\x03alg\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x06 \x01(\tR\tpublicKey\x12\x16\n" +
+	"\x06active\x18\a \x01(\bR\x06active\"F\n" +
+	"\x16GetSigningKeysResponse\x12,\n" +
+	"\x04keys\x18\x01 \x03(\v2\x18.whitelist.v2.SigningKeyR\x04keys*\xcc\x03\n" +
+	"\x06Reason\x12\x16\n" +
+	"\x12REASON_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tREASON_OK\x10\x01\x12\x1c\n" +
+	"\x18REASON_LICENSE_NOT_FOUND\x10\x02\x12\x1a\n" +
+	"\x16REASON_UNKNOWN_PRODUCT\x10\x03\x12\x1b\n" +
+	"\x17REASON_PRODUCT_DISABLED\x10\x04\x12\x14\n" +
+	"\x10REASON_SUSPENDED\x10\x05\x12\x12\n" +
+	"\x0eREASON_EXPIRED\x10\x06\x12\x18\n" +
+	"\x14REASON_HWID_MISMATCH\x10\a\x12\x1f\n" +
+	"\x1bREASON_DEVICE_LIMIT_REACHED\x10\b\x12\x18\n" +
+	"\x14REASON_DEVICE_BANNED\x10\t\x12\x1d\n" +
+	"\x19REASON_REGION_NOT_ALLOWED\x10\n" +
+	"\x12\x1a\n" +
+	"\x16REASON_CLIENT_OUTDATED\x10\v\x12\x1d\n" +
+	"\x19REASON_CHALLENGE_REQUIRED\x10\f\x12\x1c\n" +
+	"\x18REASON_INVALID_CHALLENGE\x10\r\x12\x15\n" +
+	"\x11REASON_LOCKED_OUT\x10\x0e\x12\x1a\n" +
+	"\x16REASON_REBIND_COOLDOWN\x10\x0f\x12\x1a\n" +
+	"\x16REASON_NOT_CHECKED_OUT\x10\x102\xb0\x05\n" +
+	"\x10WhitelistService\x12i\n" +
+	"\fGetAuthToken\x12\x1d.whitelist.v2.GetTokenRequest\x1a\x1f.whitelist.v2.AuthTokenResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v2/auth/token\x12q\n" +
+	"\x0fValidateLicense\x12\x1d.whitelist.v2.ValidateRequest\x1a\x1e.whitelist.v2.ValidateResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v2/license/validate\x12l\n" +
+	"\fGetChallenge\x12!.whitelist.v2.GetChallengeRequest\x1a\".whitelist.v2.GetChallengeResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v2/challenge\x12j\n" +
+	"\rGetServerTime\x12\".whitelist.v2.GetServerTimeRequest\x1a#.whitelist.v2.GetServerTimeResponse\"\x10\x82\xd3\xe4\x93\x02\n" +
+	"\x12\b/v2/time\x12m\n" +
+	"\fGetPublicKey\x12!.whitelist.v2.GetPublicKeyRequest\x1a\".whitelist.v2.GetPublicKeyResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v2/public-key\x12u\n" +
+	"\x0eGetSigningKeys\x12#.whitelist.v2.GetSigningKeysRequest\x1a$.whitelist.v2.GetSigningKeysResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v2/signing-keysB<Z:github.com/mkseven15/whitelist-server/proto/v2;whitelistv2b\x06proto3"
+
+var (
+	file_proto_v2_whitelist_proto_rawDescOnce sync.Once
+	file_proto_v2_whitelist_proto_rawDescData []byte
+)
+
+func file_proto_v2_whitelist_proto_rawDescGZIP() []byte {
+	file_proto_v2_whitelist_proto_rawDescOnce.Do(func() {
+		file_proto_v2_whitelist_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_v2_whitelist_proto_rawDesc), len(file_proto_v2_whitelist_proto_rawDesc)))
+	})
+	return file_proto_v2_whitelist_proto_rawDescData
+}
+
+var file_proto_v2_whitelist_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_v2_whitelist_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_proto_v2_whitelist_proto_goTypes = []any{
+	(Reason)(0),                    // 0: whitelist.v2.Reason
+	(*GetTokenRequest)(nil),        // 1: whitelist.v2.GetTokenRequest
+	(*AuthTokenResponse)(nil),      // 2: whitelist.v2.AuthTokenResponse
+	(*ValidateRequest)(nil),        // 3: whitelist.v2.ValidateRequest
+	(*HwidComponents)(nil),         // 4: whitelist.v2.HwidComponents
+	(*ValidateResponse)(nil),       // 5: whitelist.v2.ValidateResponse
+	(*GetChallengeRequest)(nil),    // 6: whitelist.v2.GetChallengeRequest
+	(*GetChallengeResponse)(nil),   // 7: whitelist.v2.GetChallengeResponse
+	(*GetServerTimeRequest)(nil),   // 8: whitelist.v2.GetServerTimeRequest
+	(*GetServerTimeResponse)(nil),  // 9: whitelist.v2.GetServerTimeResponse
+	(*GetPublicKeyRequest)(nil),    // 10: whitelist.v2.GetPublicKeyRequest
+	(*GetPublicKeyResponse)(nil),   // 11: whitelist.v2.GetPublicKeyResponse
+	(*GetSigningKeysRequest)(nil),  // 12: whitelist.v2.GetSigningKeysRequest
+	(*SigningKey)(nil),             // 13: whitelist.v2.SigningKey
+	(*GetSigningKeysResponse)(nil), // 14: whitelist.v2.GetSigningKeysResponse
+	nil,                            // 15: whitelist.v2.ValidateResponse.FeaturesEntry
+	(*timestamppb.Timestamp)(nil),  // 16: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),        // 17: google.protobuf.Struct
+}
+var file_proto_v2_whitelist_proto_depIdxs = []int32{
+	16, // 0: whitelist.v2.AuthTokenResponse.expires_at:type_name -> google.protobuf.Timestamp
+	16, // 1: whitelist.v2.AuthTokenResponse.refresh_expires_at:type_name -> google.protobuf.Timestamp
+	4,  // 2: whitelist.v2.ValidateRequest.hwid_components:type_name -> whitelist.v2.HwidComponents
+	16, // 3: whitelist.v2.ValidateRequest.client_time:type_name -> google.protobuf.Timestamp
+	0,  // 4: whitelist.v2.ValidateResponse.reason:type_name -> whitelist.v2.Reason
+	16, // 5: whitelist.v2.ValidateResponse.expires_at:type_name -> google.protobuf.Timestamp
+	17, // 6: whitelist.v2.ValidateResponse.metadata:type_name -> google.protobuf.Struct
+	15, // 7: whitelist.v2.ValidateResponse.features:type_name -> whitelist.v2.ValidateResponse.FeaturesEntry
+	16, // 8: whitelist.v2.ValidateResponse.server_time:type_name -> google.protobuf.Timestamp
+	16, // 9: whitelist.v2.GetChallengeResponse.expires_at:type_name -> google.protobuf.Timestamp
+	16, // 10: whitelist.v2.GetServerTimeRequest.client_time:type_name -> google.protobuf.Timestamp
+	16, // 11: whitelist.v2.GetServerTimeResponse.server_time:type_name -> google.protobuf.Timestamp
+	13, // 12: whitelist.v2.GetSigningKeysResponse.keys:type_name -> whitelist.v2.SigningKey
+	1,  // 13: whitelist.v2.WhitelistService.GetAuthToken:input_type -> whitelist.v2.GetTokenRequest
+	3,  // 14: whitelist.v2.WhitelistService.ValidateLicense:input_type -> whitelist.v2.ValidateRequest
+	6,  // 15: whitelist.v2.WhitelistService.GetChallenge:input_type -> whitelist.v2.GetChallengeRequest
+	8,  // 16: whitelist.v2.WhitelistService.GetServerTime:input_type -> whitelist.v2.GetServerTimeRequest
+	10, // 17: whitelist.v2.WhitelistService.GetPublicKey:input_type -> whitelist.v2.GetPublicKeyRequest
+	12, // 18: whitelist.v2.WhitelistService.GetSigningKeys:input_type -> whitelist.v2.GetSigningKeysRequest
+	2,  // 19: whitelist.v2.WhitelistService.GetAuthToken:output_type -> whitelist.v2.AuthTokenResponse
+	5,  // 20: whitelist.v2.WhitelistService.ValidateLicense:output_type -> whitelist.v2.ValidateResponse
+	7,  // 21: whitelist.v2.WhitelistService.GetChallenge:output_type -> whitelist.v2.GetChallengeResponse
+	9,  // 22: whitelist.v2.WhitelistService.GetServerTime:output_type -> whitelist.v2.GetServerTimeResponse
+	11, // 23: whitelist.v2.WhitelistService.GetPublicKey:output_type -> whitelist.v2.GetPublicKeyResponse
+	14, // 24: whitelist.v2.WhitelistService.GetSigningKeys:output_type -> whitelist.v2.GetSigningKeysResponse
+	19, // [19:25] is the sub-list for method output_type
+	13, // [13:19] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_proto_v2_whitelist_proto_init() }
+func file_proto_v2_whitelist_proto_init() {
+	if File_proto_v2_whitelist_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v2_whitelist_proto_rawDesc), len(file_proto_v2_whitelist_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_v2_whitelist_proto_goTypes,
+		DependencyIndexes: file_proto_v2_whitelist_proto_depIdxs,
+		EnumInfos:         file_proto_v2_whitelist_proto_enumTypes,
+		MessageInfos:      file_proto_v2_whitelist_proto_msgTypes,
+	}.Build()
+	File_proto_v2_whitelist_proto = out.File
+	file_proto_v2_whitelist_proto_goTypes = nil
+	file_proto_v2_whitelist_proto_depIdxs = nil
+}
@@ -0,0 +1,327 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v3.21.12
+// source: proto/v2/whitelist.proto
+
+// Version 2 of the client API. v1 (package whitelist) stays served next to
+// it on the same ports, with the same licenses and tokens, so clients can
+// move over one at a time.
+
+package whitelistv2
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WhitelistService_GetAuthToken_FullMethodName    = "/whitelist.v2.WhitelistService/GetAuthToken"
+	WhitelistService_ValidateLicense_FullMethodName = "/whitelist.v2.WhitelistService/ValidateLicense"
+	WhitelistService_GetChallenge_FullMethodName    = "/whitelist.v2.WhitelistService/GetChallenge"
+	WhitelistService_GetServerTime_FullMethodName   = "/whitelist.v2.WhitelistService/GetServerTime"
+	WhitelistService_GetPublicKey_FullMethodName    = "/whitelist.v2.WhitelistService/GetPublicKey"
+	WhitelistService_GetSigningKeys_FullMethodName  = "/whitelist.v2.WhitelistService/GetSigningKeys"
+)
+
+// WhitelistServiceClient is the client API for WhitelistService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WhitelistServiceClient interface {
+	// Get an access token for one ValidateLicense call
+	GetAuthToken(ctx context.Context, in *GetTokenRequest, opts ...grpc.CallOption) (*AuthTokenResponse, error)
+	// Validate a license
+	ValidateLicense(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error)
+	// Get a single-use challenge for ValidateLicense
+	GetChallenge(ctx context.Context, in *GetChallengeRequest, opts ...grpc.CallOption) (*GetChallengeResponse, error)
+	// Get the server's clock, to check the client's against
+	GetServerTime(ctx context.Context, in *GetServerTimeRequest, opts ...grpc.CallOption) (*GetServerTimeResponse, error)
+	// Get the public key that checks ValidateResponse.signature
+	GetPublicKey(ctx context.Context, in *GetPublicKeyRequest, opts ...grpc.CallOption) (*GetPublicKeyResponse, error)
+	// List the public keys clients should accept, current and retired
+	GetSigningKeys(ctx context.Context, in *GetSigningKeysRequest, opts ...grpc.CallOption) (*GetSigningKeysResponse, error)
+}
+
+type whitelistServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWhitelistServiceClient(cc grpc.ClientConnInterface) WhitelistServiceClient {
+	return &whitelistServiceClient{cc}
+}
+
+func (c *whitelistServiceClient) GetAuthToken(ctx context.Context, in *GetTokenRequest, opts ...grpc.CallOption) (*AuthTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthTokenResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_GetAuthToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) ValidateLicense(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_ValidateLicense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) GetChallenge(ctx context.Context, in *GetChallengeRequest, opts ...grpc.CallOption) (*GetChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChallengeResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_GetChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) GetServerTime(ctx context.Context, in *GetServerTimeRequest, opts ...grpc.CallOption) (*GetServerTimeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetServerTimeResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_GetServerTime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) GetPublicKey(ctx context.Context, in *GetPublicKeyRequest, opts ...grpc.CallOption) (*GetPublicKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublicKeyResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_GetPublicKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) GetSigningKeys(ctx context.Context, in *GetSigningKeysRequest, opts ...grpc.CallOption) (*GetSigningKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSigningKeysResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_GetSigningKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WhitelistServiceServer is the server API for WhitelistService service.
+// All implementations must embed UnimplementedWhitelistServiceServer
+// for forward compatibility.
+type WhitelistServiceServer interface {
+	// Get an access token for one ValidateLicense call
+	GetAuthToken(context.Context, *GetTokenRequest) (*AuthTokenResponse, error)
+	// Validate a license
+	ValidateLicense(context.Context, *ValidateRequest) (*ValidateResponse, error)
+	// Get a single-use challenge for ValidateLicense
+	GetChallenge(context.Context, *GetChallengeRequest) (*GetChallengeResponse, error)
+	// Get the server's clock, to check the client's against
+	GetServerTime(context.Context, *GetServerTimeRequest) (*GetServerTimeResponse, error)
+	// Get the public key that checks ValidateResponse.signature
+	GetPublicKey(context.Context, *GetPublicKeyRequest) (*GetPublicKeyResponse, error)
+	// List the public keys clients should accept, current and retired
+	GetSigningKeys(context.Context, *GetSigningKeysRequest) (*GetSigningKeysResponse, error)
+	mustEmbedUnimplementedWhitelistServiceServer()
+}
+
+// UnimplementedWhitelistServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWhitelistServiceServer struct{}
+
+func (UnimplementedWhitelistServiceServer) GetAuthToken(context.Context, *GetTokenRequest) (*AuthTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAuthToken not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ValidateLicense(context.Context, *ValidateRequest) (*ValidateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidateLicense not implemented")
+}
+func (UnimplementedWhitelistServiceServer) GetChallenge(context.Context, *GetChallengeRequest) (*GetChallengeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetChallenge not implemented")
+}
+func (UnimplementedWhitelistServiceServer) GetServerTime(context.Context, *GetServerTimeRequest) (*GetServerTimeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetServerTime not implemented")
+}
+func (UnimplementedWhitelistServiceServer) GetPublicKey(context.Context, *GetPublicKeyRequest) (*GetPublicKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPublicKey not implemented")
+}
+func (UnimplementedWhitelistServiceServer) GetSigningKeys(context.Context, *GetSigningKeysRequest) (*GetSigningKeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSigningKeys not implemented")
+}
+func (UnimplementedWhitelistServiceServer) mustEmbedUnimplementedWhitelistServiceServer() {}
+func (UnimplementedWhitelistServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeWhitelistServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WhitelistServiceServer will
+// result in compilation errors.
+type UnsafeWhitelistServiceServer interface {
+	mustEmbedUnimplementedWhitelistServiceServer()
+}
+
+func RegisterWhitelistServiceServer(s grpc.ServiceRegistrar, srv WhitelistServiceServer) {
+	// If the following call panics, it indicates UnimplementedWhitelistServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WhitelistService_ServiceDesc, srv)
+}
+
+func _WhitelistService_GetAuthToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).GetAuthToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_GetAuthToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).GetAuthToken(ctx, req.(*GetTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_ValidateLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).ValidateLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_ValidateLicense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).ValidateLicense(ctx, req.(*ValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_GetChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).GetChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_GetChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).GetChallenge(ctx, req.(*GetChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_GetServerTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServerTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).GetServerTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_GetServerTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).GetServerTime(ctx, req.(*GetServerTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_GetPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).GetPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_GetPublicKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).GetPublicKey(ctx, req.(*GetPublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_GetSigningKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSigningKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).GetSigningKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_GetSigningKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).GetSigningKeys(ctx, req.(*GetSigningKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WhitelistService_ServiceDesc is the grpc.ServiceDesc for WhitelistService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WhitelistService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "whitelist.v2.WhitelistService",
+	HandlerType: (*WhitelistServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAuthToken",
+			Handler:    _WhitelistService_GetAuthToken_Handler,
+		},
+		{
+			MethodName: "ValidateLicense",
+			Handler:    _WhitelistService_ValidateLicense_Handler,
+		},
+		{
+			MethodName: "GetChallenge",
+			Handler:    _WhitelistService_GetChallenge_Handler,
+		},
+		{
+			MethodName: "GetServerTime",
+			Handler:    _WhitelistService_GetServerTime_Handler,
+		},
+		{
+			MethodName: "GetPublicKey",
+			Handler:    _WhitelistService_GetPublicKey_Handler,
+		},
+		{
+			MethodName: "GetSigningKeys",
+			Handler:    _WhitelistService_GetSigningKeys_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/v2/whitelist.proto",
+}
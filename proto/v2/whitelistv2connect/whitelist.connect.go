@@ -0,0 +1,293 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/v2/whitelist.proto
+
+// Version 2 of the client API. v1 (package whitelist) stays served next to
+// it on the same ports, with the same licenses and tokens, so clients can
+// move over one at a time.
+package whitelistv2connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v2 "github.com/mkseven15/whitelist-server/proto/v2"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// WhitelistServiceName is the fully-qualified name of the WhitelistService service.
+	WhitelistServiceName = "whitelist.v2.WhitelistService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// WhitelistServiceGetAuthTokenProcedure is the fully-qualified name of the WhitelistService's
+	// GetAuthToken RPC.
+	WhitelistServiceGetAuthTokenProcedure = "/whitelist.v2.WhitelistService/GetAuthToken"
+	// WhitelistServiceValidateLicenseProcedure is the fully-qualified name of the WhitelistService's
+	// ValidateLicense RPC.
+	WhitelistServiceValidateLicenseProcedure = "/whitelist.v2.WhitelistService/ValidateLicense"
+	// WhitelistServiceGetChallengeProcedure is the fully-qualified name of the WhitelistService's
+	// GetChallenge RPC.
+	WhitelistServiceGetChallengeProcedure = "/whitelist.v2.WhitelistService/GetChallenge"
+	// WhitelistServiceGetServerTimeProcedure is the fully-qualified name of the WhitelistService's
+	// GetServerTime RPC.
+	WhitelistServiceGetServerTimeProcedure = "/whitelist.v2.WhitelistService/GetServerTime"
+	// WhitelistServiceGetPublicKeyProcedure is the fully-qualified name of the WhitelistService's
+	// GetPublicKey RPC.
+	WhitelistServiceGetPublicKeyProcedure = "/whitelist.v2.WhitelistService/GetPublicKey"
+	// WhitelistServiceGetSigningKeysProcedure is the fully-qualified name of the WhitelistService's
+	// GetSigningKeys RPC.
+	WhitelistServiceGetSigningKeysProcedure = "/whitelist.v2.WhitelistService/GetSigningKeys"
+)
+
+// WhitelistServiceClient is a client for the whitelist.v2.WhitelistService service.
+type WhitelistServiceClient interface {
+	// Get an access token for one ValidateLicense call
+	GetAuthToken(context.Context, *v2.GetTokenRequest) (*v2.AuthTokenResponse, error)
+	// Validate a license
+	ValidateLicense(context.Context, *v2.ValidateRequest) (*v2.ValidateResponse, error)
+	// Get a single-use challenge for ValidateLicense
+	GetChallenge(context.Context, *v2.GetChallengeRequest) (*v2.GetChallengeResponse, error)
+	// Get the server's clock, to check the client's against
+	GetServerTime(context.Context, *v2.GetServerTimeRequest) (*v2.GetServerTimeResponse, error)
+	// Get the public key that checks ValidateResponse.signature
+	GetPublicKey(context.Context, *v2.GetPublicKeyRequest) (*v2.GetPublicKeyResponse, error)
+	// List the public keys clients should accept, current and retired
+	GetSigningKeys(context.Context, *v2.GetSigningKeysRequest) (*v2.GetSigningKeysResponse, error)
+}
+
+// NewWhitelistServiceClient constructs a client for the whitelist.v2.WhitelistService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewWhitelistServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) WhitelistServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	whitelistServiceMethods := v2.File_proto_v2_whitelist_proto.Services().ByName("WhitelistService").Methods()
+	return &whitelistServiceClient{
+		getAuthToken: connect.NewClient[v2.GetTokenRequest, v2.AuthTokenResponse](
+			httpClient,
+			baseURL+WhitelistServiceGetAuthTokenProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("GetAuthToken")),
+			connect.WithClientOptions(opts...),
+		),
+		validateLicense: connect.NewClient[v2.ValidateRequest, v2.ValidateResponse](
+			httpClient,
+			baseURL+WhitelistServiceValidateLicenseProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("ValidateLicense")),
+			connect.WithClientOptions(opts...),
+		),
+		getChallenge: connect.NewClient[v2.GetChallengeRequest, v2.GetChallengeResponse](
+			httpClient,
+			baseURL+WhitelistServiceGetChallengeProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("GetChallenge")),
+			connect.WithClientOptions(opts...),
+		),
+		getServerTime: connect.NewClient[v2.GetServerTimeRequest, v2.GetServerTimeResponse](
+			httpClient,
+			baseURL+WhitelistServiceGetServerTimeProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("GetServerTime")),
+			connect.WithClientOptions(opts...),
+		),
+		getPublicKey: connect.NewClient[v2.GetPublicKeyRequest, v2.GetPublicKeyResponse](
+			httpClient,
+			baseURL+WhitelistServiceGetPublicKeyProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("GetPublicKey")),
+			connect.WithClientOptions(opts...),
+		),
+		getSigningKeys: connect.NewClient[v2.GetSigningKeysRequest, v2.GetSigningKeysResponse](
+			httpClient,
+			baseURL+WhitelistServiceGetSigningKeysProcedure,
+			connect.WithSchema(whitelistServiceMethods.ByName("GetSigningKeys")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// whitelistServiceClient implements WhitelistServiceClient.
+type whitelistServiceClient struct {
+	getAuthToken    *connect.Client[v2.GetTokenRequest, v2.AuthTokenResponse]
+	validateLicense *connect.Client[v2.ValidateRequest, v2.ValidateResponse]
+	getChallenge    *connect.Client[v2.GetChallengeRequest, v2.GetChallengeResponse]
+	getServerTime   *connect.Client[v2.GetServerTimeRequest, v2.GetServerTimeResponse]
+	getPublicKey    *connect.Client[v2.GetPublicKeyRequest, v2.GetPublicKeyResponse]
+	getSigningKeys  *connect.Client[v2.GetSigningKeysRequest, v2.GetSigningKeysResponse]
+}
+
+// GetAuthToken calls whitelist.v2.WhitelistService.GetAuthToken.
+func (c *whitelistServiceClient) GetAuthToken(ctx context.Context, req *v2.GetTokenRequest) (*v2.AuthTokenResponse, error) {
+	response, err := c.getAuthToken.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ValidateLicense calls whitelist.v2.WhitelistService.ValidateLicense.
+func (c *whitelistServiceClient) ValidateLicense(ctx context.Context, req *v2.ValidateRequest) (*v2.ValidateResponse, error) {
+	response, err := c.validateLicense.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetChallenge calls whitelist.v2.WhitelistService.GetChallenge.
+func (c *whitelistServiceClient) GetChallenge(ctx context.Context, req *v2.GetChallengeRequest) (*v2.GetChallengeResponse, error) {
+	response, err := c.getChallenge.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetServerTime calls whitelist.v2.WhitelistService.GetServerTime.
+func (c *whitelistServiceClient) GetServerTime(ctx context.Context, req *v2.GetServerTimeRequest) (*v2.GetServerTimeResponse, error) {
+	response, err := c.getServerTime.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetPublicKey calls whitelist.v2.WhitelistService.GetPublicKey.
+func (c *whitelistServiceClient) GetPublicKey(ctx context.Context, req *v2.GetPublicKeyRequest) (*v2.GetPublicKeyResponse, error) {
+	response, err := c.getPublicKey.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetSigningKeys calls whitelist.v2.WhitelistService.GetSigningKeys.
+func (c *whitelistServiceClient) GetSigningKeys(ctx context.Context, req *v2.GetSigningKeysRequest) (*v2.GetSigningKeysResponse, error) {
+	response, err := c.getSigningKeys.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// WhitelistServiceHandler is an implementation of the whitelist.v2.WhitelistService service.
+type WhitelistServiceHandler interface {
+	// Get an access token for one ValidateLicense call
+	GetAuthToken(context.Context, *v2.GetTokenRequest) (*v2.AuthTokenResponse, error)
+	// Validate a license
+	ValidateLicense(context.Context, *v2.ValidateRequest) (*v2.ValidateResponse, error)
+	// Get a single-use challenge for ValidateLicense
+	GetChallenge(context.Context, *v2.GetChallengeRequest) (*v2.GetChallengeResponse, error)
+	// Get the server's clock, to check the client's against
+	GetServerTime(context.Context, *v2.GetServerTimeRequest) (*v2.GetServerTimeResponse, error)
+	// Get the public key that checks ValidateResponse.signature
+	GetPublicKey(context.Context, *v2.GetPublicKeyRequest) (*v2.GetPublicKeyResponse, error)
+	// List the public keys clients should accept, current and retired
+	GetSigningKeys(context.Context, *v2.GetSigningKeysRequest) (*v2.GetSigningKeysResponse, error)
+}
+
+// NewWhitelistServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewWhitelistServiceHandler(svc WhitelistServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	whitelistServiceMethods := v2.File_proto_v2_whitelist_proto.Services().ByName("WhitelistService").Methods()
+	whitelistServiceGetAuthTokenHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceGetAuthTokenProcedure,
+		svc.GetAuthToken,
+		connect.WithSchema(whitelistServiceMethods.ByName("GetAuthToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceValidateLicenseHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceValidateLicenseProcedure,
+		svc.ValidateLicense,
+		connect.WithSchema(whitelistServiceMethods.ByName("ValidateLicense")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceGetChallengeHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceGetChallengeProcedure,
+		svc.GetChallenge,
+		connect.WithSchema(whitelistServiceMethods.ByName("GetChallenge")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceGetServerTimeHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceGetServerTimeProcedure,
+		svc.GetServerTime,
+		connect.WithSchema(whitelistServiceMethods.ByName("GetServerTime")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceGetPublicKeyHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceGetPublicKeyProcedure,
+		svc.GetPublicKey,
+		connect.WithSchema(whitelistServiceMethods.ByName("GetPublicKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	whitelistServiceGetSigningKeysHandler := connect.NewUnaryHandlerSimple(
+		WhitelistServiceGetSigningKeysProcedure,
+		svc.GetSigningKeys,
+		connect.WithSchema(whitelistServiceMethods.ByName("GetSigningKeys")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/whitelist.v2.WhitelistService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case WhitelistServiceGetAuthTokenProcedure:
+			whitelistServiceGetAuthTokenHandler.ServeHTTP(w, r)
+		case WhitelistServiceValidateLicenseProcedure:
+			whitelistServiceValidateLicenseHandler.ServeHTTP(w, r)
+		case WhitelistServiceGetChallengeProcedure:
+			whitelistServiceGetChallengeHandler.ServeHTTP(w, r)
+		case WhitelistServiceGetServerTimeProcedure:
+			whitelistServiceGetServerTimeHandler.ServeHTTP(w, r)
+		case WhitelistServiceGetPublicKeyProcedure:
+			whitelistServiceGetPublicKeyHandler.ServeHTTP(w, r)
+		case WhitelistServiceGetSigningKeysProcedure:
+			whitelistServiceGetSigningKeysHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedWhitelistServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedWhitelistServiceHandler struct{}
+
+func (UnimplementedWhitelistServiceHandler) GetAuthToken(context.Context, *v2.GetTokenRequest) (*v2.AuthTokenResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.v2.WhitelistService.GetAuthToken is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) ValidateLicense(context.Context, *v2.ValidateRequest) (*v2.ValidateResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.v2.WhitelistService.ValidateLicense is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) GetChallenge(context.Context, *v2.GetChallengeRequest) (*v2.GetChallengeResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.v2.WhitelistService.GetChallenge is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) GetServerTime(context.Context, *v2.GetServerTimeRequest) (*v2.GetServerTimeResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.v2.WhitelistService.GetServerTime is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) GetPublicKey(context.Context, *v2.GetPublicKeyRequest) (*v2.GetPublicKeyResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.v2.WhitelistService.GetPublicKey is not implemented"))
+}
+
+func (UnimplementedWhitelistServiceHandler) GetSigningKeys(context.Context, *v2.GetSigningKeysRequest) (*v2.GetSigningKeysResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whitelist.v2.WhitelistService.GetSigningKeys is not implemented"))
+}
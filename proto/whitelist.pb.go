@@ -1,16 +1,21 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.11
+// 	protoc-gen-go v1.36.10
 // 	protoc        v3.21.12
 // source: proto/whitelist.proto
 
 package proto
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,17 +28,302 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Why a client call answered as it did: the reason field of validation
+// and session responses, and the reason of the google.rpc.ErrorInfo detail
+// on client call errors (as the value name, e.g. "REASON_TOKEN_INVALID").
+// Switch on it rather than the message text.
+type Reason int32
+
+const (
+	Reason_REASON_UNSPECIFIED          Reason = 0
+	Reason_REASON_OK                   Reason = 1
+	Reason_REASON_LICENSE_NOT_FOUND    Reason = 2
+	Reason_REASON_UNKNOWN_PRODUCT      Reason = 3
+	Reason_REASON_PRODUCT_DISABLED     Reason = 4
+	Reason_REASON_SUSPENDED            Reason = 5
+	Reason_REASON_EXPIRED              Reason = 6
+	Reason_REASON_HWID_MISMATCH        Reason = 7
+	Reason_REASON_DEVICE_LIMIT_REACHED Reason = 8
+	Reason_REASON_DEVICE_BANNED        Reason = 9
+	Reason_REASON_REGION_NOT_ALLOWED   Reason = 10
+	Reason_REASON_CLIENT_OUTDATED      Reason = 11
+	Reason_REASON_CHALLENGE_REQUIRED   Reason = 12
+	Reason_REASON_INVALID_CHALLENGE    Reason = 13
+	Reason_REASON_LOCKED_OUT           Reason = 14
+	// StartSession and Heartbeat
+	Reason_REASON_TOO_MANY_SESSIONS Reason = 15
+	Reason_REASON_SESSION_EXPIRED   Reason = 16
+	// Errors
+	// A field breaks its rules; a google.rpc.BadRequest detail says which
+	Reason_REASON_INVALID_REQUEST Reason = 17
+	Reason_REASON_TOKEN_MISSING   Reason = 18
+	// Unknown, expired or already used x-access-token
+	Reason_REASON_TOKEN_INVALID Reason = 19
+	// The access token is limited to another product
+	Reason_REASON_TOKEN_WRONG_PRODUCT   Reason = 20
+	Reason_REASON_API_KEY_INVALID       Reason = 21
+	Reason_REASON_REFRESH_TOKEN_INVALID Reason = 22
+	Reason_REASON_RESELLER_KEY_INVALID  Reason = 23
+	// Signed requests (x-signature)
+	Reason_REASON_SIGNATURE_REQUIRED Reason = 24
+	Reason_REASON_SIGNATURE_INVALID  Reason = 25
+	Reason_REASON_NONCE_REUSED       Reason = 26
+	Reason_REASON_RATE_LIMITED       Reason = 27
+	Reason_REASON_ADDRESS_BANNED     Reason = 28
+	// Admin call from outside admin_allowed_ips
+	Reason_REASON_ADDRESS_NOT_ALLOWED Reason = 29
+	Reason_REASON_MAINTENANCE         Reason = 30
+	// A device of the license changed its HWID within HWID_REBIND_COOLDOWN,
+	// so this one can't replace it yet
+	Reason_REASON_REBIND_COOLDOWN Reason = 31
+	// Floating licenses: validating without a lease from CheckoutLicense, and
+	// checking out while every seat is taken
+	Reason_REASON_NOT_CHECKED_OUT   Reason = 32
+	Reason_REASON_NO_FLOATING_SEATS Reason = 33
+	// ConsumeCredits asked for more than the license's balance
+	Reason_REASON_INSUFFICIENT_CREDITS Reason = 34
+)
+
+// Enum value maps for Reason.
+var (
+	Reason_name = map[int32]string{
+		0:  "REASON_UNSPECIFIED",
+		1:  "REASON_OK",
+		2:  "REASON_LICENSE_NOT_FOUND",
+		3:  "REASON_UNKNOWN_PRODUCT",
+		4:  "REASON_PRODUCT_DISABLED",
+		5:  "REASON_SUSPENDED",
+		6:  "REASON_EXPIRED",
+		7:  "REASON_HWID_MISMATCH",
+		8:  "REASON_DEVICE_LIMIT_REACHED",
+		9:  "REASON_DEVICE_BANNED",
+		10: "REASON_REGION_NOT_ALLOWED",
+		11: "REASON_CLIENT_OUTDATED",
+		12: "REASON_CHALLENGE_REQUIRED",
+		13: "REASON_INVALID_CHALLENGE",
+		14: "REASON_LOCKED_OUT",
+		15: "REASON_TOO_MANY_SESSIONS",
+		16: "REASON_SESSION_EXPIRED",
+		17: "REASON_INVALID_REQUEST",
+		18: "REASON_TOKEN_MISSING",
+		19: "REASON_TOKEN_INVALID",
+		20: "REASON_TOKEN_WRONG_PRODUCT",
+		21: "REASON_API_KEY_INVALID",
+		22: "REASON_REFRESH_TOKEN_INVALID",
+		23: "REASON_RESELLER_KEY_INVALID",
+		24: "REASON_SIGNATURE_REQUIRED",
+		25: "REASON_SIGNATURE_INVALID",
+		26: "REASON_NONCE_REUSED",
+		27: "REASON_RATE_LIMITED",
+		28: "REASON_ADDRESS_BANNED",
+		29: "REASON_ADDRESS_NOT_ALLOWED",
+		30: "REASON_MAINTENANCE",
+		31: "REASON_REBIND_COOLDOWN",
+		32: "REASON_NOT_CHECKED_OUT",
+		33: "REASON_NO_FLOATING_SEATS",
+		34: "REASON_INSUFFICIENT_CREDITS",
+	}
+	Reason_value = map[string]int32{
+		"REASON_UNSPECIFIED":           0,
+		"REASON_OK":                    1,
+		"REASON_LICENSE_NOT_FOUND":     2,
+		"REASON_UNKNOWN_PRODUCT":       3,
+		"REASON_PRODUCT_DISABLED":      4,
+		"REASON_SUSPENDED":             5,
+		"REASON_EXPIRED":               6,
+		"REASON_HWID_MISMATCH":         7,
+		"REASON_DEVICE_LIMIT_REACHED":  8,
+		"REASON_DEVICE_BANNED":         9,
+		"REASON_REGION_NOT_ALLOWED":    10,
+		"REASON_CLIENT_OUTDATED":       11,
+		"REASON_CHALLENGE_REQUIRED":    12,
+		"REASON_INVALID_CHALLENGE":     13,
+		"REASON_LOCKED_OUT":            14,
+		"REASON_TOO_MANY_SESSIONS":     15,
+		"REASON_SESSION_EXPIRED":       16,
+		"REASON_INVALID_REQUEST":       17,
+		"REASON_TOKEN_MISSING":         18,
+		"REASON_TOKEN_INVALID":         19,
+		"REASON_TOKEN_WRONG_PRODUCT":   20,
+		"REASON_API_KEY_INVALID":       21,
+		"REASON_REFRESH_TOKEN_INVALID": 22,
+		"REASON_RESELLER_KEY_INVALID":  23,
+		"REASON_SIGNATURE_REQUIRED":    24,
+		"REASON_SIGNATURE_INVALID":     25,
+		"REASON_NONCE_REUSED":          26,
+		"REASON_RATE_LIMITED":          27,
+		"REASON_ADDRESS_BANNED":        28,
+		"REASON_ADDRESS_NOT_ALLOWED":   29,
+		"REASON_MAINTENANCE":           30,
+		"REASON_REBIND_COOLDOWN":       31,
+		"REASON_NOT_CHECKED_OUT":       32,
+		"REASON_NO_FLOATING_SEATS":     33,
+		"REASON_INSUFFICIENT_CREDITS":  34,
+	}
+)
+
+func (x Reason) Enum() *Reason {
+	p := new(Reason)
+	*p = x
+	return p
+}
+
+func (x Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_whitelist_proto_enumTypes[0].Descriptor()
+}
+
+func (Reason) Type() protoreflect.EnumType {
+	return &file_proto_whitelist_proto_enumTypes[0]
+}
+
+func (x Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Reason.Descriptor instead.
+func (Reason) EnumDescriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{0}
+}
+
+type LicenseStatus int32
+
+const (
+	LicenseStatus_LICENSE_STATUS_UNSPECIFIED LicenseStatus = 0
+	LicenseStatus_LICENSE_STATUS_ACTIVE      LicenseStatus = 1
+	LicenseStatus_LICENSE_STATUS_SUSPENDED   LicenseStatus = 2
+	LicenseStatus_LICENSE_STATUS_EXPIRED     LicenseStatus = 3
+	// Deleted or moved to another product. The stream ends after this event.
+	LicenseStatus_LICENSE_STATUS_REVOKED LicenseStatus = 4
+)
+
+// Enum value maps for LicenseStatus.
+var (
+	LicenseStatus_name = map[int32]string{
+		0: "LICENSE_STATUS_UNSPECIFIED",
+		1: "LICENSE_STATUS_ACTIVE",
+		2: "LICENSE_STATUS_SUSPENDED",
+		3: "LICENSE_STATUS_EXPIRED",
+		4: "LICENSE_STATUS_REVOKED",
+	}
+	LicenseStatus_value = map[string]int32{
+		"LICENSE_STATUS_UNSPECIFIED": 0,
+		"LICENSE_STATUS_ACTIVE":      1,
+		"LICENSE_STATUS_SUSPENDED":   2,
+		"LICENSE_STATUS_EXPIRED":     3,
+		"LICENSE_STATUS_REVOKED":     4,
+	}
+)
+
+func (x LicenseStatus) Enum() *LicenseStatus {
+	p := new(LicenseStatus)
+	*p = x
+	return p
+}
+
+func (x LicenseStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LicenseStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_whitelist_proto_enumTypes[1].Descriptor()
+}
+
+func (LicenseStatus) Type() protoreflect.EnumType {
+	return &file_proto_whitelist_proto_enumTypes[1]
+}
+
+func (x LicenseStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LicenseStatus.Descriptor instead.
+func (LicenseStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{1}
+}
+
+// New Request Message for API Key
+type GetTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One of them
+	ApiKey string `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// From an earlier AuthTokenResponse; single use
+	RefreshToken string `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// Limits the token to calls for this Product; empty allows any
+	ProductId     string `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTokenRequest) Reset() {
+	*x = GetTokenRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTokenRequest) ProtoMessage() {}
+
+func (x *GetTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetTokenRequest) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *GetTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *GetTokenRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
 type AuthTokenResponse struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Token            string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	ExpiresInSeconds int64                  `protobuf:"varint,2,opt,name=expires_in_seconds,json=expiresInSeconds,proto3" json:"expires_in_seconds,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Trade it for the next token instead of the API key. Unset when refresh
+	// tokens are disabled.
+	RefreshToken            string `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	RefreshExpiresInSeconds int64  `protobuf:"varint,4,opt,name=refresh_expires_in_seconds,json=refreshExpiresInSeconds,proto3" json:"refresh_expires_in_seconds,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *AuthTokenResponse) Reset() {
 	*x = AuthTokenResponse{}
-	mi := &file_proto_whitelist_proto_msgTypes[0]
+	mi := &file_proto_whitelist_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -45,7 +335,7 @@ func (x *AuthTokenResponse) String() string {
 func (*AuthTokenResponse) ProtoMessage() {}
 
 func (x *AuthTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_whitelist_proto_msgTypes[0]
+	mi := &file_proto_whitelist_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -58,7 +348,7 @@ func (x *AuthTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthTokenResponse.ProtoReflect.Descriptor instead.
 func (*AuthTokenResponse) Descriptor() ([]byte, []int) {
-	return file_proto_whitelist_proto_rawDescGZIP(), []int{0}
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *AuthTokenResponse) GetToken() string {
@@ -75,18 +365,51 @@ func (x *AuthTokenResponse) GetExpiresInSeconds() int64 {
 	return 0
 }
 
+func (x *AuthTokenResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *AuthTokenResponse) GetRefreshExpiresInSeconds() int64 {
+	if x != nil {
+		return x.RefreshExpiresInSeconds
+	}
+	return 0
+}
+
 type ValidateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LicenseKey    string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
-	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Hwid          string                 `protobuf:"bytes,3,opt,name=hwid,proto3" json:"hwid,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	ProductId  string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Hwid       string                 `protobuf:"bytes,3,opt,name=hwid,proto3" json:"hwid,omitempty"`
+	// Version of the calling client, e.g. "1.4.2". Checked against the
+	// product's min_version.
+	ClientVersion string `protobuf:"bytes,4,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	// From GetChallenge, single use. Required by products with
+	// require_challenge.
+	Challenge string `protobuf:"bytes,5,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// Optional proof of the key: hex HMAC-SHA256 of challenge, keyed with
+	// license_key.
+	ChallengeResponse string `protobuf:"bytes,6,opt,name=challenge_response,json=challengeResponse,proto3" json:"challenge_response,omitempty"`
+	// Language of the response message, as a BCP 47 tag like "pt-BR".
+	// Empty, or one the server has no messages in, answers in English.
+	Locale string `protobuf:"bytes,7,opt,name=locale,proto3" json:"locale,omitempty"`
+	// What hwid was derived from. When hwid isn't bound to the license, a
+	// bound device sharing enough of these is taken to be this one with new
+	// hardware.
+	HwidComponents *HwidComponents `protobuf:"bytes,8,opt,name=hwid_components,json=hwidComponents,proto3" json:"hwid_components,omitempty"`
+	// Optional. The client's clock as it sends the request; the response's
+	// clock_skew_seconds then tells how far off it is.
+	ClientTime    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=client_time,json=clientTime,proto3" json:"client_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ValidateRequest) Reset() {
 	*x = ValidateRequest{}
-	mi := &file_proto_whitelist_proto_msgTypes[1]
+	mi := &file_proto_whitelist_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -98,7 +421,7 @@ func (x *ValidateRequest) String() string {
 func (*ValidateRequest) ProtoMessage() {}
 
 func (x *ValidateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_whitelist_proto_msgTypes[1]
+	mi := &file_proto_whitelist_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -111,7 +434,7 @@ func (x *ValidateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateRequest.ProtoReflect.Descriptor instead.
 func (*ValidateRequest) Descriptor() ([]byte, []int) {
-	return file_proto_whitelist_proto_rawDescGZIP(), []int{1}
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ValidateRequest) GetLicenseKey() string {
@@ -135,81 +458,73 @@ func (x *ValidateRequest) GetHwid() string {
 	return ""
 }
 
-type ValidateResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ValidateResponse) Reset() {
-	*x = ValidateResponse{}
-	mi := &file_proto_whitelist_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ValidateRequest) GetClientVersion() string {
+	if x != nil {
+		return x.ClientVersion
+	}
+	return ""
 }
 
-func (x *ValidateResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ValidateRequest) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
 }
 
-func (*ValidateResponse) ProtoMessage() {}
-
-func (x *ValidateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_whitelist_proto_msgTypes[2]
+func (x *ValidateRequest) GetChallengeResponse() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ChallengeResponse
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ValidateResponse.ProtoReflect.Descriptor instead.
-func (*ValidateResponse) Descriptor() ([]byte, []int) {
-	return file_proto_whitelist_proto_rawDescGZIP(), []int{2}
+func (x *ValidateRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
 }
 
-func (x *ValidateResponse) GetValid() bool {
+func (x *ValidateRequest) GetHwidComponents() *HwidComponents {
 	if x != nil {
-		return x.Valid
+		return x.HwidComponents
 	}
-	return false
+	return nil
 }
 
-func (x *ValidateResponse) GetMessage() string {
+func (x *ValidateRequest) GetClientTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Message
+		return x.ClientTime
 	}
-	return ""
+	return nil
 }
 
-type UpdateLicenseRequest struct {
+// Identifiers of a device's parts, each as the client reads it
+type HwidComponents struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LicenseKey    string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
-	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Cpu           string                 `protobuf:"bytes,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Disk          string                 `protobuf:"bytes,2,opt,name=disk,proto3" json:"disk,omitempty"`
+	Mac           string                 `protobuf:"bytes,3,opt,name=mac,proto3" json:"mac,omitempty"`
+	MachineGuid   string                 `protobuf:"bytes,4,opt,name=machine_guid,json=machineGuid,proto3" json:"machine_guid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateLicenseRequest) Reset() {
-	*x = UpdateLicenseRequest{}
+func (x *HwidComponents) Reset() {
+	*x = HwidComponents{}
 	mi := &file_proto_whitelist_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateLicenseRequest) String() string {
+func (x *HwidComponents) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLicenseRequest) ProtoMessage() {}
+func (*HwidComponents) ProtoMessage() {}
 
-func (x *UpdateLicenseRequest) ProtoReflect() protoreflect.Message {
+func (x *HwidComponents) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_whitelist_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -221,53 +536,98 @@ func (x *UpdateLicenseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLicenseRequest.ProtoReflect.Descriptor instead.
-func (*UpdateLicenseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HwidComponents.ProtoReflect.Descriptor instead.
+func (*HwidComponents) Descriptor() ([]byte, []int) {
 	return file_proto_whitelist_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *UpdateLicenseRequest) GetLicenseKey() string {
+func (x *HwidComponents) GetCpu() string {
 	if x != nil {
-		return x.LicenseKey
+		return x.Cpu
 	}
 	return ""
 }
 
-func (x *UpdateLicenseRequest) GetProductId() string {
+func (x *HwidComponents) GetDisk() string {
 	if x != nil {
-		return x.ProductId
+		return x.Disk
 	}
 	return ""
 }
 
-func (x *UpdateLicenseRequest) GetIsActive() bool {
+func (x *HwidComponents) GetMac() string {
 	if x != nil {
-		return x.IsActive
+		return x.Mac
 	}
-	return false
+	return ""
 }
 
-type DeleteLicenseRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LicenseKey    string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+func (x *HwidComponents) GetMachineGuid() string {
+	if x != nil {
+		return x.MachineGuid
+	}
+	return ""
+}
+
+type ValidateResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Valid   bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Seconds until the license expires. 0 means the license never expires.
+	ExpiresInSeconds int64 `protobuf:"varint,3,opt,name=expires_in_seconds,json=expiresInSeconds,proto3" json:"expires_in_seconds,omitempty"`
+	// The license's metadata, on valid responses only.
+	Metadata *structpb.Struct `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Set with "Client outdated": the oldest version that is accepted.
+	RequiredVersion string `protobuf:"bytes,5,opt,name=required_version,json=requiredVersion,proto3" json:"required_version,omitempty"`
+	// Set with "License is suspended" when the admin gave a reason.
+	SuspendReason string `protobuf:"bytes,6,opt,name=suspend_reason,json=suspendReason,proto3" json:"suspend_reason,omitempty"`
+	// Set with "Too many failed attempts": seconds until the lockout ends.
+	// With REASON_REBIND_COOLDOWN: seconds until the HWID may change again.
+	RetryAfterSeconds int64 `protobuf:"varint,7,opt,name=retry_after_seconds,json=retryAfterSeconds,proto3" json:"retry_after_seconds,omitempty"`
+	// Set when the request carried a challenge: hex HMAC-SHA256 of
+	// challenge + "\n" + ("valid" or "invalid"), keyed with the license key, so
+	// clients can tell this answer from a recorded one.
+	ChallengeResponse string `protobuf:"bytes,8,opt,name=challenge_response,json=challengeResponse,proto3" json:"challenge_response,omitempty"`
+	// Switch on this rather than message
+	Reason Reason `protobuf:"varint,9,opt,name=reason,proto3,enum=whitelist.Reason" json:"reason,omitempty"`
+	// The product's feature flags with the plan's and then the license's own
+	// on top, on valid responses only. Features missing from the map are off.
+	Features map[string]bool `protobuf:"bytes,10,rep,name=features,proto3" json:"features,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// The server's clock as it answered, to go by instead of the client's
+	ServerTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+	// How far the request's client_time is ahead of server_time (negative if
+	// behind), rounded to seconds; 0 without client_time
+	ClockSkewSeconds int64 `protobuf:"varint,12,opt,name=clock_skew_seconds,json=clockSkewSeconds,proto3" json:"clock_skew_seconds,omitempty"`
+	// Ed25519 signature of license_key, product_id, hwid (as sent),
+	// server_time and whether the license is valid, with the key from
+	// GetPublicKey; see the licensefile Go package. Empty unless the server
+	// has a LICENSE_SIGNING_KEY.
+	Signature string `protobuf:"bytes,13,opt,name=signature,proto3" json:"signature,omitempty"`
+	// kid of the key that made signature, see GetSigningKeys
+	SigningKeyId string `protobuf:"bytes,14,opt,name=signing_key_id,json=signingKeyId,proto3" json:"signing_key_id,omitempty"`
+	// Set on answers repeated from memory while the database is down (see
+	// FAIL_OPEN_WINDOW). Bans and changes made since aren't seen, the access
+	// token wasn't used up and challenge_response is empty: the challenge
+	// couldn't be checked.
+	Degraded      bool `protobuf:"varint,15,opt,name=degraded,proto3" json:"degraded,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteLicenseRequest) Reset() {
-	*x = DeleteLicenseRequest{}
+func (x *ValidateResponse) Reset() {
+	*x = ValidateResponse{}
 	mi := &file_proto_whitelist_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteLicenseRequest) String() string {
+func (x *ValidateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteLicenseRequest) ProtoMessage() {}
+func (*ValidateResponse) ProtoMessage() {}
 
-func (x *DeleteLicenseRequest) ProtoReflect() protoreflect.Message {
+func (x *ValidateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_whitelist_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -279,49 +639,11510 @@ func (x *DeleteLicenseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteLicenseRequest.ProtoReflect.Descriptor instead.
-func (*DeleteLicenseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidateResponse.ProtoReflect.Descriptor instead.
+func (*ValidateResponse) Descriptor() ([]byte, []int) {
 	return file_proto_whitelist_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *DeleteLicenseRequest) GetLicenseKey() string {
+func (x *ValidateResponse) GetValid() bool {
 	if x != nil {
-		return x.LicenseKey
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-var File_proto_whitelist_proto protoreflect.FileDescriptor
+func (x *ValidateResponse) GetExpiresInSeconds() int64 {
+	if x != nil {
+		return x.ExpiresInSeconds
+	}
+	return 0
+}
 
-const file_proto_whitelist_proto_rawDesc = "" +
-	"\n" +
-	"\x15proto/whitelist.proto\x12\twhitelist\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\"W\n" +
-	"\x11AuthTokenResponse\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x12,\n" +
-	"\x12expires_in_seconds\x18\x02 \x01(\x03R\x10expiresInSeconds\"e\n" +
-	"\x0fValidateRequest\x12\x1f\n" +
-	"\vlicense_key\x18\x01 \x01(\tR\n" +
-	"licenseKey\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x02 \x01(\tR\tproductId\x12\x12\n" +
-	"\x04hwid\x18\x03 \x01(\tR\x04hwid\"B\n" +
-	"\x10ValidateResponse\x12\x14\n" +
-	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"s\n" +
-	"\x14UpdateLicenseRequest\x12\x1f\n" +
-	"\vlicense_key\x18\x01 \x01(\tR\n" +
-	"licenseKey\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1b\n" +
-	"\tis_active\x18\x03 \x01(\bR\bisActive\"7\n" +
-	"\x14DeleteLicenseRequest\x12\x1f\n" +
-	"\vlicense_key\x18\x01 \x01(\tR\n" +
-	"licenseKey2\xaf\x03\n" +
-	"\x10WhitelistService\x12_\n" +
-	"\fGetAuthToken\x12\x16.google.protobuf.Empty\x1a\x1c.whitelist.AuthTokenResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/token\x12k\n" +
-	"\x0fValidateLicense\x12\x1a.whitelist.ValidateRequest\x1a\x1b.whitelist.ValidateResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/license/validate\x12`\n" +
-	"\rUpdateLicense\x12\x1f.whitelist.UpdateLicenseRequest\x1a\x16.google.protobuf.Empty\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\x1a\v/v1/license\x12k\n" +
-	"\rDeleteLicense\x12\x1f.whitelist.DeleteLicenseRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b*\x19/v1/license/{license_key}B-Z+github.com/mkseven15/whitelist-server/protob\x06proto3"
+func (x *ValidateResponse) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetRequiredVersion() string {
+	if x != nil {
+		return x.RequiredVersion
+	}
+	return ""
+}
+
+func (x *ValidateResponse) GetSuspendReason() string {
+	if x != nil {
+		return x.SuspendReason
+	}
+	return ""
+}
+
+func (x *ValidateResponse) GetRetryAfterSeconds() int64 {
+	if x != nil {
+		return x.RetryAfterSeconds
+	}
+	return 0
+}
+
+func (x *ValidateResponse) GetChallengeResponse() string {
+	if x != nil {
+		return x.ChallengeResponse
+	}
+	return ""
+}
+
+func (x *ValidateResponse) GetReason() Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return Reason_REASON_UNSPECIFIED
+}
+
+func (x *ValidateResponse) GetFeatures() map[string]bool {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetServerTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ServerTime
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetClockSkewSeconds() int64 {
+	if x != nil {
+		return x.ClockSkewSeconds
+	}
+	return 0
+}
+
+func (x *ValidateResponse) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *ValidateResponse) GetSigningKeyId() string {
+	if x != nil {
+		return x.SigningKeyId
+	}
+	return ""
+}
+
+func (x *ValidateResponse) GetDegraded() bool {
+	if x != nil {
+		return x.Degraded
+	}
+	return false
+}
+
+type UpdateLicenseRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	ProductId  string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	IsActive   bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// Optional. Leave unset for a lifetime license.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Number of devices that may be bound. Defaults to 1.
+	MaxDevices int32 `protobuf:"varint,5,opt,name=max_devices,json=maxDevices,proto3" json:"max_devices,omitempty"`
+	// Sessions (StartSession) that may run at once. 0 means unlimited.
+	MaxSessions int32 `protobuf:"varint,6,opt,name=max_sessions,json=maxSessions,proto3" json:"max_sessions,omitempty"`
+	// Free-form data such as customer email or order ID, at most 16 KiB.
+	// Left unchanged when unset, unless update_mask names it.
+	Metadata *structpb.Struct `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Optional. Update only these fields of an existing license; the others
+	// keep their values. Without a mask every field is overwritten.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,8,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Optional. Puts the license on this plan (see CreatePlan); empty takes
+	// it off. Left unchanged when unset, unless update_mask names it. A
+	// license on a plan gets the plan's max_devices unless the request sets
+	// it; one joining a plan also lasts the plan's duration unless the
+	// request sets expires_at, and one staying on it keeps its expiry.
+	Plan          *string `protobuf:"bytes,9,opt,name=plan,proto3,oneof" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLicenseRequest) Reset() {
+	*x = UpdateLicenseRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLicenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLicenseRequest) ProtoMessage() {}
+
+func (x *UpdateLicenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLicenseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLicenseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateLicenseRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *UpdateLicenseRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *UpdateLicenseRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *UpdateLicenseRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *UpdateLicenseRequest) GetMaxDevices() int32 {
+	if x != nil {
+		return x.MaxDevices
+	}
+	return 0
+}
+
+func (x *UpdateLicenseRequest) GetMaxSessions() int32 {
+	if x != nil {
+		return x.MaxSessions
+	}
+	return 0
+}
+
+func (x *UpdateLicenseRequest) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *UpdateLicenseRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateLicenseRequest) GetPlan() string {
+	if x != nil && x.Plan != nil {
+		return *x.Plan
+	}
+	return ""
+}
+
+type DeleteLicenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey    string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLicenseRequest) Reset() {
+	*x = DeleteLicenseRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLicenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLicenseRequest) ProtoMessage() {}
+
+func (x *DeleteLicenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLicenseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLicenseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteLicenseRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+type License struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	ProductId  string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	IsActive   bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// The first of hwids, for clients from before multi-device licenses; empty
+	// with no device bound. Use hwids instead.
+	//
+	// Deprecated: Marked as deprecated in proto/whitelist.proto.
+	Hwid      string                 `protobuf:"bytes,4,opt,name=hwid,proto3" json:"hwid,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last successful ValidateLicense call. Unset if never validated.
+	LastValidatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_validated_at,json=lastValidatedAt,proto3" json:"last_validated_at,omitempty"`
+	MaxDevices      int32                  `protobuf:"varint,8,opt,name=max_devices,json=maxDevices,proto3" json:"max_devices,omitempty"`
+	// Bound devices, oldest first.
+	Hwids       []string `protobuf:"bytes,9,rep,name=hwids,proto3" json:"hwids,omitempty"`
+	MaxSessions int32    `protobuf:"varint,10,opt,name=max_sessions,json=maxSessions,proto3" json:"max_sessions,omitempty"`
+	// Sessions that haven't ended or timed out.
+	ActiveSessions int32            `protobuf:"varint,11,opt,name=active_sessions,json=activeSessions,proto3" json:"active_sessions,omitempty"`
+	Metadata       *structpb.Struct `protobuf:"bytes,12,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Update channel this license is pinned to; empty follows the client's choice.
+	Channel string `protobuf:"bytes,13,opt,name=channel,proto3" json:"channel,omitempty"`
+	// Owning customer; 0 if the license isn't attached to one.
+	CustomerId int64 `protobuf:"varint,14,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	// Why the license is suspended; empty while active.
+	SuspendReason string `protobuf:"bytes,15,opt,name=suspend_reason,json=suspendReason,proto3" json:"suspend_reason,omitempty"`
+	// Country restrictions on top of the product's, as in Product.
+	AllowedCountries []string `protobuf:"bytes,16,rep,name=allowed_countries,json=allowedCountries,proto3" json:"allowed_countries,omitempty"`
+	BlockedCountries []string `protobuf:"bytes,17,rep,name=blocked_countries,json=blockedCountries,proto3" json:"blocked_countries,omitempty"`
+	// Set once DeleteLicense was called; the license no longer validates
+	// until RestoreLicense.
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// Last time one of its devices came back with a new HWID (see
+	// HwidComponents); unset if none has.
+	HwidReboundAt *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=hwid_rebound_at,json=hwidReboundAt,proto3" json:"hwid_rebound_at,omitempty"`
+	// Machines that may use the license at once, each holding a lease from
+	// CheckoutLicense; 0 binds devices instead.
+	FloatingSeats int32 `protobuf:"varint,20,opt,name=floating_seats,json=floatingSeats,proto3" json:"floating_seats,omitempty"`
+	// Leases that haven't been checked in or expired.
+	ActiveLeases int32 `protobuf:"varint,21,opt,name=active_leases,json=activeLeases,proto3" json:"active_leases,omitempty"`
+	// Balance left for ConsumeCredits.
+	Credits int64 `protobuf:"varint,22,opt,name=credits,proto3" json:"credits,omitempty"`
+	// The license's own feature flags, overriding its product's and plan's
+	// (see SetLicenseFeatures).
+	Features map[string]bool `protobuf:"bytes,23,rep,name=features,proto3" json:"features,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Plan the license is on; empty if none.
+	Plan          string `protobuf:"bytes,24,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *License) Reset() {
+	*x = License{}
+	mi := &file_proto_whitelist_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *License) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*License) ProtoMessage() {}
+
+func (x *License) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use License.ProtoReflect.Descriptor instead.
+func (*License) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *License) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *License) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *License) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+// Deprecated: Marked as deprecated in proto/whitelist.proto.
+func (x *License) GetHwid() string {
+	if x != nil {
+		return x.Hwid
+	}
+	return ""
+}
+
+func (x *License) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *License) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *License) GetLastValidatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastValidatedAt
+	}
+	return nil
+}
+
+func (x *License) GetMaxDevices() int32 {
+	if x != nil {
+		return x.MaxDevices
+	}
+	return 0
+}
+
+func (x *License) GetHwids() []string {
+	if x != nil {
+		return x.Hwids
+	}
+	return nil
+}
+
+func (x *License) GetMaxSessions() int32 {
+	if x != nil {
+		return x.MaxSessions
+	}
+	return 0
+}
+
+func (x *License) GetActiveSessions() int32 {
+	if x != nil {
+		return x.ActiveSessions
+	}
+	return 0
+}
+
+func (x *License) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *License) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *License) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *License) GetSuspendReason() string {
+	if x != nil {
+		return x.SuspendReason
+	}
+	return ""
+}
+
+func (x *License) GetAllowedCountries() []string {
+	if x != nil {
+		return x.AllowedCountries
+	}
+	return nil
+}
+
+func (x *License) GetBlockedCountries() []string {
+	if x != nil {
+		return x.BlockedCountries
+	}
+	return nil
+}
+
+func (x *License) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *License) GetHwidReboundAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HwidReboundAt
+	}
+	return nil
+}
+
+func (x *License) GetFloatingSeats() int32 {
+	if x != nil {
+		return x.FloatingSeats
+	}
+	return 0
+}
+
+func (x *License) GetActiveLeases() int32 {
+	if x != nil {
+		return x.ActiveLeases
+	}
+	return 0
+}
+
+func (x *License) GetCredits() int64 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *License) GetFeatures() map[string]bool {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *License) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+type GetLicenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey    string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLicenseRequest) Reset() {
+	*x = GetLicenseRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLicenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLicenseRequest) ProtoMessage() {}
+
+func (x *GetLicenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLicenseRequest.ProtoReflect.Descriptor instead.
+func (*GetLicenseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetLicenseRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+type ListLicensesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filters (all optional)
+	ProductId  string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	IsActive   *bool  `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	HwidBound  *bool  `protobuf:"varint,3,opt,name=hwid_bound,json=hwidBound,proto3,oneof" json:"hwid_bound,omitempty"`
+	CustomerId int64  `protobuf:"varint,6,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	// Keys containing this text, ignoring case
+	Search string `protobuf:"bytes,7,opt,name=search,proto3" json:"search,omitempty"`
+	// List deleted licenses instead, which are left out otherwise
+	Deleted bool `protobuf:"varint,9,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// Pagination. page_size defaults to 50, max 500.
+	PageSize  int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// license_key (the default), created_at, expires_at or last_validated_at,
+	// optionally followed by " desc". Lifetime licenses sort as expiring last,
+	// never-validated ones as validated first.
+	OrderBy       string `protobuf:"bytes,8,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLicensesRequest) Reset() {
+	*x = ListLicensesRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLicensesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLicensesRequest) ProtoMessage() {}
+
+func (x *ListLicensesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLicensesRequest.ProtoReflect.Descriptor instead.
+func (*ListLicensesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListLicensesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListLicensesRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *ListLicensesRequest) GetHwidBound() bool {
+	if x != nil && x.HwidBound != nil {
+		return *x.HwidBound
+	}
+	return false
+}
+
+func (x *ListLicensesRequest) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *ListLicensesRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListLicensesRequest) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *ListLicensesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListLicensesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListLicensesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListLicensesResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Licenses []*License             `protobuf:"bytes,1,rep,name=licenses,proto3" json:"licenses,omitempty"`
+	// Empty when there are no more results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLicensesResponse) Reset() {
+	*x = ListLicensesResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLicensesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLicensesResponse) ProtoMessage() {}
+
+func (x *ListLicensesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLicensesResponse.ProtoReflect.Descriptor instead.
+func (*ListLicensesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListLicensesResponse) GetLicenses() []*License {
+	if x != nil {
+		return x.Licenses
+	}
+	return nil
+}
+
+func (x *ListLicensesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ResetHwidRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	// Optional. Who requested the reset, recorded in the audit log.
+	// Defaults to the x-admin-actor header.
+	Actor string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Optional. Unbind only this device instead of all of them.
+	Hwid          string `protobuf:"bytes,3,opt,name=hwid,proto3" json:"hwid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetHwidRequest) Reset() {
+	*x = ResetHwidRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetHwidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetHwidRequest) ProtoMessage() {}
+
+func (x *ResetHwidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetHwidRequest.ProtoReflect.Descriptor instead.
+func (*ResetHwidRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ResetHwidRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *ResetHwidRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ResetHwidRequest) GetHwid() string {
+	if x != nil {
+		return x.Hwid
+	}
+	return ""
+}
+
+type GenerateLicensesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Number of keys to create. Defaults to 1, max 1000.
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// Key pattern: PREFIX-XXXX-XXXX-XXXX-XXXX. Leave these unset for products
+	// with a key_format, whose keys follow it.
+	Prefix string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Defaults to 4 groups of 4 characters.
+	Groups    int32 `protobuf:"varint,4,opt,name=groups,proto3" json:"groups,omitempty"`
+	GroupSize int32 `protobuf:"varint,5,opt,name=group_size,json=groupSize,proto3" json:"group_size,omitempty"`
+	// Defaults to uppercase letters and digits without look-alikes (0/O, 1/I).
+	Charset string `protobuf:"bytes,6,opt,name=charset,proto3" json:"charset,omitempty"`
+	// Applied to every generated license, as in UpdateLicenseRequest.
+	IsActive      bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	MaxDevices    int32                  `protobuf:"varint,9,opt,name=max_devices,json=maxDevices,proto3" json:"max_devices,omitempty"`
+	Plan          string                 `protobuf:"bytes,10,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateLicensesRequest) Reset() {
+	*x = GenerateLicensesRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateLicensesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateLicensesRequest) ProtoMessage() {}
+
+func (x *GenerateLicensesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateLicensesRequest.ProtoReflect.Descriptor instead.
+func (*GenerateLicensesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GenerateLicensesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GenerateLicensesRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GenerateLicensesRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *GenerateLicensesRequest) GetGroups() int32 {
+	if x != nil {
+		return x.Groups
+	}
+	return 0
+}
+
+func (x *GenerateLicensesRequest) GetGroupSize() int32 {
+	if x != nil {
+		return x.GroupSize
+	}
+	return 0
+}
+
+func (x *GenerateLicensesRequest) GetCharset() string {
+	if x != nil {
+		return x.Charset
+	}
+	return ""
+}
+
+func (x *GenerateLicensesRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *GenerateLicensesRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *GenerateLicensesRequest) GetMaxDevices() int32 {
+	if x != nil {
+		return x.MaxDevices
+	}
+	return 0
+}
+
+func (x *GenerateLicensesRequest) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+type GenerateLicensesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKeys   []string               `protobuf:"bytes,1,rep,name=license_keys,json=licenseKeys,proto3" json:"license_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateLicensesResponse) Reset() {
+	*x = GenerateLicensesResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateLicensesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateLicensesResponse) ProtoMessage() {}
+
+func (x *GenerateLicensesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateLicensesResponse.ProtoReflect.Descriptor instead.
+func (*GenerateLicensesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GenerateLicensesResponse) GetLicenseKeys() []string {
+	if x != nil {
+		return x.LicenseKeys
+	}
+	return nil
+}
+
+type BatchUpsertLicensesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// At most 1000 per call.
+	Licenses      []*UpdateLicenseRequest `protobuf:"bytes,1,rep,name=licenses,proto3" json:"licenses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpsertLicensesRequest) Reset() {
+	*x = BatchUpsertLicensesRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpsertLicensesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpsertLicensesRequest) ProtoMessage() {}
+
+func (x *BatchUpsertLicensesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpsertLicensesRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpsertLicensesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BatchUpsertLicensesRequest) GetLicenses() []*UpdateLicenseRequest {
+	if x != nil {
+		return x.Licenses
+	}
+	return nil
+}
+
+type BatchUpsertLicensesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Upserted      int32                  `protobuf:"varint,1,opt,name=upserted,proto3" json:"upserted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpsertLicensesResponse) Reset() {
+	*x = BatchUpsertLicensesResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpsertLicensesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpsertLicensesResponse) ProtoMessage() {}
+
+func (x *BatchUpsertLicensesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpsertLicensesResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpsertLicensesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BatchUpsertLicensesResponse) GetUpserted() int32 {
+	if x != nil {
+		return x.Upserted
+	}
+	return 0
+}
+
+type ExportLicensesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Export only this product.
+	ProductId     string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportLicensesRequest) Reset() {
+	*x = ExportLicensesRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportLicensesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportLicensesRequest) ProtoMessage() {}
+
+func (x *ExportLicensesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportLicensesRequest.ProtoReflect.Descriptor instead.
+func (*ExportLicensesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ExportLicensesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type ImportLicensesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CSV with a header row. Columns: license_key, product_id (required),
+	// is_active, expires_at (RFC 3339), max_devices, max_sessions.
+	Csv string `protobuf:"bytes,1,opt,name=csv,proto3" json:"csv,omitempty"`
+	// Report what would change without writing anything.
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportLicensesRequest) Reset() {
+	*x = ImportLicensesRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportLicensesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportLicensesRequest) ProtoMessage() {}
+
+func (x *ImportLicensesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportLicensesRequest.ProtoReflect.Descriptor instead.
+func (*ImportLicensesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ImportLicensesRequest) GetCsv() string {
+	if x != nil {
+		return x.Csv
+	}
+	return ""
+}
+
+func (x *ImportLicensesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportLicensesResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	DryRun    bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Created   int32                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Updated   int32                  `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	Unchanged int32                  `protobuf:"varint,4,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+	Rows      []*ImportLicenseRow    `protobuf:"bytes,5,rep,name=rows,proto3" json:"rows,omitempty"`
+	// Parse errors. Nothing is written if any are present.
+	Errors        []string `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportLicensesResponse) Reset() {
+	*x = ImportLicensesResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportLicensesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportLicensesResponse) ProtoMessage() {}
+
+func (x *ImportLicensesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportLicensesResponse.ProtoReflect.Descriptor instead.
+func (*ImportLicensesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ImportLicensesResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportLicensesResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportLicensesResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportLicensesResponse) GetUnchanged() int32 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+func (x *ImportLicensesResponse) GetRows() []*ImportLicenseRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *ImportLicensesResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ImportLicenseRow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CSV line, or the license's position in a JSON import
+	Line       int32  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	LicenseKey string `protobuf:"bytes,2,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	// "create", "update" or "unchanged"
+	Action        string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportLicenseRow) Reset() {
+	*x = ImportLicenseRow{}
+	mi := &file_proto_whitelist_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportLicenseRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportLicenseRow) ProtoMessage() {}
+
+func (x *ImportLicenseRow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportLicenseRow.ProtoReflect.Descriptor instead.
+func (*ImportLicenseRow) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ImportLicenseRow) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportLicenseRow) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *ImportLicenseRow) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type AuditEvent struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// From the x-admin-actor header, "admin" if not sent.
+	Actor string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	// e.g. "license.update", "license.delete", "license.reset_hwid"
+	Action string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	// The license key affected.
+	Target        string           `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	OldValue      *structpb.Struct `protobuf:"bytes,6,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue      *structpb.Struct `protobuf:"bytes,7,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	SourceIp      string           `protobuf:"bytes,8,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_proto_whitelist_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AuditEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetOldValue() *structpb.Struct {
+	if x != nil {
+		return x.OldValue
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetNewValue() *structpb.Struct {
+	if x != nil {
+		return x.NewValue
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetSourceIp() string {
+	if x != nil {
+		return x.SourceIp
+	}
+	return ""
+}
+
+type ListAuditEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filters (all optional)
+	Actor  string                 `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	Action string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Target string                 `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Since  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`
+	Until  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=until,proto3" json:"until,omitempty"`
+	// Pagination. page_size defaults to 50, max 500.
+	PageSize  int32  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// "id desc" (newest first, the default) or "id"
+	OrderBy       string `protobuf:"bytes,8,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListAuditEventsRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ResellerGenerateLicenseRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Keys to generate, one credit each (default 1)
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	MaxDevices    int32                  `protobuf:"varint,4,opt,name=max_devices,json=maxDevices,proto3" json:"max_devices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResellerGenerateLicenseRequest) Reset() {
+	*x = ResellerGenerateLicenseRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResellerGenerateLicenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResellerGenerateLicenseRequest) ProtoMessage() {}
+
+func (x *ResellerGenerateLicenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResellerGenerateLicenseRequest.ProtoReflect.Descriptor instead.
+func (*ResellerGenerateLicenseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ResellerGenerateLicenseRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ResellerGenerateLicenseRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ResellerGenerateLicenseRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ResellerGenerateLicenseRequest) GetMaxDevices() int32 {
+	if x != nil {
+		return x.MaxDevices
+	}
+	return 0
+}
+
+type ResellerGenerateLicenseResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKeys      []string               `protobuf:"bytes,1,rep,name=license_keys,json=licenseKeys,proto3" json:"license_keys,omitempty"`
+	RemainingCredits int64                  `protobuf:"varint,2,opt,name=remaining_credits,json=remainingCredits,proto3" json:"remaining_credits,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ResellerGenerateLicenseResponse) Reset() {
+	*x = ResellerGenerateLicenseResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResellerGenerateLicenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResellerGenerateLicenseResponse) ProtoMessage() {}
+
+func (x *ResellerGenerateLicenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResellerGenerateLicenseResponse.ProtoReflect.Descriptor instead.
+func (*ResellerGenerateLicenseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ResellerGenerateLicenseResponse) GetLicenseKeys() []string {
+	if x != nil {
+		return x.LicenseKeys
+	}
+	return nil
+}
+
+func (x *ResellerGenerateLicenseResponse) GetRemainingCredits() int64 {
+	if x != nil {
+		return x.RemainingCredits
+	}
+	return 0
+}
+
+type Reseller struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name    string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Credits int64                  `protobuf:"varint,3,opt,name=credits,proto3" json:"credits,omitempty"`
+	// Products the reseller may generate keys for; empty means any
+	ProductIds    []string               `protobuf:"bytes,4,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reseller) Reset() {
+	*x = Reseller{}
+	mi := &file_proto_whitelist_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reseller) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reseller) ProtoMessage() {}
+
+func (x *Reseller) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reseller.ProtoReflect.Descriptor instead.
+func (*Reseller) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Reseller) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Reseller) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Reseller) GetCredits() int64 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *Reseller) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *Reseller) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateResellerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Credits       int64                  `protobuf:"varint,2,opt,name=credits,proto3" json:"credits,omitempty"`
+	ProductIds    []string               `protobuf:"bytes,3,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateResellerRequest) Reset() {
+	*x = CreateResellerRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateResellerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateResellerRequest) ProtoMessage() {}
+
+func (x *CreateResellerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateResellerRequest.ProtoReflect.Descriptor instead.
+func (*CreateResellerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateResellerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateResellerRequest) GetCredits() int64 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *CreateResellerRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+type CreateResellerResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Reseller *Reseller              `protobuf:"bytes,1,opt,name=reseller,proto3" json:"reseller,omitempty"`
+	// Only returned here; store it, the server keeps a hash
+	ApiKey        string `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateResellerResponse) Reset() {
+	*x = CreateResellerResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateResellerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateResellerResponse) ProtoMessage() {}
+
+func (x *CreateResellerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateResellerResponse.ProtoReflect.Descriptor instead.
+func (*CreateResellerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateResellerResponse) GetReseller() *Reseller {
+	if x != nil {
+		return x.Reseller
+	}
+	return nil
+}
+
+func (x *CreateResellerResponse) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+type TopUpResellerCreditsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ResellerId int64                  `protobuf:"varint,1,opt,name=reseller_id,json=resellerId,proto3" json:"reseller_id,omitempty"`
+	// Negative to take credits back
+	Credits       int64  `protobuf:"varint,2,opt,name=credits,proto3" json:"credits,omitempty"`
+	Note          string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopUpResellerCreditsRequest) Reset() {
+	*x = TopUpResellerCreditsRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopUpResellerCreditsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopUpResellerCreditsRequest) ProtoMessage() {}
+
+func (x *TopUpResellerCreditsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopUpResellerCreditsRequest.ProtoReflect.Descriptor instead.
+func (*TopUpResellerCreditsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TopUpResellerCreditsRequest) GetResellerId() int64 {
+	if x != nil {
+		return x.ResellerId
+	}
+	return 0
+}
+
+func (x *TopUpResellerCreditsRequest) GetCredits() int64 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *TopUpResellerCreditsRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ResellerActivity struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// "topup", "generate" or "extend"
+	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Change in credits (negative for generate)
+	Delta int64 `protobuf:"varint,4,opt,name=delta,proto3" json:"delta,omitempty"`
+	// Balance after this entry
+	Balance       int64    `protobuf:"varint,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	LicenseKeys   []string `protobuf:"bytes,6,rep,name=license_keys,json=licenseKeys,proto3" json:"license_keys,omitempty"`
+	Actor         string   `protobuf:"bytes,7,opt,name=actor,proto3" json:"actor,omitempty"`
+	Note          string   `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResellerActivity) Reset() {
+	*x = ResellerActivity{}
+	mi := &file_proto_whitelist_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResellerActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResellerActivity) ProtoMessage() {}
+
+func (x *ResellerActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResellerActivity.ProtoReflect.Descriptor instead.
+func (*ResellerActivity) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ResellerActivity) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResellerActivity) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ResellerActivity) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ResellerActivity) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *ResellerActivity) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *ResellerActivity) GetLicenseKeys() []string {
+	if x != nil {
+		return x.LicenseKeys
+	}
+	return nil
+}
+
+func (x *ResellerActivity) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ResellerActivity) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ListResellerActivityRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ResellerId int64                  `protobuf:"varint,1,opt,name=reseller_id,json=resellerId,proto3" json:"reseller_id,omitempty"`
+	// Pagination. page_size defaults to 50, max 500.
+	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// "id desc" (newest first, the default) or "id"
+	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResellerActivityRequest) Reset() {
+	*x = ListResellerActivityRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResellerActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResellerActivityRequest) ProtoMessage() {}
+
+func (x *ListResellerActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResellerActivityRequest.ProtoReflect.Descriptor instead.
+func (*ListResellerActivityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListResellerActivityRequest) GetResellerId() int64 {
+	if x != nil {
+		return x.ResellerId
+	}
+	return 0
+}
+
+func (x *ListResellerActivityRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListResellerActivityRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListResellerActivityRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListResellerActivityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Activity      []*ResellerActivity    `protobuf:"bytes,1,rep,name=activity,proto3" json:"activity,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResellerActivityResponse) Reset() {
+	*x = ListResellerActivityResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResellerActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResellerActivityResponse) ProtoMessage() {}
+
+func (x *ListResellerActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResellerActivityResponse.ProtoReflect.Descriptor instead.
+func (*ListResellerActivityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListResellerActivityResponse) GetActivity() []*ResellerActivity {
+	if x != nil {
+		return x.Activity
+	}
+	return nil
+}
+
+func (x *ListResellerActivityResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type AdminLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminLoginRequest) Reset() {
+	*x = AdminLoginRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLoginRequest) ProtoMessage() {}
+
+func (x *AdminLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLoginRequest.ProtoReflect.Descriptor instead.
+func (*AdminLoginRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AdminLoginRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AdminLoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type AdminLoginResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Send as "Authorization: Bearer <token>"
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	SessionId     string                 `protobuf:"bytes,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminLoginResponse) Reset() {
+	*x = AdminLoginResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLoginResponse) ProtoMessage() {}
+
+func (x *AdminLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLoginResponse.ProtoReflect.Descriptor instead.
+func (*AdminLoginResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AdminLoginResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AdminLoginResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *AdminLoginResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AdminLoginResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type Admin struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// "owner", "support" or "read-only"
+	Role        string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Disabled    bool                   `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastLoginAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_login_at,json=lastLoginAt,proto3" json:"last_login_at,omitempty"`
+	// Whether destructive calls need an x-admin-otp code
+	TotpEnabled   bool `protobuf:"varint,6,opt,name=totp_enabled,json=totpEnabled,proto3" json:"totp_enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Admin) Reset() {
+	*x = Admin{}
+	mi := &file_proto_whitelist_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Admin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Admin) ProtoMessage() {}
+
+func (x *Admin) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Admin.ProtoReflect.Descriptor instead.
+func (*Admin) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Admin) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Admin) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Admin) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Admin) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Admin) GetLastLoginAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastLoginAt
+	}
+	return nil
+}
+
+func (x *Admin) GetTotpEnabled() bool {
+	if x != nil {
+		return x.TotpEnabled
+	}
+	return false
+}
+
+type CreateAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAdminRequest) Reset() {
+	*x = CreateAdminRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAdminRequest) ProtoMessage() {}
+
+func (x *CreateAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAdminRequest.ProtoReflect.Descriptor instead.
+func (*CreateAdminRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateAdminRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CreateAdminRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateAdminRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type UpdateAdminRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// Unchanged when empty
+	Role     string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Disabled *bool  `protobuf:"varint,4,opt,name=disabled,proto3,oneof" json:"disabled,omitempty"`
+	// Drops the account's TOTP so it can enroll again, e.g. after losing the
+	// device. Needs the caller's own x-admin-otp.
+	ResetTotp     bool `protobuf:"varint,5,opt,name=reset_totp,json=resetTotp,proto3" json:"reset_totp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAdminRequest) Reset() {
+	*x = UpdateAdminRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAdminRequest) ProtoMessage() {}
+
+func (x *UpdateAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAdminRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAdminRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdateAdminRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UpdateAdminRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *UpdateAdminRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *UpdateAdminRequest) GetDisabled() bool {
+	if x != nil && x.Disabled != nil {
+		return *x.Disabled
+	}
+	return false
+}
+
+func (x *UpdateAdminRequest) GetResetTotp() bool {
+	if x != nil {
+		return x.ResetTotp
+	}
+	return false
+}
+
+type ListAdminsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAdminsRequest) Reset() {
+	*x = ListAdminsRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdminsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdminsRequest) ProtoMessage() {}
+
+func (x *ListAdminsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdminsRequest.ProtoReflect.Descriptor instead.
+func (*ListAdminsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{37}
+}
+
+type ListAdminsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Admins        []*Admin               `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAdminsResponse) Reset() {
+	*x = ListAdminsResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdminsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdminsResponse) ProtoMessage() {}
+
+func (x *ListAdminsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdminsResponse.ProtoReflect.Descriptor instead.
+func (*ListAdminsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListAdminsResponse) GetAdmins() []*Admin {
+	if x != nil {
+		return x.Admins
+	}
+	return nil
+}
+
+type AdminSession struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username   string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LastSeenAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	// Set once logged out or revoked
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,7,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,8,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSession) Reset() {
+	*x = AdminSession{}
+	mi := &file_proto_whitelist_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSession) ProtoMessage() {}
+
+func (x *AdminSession) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSession.ProtoReflect.Descriptor instead.
+func (*AdminSession) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AdminSession) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AdminSession) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AdminSession) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AdminSession) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *AdminSession) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+func (x *AdminSession) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *AdminSession) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *AdminSession) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+type ListAdminSessionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to the caller; only owners may name someone else
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// Also return ended (expired, logged out or revoked) sessions
+	IncludeEnded  bool `protobuf:"varint,2,opt,name=include_ended,json=includeEnded,proto3" json:"include_ended,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAdminSessionsRequest) Reset() {
+	*x = ListAdminSessionsRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdminSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdminSessionsRequest) ProtoMessage() {}
+
+func (x *ListAdminSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdminSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListAdminSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListAdminSessionsRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ListAdminSessionsRequest) GetIncludeEnded() bool {
+	if x != nil {
+		return x.IncludeEnded
+	}
+	return false
+}
+
+type ListAdminSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*AdminSession        `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAdminSessionsResponse) Reset() {
+	*x = ListAdminSessionsResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdminSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdminSessionsResponse) ProtoMessage() {}
+
+func (x *ListAdminSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdminSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListAdminSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListAdminSessionsResponse) GetSessions() []*AdminSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeAdminSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAdminSessionRequest) Reset() {
+	*x = RevokeAdminSessionRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAdminSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAdminSessionRequest) ProtoMessage() {}
+
+func (x *RevokeAdminSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAdminSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAdminSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RevokeAdminSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type ExportLicenseFileRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	// Device to issue the file for; it's bound to the license if it isn't yet.
+	// Empty issues a file usable on any device.
+	Hwid string `protobuf:"bytes,2,opt,name=hwid,proto3" json:"hwid,omitempty"`
+	// Offline window; 0 uses the server default
+	ValidForSeconds int64 `protobuf:"varint,3,opt,name=valid_for_seconds,json=validForSeconds,proto3" json:"valid_for_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExportLicenseFileRequest) Reset() {
+	*x = ExportLicenseFileRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportLicenseFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportLicenseFileRequest) ProtoMessage() {}
+
+func (x *ExportLicenseFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportLicenseFileRequest.ProtoReflect.Descriptor instead.
+func (*ExportLicenseFileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ExportLicenseFileRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *ExportLicenseFileRequest) GetHwid() string {
+	if x != nil {
+		return x.Hwid
+	}
+	return ""
+}
+
+func (x *ExportLicenseFileRequest) GetValidForSeconds() int64 {
+	if x != nil {
+		return x.ValidForSeconds
+	}
+	return 0
+}
+
+type ExportLicenseFileResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Signed license file, see the licensefile Go package
+	LicenseFile string                 `protobuf:"bytes,1,opt,name=license_file,json=licenseFile,proto3" json:"license_file,omitempty"`
+	ValidUntil  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	// Base64 Ed25519 public key that verifies the file
+	PublicKey string `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// Its kid, see GetSigningKeys
+	KeyId         string `protobuf:"bytes,4,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportLicenseFileResponse) Reset() {
+	*x = ExportLicenseFileResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportLicenseFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportLicenseFileResponse) ProtoMessage() {}
+
+func (x *ExportLicenseFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportLicenseFileResponse.ProtoReflect.Descriptor instead.
+func (*ExportLicenseFileResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ExportLicenseFileResponse) GetLicenseFile() string {
+	if x != nil {
+		return x.LicenseFile
+	}
+	return ""
+}
+
+func (x *ExportLicenseFileResponse) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+func (x *ExportLicenseFileResponse) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *ExportLicenseFileResponse) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+// StartSession needs an x-access-token header, like ValidateLicense.
+type StartSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey    string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Hwid          string                 `protobuf:"bytes,3,opt,name=hwid,proto3" json:"hwid,omitempty"`
+	ClientVersion string                 `protobuf:"bytes,4,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	// As in ValidateRequest
+	Challenge         string `protobuf:"bytes,5,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	ChallengeResponse string `protobuf:"bytes,6,opt,name=challenge_response,json=challengeResponse,proto3" json:"challenge_response,omitempty"`
+	Locale            string `protobuf:"bytes,7,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StartSessionRequest) Reset() {
+	*x = StartSessionRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSessionRequest) ProtoMessage() {}
+
+func (x *StartSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSessionRequest.ProtoReflect.Descriptor instead.
+func (*StartSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *StartSessionRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *StartSessionRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *StartSessionRequest) GetHwid() string {
+	if x != nil {
+		return x.Hwid
+	}
+	return ""
+}
+
+func (x *StartSessionRequest) GetClientVersion() string {
+	if x != nil {
+		return x.ClientVersion
+	}
+	return ""
+}
+
+func (x *StartSessionRequest) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+func (x *StartSessionRequest) GetChallengeResponse() string {
+	if x != nil {
+		return x.ChallengeResponse
+	}
+	return ""
+}
+
+func (x *StartSessionRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type StartSessionResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Valid   bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Seconds until the license expires. 0 means the license never expires.
+	ExpiresInSeconds int64 `protobuf:"varint,3,opt,name=expires_in_seconds,json=expiresInSeconds,proto3" json:"expires_in_seconds,omitempty"`
+	// Pass to Heartbeat and EndSession. Set only when valid.
+	SessionToken string `protobuf:"bytes,4,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	// Send a Heartbeat at least this often or the session times out.
+	HeartbeatIntervalSeconds int64 `protobuf:"varint,5,opt,name=heartbeat_interval_seconds,json=heartbeatIntervalSeconds,proto3" json:"heartbeat_interval_seconds,omitempty"`
+	// As in ValidateResponse
+	RequiredVersion   string `protobuf:"bytes,6,opt,name=required_version,json=requiredVersion,proto3" json:"required_version,omitempty"`
+	SuspendReason     string `protobuf:"bytes,7,opt,name=suspend_reason,json=suspendReason,proto3" json:"suspend_reason,omitempty"`
+	ChallengeResponse string `protobuf:"bytes,8,opt,name=challenge_response,json=challengeResponse,proto3" json:"challenge_response,omitempty"`
+	Reason            Reason `protobuf:"varint,9,opt,name=reason,proto3,enum=whitelist.Reason" json:"reason,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StartSessionResponse) Reset() {
+	*x = StartSessionResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSessionResponse) ProtoMessage() {}
+
+func (x *StartSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSessionResponse.ProtoReflect.Descriptor instead.
+func (*StartSessionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *StartSessionResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *StartSessionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StartSessionResponse) GetExpiresInSeconds() int64 {
+	if x != nil {
+		return x.ExpiresInSeconds
+	}
+	return 0
+}
+
+func (x *StartSessionResponse) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *StartSessionResponse) GetHeartbeatIntervalSeconds() int64 {
+	if x != nil {
+		return x.HeartbeatIntervalSeconds
+	}
+	return 0
+}
+
+func (x *StartSessionResponse) GetRequiredVersion() string {
+	if x != nil {
+		return x.RequiredVersion
+	}
+	return ""
+}
+
+func (x *StartSessionResponse) GetSuspendReason() string {
+	if x != nil {
+		return x.SuspendReason
+	}
+	return ""
+}
+
+func (x *StartSessionResponse) GetChallengeResponse() string {
+	if x != nil {
+		return x.ChallengeResponse
+	}
+	return ""
+}
+
+func (x *StartSessionResponse) GetReason() Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return Reason_REASON_UNSPECIFIED
+}
+
+type HeartbeatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeartbeatRequest) Reset() {
+	*x = HeartbeatRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeartbeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatRequest) ProtoMessage() {}
+
+func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *HeartbeatRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type HeartbeatResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// False once the session timed out or the license stopped being valid;
+	// start a new session.
+	Valid                    bool   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Message                  string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	HeartbeatIntervalSeconds int64  `protobuf:"varint,3,opt,name=heartbeat_interval_seconds,json=heartbeatIntervalSeconds,proto3" json:"heartbeat_interval_seconds,omitempty"`
+	// As in ValidateResponse
+	SuspendReason string `protobuf:"bytes,4,opt,name=suspend_reason,json=suspendReason,proto3" json:"suspend_reason,omitempty"`
+	Reason        Reason `protobuf:"varint,5,opt,name=reason,proto3,enum=whitelist.Reason" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeartbeatResponse) Reset() {
+	*x = HeartbeatResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeartbeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatResponse) ProtoMessage() {}
+
+func (x *HeartbeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatResponse.ProtoReflect.Descriptor instead.
+func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *HeartbeatResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *HeartbeatResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *HeartbeatResponse) GetHeartbeatIntervalSeconds() int64 {
+	if x != nil {
+		return x.HeartbeatIntervalSeconds
+	}
+	return 0
+}
+
+func (x *HeartbeatResponse) GetSuspendReason() string {
+	if x != nil {
+		return x.SuspendReason
+	}
+	return ""
+}
+
+func (x *HeartbeatResponse) GetReason() Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return Reason_REASON_UNSPECIFIED
+}
+
+type EndSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndSessionRequest) Reset() {
+	*x = EndSessionRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndSessionRequest) ProtoMessage() {}
+
+func (x *EndSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndSessionRequest.ProtoReflect.Descriptor instead.
+func (*EndSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *EndSessionRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+// WatchLicense needs an x-access-token header, like ValidateLicense.
+type WatchLicenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey    string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchLicenseRequest) Reset() {
+	*x = WatchLicenseRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchLicenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchLicenseRequest) ProtoMessage() {}
+
+func (x *WatchLicenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchLicenseRequest.ProtoReflect.Descriptor instead.
+func (*WatchLicenseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *WatchLicenseRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *WatchLicenseRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+// The first event is the current status; later ones are sent when the status
+// or expiry changes.
+type LicenseStatusEvent struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Status LicenseStatus          `protobuf:"varint,1,opt,name=status,proto3,enum=whitelist.LicenseStatus" json:"status,omitempty"`
+	// Whether ValidateLicense would accept the license now
+	Valid     bool                   `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	Message   string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	ChangedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	// As in ValidateResponse
+	SuspendReason string `protobuf:"bytes,6,opt,name=suspend_reason,json=suspendReason,proto3" json:"suspend_reason,omitempty"`
+	Reason        Reason `protobuf:"varint,7,opt,name=reason,proto3,enum=whitelist.Reason" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LicenseStatusEvent) Reset() {
+	*x = LicenseStatusEvent{}
+	mi := &file_proto_whitelist_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LicenseStatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LicenseStatusEvent) ProtoMessage() {}
+
+func (x *LicenseStatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LicenseStatusEvent.ProtoReflect.Descriptor instead.
+func (*LicenseStatusEvent) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *LicenseStatusEvent) GetStatus() LicenseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LicenseStatus_LICENSE_STATUS_UNSPECIFIED
+}
+
+func (x *LicenseStatusEvent) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *LicenseStatusEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LicenseStatusEvent) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *LicenseStatusEvent) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *LicenseStatusEvent) GetSuspendReason() string {
+	if x != nil {
+		return x.SuspendReason
+	}
+	return ""
+}
+
+func (x *LicenseStatusEvent) GetReason() Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return Reason_REASON_UNSPECIFIED
+}
+
+type ValidateLicensesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// At most 50 per call.
+	Licenses      []*ValidateRequest `protobuf:"bytes,1,rep,name=licenses,proto3" json:"licenses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateLicensesRequest) Reset() {
+	*x = ValidateLicensesRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateLicensesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateLicensesRequest) ProtoMessage() {}
+
+func (x *ValidateLicensesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateLicensesRequest.ProtoReflect.Descriptor instead.
+func (*ValidateLicensesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ValidateLicensesRequest) GetLicenses() []*ValidateRequest {
+	if x != nil {
+		return x.Licenses
+	}
+	return nil
+}
+
+type ValidateLicensesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One per requested license, in request order.
+	Results       []*ValidateResponse `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateLicensesResponse) Reset() {
+	*x = ValidateLicensesResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateLicensesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateLicensesResponse) ProtoMessage() {}
+
+func (x *ValidateLicensesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateLicensesResponse.ProtoReflect.Descriptor instead.
+func (*ValidateLicensesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ValidateLicensesResponse) GetResults() []*ValidateResponse {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type Product struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ProductId   string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Disabled products fail validation for all of their licenses
+	Disabled     bool                   `protobuf:"varint,4,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	LicenseCount int32                  `protobuf:"varint,7,opt,name=license_count,json=licenseCount,proto3" json:"license_count,omitempty"`
+	// Oldest client version ValidateLicense accepts; empty accepts any
+	MinVersion string `protobuf:"bytes,8,opt,name=min_version,json=minVersion,proto3" json:"min_version,omitempty"`
+	// Longest trial CreateTrialLicense hands out; 0 offers no trials
+	TrialDurationSeconds int64 `protobuf:"varint,9,opt,name=trial_duration_seconds,json=trialDurationSeconds,proto3" json:"trial_duration_seconds,omitempty"`
+	// ISO 3166-1 alpha-2 codes ValidateLicense accepts clients from; empty
+	// accepts every country
+	AllowedCountries []string `protobuf:"bytes,10,rep,name=allowed_countries,json=allowedCountries,proto3" json:"allowed_countries,omitempty"`
+	// Codes ValidateLicense rejects
+	BlockedCountries []string `protobuf:"bytes,11,rep,name=blocked_countries,json=blockedCountries,proto3" json:"blocked_countries,omitempty"`
+	// Whether validations must be signed (see RotateProductSigningSecret)
+	SignedRequests bool `protobuf:"varint,12,opt,name=signed_requests,json=signedRequests,proto3" json:"signed_requests,omitempty"`
+	// Whether validations must carry a challenge from GetChallenge
+	RequireChallenge bool `protobuf:"varint,13,opt,name=require_challenge,json=requireChallenge,proto3" json:"require_challenge,omitempty"`
+	// Shape of the product's license keys, e.g. "PROD-XXXX-XXXX-XXX#": X is a
+	// random character, # a check character over them and anything else
+	// itself. Empty allows any key.
+	KeyFormat string `protobuf:"bytes,14,opt,name=key_format,json=keyFormat,proto3" json:"key_format,omitempty"`
+	// Feature flags of every license of the product, unless the license sets
+	// its own
+	Features      map[string]bool `protobuf:"bytes,15,rep,name=features,proto3" json:"features,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_proto_whitelist_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Product) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *Product) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Product) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Product) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Product) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Product) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Product) GetLicenseCount() int32 {
+	if x != nil {
+		return x.LicenseCount
+	}
+	return 0
+}
+
+func (x *Product) GetMinVersion() string {
+	if x != nil {
+		return x.MinVersion
+	}
+	return ""
+}
+
+func (x *Product) GetTrialDurationSeconds() int64 {
+	if x != nil {
+		return x.TrialDurationSeconds
+	}
+	return 0
+}
+
+func (x *Product) GetAllowedCountries() []string {
+	if x != nil {
+		return x.AllowedCountries
+	}
+	return nil
+}
+
+func (x *Product) GetBlockedCountries() []string {
+	if x != nil {
+		return x.BlockedCountries
+	}
+	return nil
+}
+
+func (x *Product) GetSignedRequests() bool {
+	if x != nil {
+		return x.SignedRequests
+	}
+	return false
+}
+
+func (x *Product) GetRequireChallenge() bool {
+	if x != nil {
+		return x.RequireChallenge
+	}
+	return false
+}
+
+func (x *Product) GetKeyFormat() string {
+	if x != nil {
+		return x.KeyFormat
+	}
+	return ""
+}
+
+func (x *Product) GetFeatures() map[string]bool {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+type CreateProductRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Defaults to product_id
+	Name                 string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string          `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	MinVersion           string          `protobuf:"bytes,4,opt,name=min_version,json=minVersion,proto3" json:"min_version,omitempty"`
+	TrialDurationSeconds int64           `protobuf:"varint,5,opt,name=trial_duration_seconds,json=trialDurationSeconds,proto3" json:"trial_duration_seconds,omitempty"`
+	AllowedCountries     []string        `protobuf:"bytes,6,rep,name=allowed_countries,json=allowedCountries,proto3" json:"allowed_countries,omitempty"`
+	BlockedCountries     []string        `protobuf:"bytes,7,rep,name=blocked_countries,json=blockedCountries,proto3" json:"blocked_countries,omitempty"`
+	RequireChallenge     bool            `protobuf:"varint,8,opt,name=require_challenge,json=requireChallenge,proto3" json:"require_challenge,omitempty"`
+	KeyFormat            string          `protobuf:"bytes,9,opt,name=key_format,json=keyFormat,proto3" json:"key_format,omitempty"`
+	Features             map[string]bool `protobuf:"bytes,10,rep,name=features,proto3" json:"features,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateProductRequest) Reset() {
+	*x = CreateProductRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductRequest) ProtoMessage() {}
+
+func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CreateProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetMinVersion() string {
+	if x != nil {
+		return x.MinVersion
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetTrialDurationSeconds() int64 {
+	if x != nil {
+		return x.TrialDurationSeconds
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetAllowedCountries() []string {
+	if x != nil {
+		return x.AllowedCountries
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetBlockedCountries() []string {
+	if x != nil {
+		return x.BlockedCountries
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetRequireChallenge() bool {
+	if x != nil {
+		return x.RequireChallenge
+	}
+	return false
+}
+
+func (x *CreateProductRequest) GetKeyFormat() string {
+	if x != nil {
+		return x.KeyFormat
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetFeatures() map[string]bool {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+type UpdateProductRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Unchanged when unset
+	Name        *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Disabled    *bool   `protobuf:"varint,4,opt,name=disabled,proto3,oneof" json:"disabled,omitempty"`
+	// Empty accepts any client version
+	MinVersion *string `protobuf:"bytes,5,opt,name=min_version,json=minVersion,proto3,oneof" json:"min_version,omitempty"`
+	// 0 stops offering trials
+	TrialDurationSeconds *int64       `protobuf:"varint,6,opt,name=trial_duration_seconds,json=trialDurationSeconds,proto3,oneof" json:"trial_duration_seconds,omitempty"`
+	AllowedCountries     *CountryList `protobuf:"bytes,7,opt,name=allowed_countries,json=allowedCountries,proto3" json:"allowed_countries,omitempty"`
+	BlockedCountries     *CountryList `protobuf:"bytes,8,opt,name=blocked_countries,json=blockedCountries,proto3" json:"blocked_countries,omitempty"`
+	RequireChallenge     *bool        `protobuf:"varint,9,opt,name=require_challenge,json=requireChallenge,proto3,oneof" json:"require_challenge,omitempty"`
+	// Every license of the product must already fit it; empty allows any key
+	KeyFormat     *string       `protobuf:"bytes,10,opt,name=key_format,json=keyFormat,proto3,oneof" json:"key_format,omitempty"`
+	Features      *FeatureFlags `protobuf:"bytes,11,opt,name=features,proto3" json:"features,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRequest) ProtoMessage() {}
+
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UpdateProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetDisabled() bool {
+	if x != nil && x.Disabled != nil {
+		return *x.Disabled
+	}
+	return false
+}
+
+func (x *UpdateProductRequest) GetMinVersion() string {
+	if x != nil && x.MinVersion != nil {
+		return *x.MinVersion
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetTrialDurationSeconds() int64 {
+	if x != nil && x.TrialDurationSeconds != nil {
+		return *x.TrialDurationSeconds
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetAllowedCountries() *CountryList {
+	if x != nil {
+		return x.AllowedCountries
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetBlockedCountries() *CountryList {
+	if x != nil {
+		return x.BlockedCountries
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetRequireChallenge() bool {
+	if x != nil && x.RequireChallenge != nil {
+		return *x.RequireChallenge
+	}
+	return false
+}
+
+func (x *UpdateProductRequest) GetKeyFormat() string {
+	if x != nil && x.KeyFormat != nil {
+		return *x.KeyFormat
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetFeatures() *FeatureFlags {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+// Wraps a country list so an update can tell "unchanged" (unset) from
+// "clear" (set, empty).
+type CountryList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Countries     []string               `protobuf:"bytes,1,rep,name=countries,proto3" json:"countries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountryList) Reset() {
+	*x = CountryList{}
+	mi := &file_proto_whitelist_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountryList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountryList) ProtoMessage() {}
+
+func (x *CountryList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountryList.ProtoReflect.Descriptor instead.
+func (*CountryList) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CountryList) GetCountries() []string {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+// Wraps feature flags the same way.
+type FeatureFlags struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flags         map[string]bool        `protobuf:"bytes,1,rep,name=flags,proto3" json:"flags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeatureFlags) Reset() {
+	*x = FeatureFlags{}
+	mi := &file_proto_whitelist_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeatureFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeatureFlags) ProtoMessage() {}
+
+func (x *FeatureFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeatureFlags.ProtoReflect.Descriptor instead.
+func (*FeatureFlags) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *FeatureFlags) GetFlags() map[string]bool {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+type ListProductsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Also return disabled products
+	IncludeDisabled bool `protobuf:"varint,1,opt,name=include_disabled,json=includeDisabled,proto3" json:"include_disabled,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListProductsRequest) GetIncludeDisabled() bool {
+	if x != nil {
+		return x.IncludeDisabled
+	}
+	return false
+}
+
+type ListProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsResponse) ProtoMessage() {}
+
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type DeleteProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DeleteProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+// Settings shared by licenses on the plan, so they needn't be repeated key
+// by key.
+type Plan struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Given to licenses joining the plan
+	MaxDevices int32 `protobuf:"varint,3,opt,name=max_devices,json=maxDevices,proto3" json:"max_devices,omitempty"`
+	// How long licenses joining the plan last; 0 for lifetime
+	DurationSeconds int64 `protobuf:"varint,4,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	// Feature flags of the plan's licenses, over their product's and under
+	// their own
+	Features      map[string]bool        `protobuf:"bytes,5,rep,name=features,proto3" json:"features,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	LicenseCount  int32                  `protobuf:"varint,8,opt,name=license_count,json=licenseCount,proto3" json:"license_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Plan) Reset() {
+	*x = Plan{}
+	mi := &file_proto_whitelist_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Plan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plan) ProtoMessage() {}
+
+func (x *Plan) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plan.ProtoReflect.Descriptor instead.
+func (*Plan) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Plan) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Plan) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Plan) GetMaxDevices() int32 {
+	if x != nil {
+		return x.MaxDevices
+	}
+	return 0
+}
+
+func (x *Plan) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *Plan) GetFeatures() map[string]bool {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *Plan) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Plan) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Plan) GetLicenseCount() int32 {
+	if x != nil {
+		return x.LicenseCount
+	}
+	return 0
+}
+
+type CreatePlanRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Defaults to 1
+	MaxDevices      int32           `protobuf:"varint,3,opt,name=max_devices,json=maxDevices,proto3" json:"max_devices,omitempty"`
+	DurationSeconds int64           `protobuf:"varint,4,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Features        map[string]bool `protobuf:"bytes,5,rep,name=features,proto3" json:"features,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreatePlanRequest) Reset() {
+	*x = CreatePlanRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlanRequest) ProtoMessage() {}
+
+func (x *CreatePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlanRequest.ProtoReflect.Descriptor instead.
+func (*CreatePlanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CreatePlanRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePlanRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreatePlanRequest) GetMaxDevices() int32 {
+	if x != nil {
+		return x.MaxDevices
+	}
+	return 0
+}
+
+func (x *CreatePlanRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *CreatePlanRequest) GetFeatures() map[string]bool {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+// Changes apply to licenses joining the plan from now on, except features,
+// which apply to all of its licenses at once.
+type UpdatePlanRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Unchanged when unset
+	Description     *string       `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	MaxDevices      *int32        `protobuf:"varint,3,opt,name=max_devices,json=maxDevices,proto3,oneof" json:"max_devices,omitempty"`
+	DurationSeconds *int64        `protobuf:"varint,4,opt,name=duration_seconds,json=durationSeconds,proto3,oneof" json:"duration_seconds,omitempty"`
+	Features        *FeatureFlags `protobuf:"bytes,5,opt,name=features,proto3" json:"features,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdatePlanRequest) Reset() {
+	*x = UpdatePlanRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlanRequest) ProtoMessage() {}
+
+func (x *UpdatePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlanRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePlanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UpdatePlanRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdatePlanRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdatePlanRequest) GetMaxDevices() int32 {
+	if x != nil && x.MaxDevices != nil {
+		return *x.MaxDevices
+	}
+	return 0
+}
+
+func (x *UpdatePlanRequest) GetDurationSeconds() int64 {
+	if x != nil && x.DurationSeconds != nil {
+		return *x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *UpdatePlanRequest) GetFeatures() *FeatureFlags {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+type ListPlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlansRequest) Reset() {
+	*x = ListPlansRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlansRequest) ProtoMessage() {}
+
+func (x *ListPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlansRequest.ProtoReflect.Descriptor instead.
+func (*ListPlansRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{65}
+}
+
+type ListPlansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plans         []*Plan                `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlansResponse) Reset() {
+	*x = ListPlansResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlansResponse) ProtoMessage() {}
+
+func (x *ListPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlansResponse.ProtoReflect.Descriptor instead.
+func (*ListPlansResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListPlansResponse) GetPlans() []*Plan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+type DeletePlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlanRequest) Reset() {
+	*x = DeletePlanRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlanRequest) ProtoMessage() {}
+
+func (x *DeletePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlanRequest.ProtoReflect.Descriptor instead.
+func (*DeletePlanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DeletePlanRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// A version published on a product's update channel.
+type Release struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Changelog     string                 `protobuf:"bytes,4,opt,name=changelog,proto3" json:"changelog,omitempty"`
+	DownloadUrl   string                 `protobuf:"bytes,5,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	PublishedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Release) Reset() {
+	*x = Release{}
+	mi := &file_proto_whitelist_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Release) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Release) ProtoMessage() {}
+
+func (x *Release) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Release.ProtoReflect.Descriptor instead.
+func (*Release) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *Release) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *Release) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *Release) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Release) GetChangelog() string {
+	if x != nil {
+		return x.Changelog
+	}
+	return ""
+}
+
+func (x *Release) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *Release) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+type GetLatestVersionRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Defaults to "stable"
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// Optional. A license pinned to a channel gets that channel instead.
+	LicenseKey    string `protobuf:"bytes,3,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLatestVersionRequest) Reset() {
+	*x = GetLatestVersionRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLatestVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLatestVersionRequest) ProtoMessage() {}
+
+func (x *GetLatestVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLatestVersionRequest.ProtoReflect.Descriptor instead.
+func (*GetLatestVersionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetLatestVersionRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetLatestVersionRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *GetLatestVersionRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+type PublishReleaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Changelog     string                 `protobuf:"bytes,4,opt,name=changelog,proto3" json:"changelog,omitempty"`
+	DownloadUrl   string                 `protobuf:"bytes,5,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishReleaseRequest) Reset() {
+	*x = PublishReleaseRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishReleaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishReleaseRequest) ProtoMessage() {}
+
+func (x *PublishReleaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishReleaseRequest.ProtoReflect.Descriptor instead.
+func (*PublishReleaseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *PublishReleaseRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PublishReleaseRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *PublishReleaseRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *PublishReleaseRequest) GetChangelog() string {
+	if x != nil {
+		return x.Changelog
+	}
+	return ""
+}
+
+func (x *PublishReleaseRequest) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+type SetLicenseChannelRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	// Empty unpins the license
+	Channel       string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLicenseChannelRequest) Reset() {
+	*x = SetLicenseChannelRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLicenseChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLicenseChannelRequest) ProtoMessage() {}
+
+func (x *SetLicenseChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLicenseChannelRequest.ProtoReflect.Descriptor instead.
+func (*SetLicenseChannelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SetLicenseChannelRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *SetLicenseChannelRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+// A person (or account) that owns licenses.
+type Customer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	DiscordId     string                 `protobuf:"bytes,3,opt,name=discord_id,json=discordId,proto3" json:"discord_id,omitempty"`
+	Notes         string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LicenseCount  int32                  `protobuf:"varint,6,opt,name=license_count,json=licenseCount,proto3" json:"license_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Customer) Reset() {
+	*x = Customer{}
+	mi := &file_proto_whitelist_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Customer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Customer) ProtoMessage() {}
+
+func (x *Customer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Customer.ProtoReflect.Descriptor instead.
+func (*Customer) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *Customer) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Customer) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Customer) GetDiscordId() string {
+	if x != nil {
+		return x.DiscordId
+	}
+	return ""
+}
+
+func (x *Customer) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Customer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Customer) GetLicenseCount() int32 {
+	if x != nil {
+		return x.LicenseCount
+	}
+	return 0
+}
+
+type CreateCustomerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// At least one of email and discord_id is required; each is unique.
+	Email         string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	DiscordId     string `protobuf:"bytes,2,opt,name=discord_id,json=discordId,proto3" json:"discord_id,omitempty"`
+	Notes         string `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCustomerRequest) Reset() {
+	*x = CreateCustomerRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCustomerRequest) ProtoMessage() {}
+
+func (x *CreateCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCustomerRequest.ProtoReflect.Descriptor instead.
+func (*CreateCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CreateCustomerRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateCustomerRequest) GetDiscordId() string {
+	if x != nil {
+		return x.DiscordId
+	}
+	return ""
+}
+
+func (x *CreateCustomerRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type ListCustomersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filters (all optional). email matches case-insensitively.
+	Email     string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	DiscordId string `protobuf:"bytes,2,opt,name=discord_id,json=discordId,proto3" json:"discord_id,omitempty"`
+	// Customer owning this license
+	LicenseKey string `protobuf:"bytes,3,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	// Pagination. page_size defaults to 50, max 500.
+	PageSize  int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// id (the default), email or created_at, optionally followed by " desc"
+	OrderBy       string `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCustomersRequest) Reset() {
+	*x = ListCustomersRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomersRequest) ProtoMessage() {}
+
+func (x *ListCustomersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomersRequest.ProtoReflect.Descriptor instead.
+func (*ListCustomersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListCustomersRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ListCustomersRequest) GetDiscordId() string {
+	if x != nil {
+		return x.DiscordId
+	}
+	return ""
+}
+
+func (x *ListCustomersRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *ListCustomersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCustomersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCustomersRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListCustomersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customers     []*Customer            `protobuf:"bytes,1,rep,name=customers,proto3" json:"customers,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCustomersResponse) Reset() {
+	*x = ListCustomersResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomersResponse) ProtoMessage() {}
+
+func (x *ListCustomersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomersResponse.ProtoReflect.Descriptor instead.
+func (*ListCustomersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListCustomersResponse) GetCustomers() []*Customer {
+	if x != nil {
+		return x.Customers
+	}
+	return nil
+}
+
+func (x *ListCustomersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type AttachLicenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    int64                  `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	LicenseKey    string                 `protobuf:"bytes,2,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachLicenseRequest) Reset() {
+	*x = AttachLicenseRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachLicenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachLicenseRequest) ProtoMessage() {}
+
+func (x *AttachLicenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachLicenseRequest.ProtoReflect.Descriptor instead.
+func (*AttachLicenseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *AttachLicenseRequest) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *AttachLicenseRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+type DetachLicenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    int64                  `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	LicenseKey    string                 `protobuf:"bytes,2,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetachLicenseRequest) Reset() {
+	*x = DetachLicenseRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetachLicenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachLicenseRequest) ProtoMessage() {}
+
+func (x *DetachLicenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachLicenseRequest.ProtoReflect.Descriptor instead.
+func (*DetachLicenseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DetachLicenseRequest) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *DetachLicenseRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+type IssueLicenseToEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Email string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	// Send this existing license. When empty a new one is generated from
+	// `license` (its count is ignored).
+	LicenseKey    string                   `protobuf:"bytes,2,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	License       *GenerateLicensesRequest `protobuf:"bytes,3,opt,name=license,proto3" json:"license,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueLicenseToEmailRequest) Reset() {
+	*x = IssueLicenseToEmailRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueLicenseToEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueLicenseToEmailRequest) ProtoMessage() {}
+
+func (x *IssueLicenseToEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueLicenseToEmailRequest.ProtoReflect.Descriptor instead.
+func (*IssueLicenseToEmailRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *IssueLicenseToEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *IssueLicenseToEmailRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *IssueLicenseToEmailRequest) GetLicense() *GenerateLicensesRequest {
+	if x != nil {
+		return x.License
+	}
+	return nil
+}
+
+// One email of a license key.
+type LicenseDelivery struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	LicenseKey string                 `protobuf:"bytes,2,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	Email      string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	// "sent" or "failed"
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// Provider error when failed
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// Admin who requested it
+	RequestedBy   string                 `protobuf:"bytes,6,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	SentAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LicenseDelivery) Reset() {
+	*x = LicenseDelivery{}
+	mi := &file_proto_whitelist_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LicenseDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LicenseDelivery) ProtoMessage() {}
+
+func (x *LicenseDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LicenseDelivery.ProtoReflect.Descriptor instead.
+func (*LicenseDelivery) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *LicenseDelivery) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LicenseDelivery) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *LicenseDelivery) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LicenseDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *LicenseDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *LicenseDelivery) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *LicenseDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LicenseDelivery) GetSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentAt
+	}
+	return nil
+}
+
+type IssueLicenseToEmailResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	// A failed send still returns the (possibly new) key; retry by license_key.
+	Delivery      *LicenseDelivery `protobuf:"bytes,2,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueLicenseToEmailResponse) Reset() {
+	*x = IssueLicenseToEmailResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueLicenseToEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueLicenseToEmailResponse) ProtoMessage() {}
+
+func (x *IssueLicenseToEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueLicenseToEmailResponse.ProtoReflect.Descriptor instead.
+func (*IssueLicenseToEmailResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *IssueLicenseToEmailResponse) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *IssueLicenseToEmailResponse) GetDelivery() *LicenseDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+type ListLicenseDeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey    string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLicenseDeliveriesRequest) Reset() {
+	*x = ListLicenseDeliveriesRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLicenseDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLicenseDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListLicenseDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLicenseDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListLicenseDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListLicenseDeliveriesRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+type ListLicenseDeliveriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Newest first
+	Deliveries    []*LicenseDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLicenseDeliveriesResponse) Reset() {
+	*x = ListLicenseDeliveriesResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLicenseDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLicenseDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListLicenseDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLicenseDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListLicenseDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListLicenseDeliveriesResponse) GetDeliveries() []*LicenseDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+type CreateTrialLicenseRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Defaults to, and is capped at, the product's trial_duration_seconds
+	DurationSeconds int64 `protobuf:"varint,2,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	// The new license is bound to this device
+	Hwid          string `protobuf:"bytes,3,opt,name=hwid,proto3" json:"hwid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTrialLicenseRequest) Reset() {
+	*x = CreateTrialLicenseRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTrialLicenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTrialLicenseRequest) ProtoMessage() {}
+
+func (x *CreateTrialLicenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTrialLicenseRequest.ProtoReflect.Descriptor instead.
+func (*CreateTrialLicenseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *CreateTrialLicenseRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateTrialLicenseRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *CreateTrialLicenseRequest) GetHwid() string {
+	if x != nil {
+		return x.Hwid
+	}
+	return ""
+}
+
+type CreateTrialLicenseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey    string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTrialLicenseResponse) Reset() {
+	*x = CreateTrialLicenseResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTrialLicenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTrialLicenseResponse) ProtoMessage() {}
+
+func (x *CreateTrialLicenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTrialLicenseResponse.ProtoReflect.Descriptor instead.
+func (*CreateTrialLicenseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CreateTrialLicenseResponse) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *CreateTrialLicenseResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type ExtendLicenseRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	// Added to the current expiry, or to now if the license already expired
+	DurationSeconds int64 `protobuf:"varint,2,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExtendLicenseRequest) Reset() {
+	*x = ExtendLicenseRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendLicenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendLicenseRequest) ProtoMessage() {}
+
+func (x *ExtendLicenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendLicenseRequest.ProtoReflect.Descriptor instead.
+func (*ExtendLicenseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ExtendLicenseRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *ExtendLicenseRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type ResellerExtendLicenseResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RemainingCredits int64                  `protobuf:"varint,2,opt,name=remaining_credits,json=remainingCredits,proto3" json:"remaining_credits,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ResellerExtendLicenseResponse) Reset() {
+	*x = ResellerExtendLicenseResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResellerExtendLicenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResellerExtendLicenseResponse) ProtoMessage() {}
+
+func (x *ResellerExtendLicenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResellerExtendLicenseResponse.ProtoReflect.Descriptor instead.
+func (*ResellerExtendLicenseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ResellerExtendLicenseResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ResellerExtendLicenseResponse) GetRemainingCredits() int64 {
+	if x != nil {
+		return x.RemainingCredits
+	}
+	return 0
+}
+
+type SuspendLicenseRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	// Shown to clients, e.g. "Chargeback" or "ToS violation". At most 200 characters.
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendLicenseRequest) Reset() {
+	*x = SuspendLicenseRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendLicenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendLicenseRequest) ProtoMessage() {}
+
+func (x *SuspendLicenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendLicenseRequest.ProtoReflect.Descriptor instead.
+func (*SuspendLicenseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SuspendLicenseRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *SuspendLicenseRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UnsuspendLicenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey    string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsuspendLicenseRequest) Reset() {
+	*x = UnsuspendLicenseRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsuspendLicenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsuspendLicenseRequest) ProtoMessage() {}
+
+func (x *UnsuspendLicenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsuspendLicenseRequest.ProtoReflect.Descriptor instead.
+func (*UnsuspendLicenseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *UnsuspendLicenseRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+type HwidBan struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Stored hashed, see README "Device IDs"
+	Hwid string `protobuf:"bytes,1,opt,name=hwid,proto3" json:"hwid,omitempty"`
+	// For admins; clients only see "Device is banned"
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	BannedBy      string                 `protobuf:"bytes,3,opt,name=banned_by,json=bannedBy,proto3" json:"banned_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HwidBan) Reset() {
+	*x = HwidBan{}
+	mi := &file_proto_whitelist_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HwidBan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HwidBan) ProtoMessage() {}
+
+func (x *HwidBan) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HwidBan.ProtoReflect.Descriptor instead.
+func (*HwidBan) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *HwidBan) GetHwid() string {
+	if x != nil {
+		return x.Hwid
+	}
+	return ""
+}
+
+func (x *HwidBan) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *HwidBan) GetBannedBy() string {
+	if x != nil {
+		return x.BannedBy
+	}
+	return ""
+}
+
+func (x *HwidBan) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type BanHwidRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hwid          string                 `protobuf:"bytes,1,opt,name=hwid,proto3" json:"hwid,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanHwidRequest) Reset() {
+	*x = BanHwidRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanHwidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanHwidRequest) ProtoMessage() {}
+
+func (x *BanHwidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanHwidRequest.ProtoReflect.Descriptor instead.
+func (*BanHwidRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *BanHwidRequest) GetHwid() string {
+	if x != nil {
+		return x.Hwid
+	}
+	return ""
+}
+
+func (x *BanHwidRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UnbanHwidRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hwid          string                 `protobuf:"bytes,1,opt,name=hwid,proto3" json:"hwid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnbanHwidRequest) Reset() {
+	*x = UnbanHwidRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbanHwidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanHwidRequest) ProtoMessage() {}
+
+func (x *UnbanHwidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanHwidRequest.ProtoReflect.Descriptor instead.
+func (*UnbanHwidRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *UnbanHwidRequest) GetHwid() string {
+	if x != nil {
+		return x.Hwid
+	}
+	return ""
+}
+
+type ListHwidBansRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pagination. page_size defaults to 50, max 500.
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// hwid (the default) or created_at, optionally followed by " desc"
+	OrderBy       string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHwidBansRequest) Reset() {
+	*x = ListHwidBansRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHwidBansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHwidBansRequest) ProtoMessage() {}
+
+func (x *ListHwidBansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHwidBansRequest.ProtoReflect.Descriptor instead.
+func (*ListHwidBansRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ListHwidBansRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListHwidBansRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListHwidBansRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListHwidBansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bans          []*HwidBan             `protobuf:"bytes,1,rep,name=bans,proto3" json:"bans,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHwidBansResponse) Reset() {
+	*x = ListHwidBansResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHwidBansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHwidBansResponse) ProtoMessage() {}
+
+func (x *ListHwidBansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHwidBansResponse.ProtoReflect.Descriptor instead.
+func (*ListHwidBansResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ListHwidBansResponse) GetBans() []*HwidBan {
+	if x != nil {
+		return x.Bans
+	}
+	return nil
+}
+
+func (x *ListHwidBansResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type IpBan struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CIDR, e.g. "203.0.113.7/32"
+	Network string `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Admin, or "auto" for automatic bans
+	BannedBy  string                 `protobuf:"bytes,3,opt,name=banned_by,json=bannedBy,proto3" json:"banned_by,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Unset for permanent bans
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IpBan) Reset() {
+	*x = IpBan{}
+	mi := &file_proto_whitelist_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IpBan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IpBan) ProtoMessage() {}
+
+func (x *IpBan) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IpBan.ProtoReflect.Descriptor instead.
+func (*IpBan) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *IpBan) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *IpBan) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *IpBan) GetBannedBy() string {
+	if x != nil {
+		return x.BannedBy
+	}
+	return ""
+}
+
+func (x *IpBan) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *IpBan) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type BanIpRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// An address or a CIDR range
+	Network string `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// 0 bans for good
+	DurationSeconds int64 `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BanIpRequest) Reset() {
+	*x = BanIpRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanIpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanIpRequest) ProtoMessage() {}
+
+func (x *BanIpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanIpRequest.ProtoReflect.Descriptor instead.
+func (*BanIpRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *BanIpRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *BanIpRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BanIpRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type UnbanIpRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// As banned; passed as ?network=
+	Network       string `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnbanIpRequest) Reset() {
+	*x = UnbanIpRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbanIpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanIpRequest) ProtoMessage() {}
+
+func (x *UnbanIpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanIpRequest.ProtoReflect.Descriptor instead.
+func (*UnbanIpRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *UnbanIpRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+type ListIpBansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIpBansRequest) Reset() {
+	*x = ListIpBansRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIpBansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIpBansRequest) ProtoMessage() {}
+
+func (x *ListIpBansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIpBansRequest.ProtoReflect.Descriptor instead.
+func (*ListIpBansRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{97}
+}
+
+type ListIpBansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bans          []*IpBan               `protobuf:"bytes,1,rep,name=bans,proto3" json:"bans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIpBansResponse) Reset() {
+	*x = ListIpBansResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIpBansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIpBansResponse) ProtoMessage() {}
+
+func (x *ListIpBansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIpBansResponse.ProtoReflect.Descriptor instead.
+func (*ListIpBansResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ListIpBansResponse) GetBans() []*IpBan {
+	if x != nil {
+		return x.Bans
+	}
+	return nil
+}
+
+type SetLicenseCountriesRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	// Replace the license's lists; empty clears them
+	AllowedCountries []string `protobuf:"bytes,2,rep,name=allowed_countries,json=allowedCountries,proto3" json:"allowed_countries,omitempty"`
+	BlockedCountries []string `protobuf:"bytes,3,rep,name=blocked_countries,json=blockedCountries,proto3" json:"blocked_countries,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetLicenseCountriesRequest) Reset() {
+	*x = SetLicenseCountriesRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLicenseCountriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLicenseCountriesRequest) ProtoMessage() {}
+
+func (x *SetLicenseCountriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLicenseCountriesRequest.ProtoReflect.Descriptor instead.
+func (*SetLicenseCountriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *SetLicenseCountriesRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *SetLicenseCountriesRequest) GetAllowedCountries() []string {
+	if x != nil {
+		return x.AllowedCountries
+	}
+	return nil
+}
+
+func (x *SetLicenseCountriesRequest) GetBlockedCountries() []string {
+	if x != nil {
+		return x.BlockedCountries
+	}
+	return nil
+}
+
+type Lockout struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "key" or "ip"
+	Scope string `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	// The License key or client IP
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// Failed validations since the last success or lockout
+	Failures int32 `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"`
+	// Unset unless locked out
+	LockedUntil   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=locked_until,json=lockedUntil,proto3" json:"locked_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Lockout) Reset() {
+	*x = Lockout{}
+	mi := &file_proto_whitelist_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Lockout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lockout) ProtoMessage() {}
+
+func (x *Lockout) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lockout.ProtoReflect.Descriptor instead.
+func (*Lockout) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *Lockout) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *Lockout) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Lockout) GetFailures() int32 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *Lockout) GetLockedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LockedUntil
+	}
+	return nil
+}
+
+type ClearLockoutsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// At least one of them; passed as ?license_key=&ip=
+	LicenseKey    string `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	Ip            string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearLockoutsRequest) Reset() {
+	*x = ClearLockoutsRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearLockoutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearLockoutsRequest) ProtoMessage() {}
+
+func (x *ClearLockoutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearLockoutsRequest.ProtoReflect.Descriptor instead.
+func (*ClearLockoutsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ClearLockoutsRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *ClearLockoutsRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+type ClearLockoutsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The entries removed, counters included
+	Cleared       []*Lockout `protobuf:"bytes,1,rep,name=cleared,proto3" json:"cleared,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearLockoutsResponse) Reset() {
+	*x = ClearLockoutsResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearLockoutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearLockoutsResponse) ProtoMessage() {}
+
+func (x *ClearLockoutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearLockoutsResponse.ProtoReflect.Descriptor instead.
+func (*ClearLockoutsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ClearLockoutsResponse) GetCleared() []*Lockout {
+	if x != nil {
+		return x.Cleared
+	}
+	return nil
+}
+
+type RotateProductSigningSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateProductSigningSecretRequest) Reset() {
+	*x = RotateProductSigningSecretRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateProductSigningSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateProductSigningSecretRequest) ProtoMessage() {}
+
+func (x *RotateProductSigningSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateProductSigningSecretRequest.ProtoReflect.Descriptor instead.
+func (*RotateProductSigningSecretRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *RotateProductSigningSecretRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type RotateProductSigningSecretResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Product *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	// Shown only here; the old secret stops working at once
+	SigningSecret string `protobuf:"bytes,2,opt,name=signing_secret,json=signingSecret,proto3" json:"signing_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateProductSigningSecretResponse) Reset() {
+	*x = RotateProductSigningSecretResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateProductSigningSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateProductSigningSecretResponse) ProtoMessage() {}
+
+func (x *RotateProductSigningSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateProductSigningSecretResponse.ProtoReflect.Descriptor instead.
+func (*RotateProductSigningSecretResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *RotateProductSigningSecretResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *RotateProductSigningSecretResponse) GetSigningSecret() string {
+	if x != nil {
+		return x.SigningSecret
+	}
+	return ""
+}
+
+type RemoveProductSigningSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveProductSigningSecretRequest) Reset() {
+	*x = RemoveProductSigningSecretRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveProductSigningSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveProductSigningSecretRequest) ProtoMessage() {}
+
+func (x *RemoveProductSigningSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveProductSigningSecretRequest.ProtoReflect.Descriptor instead.
+func (*RemoveProductSigningSecretRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *RemoveProductSigningSecretRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type GetChallengeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChallengeRequest) Reset() {
+	*x = GetChallengeRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChallengeRequest) ProtoMessage() {}
+
+func (x *GetChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChallengeRequest.ProtoReflect.Descriptor instead.
+func (*GetChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{106}
+}
+
+type GetChallengeResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Challenge string                 `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// Use it within this long
+	ExpiresInSeconds int64 `protobuf:"varint,2,opt,name=expires_in_seconds,json=expiresInSeconds,proto3" json:"expires_in_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetChallengeResponse) Reset() {
+	*x = GetChallengeResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChallengeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChallengeResponse) ProtoMessage() {}
+
+func (x *GetChallengeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChallengeResponse.ProtoReflect.Descriptor instead.
+func (*GetChallengeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetChallengeResponse) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+func (x *GetChallengeResponse) GetExpiresInSeconds() int64 {
+	if x != nil {
+		return x.ExpiresInSeconds
+	}
+	return 0
+}
+
+type GetServerTimeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The client's clock, to get clock_skew_seconds back
+	ClientTime    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=client_time,json=clientTime,proto3" json:"client_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServerTimeRequest) Reset() {
+	*x = GetServerTimeRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServerTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerTimeRequest) ProtoMessage() {}
+
+func (x *GetServerTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerTimeRequest.ProtoReflect.Descriptor instead.
+func (*GetServerTimeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetServerTimeRequest) GetClientTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClientTime
+	}
+	return nil
+}
+
+type GetServerTimeResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ServerTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+	// How far client_time is ahead of server_time (negative if behind),
+	// rounded to seconds; 0 without client_time
+	ClockSkewSeconds int64 `protobuf:"varint,2,opt,name=clock_skew_seconds,json=clockSkewSeconds,proto3" json:"clock_skew_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetServerTimeResponse) Reset() {
+	*x = GetServerTimeResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServerTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerTimeResponse) ProtoMessage() {}
+
+func (x *GetServerTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerTimeResponse.ProtoReflect.Descriptor instead.
+func (*GetServerTimeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *GetServerTimeResponse) GetServerTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ServerTime
+	}
+	return nil
+}
+
+func (x *GetServerTimeResponse) GetClockSkewSeconds() int64 {
+	if x != nil {
+		return x.ClockSkewSeconds
+	}
+	return 0
+}
+
+type GetPublicKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicKeyRequest) Reset() {
+	*x = GetPublicKeyRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicKeyRequest) ProtoMessage() {}
+
+func (x *GetPublicKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{110}
+}
+
+type GetPublicKeyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ed25519, base64 as in ExportLicenseFileResponse
+	PublicKey string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// Its kid, see GetSigningKeys
+	KeyId         string `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicKeyResponse) Reset() {
+	*x = GetPublicKeyResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicKeyResponse) ProtoMessage() {}
+
+func (x *GetPublicKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicKeyResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicKeyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *GetPublicKeyResponse) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *GetPublicKeyResponse) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+type GetSigningKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSigningKeysRequest) Reset() {
+	*x = GetSigningKeysRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSigningKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSigningKeysRequest) ProtoMessage() {}
+
+func (x *GetSigningKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSigningKeysRequest.ProtoReflect.Descriptor instead.
+func (*GetSigningKeysRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{112}
+}
+
+// A public key as a JWK (RFC 8037), plus the base64 form the rest of the API
+// uses.
+type SigningKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RFC 7638 thumbprint
+	Kid string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	// "OKP"
+	Kty string `protobuf:"bytes,2,opt,name=kty,proto3" json:"kty,omitempty"`
+	// "Ed25519"
+	Crv string `protobuf:"bytes,3,opt,name=crv,proto3" json:"crv,omitempty"`
+	// The key, base64url without padding
+	X string `protobuf:"bytes,4,opt,name=x,proto3" json:"x,omitempty"`
+	// "EdDSA"
+	Alg       string `protobuf:"bytes,5,opt,name=alg,proto3" json:"alg,omitempty"`
+	PublicKey string `protobuf:"bytes,6,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// Signs new answers and files; the others only check older ones
+	Active        bool `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SigningKey) Reset() {
+	*x = SigningKey{}
+	mi := &file_proto_whitelist_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SigningKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigningKey) ProtoMessage() {}
+
+func (x *SigningKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SigningKey.ProtoReflect.Descriptor instead.
+func (*SigningKey) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *SigningKey) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
+}
+
+func (x *SigningKey) GetKty() string {
+	if x != nil {
+		return x.Kty
+	}
+	return ""
+}
+
+func (x *SigningKey) GetCrv() string {
+	if x != nil {
+		return x.Crv
+	}
+	return ""
+}
+
+func (x *SigningKey) GetX() string {
+	if x != nil {
+		return x.X
+	}
+	return ""
+}
+
+func (x *SigningKey) GetAlg() string {
+	if x != nil {
+		return x.Alg
+	}
+	return ""
+}
+
+func (x *SigningKey) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *SigningKey) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type GetSigningKeysResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The active key first
+	Keys          []*SigningKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSigningKeysResponse) Reset() {
+	*x = GetSigningKeysResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSigningKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSigningKeysResponse) ProtoMessage() {}
+
+func (x *GetSigningKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSigningKeysResponse.ProtoReflect.Descriptor instead.
+func (*GetSigningKeysResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *GetSigningKeysResponse) GetKeys() []*SigningKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type RevokeRefreshTokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        string                 `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeRefreshTokensRequest) Reset() {
+	*x = RevokeRefreshTokensRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeRefreshTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRefreshTokensRequest) ProtoMessage() {}
+
+func (x *RevokeRefreshTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRefreshTokensRequest.ProtoReflect.Descriptor instead.
+func (*RevokeRefreshTokensRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *RevokeRefreshTokensRequest) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+type RevokeRefreshTokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revoked       int32                  `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeRefreshTokensResponse) Reset() {
+	*x = RevokeRefreshTokensResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeRefreshTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRefreshTokensResponse) ProtoMessage() {}
+
+func (x *RevokeRefreshTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRefreshTokensResponse.ProtoReflect.Descriptor instead.
+func (*RevokeRefreshTokensResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *RevokeRefreshTokensResponse) GetRevoked() int32 {
+	if x != nil {
+		return x.Revoked
+	}
+	return 0
+}
+
+type RotateAdminSecretRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How long the secrets it replaces keep working; 0 means 24 hours
+	OverlapSeconds int64 `protobuf:"varint,1,opt,name=overlap_seconds,json=overlapSeconds,proto3" json:"overlap_seconds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RotateAdminSecretRequest) Reset() {
+	*x = RotateAdminSecretRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateAdminSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateAdminSecretRequest) ProtoMessage() {}
+
+func (x *RotateAdminSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateAdminSecretRequest.ProtoReflect.Descriptor instead.
+func (*RotateAdminSecretRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *RotateAdminSecretRequest) GetOverlapSeconds() int64 {
+	if x != nil {
+		return x.OverlapSeconds
+	}
+	return 0
+}
+
+type RotateAdminSecretResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Shown only here
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// When the secrets it replaces stop working. Secrets from ADMIN_SECRET
+	// are not affected; take them out of the config.
+	PreviousExpireAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=previous_expire_at,json=previousExpireAt,proto3" json:"previous_expire_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RotateAdminSecretResponse) Reset() {
+	*x = RotateAdminSecretResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateAdminSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateAdminSecretResponse) ProtoMessage() {}
+
+func (x *RotateAdminSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateAdminSecretResponse.ProtoReflect.Descriptor instead.
+func (*RotateAdminSecretResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *RotateAdminSecretResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *RotateAdminSecretResponse) GetPreviousExpireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PreviousExpireAt
+	}
+	return nil
+}
+
+type EnrollAdminTotpRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Empty to start (or restart) enrollment; then a code from the new secret
+	// to confirm it
+	Code          string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollAdminTotpRequest) Reset() {
+	*x = EnrollAdminTotpRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollAdminTotpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollAdminTotpRequest) ProtoMessage() {}
+
+func (x *EnrollAdminTotpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollAdminTotpRequest.ProtoReflect.Descriptor instead.
+func (*EnrollAdminTotpRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *EnrollAdminTotpRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type EnrollAdminTotpResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Set when starting: add it to an authenticator app
+	Secret     string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	OtpauthUrl string `protobuf:"bytes,2,opt,name=otpauth_url,json=otpauthUrl,proto3" json:"otpauth_url,omitempty"`
+	// Set once confirmed: destructive calls now need x-admin-otp
+	Enabled       bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollAdminTotpResponse) Reset() {
+	*x = EnrollAdminTotpResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollAdminTotpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollAdminTotpResponse) ProtoMessage() {}
+
+func (x *EnrollAdminTotpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollAdminTotpResponse.ProtoReflect.Descriptor instead.
+func (*EnrollAdminTotpResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *EnrollAdminTotpResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollAdminTotpResponse) GetOtpauthUrl() string {
+	if x != nil {
+		return x.OtpauthUrl
+	}
+	return ""
+}
+
+func (x *EnrollAdminTotpResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type ExportAuditLogRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filters (all optional), as in ListAuditEventsRequest
+	Actor  string                 `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	Action string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Target string                 `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Since  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`
+	Until  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=until,proto3" json:"until,omitempty"`
+	// "ndjson" (the default: one AuditEvent as JSON per line) or "csv"
+	Format        string `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAuditLogRequest) Reset() {
+	*x = ExportAuditLogRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAuditLogRequest) ProtoMessage() {}
+
+func (x *ExportAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*ExportAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ExportAuditLogRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ExportAuditLogRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ExportAuditLogRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ExportAuditLogRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ExportAuditLogRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *ExportAuditLogRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type GetStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Only this product's validations and tokens scoped to it.
+	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Number of days ending today (UTC), e.g. "7d"; defaults to "7d", at most
+	// "366d"
+	Period        string `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatsRequest) Reset() {
+	*x = GetStatsRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsRequest) ProtoMessage() {}
+
+func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *GetStatsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetStatsRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+type DailyStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// YYYY-MM-DD, UTC
+	Date              string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Validations       int64  `protobuf:"varint,2,opt,name=validations,proto3" json:"validations,omitempty"`
+	FailedValidations int64  `protobuf:"varint,3,opt,name=failed_validations,json=failedValidations,proto3" json:"failed_validations,omitempty"`
+	UniqueHwids       int64  `protobuf:"varint,4,opt,name=unique_hwids,json=uniqueHwids,proto3" json:"unique_hwids,omitempty"`
+	TokensIssued      int64  `protobuf:"varint,5,opt,name=tokens_issued,json=tokensIssued,proto3" json:"tokens_issued,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DailyStats) Reset() {
+	*x = DailyStats{}
+	mi := &file_proto_whitelist_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyStats) ProtoMessage() {}
+
+func (x *DailyStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyStats.ProtoReflect.Descriptor instead.
+func (*DailyStats) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *DailyStats) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyStats) GetValidations() int64 {
+	if x != nil {
+		return x.Validations
+	}
+	return 0
+}
+
+func (x *DailyStats) GetFailedValidations() int64 {
+	if x != nil {
+		return x.FailedValidations
+	}
+	return 0
+}
+
+func (x *DailyStats) GetUniqueHwids() int64 {
+	if x != nil {
+		return x.UniqueHwids
+	}
+	return 0
+}
+
+func (x *DailyStats) GetTokensIssued() int64 {
+	if x != nil {
+		return x.TokensIssued
+	}
+	return 0
+}
+
+type FailureReason struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The failed validation's message, e.g. "License expired"
+	Reason        string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Count         int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FailureReason) Reset() {
+	*x = FailureReason{}
+	mi := &file_proto_whitelist_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailureReason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailureReason) ProtoMessage() {}
+
+func (x *FailureReason) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailureReason.ProtoReflect.Descriptor instead.
+func (*FailureReason) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *FailureReason) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FailureReason) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Start of the first day counted
+	Since *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+	// Oldest first, one per day including days with no activity
+	Days []*DailyStats `protobuf:"bytes,2,rep,name=days,proto3" json:"days,omitempty"`
+	// Totals over the whole period
+	Validations       int64 `protobuf:"varint,3,opt,name=validations,proto3" json:"validations,omitempty"`
+	FailedValidations int64 `protobuf:"varint,4,opt,name=failed_validations,json=failedValidations,proto3" json:"failed_validations,omitempty"`
+	// Distinct over the period, not the sum of the days
+	UniqueHwids  int64 `protobuf:"varint,5,opt,name=unique_hwids,json=uniqueHwids,proto3" json:"unique_hwids,omitempty"`
+	TokensIssued int64 `protobuf:"varint,6,opt,name=tokens_issued,json=tokensIssued,proto3" json:"tokens_issued,omitempty"`
+	// Most common first
+	FailureReasons []*FailureReason `protobuf:"bytes,7,rep,name=failure_reasons,json=failureReasons,proto3" json:"failure_reasons,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetStatsResponse) Reset() {
+	*x = GetStatsResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsResponse) ProtoMessage() {}
+
+func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *GetStatsResponse) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *GetStatsResponse) GetDays() []*DailyStats {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *GetStatsResponse) GetValidations() int64 {
+	if x != nil {
+		return x.Validations
+	}
+	return 0
+}
+
+func (x *GetStatsResponse) GetFailedValidations() int64 {
+	if x != nil {
+		return x.FailedValidations
+	}
+	return 0
+}
+
+func (x *GetStatsResponse) GetUniqueHwids() int64 {
+	if x != nil {
+		return x.UniqueHwids
+	}
+	return 0
+}
+
+func (x *GetStatsResponse) GetTokensIssued() int64 {
+	if x != nil {
+		return x.TokensIssued
+	}
+	return 0
+}
+
+func (x *GetStatsResponse) GetFailureReasons() []*FailureReason {
+	if x != nil {
+		return x.FailureReasons
+	}
+	return nil
+}
+
+type ValidationEvent struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LicenseKey string                 `protobuf:"bytes,3,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	ProductId  string                 `protobuf:"bytes,4,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Hwid       string                 `protobuf:"bytes,5,opt,name=hwid,proto3" json:"hwid,omitempty"`
+	Valid      bool                   `protobuf:"varint,6,opt,name=valid,proto3" json:"valid,omitempty"`
+	// The response message, or the error for rejected calls
+	Result   string `protobuf:"bytes,7,opt,name=result,proto3" json:"result,omitempty"`
+	ClientIp string `protobuf:"bytes,8,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	// Empty without a GeoIP database
+	Country       string `protobuf:"bytes,9,opt,name=country,proto3" json:"country,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidationEvent) Reset() {
+	*x = ValidationEvent{}
+	mi := &file_proto_whitelist_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationEvent) ProtoMessage() {}
+
+func (x *ValidationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationEvent.ProtoReflect.Descriptor instead.
+func (*ValidationEvent) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ValidationEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ValidationEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ValidationEvent) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *ValidationEvent) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ValidationEvent) GetHwid() string {
+	if x != nil {
+		return x.Hwid
+	}
+	return ""
+}
+
+func (x *ValidationEvent) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidationEvent) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *ValidationEvent) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *ValidationEvent) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+type ListValidationEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filters (all optional)
+	LicenseKey string `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	ProductId  string `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Valid      *bool  `protobuf:"varint,3,opt,name=valid,proto3,oneof" json:"valid,omitempty"`
+	// Pagination. page_size defaults to 50, max 500.
+	PageSize  int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// "id desc" (newest first, the default) or "id"
+	OrderBy       string `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListValidationEventsRequest) Reset() {
+	*x = ListValidationEventsRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListValidationEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListValidationEventsRequest) ProtoMessage() {}
+
+func (x *ListValidationEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListValidationEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListValidationEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ListValidationEventsRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *ListValidationEventsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListValidationEventsRequest) GetValid() bool {
+	if x != nil && x.Valid != nil {
+		return *x.Valid
+	}
+	return false
+}
+
+func (x *ListValidationEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListValidationEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListValidationEventsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListValidationEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*ValidationEvent     `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListValidationEventsResponse) Reset() {
+	*x = ListValidationEventsResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListValidationEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListValidationEventsResponse) ProtoMessage() {}
+
+func (x *ListValidationEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListValidationEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListValidationEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ListValidationEventsResponse) GetEvents() []*ValidationEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListValidationEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// At least one filter is required; licenses must match all that are set.
+// Matching ignores case except for metadata.
+type SearchLicensesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The end of the key, e.g. the last group a customer read out
+	KeySuffix string `protobuf:"bytes,1,opt,name=key_suffix,json=keySuffix,proto3" json:"key_suffix,omitempty"`
+	// A bound HWID, whole; either as the client sends it or hashed
+	Hwid string `protobuf:"bytes,2,opt,name=hwid,proto3" json:"hwid,omitempty"`
+	// Part of the owning customer's email
+	Email string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	// Metadata containing these fields with these values, e.g.
+	// {"order_id": "A-1001"}
+	Metadata  *structpb.Struct `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ProductId string           `protobuf:"bytes,5,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Pagination as in ListLicensesRequest
+	PageSize      int32  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrderBy       string `protobuf:"bytes,8,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchLicensesRequest) Reset() {
+	*x = SearchLicensesRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchLicensesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchLicensesRequest) ProtoMessage() {}
+
+func (x *SearchLicensesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchLicensesRequest.ProtoReflect.Descriptor instead.
+func (*SearchLicensesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *SearchLicensesRequest) GetKeySuffix() string {
+	if x != nil {
+		return x.KeySuffix
+	}
+	return ""
+}
+
+func (x *SearchLicensesRequest) GetHwid() string {
+	if x != nil {
+		return x.Hwid
+	}
+	return ""
+}
+
+func (x *SearchLicensesRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SearchLicensesRequest) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *SearchLicensesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SearchLicensesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchLicensesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchLicensesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type SearchLicensesResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Licenses []*License             `protobuf:"bytes,1,rep,name=licenses,proto3" json:"licenses,omitempty"`
+	// Empty when there are no more results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchLicensesResponse) Reset() {
+	*x = SearchLicensesResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchLicensesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchLicensesResponse) ProtoMessage() {}
+
+func (x *SearchLicensesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchLicensesResponse.ProtoReflect.Descriptor instead.
+func (*SearchLicensesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *SearchLicensesResponse) GetLicenses() []*License {
+	if x != nil {
+		return x.Licenses
+	}
+	return nil
+}
+
+func (x *SearchLicensesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RestoreLicenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey    string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreLicenseRequest) Reset() {
+	*x = RestoreLicenseRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreLicenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreLicenseRequest) ProtoMessage() {}
+
+func (x *RestoreLicenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreLicenseRequest.ProtoReflect.Descriptor instead.
+func (*RestoreLicenseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *RestoreLicenseRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+type PurgeLicenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey    string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeLicenseRequest) Reset() {
+	*x = PurgeLicenseRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeLicenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeLicenseRequest) ProtoMessage() {}
+
+func (x *PurgeLicenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeLicenseRequest.ProtoReflect.Descriptor instead.
+func (*PurgeLicenseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *PurgeLicenseRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+type GetLicenseHistoryRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	// Pagination as in ListAuditEventsRequest
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLicenseHistoryRequest) Reset() {
+	*x = GetLicenseHistoryRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLicenseHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLicenseHistoryRequest) ProtoMessage() {}
+
+func (x *GetLicenseHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLicenseHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetLicenseHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *GetLicenseHistoryRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *GetLicenseHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetLicenseHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetLicenseHistoryRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type GetLicenseHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*LicenseRevision     `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLicenseHistoryResponse) Reset() {
+	*x = GetLicenseHistoryResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLicenseHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLicenseHistoryResponse) ProtoMessage() {}
+
+func (x *GetLicenseHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLicenseHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetLicenseHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *GetLicenseHistoryResponse) GetRevisions() []*LicenseRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *GetLicenseHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// One change to a license.
+type LicenseRevision struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Who made the change: the admin actor, a reseller, or "client" for a
+	// device bound during validation.
+	Actor string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	// The audit action, e.g. "license.update", "license.suspend" or
+	// "license.bind_hwid"
+	Action string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	// License fields the change touched; empty when it was created.
+	ChangedFields []string `protobuf:"bytes,5,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"`
+	// The license as the change left it.
+	License       *License `protobuf:"bytes,6,opt,name=license,proto3" json:"license,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LicenseRevision) Reset() {
+	*x = LicenseRevision{}
+	mi := &file_proto_whitelist_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LicenseRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LicenseRevision) ProtoMessage() {}
+
+func (x *LicenseRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LicenseRevision.ProtoReflect.Descriptor instead.
+func (*LicenseRevision) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *LicenseRevision) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LicenseRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LicenseRevision) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *LicenseRevision) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *LicenseRevision) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+func (x *LicenseRevision) GetLicense() *License {
+	if x != nil {
+		return x.License
+	}
+	return nil
+}
+
+type ImportExternalLicensesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "keyauth": KeyAuth's seller API output, {"keys": [...], "users": [...]}
+	// (either list may be left out) or just the keys array.
+	// "json": an array of {"license_key", "product_id", "is_active",
+	// "expires_at" (RFC 3339), "max_devices", "max_sessions", "hwids",
+	// "email", "metadata"}, only license_key required.
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	// The export's contents
+	Data string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// Product for licenses that don't name one; required for KeyAuth
+	ProductId string `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Report what would change without writing anything.
+	DryRun        bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportExternalLicensesRequest) Reset() {
+	*x = ImportExternalLicensesRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportExternalLicensesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportExternalLicensesRequest) ProtoMessage() {}
+
+func (x *ImportExternalLicensesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportExternalLicensesRequest.ProtoReflect.Descriptor instead.
+func (*ImportExternalLicensesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *ImportExternalLicensesRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportExternalLicensesRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *ImportExternalLicensesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ImportExternalLicensesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type BulkSuspendByProductRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// As in SuspendLicenseRequest
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkSuspendByProductRequest) Reset() {
+	*x = BulkSuspendByProductRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkSuspendByProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkSuspendByProductRequest) ProtoMessage() {}
+
+func (x *BulkSuspendByProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkSuspendByProductRequest.ProtoReflect.Descriptor instead.
+func (*BulkSuspendByProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *BulkSuspendByProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *BulkSuspendByProductRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type BulkDeleteByProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkDeleteByProductRequest) Reset() {
+	*x = BulkDeleteByProductRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkDeleteByProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkDeleteByProductRequest) ProtoMessage() {}
+
+func (x *BulkDeleteByProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkDeleteByProductRequest.ProtoReflect.Descriptor instead.
+func (*BulkDeleteByProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *BulkDeleteByProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type BulkExtendByProductRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Added to each license's expiry. Licenses that never expire are skipped.
+	DurationSeconds int64 `protobuf:"varint,2,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	// Also extend licenses that already expired, from now. They're skipped
+	// otherwise.
+	IncludeExpired bool `protobuf:"varint,3,opt,name=include_expired,json=includeExpired,proto3" json:"include_expired,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BulkExtendByProductRequest) Reset() {
+	*x = BulkExtendByProductRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkExtendByProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkExtendByProductRequest) ProtoMessage() {}
+
+func (x *BulkExtendByProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkExtendByProductRequest.ProtoReflect.Descriptor instead.
+func (*BulkExtendByProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *BulkExtendByProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *BulkExtendByProductRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *BulkExtendByProductRequest) GetIncludeExpired() bool {
+	if x != nil {
+		return x.IncludeExpired
+	}
+	return false
+}
+
+type BulkOperationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Licenses the operation changed
+	Affected      int32 `protobuf:"varint,1,opt,name=affected,proto3" json:"affected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkOperationResponse) Reset() {
+	*x = BulkOperationResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkOperationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkOperationResponse) ProtoMessage() {}
+
+func (x *BulkOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkOperationResponse.ProtoReflect.Descriptor instead.
+func (*BulkOperationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *BulkOperationResponse) GetAffected() int32 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+type SetMaintenanceModeRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Returned with every refused change; empty for a generic one
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMaintenanceModeRequest) Reset() {
+	*x = SetMaintenanceModeRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMaintenanceModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaintenanceModeRequest) ProtoMessage() {}
+
+func (x *SetMaintenanceModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaintenanceModeRequest.ProtoReflect.Descriptor instead.
+func (*SetMaintenanceModeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *SetMaintenanceModeRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetMaintenanceModeRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type MaintenanceMode struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Unset when off, or when on from MAINTENANCE_MESSAGE
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	StartedBy string                 `protobuf:"bytes,4,opt,name=started_by,json=startedBy,proto3" json:"started_by,omitempty"`
+	// Set by MAINTENANCE_MESSAGE, so it can't be turned off here
+	FromConfig    bool `protobuf:"varint,5,opt,name=from_config,json=fromConfig,proto3" json:"from_config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaintenanceMode) Reset() {
+	*x = MaintenanceMode{}
+	mi := &file_proto_whitelist_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceMode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceMode) ProtoMessage() {}
+
+func (x *MaintenanceMode) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceMode.ProtoReflect.Descriptor instead.
+func (*MaintenanceMode) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *MaintenanceMode) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *MaintenanceMode) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MaintenanceMode) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *MaintenanceMode) GetStartedBy() string {
+	if x != nil {
+		return x.StartedBy
+	}
+	return ""
+}
+
+func (x *MaintenanceMode) GetFromConfig() bool {
+	if x != nil {
+		return x.FromConfig
+	}
+	return false
+}
+
+// A product's own text for one validation message in one language
+type ProductMessage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// BCP 47 tag, e.g. "en" or "pt-BR"
+	Locale string `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Which message, e.g. REASON_EXPIRED
+	Reason        Reason `protobuf:"varint,2,opt,name=reason,proto3,enum=whitelist.Reason" json:"reason,omitempty"`
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductMessage) Reset() {
+	*x = ProductMessage{}
+	mi := &file_proto_whitelist_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductMessage) ProtoMessage() {}
+
+func (x *ProductMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductMessage.ProtoReflect.Descriptor instead.
+func (*ProductMessage) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *ProductMessage) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *ProductMessage) GetReason() Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return Reason_REASON_UNSPECIFIED
+}
+
+func (x *ProductMessage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SetProductMessagesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Replaces all of the product's messages; empty goes back to the
+	// built-in ones
+	Messages      []*ProductMessage `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductMessagesRequest) Reset() {
+	*x = SetProductMessagesRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductMessagesRequest) ProtoMessage() {}
+
+func (x *SetProductMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductMessagesRequest.ProtoReflect.Descriptor instead.
+func (*SetProductMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *SetProductMessagesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetProductMessagesRequest) GetMessages() []*ProductMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type GetProductMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductMessagesRequest) Reset() {
+	*x = GetProductMessagesRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductMessagesRequest) ProtoMessage() {}
+
+func (x *GetProductMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetProductMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *GetProductMessagesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type ProductMessages struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Sorted by locale, then reason
+	Messages      []*ProductMessage `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductMessages) Reset() {
+	*x = ProductMessages{}
+	mi := &file_proto_whitelist_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductMessages) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductMessages) ProtoMessage() {}
+
+func (x *ProductMessages) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductMessages.ProtoReflect.Descriptor instead.
+func (*ProductMessages) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *ProductMessages) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductMessages) GetMessages() []*ProductMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type ListMyDevicesRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	ProductId  string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// The calling device's HWID, to point it out among the devices; optional
+	Hwid          string `protobuf:"bytes,3,opt,name=hwid,proto3" json:"hwid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyDevicesRequest) Reset() {
+	*x = ListMyDevicesRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyDevicesRequest) ProtoMessage() {}
+
+func (x *ListMyDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyDevicesRequest.ProtoReflect.Descriptor instead.
+func (*ListMyDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *ListMyDevicesRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *ListMyDevicesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListMyDevicesRequest) GetHwid() string {
+	if x != nil {
+		return x.Hwid
+	}
+	return ""
+}
+
+type MyDevice struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The hashed HWID, to pass to DeactivateDevice
+	DeviceId string                 `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	BoundAt  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=bound_at,json=boundAt,proto3" json:"bound_at,omitempty"`
+	// Whether it's the device named by the request's hwid
+	Current       bool `protobuf:"varint,3,opt,name=current,proto3" json:"current,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MyDevice) Reset() {
+	*x = MyDevice{}
+	mi := &file_proto_whitelist_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MyDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MyDevice) ProtoMessage() {}
+
+func (x *MyDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MyDevice.ProtoReflect.Descriptor instead.
+func (*MyDevice) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *MyDevice) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *MyDevice) GetBoundAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BoundAt
+	}
+	return nil
+}
+
+func (x *MyDevice) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type ListMyDevicesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Oldest first
+	Devices    []*MyDevice `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+	MaxDevices int32       `protobuf:"varint,2,opt,name=max_devices,json=maxDevices,proto3" json:"max_devices,omitempty"`
+	// When DeactivateDevice can next be used (see HWID_REBIND_COOLDOWN);
+	// unset if it can now.
+	NextDeactivationAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=next_deactivation_at,json=nextDeactivationAt,proto3" json:"next_deactivation_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListMyDevicesResponse) Reset() {
+	*x = ListMyDevicesResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyDevicesResponse) ProtoMessage() {}
+
+func (x *ListMyDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyDevicesResponse.ProtoReflect.Descriptor instead.
+func (*ListMyDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *ListMyDevicesResponse) GetDevices() []*MyDevice {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+func (x *ListMyDevicesResponse) GetMaxDevices() int32 {
+	if x != nil {
+		return x.MaxDevices
+	}
+	return 0
+}
+
+func (x *ListMyDevicesResponse) GetNextDeactivationAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextDeactivationAt
+	}
+	return nil
+}
+
+type DeactivateDeviceRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	ProductId  string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// From ListMyDevices; the device's raw HWID works too
+	DeviceId      string `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateDeviceRequest) Reset() {
+	*x = DeactivateDeviceRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateDeviceRequest) ProtoMessage() {}
+
+func (x *DeactivateDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateDeviceRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *DeactivateDeviceRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *DeactivateDeviceRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DeactivateDeviceRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+type SetLicenseFloatingSeatsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	// 0 turns the pool off, so the license binds devices again
+	FloatingSeats int32 `protobuf:"varint,2,opt,name=floating_seats,json=floatingSeats,proto3" json:"floating_seats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLicenseFloatingSeatsRequest) Reset() {
+	*x = SetLicenseFloatingSeatsRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLicenseFloatingSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLicenseFloatingSeatsRequest) ProtoMessage() {}
+
+func (x *SetLicenseFloatingSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLicenseFloatingSeatsRequest.ProtoReflect.Descriptor instead.
+func (*SetLicenseFloatingSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *SetLicenseFloatingSeatsRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *SetLicenseFloatingSeatsRequest) GetFloatingSeats() int32 {
+	if x != nil {
+		return x.FloatingSeats
+	}
+	return 0
+}
+
+// CheckoutLicense needs an x-access-token header, like ValidateLicense.
+// Checking out again from the same machine renews its lease.
+type CheckoutLicenseRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	ProductId  string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// The lease is for this machine
+	Hwid string `protobuf:"bytes,3,opt,name=hwid,proto3" json:"hwid,omitempty"`
+	// As in ValidateRequest
+	ClientVersion     string `protobuf:"bytes,4,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	Challenge         string `protobuf:"bytes,5,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	ChallengeResponse string `protobuf:"bytes,6,opt,name=challenge_response,json=challengeResponse,proto3" json:"challenge_response,omitempty"`
+	Locale            string `protobuf:"bytes,7,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CheckoutLicenseRequest) Reset() {
+	*x = CheckoutLicenseRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutLicenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutLicenseRequest) ProtoMessage() {}
+
+func (x *CheckoutLicenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutLicenseRequest.ProtoReflect.Descriptor instead.
+func (*CheckoutLicenseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *CheckoutLicenseRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *CheckoutLicenseRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CheckoutLicenseRequest) GetHwid() string {
+	if x != nil {
+		return x.Hwid
+	}
+	return ""
+}
+
+func (x *CheckoutLicenseRequest) GetClientVersion() string {
+	if x != nil {
+		return x.ClientVersion
+	}
+	return ""
+}
+
+func (x *CheckoutLicenseRequest) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+func (x *CheckoutLicenseRequest) GetChallengeResponse() string {
+	if x != nil {
+		return x.ChallengeResponse
+	}
+	return ""
+}
+
+func (x *CheckoutLicenseRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type CheckoutLicenseResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Valid   bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Seconds until the license expires. 0 means the license never expires.
+	ExpiresInSeconds int64 `protobuf:"varint,3,opt,name=expires_in_seconds,json=expiresInSeconds,proto3" json:"expires_in_seconds,omitempty"`
+	// Pass to CheckinLicense. Set only when valid.
+	LeaseToken string `protobuf:"bytes,4,opt,name=lease_token,json=leaseToken,proto3" json:"lease_token,omitempty"`
+	// Check out again before then to keep the seat.
+	LeaseExpiresInSeconds int64 `protobuf:"varint,5,opt,name=lease_expires_in_seconds,json=leaseExpiresInSeconds,proto3" json:"lease_expires_in_seconds,omitempty"`
+	// As in ValidateResponse
+	RequiredVersion string `protobuf:"bytes,6,opt,name=required_version,json=requiredVersion,proto3" json:"required_version,omitempty"`
+	SuspendReason   string `protobuf:"bytes,7,opt,name=suspend_reason,json=suspendReason,proto3" json:"suspend_reason,omitempty"`
+	// With REASON_NO_FLOATING_SEATS: seconds until the first lease expires,
+	// unless it's checked in sooner. Otherwise as in ValidateResponse.
+	RetryAfterSeconds int64  `protobuf:"varint,8,opt,name=retry_after_seconds,json=retryAfterSeconds,proto3" json:"retry_after_seconds,omitempty"`
+	ChallengeResponse string `protobuf:"bytes,9,opt,name=challenge_response,json=challengeResponse,proto3" json:"challenge_response,omitempty"`
+	Reason            Reason `protobuf:"varint,10,opt,name=reason,proto3,enum=whitelist.Reason" json:"reason,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CheckoutLicenseResponse) Reset() {
+	*x = CheckoutLicenseResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutLicenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutLicenseResponse) ProtoMessage() {}
+
+func (x *CheckoutLicenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutLicenseResponse.ProtoReflect.Descriptor instead.
+func (*CheckoutLicenseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *CheckoutLicenseResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *CheckoutLicenseResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CheckoutLicenseResponse) GetExpiresInSeconds() int64 {
+	if x != nil {
+		return x.ExpiresInSeconds
+	}
+	return 0
+}
+
+func (x *CheckoutLicenseResponse) GetLeaseToken() string {
+	if x != nil {
+		return x.LeaseToken
+	}
+	return ""
+}
+
+func (x *CheckoutLicenseResponse) GetLeaseExpiresInSeconds() int64 {
+	if x != nil {
+		return x.LeaseExpiresInSeconds
+	}
+	return 0
+}
+
+func (x *CheckoutLicenseResponse) GetRequiredVersion() string {
+	if x != nil {
+		return x.RequiredVersion
+	}
+	return ""
+}
+
+func (x *CheckoutLicenseResponse) GetSuspendReason() string {
+	if x != nil {
+		return x.SuspendReason
+	}
+	return ""
+}
+
+func (x *CheckoutLicenseResponse) GetRetryAfterSeconds() int64 {
+	if x != nil {
+		return x.RetryAfterSeconds
+	}
+	return 0
+}
+
+func (x *CheckoutLicenseResponse) GetChallengeResponse() string {
+	if x != nil {
+		return x.ChallengeResponse
+	}
+	return ""
+}
+
+func (x *CheckoutLicenseResponse) GetReason() Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return Reason_REASON_UNSPECIFIED
+}
+
+type CheckinLicenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeaseToken    string                 `protobuf:"bytes,1,opt,name=lease_token,json=leaseToken,proto3" json:"lease_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckinLicenseRequest) Reset() {
+	*x = CheckinLicenseRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckinLicenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckinLicenseRequest) ProtoMessage() {}
+
+func (x *CheckinLicenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckinLicenseRequest.ProtoReflect.Descriptor instead.
+func (*CheckinLicenseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *CheckinLicenseRequest) GetLeaseToken() string {
+	if x != nil {
+		return x.LeaseToken
+	}
+	return ""
+}
+
+// ConsumeCredits needs an x-access-token header, like ValidateLicense.
+type ConsumeCreditsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	ProductId  string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Hwid       string                 `protobuf:"bytes,3,opt,name=hwid,proto3" json:"hwid,omitempty"`
+	Amount     int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// What the credits were spent on, kept in the license's credit activity
+	Note string `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	// As in ValidateRequest
+	ClientVersion     string `protobuf:"bytes,6,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	Challenge         string `protobuf:"bytes,7,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	ChallengeResponse string `protobuf:"bytes,8,opt,name=challenge_response,json=challengeResponse,proto3" json:"challenge_response,omitempty"`
+	Locale            string `protobuf:"bytes,9,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ConsumeCreditsRequest) Reset() {
+	*x = ConsumeCreditsRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumeCreditsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeCreditsRequest) ProtoMessage() {}
+
+func (x *ConsumeCreditsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeCreditsRequest.ProtoReflect.Descriptor instead.
+func (*ConsumeCreditsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *ConsumeCreditsRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *ConsumeCreditsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ConsumeCreditsRequest) GetHwid() string {
+	if x != nil {
+		return x.Hwid
+	}
+	return ""
+}
+
+func (x *ConsumeCreditsRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ConsumeCreditsRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ConsumeCreditsRequest) GetClientVersion() string {
+	if x != nil {
+		return x.ClientVersion
+	}
+	return ""
+}
+
+func (x *ConsumeCreditsRequest) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+func (x *ConsumeCreditsRequest) GetChallengeResponse() string {
+	if x != nil {
+		return x.ChallengeResponse
+	}
+	return ""
+}
+
+func (x *ConsumeCreditsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type ConsumeCreditsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// False, with REASON_INSUFFICIENT_CREDITS, when the balance is too low;
+	// then nothing is spent
+	Valid   bool   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Balance after this call. Set when valid or on
+	// REASON_INSUFFICIENT_CREDITS.
+	RemainingCredits int64 `protobuf:"varint,3,opt,name=remaining_credits,json=remainingCredits,proto3" json:"remaining_credits,omitempty"`
+	// As in ValidateResponse
+	RequiredVersion   string `protobuf:"bytes,4,opt,name=required_version,json=requiredVersion,proto3" json:"required_version,omitempty"`
+	SuspendReason     string `protobuf:"bytes,5,opt,name=suspend_reason,json=suspendReason,proto3" json:"suspend_reason,omitempty"`
+	RetryAfterSeconds int64  `protobuf:"varint,6,opt,name=retry_after_seconds,json=retryAfterSeconds,proto3" json:"retry_after_seconds,omitempty"`
+	ChallengeResponse string `protobuf:"bytes,7,opt,name=challenge_response,json=challengeResponse,proto3" json:"challenge_response,omitempty"`
+	Reason            Reason `protobuf:"varint,8,opt,name=reason,proto3,enum=whitelist.Reason" json:"reason,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ConsumeCreditsResponse) Reset() {
+	*x = ConsumeCreditsResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumeCreditsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeCreditsResponse) ProtoMessage() {}
+
+func (x *ConsumeCreditsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeCreditsResponse.ProtoReflect.Descriptor instead.
+func (*ConsumeCreditsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *ConsumeCreditsResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ConsumeCreditsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ConsumeCreditsResponse) GetRemainingCredits() int64 {
+	if x != nil {
+		return x.RemainingCredits
+	}
+	return 0
+}
+
+func (x *ConsumeCreditsResponse) GetRequiredVersion() string {
+	if x != nil {
+		return x.RequiredVersion
+	}
+	return ""
+}
+
+func (x *ConsumeCreditsResponse) GetSuspendReason() string {
+	if x != nil {
+		return x.SuspendReason
+	}
+	return ""
+}
+
+func (x *ConsumeCreditsResponse) GetRetryAfterSeconds() int64 {
+	if x != nil {
+		return x.RetryAfterSeconds
+	}
+	return 0
+}
+
+func (x *ConsumeCreditsResponse) GetChallengeResponse() string {
+	if x != nil {
+		return x.ChallengeResponse
+	}
+	return ""
+}
+
+func (x *ConsumeCreditsResponse) GetReason() Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return Reason_REASON_UNSPECIFIED
+}
+
+type TopUpLicenseCreditsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	// Negative to take credits back
+	Credits       int64  `protobuf:"varint,2,opt,name=credits,proto3" json:"credits,omitempty"`
+	Note          string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopUpLicenseCreditsRequest) Reset() {
+	*x = TopUpLicenseCreditsRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopUpLicenseCreditsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopUpLicenseCreditsRequest) ProtoMessage() {}
+
+func (x *TopUpLicenseCreditsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopUpLicenseCreditsRequest.ProtoReflect.Descriptor instead.
+func (*TopUpLicenseCreditsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *TopUpLicenseCreditsRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *TopUpLicenseCreditsRequest) GetCredits() int64 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *TopUpLicenseCreditsRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type LicenseCreditActivity struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// "topup" or "consume"
+	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Change in credits (negative for consume)
+	Delta int64 `protobuf:"varint,4,opt,name=delta,proto3" json:"delta,omitempty"`
+	// Balance after this entry
+	Balance int64 `protobuf:"varint,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	// The admin, or "customer" for ConsumeCredits
+	Actor         string `protobuf:"bytes,6,opt,name=actor,proto3" json:"actor,omitempty"`
+	Note          string `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LicenseCreditActivity) Reset() {
+	*x = LicenseCreditActivity{}
+	mi := &file_proto_whitelist_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LicenseCreditActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LicenseCreditActivity) ProtoMessage() {}
+
+func (x *LicenseCreditActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LicenseCreditActivity.ProtoReflect.Descriptor instead.
+func (*LicenseCreditActivity) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *LicenseCreditActivity) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LicenseCreditActivity) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LicenseCreditActivity) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LicenseCreditActivity) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *LicenseCreditActivity) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *LicenseCreditActivity) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *LicenseCreditActivity) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ListLicenseCreditActivityRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	// Pagination. page_size defaults to 50, max 500.
+	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// "id desc" (newest first, the default) or "id"
+	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLicenseCreditActivityRequest) Reset() {
+	*x = ListLicenseCreditActivityRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLicenseCreditActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLicenseCreditActivityRequest) ProtoMessage() {}
+
+func (x *ListLicenseCreditActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLicenseCreditActivityRequest.ProtoReflect.Descriptor instead.
+func (*ListLicenseCreditActivityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *ListLicenseCreditActivityRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *ListLicenseCreditActivityRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListLicenseCreditActivityRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListLicenseCreditActivityRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListLicenseCreditActivityResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Activity      []*LicenseCreditActivity `protobuf:"bytes,1,rep,name=activity,proto3" json:"activity,omitempty"`
+	NextPageToken string                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLicenseCreditActivityResponse) Reset() {
+	*x = ListLicenseCreditActivityResponse{}
+	mi := &file_proto_whitelist_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLicenseCreditActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLicenseCreditActivityResponse) ProtoMessage() {}
+
+func (x *ListLicenseCreditActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLicenseCreditActivityResponse.ProtoReflect.Descriptor instead.
+func (*ListLicenseCreditActivityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *ListLicenseCreditActivityResponse) GetActivity() []*LicenseCreditActivity {
+	if x != nil {
+		return x.Activity
+	}
+	return nil
+}
+
+func (x *ListLicenseCreditActivityResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SetLicenseFeaturesRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LicenseKey string                 `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	// Replaces the license's flags; empty leaves only its product's
+	Features      map[string]bool `protobuf:"bytes,2,rep,name=features,proto3" json:"features,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLicenseFeaturesRequest) Reset() {
+	*x = SetLicenseFeaturesRequest{}
+	mi := &file_proto_whitelist_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLicenseFeaturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLicenseFeaturesRequest) ProtoMessage() {}
+
+func (x *SetLicenseFeaturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_whitelist_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLicenseFeaturesRequest.ProtoReflect.Descriptor instead.
+func (*SetLicenseFeaturesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_whitelist_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *SetLicenseFeaturesRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *SetLicenseFeaturesRequest) GetFeatures() map[string]bool {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+var File_proto_whitelist_proto protoreflect.FileDescriptor
+
+const file_proto_whitelist_proto_rawDesc = "" +
+	"\n" +
+	"\x15proto/whitelist.proto\x12\twhitelist\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/api/httpbody.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\x8c\x01\n" +
+	"\x0fGetTokenRequest\x12!\n" +
+	"\aapi_key\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x06apiKey\x12-\n" +
+	"\rrefresh_token\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\frefreshToken\x12'\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x01R\tproductId\"\xb9\x01\n" +
+	"\x11AuthTokenResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12,\n" +
+	"\x12expires_in_seconds\x18\x02 \x01(\x03R\x10expiresInSeconds\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x12;\n" +
+	"\x1arefresh_expires_in_seconds\x18\x04 \x01(\x03R\x17refreshExpiresInSeconds\"\xc4\x03\n" +
+	"\x0fValidateRequest\x125\n" +
+	"\vlicense_key\x18\x01 \x01(\tB\x14\xfaB\x11r\x0f\x10\x01\x18\x80\x012\b^[!-~]+$R\n" +
+	"licenseKey\x12)\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\tproductId\x12\x1c\n" +
+	"\x04hwid\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x04hwid\x12.\n" +
+	"\x0eclient_version\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x18@R\rclientVersion\x12&\n" +
+	"\tchallenge\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\tchallenge\x127\n" +
+	"\x12challenge_response\x18\x06 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x11challengeResponse\x12\x1f\n" +
+	"\x06locale\x18\a \x01(\tB\a\xfaB\x04r\x02\x18#R\x06locale\x12B\n" +
+	"\x0fhwid_components\x18\b \x01(\v2\x19.whitelist.HwidComponentsR\x0ehwidComponents\x12;\n" +
+	"\vclient_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"clientTime\"\x93\x01\n" +
+	"\x0eHwidComponents\x12\x1a\n" +
+	"\x03cpu\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x03cpu\x12\x1c\n" +
+	"\x04disk\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x04disk\x12\x1a\n" +
+	"\x03mac\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x03mac\x12+\n" +
+	"\fmachine_guid\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\vmachineGuid\"\xd0\x05\n" +
+	"\x10ValidateResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\x12expires_in_seconds\x18\x03 \x01(\x03R\x10expiresInSeconds\x123\n" +
+	"\bmetadata\x18\x04 \x01(\v2\x17.google.protobuf.StructR\bmetadata\x12)\n" +
+	"\x10required_version\x18\x05 \x01(\tR\x0frequiredVersion\x12%\n" +
+	"\x0esuspend_reason\x18\x06 \x01(\tR\rsuspendReason\x12.\n" +
+	"\x13retry_after_seconds\x18\a \x01(\x03R\x11retryAfterSeconds\x12-\n" +
+	"\x12challenge_response\x18\b \x01(\tR\x11challengeResponse\x12)\n" +
+	"\x06reason\x18\t \x01(\x0e2\x11.whitelist.ReasonR\x06reason\x12E\n" +
+	"\bfeatures\x18\n" +
+	" \x03(\v2).whitelist.ValidateResponse.FeaturesEntryR\bfeatures\x12;\n" +
+	"\vserver_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"serverTime\x12,\n" +
+	"\x12clock_skew_seconds\x18\f \x01(\x03R\x10clockSkewSeconds\x12\x1c\n" +
+	"\tsignature\x18\r \x01(\tR\tsignature\x12$\n" +
+	"\x0esigning_key_id\x18\x0e \x01(\tR\fsigningKeyId\x12\x1a\n" +
+	"\bdegraded\x18\x0f \x01(\bR\bdegraded\x1a;\n" +
+	"\rFeaturesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"\xb0\x03\n" +
+	"\x14UpdateLicenseRequest\x125\n" +
+	"\vlicense_key\x18\x01 \x01(\tB\x14\xfaB\x11r\x0f\x10\x01\x18\x80\x012\b^[!-~]+$R\n" +
+	"licenseKey\x12'\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x01R\tproductId\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1f\n" +
+	"\vmax_devices\x18\x05 \x01(\x05R\n" +
+	"maxDevices\x12!\n" +
+	"\fmax_sessions\x18\x06 \x01(\x05R\vmaxSessions\x123\n" +
+	"\bmetadata\x18\a \x01(\v2\x17.google.protobuf.StructR\bmetadata\x12;\n" +
+	"\vupdate_mask\x18\b \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12!\n" +
+	"\x04plan\x18\t \x01(\tB\b\xfaB\x05r\x03\x18\x80\x01H\x00R\x04plan\x88\x01\x01B\a\n" +
+	"\x05_plan\"M\n" +
+	"\x14DeleteLicenseRequest\x125\n" +
+	"\vlicense_key\x18\x01 \x01(\tB\x14\xfaB\x11r\x0f\x10\x01\x18\x80\x012\b^[!-~]+$R\n" +
+	"licenseKey\"\xa4\b\n" +
+	"\aLicense\x12\x1f\n" +
+	"\vlicense_key\x18\x01 \x01(\tR\n" +
+	"licenseKey\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\x12\x16\n" +
+	"\x04hwid\x18\x04 \x01(\tB\x02\x18\x01R\x04hwid\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12F\n" +
+	"\x11last_validated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0flastValidatedAt\x12\x1f\n" +
+	"\vmax_devices\x18\b \x01(\x05R\n" +
+	"maxDevices\x12\x14\n" +
+	"\x05hwids\x18\t \x03(\tR\x05hwids\x12!\n" +
+	"\fmax_sessions\x18\n" +
+	" \x01(\x05R\vmaxSessions\x12'\n" +
+	"\x0factive_sessions\x18\v \x01(\x05R\x0eactiveSessions\x123\n" +
+	"\bmetadata\x18\f \x01(\v2\x17.google.protobuf.StructR\bmetadata\x12\x18\n" +
+	"\achannel\x18\r \x01(\tR\achannel\x12\x1f\n" +
+	"\vcustomer_id\x18\x0e \x01(\x03R\n" +
+	"customerId\x12%\n" +
+	"\x0esuspend_reason\x18\x0f \x01(\tR\rsuspendReason\x12+\n" +
+	"\x11allowed_countries\x18\x10 \x03(\tR\x10allowedCountries\x12+\n" +
+	"\x11blocked_countries\x18\x11 \x03(\tR\x10blockedCountries\x129\n" +
+	"\n" +
+	"deleted_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12B\n" +
+	"\x0fhwid_rebound_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\rhwidReboundAt\x12%\n" +
+	"\x0efloating_seats\x18\x14 \x01(\x05R\rfloatingSeats\x12#\n" +
+	"\ractive_leases\x18\x15 \x01(\x05R\factiveLeases\x12\x18\n" +
+	"\acredits\x18\x16 \x01(\x03R\acredits\x12<\n" +
+	"\bfeatures\x18\x17 \x03(\v2 .whitelist.License.FeaturesEntryR\bfeatures\x12\x12\n" +
+	"\x04plan\x18\x18 \x01(\tR\x04plan\x1a;\n" +
+	"\rFeaturesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"J\n" +
+	"\x11GetLicenseRequest\x125\n" +
+	"\vlicense_key\x18\x01 \x01(\tB\x14\xfaB\x11r\x0f\x10\x01\x18\x80\x012\b^[!-~]+$R\n" +
+	"licenseKey\"\xc1\x02\n" +
+	"\x13ListLicensesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12 \n" +
+	"\tis_active\x18\x02 \x01(\bH\x00R\bisActive\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"hwid_bound\x18\x03 \x01(\bH\x01R\thwidBound\x88\x01\x01\x12\x1f\n" +
+	"\vcustomer_id\x18\x06 \x01(\x03R\n" +
+	"customerId\x12\x16\n" +
+	"\x06search\x18\a \x01(\tR\x06search\x12\x18\n" +
+	"\adeleted\x18\t \x01(\bR\adeleted\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\b \x01(\tR\aorderByB\f\n" +
+	"\n" +
+	"_is_activeB\r\n" +
+	"\v_hwid_bound\"n\n" +
+	"\x14ListLicensesResponse\x12.\n" +
+	"\blicenses\x18\x01 \x03(\v2\x12.whitelist.LicenseR\blicenses\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"}\n" +
+	"\x10ResetHwidRequest\x125\n" +
+	"\vlicense_key\x18\x01 \x01(\tB\x14\xfaB\x11r\x0f\x10\x01\x18\x80\x012\b^[!-~]+$R\n" +
+	"licenseKey\x12\x14\n" +
+	"\x05actor\x18\x02 \x01(\tR\x05actor\x12\x1c\n" +
+	"\x04hwid\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x04hwid\"\xda\x02\n" +
+	"\x17GenerateLicensesRequest\x12)\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\tproductId\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x12\x16\n" +
+	"\x06groups\x18\x04 \x01(\x05R\x06groups\x12\x1d\n" +
+	"\n" +
+	"group_size\x18\x05 \x01(\x05R\tgroupSize\x12\x18\n" +
+	"\acharset\x18\x06 \x01(\tR\acharset\x12\x1b\n" +
+	"\tis_active\x18\a \x01(\bR\bisActive\x129\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1f\n" +
+	"\vmax_devices\x18\t \x01(\x05R\n" +
+	"maxDevices\x12\x1c\n" +
+	"\x04plan\x18\n" +
+	" \x01(\tB\b\xfaB\x05r\x03\x18\x80\x01R\x04plan\"=\n" +
+	"\x18GenerateLicensesResponse\x12!\n" +
+	"\flicense_keys\x18\x01 \x03(\tR\vlicenseKeys\"Y\n" +
+	"\x1aBatchUpsertLicensesRequest\x12;\n" +
+	"\blicenses\x18\x01 \x03(\v2\x1f.whitelist.UpdateLicenseRequestR\blicenses\"9\n" +
+	"\x1bBatchUpsertLicensesResponse\x12\x1a\n" +
+	"\bupserted\x18\x01 \x01(\x05R\bupserted\"6\n" +
+	"\x15ExportLicensesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"B\n" +
+	"\x15ImportLicensesRequest\x12\x10\n" +
+	"\x03csv\x18\x01 \x01(\tR\x03csv\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\xcc\x01\n" +
+	"\x16ImportLicensesResponse\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x03 \x01(\x05R\aupdated\x12\x1c\n" +
+	"\tunchanged\x18\x04 \x01(\x05R\tunchanged\x12/\n" +
+	"\x04rows\x18\x05 \x03(\v2\x1b.whitelist.ImportLicenseRowR\x04rows\x12\x16\n" +
+	"\x06errors\x18\x06 \x03(\tR\x06errors\"_\n" +
+	"\x10ImportLicenseRow\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x1f\n" +
+	"\vlicense_key\x18\x02 \x01(\tR\n" +
+	"licenseKey\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\"\xa6\x02\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x16\n" +
+	"\x06target\x18\x05 \x01(\tR\x06target\x124\n" +
+	"\told_value\x18\x06 \x01(\v2\x17.google.protobuf.StructR\boldValue\x124\n" +
+	"\tnew_value\x18\a \x01(\v2\x17.google.protobuf.StructR\bnewValue\x12\x1b\n" +
+	"\tsource_ip\x18\b \x01(\tR\bsourceIp\"\x99\x02\n" +
+	"\x16ListAuditEventsRequest\x12\x14\n" +
+	"\x05actor\x18\x01 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\tR\x06target\x120\n" +
+	"\x05since\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x120\n" +
+	"\x05until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\b \x01(\tR\aorderBy\"p\n" +
+	"\x17ListAuditEventsResponse\x12-\n" +
+	"\x06events\x18\x01 \x03(\v2\x15.whitelist.AuditEventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xbd\x01\n" +
+	"\x1eResellerGenerateLicenseRequest\x12)\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\tproductId\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1f\n" +
+	"\vmax_devices\x18\x04 \x01(\x05R\n" +
+	"maxDevices\"q\n" +
+	"\x1fResellerGenerateLicenseResponse\x12!\n" +
+	"\flicense_keys\x18\x01 \x03(\tR\vlicenseKeys\x12+\n" +
+	"\x11remaining_credits\x18\x02 \x01(\x03R\x10remainingCredits\"\xa4\x01\n" +
+	"\bReseller\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\acredits\x18\x03 \x01(\x03R\acredits\x12\x1f\n" +
+	"\vproduct_ids\x18\x04 \x03(\tR\n" +
+	"productIds\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"{\n" +
+	"\x15CreateResellerRequest\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\x04name\x12!\n" +
+	"\acredits\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\acredits\x12\x1f\n" +
+	"\vproduct_ids\x18\x03 \x03(\tR\n" +
+	"productIds\"b\n" +
+	"\x16CreateResellerResponse\x12/\n" +
+	"\breseller\x18\x01 \x01(\v2\x13.whitelist.ResellerR\breseller\x12\x17\n" +
+	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\"l\n" +
+	"\x1bTopUpResellerCreditsRequest\x12\x1f\n" +
+	"\vreseller_id\x18\x01 \x01(\x03R\n" +
+	"resellerId\x12\x18\n" +
+	"\acredits\x18\x02 \x01(\x03R\acredits\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"\xee\x01\n" +
+	"\x10ResellerActivity\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05delta\x18\x04 \x01(\x03R\x05delta\x12\x18\n" +
+	"\abalance\x18\x05 \x01(\x03R\abalance\x12!\n" +
+	"\flicense_keys\x18\x06 \x03(\tR\vlicenseKeys\x12\x14\n" +
+	"\x05actor\x18\a \x01(\tR\x05actor\x12\x12\n" +
+	"\x04note\x18\b \x01(\tR\x04note\"\x95\x01\n" +
+	"\x1bListResellerActivityRequest\x12\x1f\n" +
+	"\vreseller_id\x18\x01 \x01(\x03R\n" +
+	"resellerId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\"\x7f\n" +
+	"\x1cListResellerActivityResponse\x127\n" +
+	"\bactivity\x18\x01 \x03(\v2\x1b.whitelist.ResellerActivityR\bactivity\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"K\n" +
+	"\x11AdminLoginRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x98\x01\n" +
+	"\x12AdminLoginResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x04 \x01(\tR\tsessionId\"\xf1\x01\n" +
+	"\x05Admin\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1a\n" +
+	"\bdisabled\x18\x03 \x01(\bR\bdisabled\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12>\n" +
+	"\rlast_login_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vlastLoginAt\x12!\n" +
+	"\ftotp_enabled\x18\x06 \x01(\bR\vtotpEnabled\"l\n" +
+	"\x12CreateAdminRequest\x12&\n" +
+	"\busername\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"\xad\x01\n" +
+	"\x12UpdateAdminRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x1f\n" +
+	"\bdisabled\x18\x04 \x01(\bH\x00R\bdisabled\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"reset_totp\x18\x05 \x01(\bR\tresetTotpB\v\n" +
+	"\t_disabled\"\x13\n" +
+	"\x11ListAdminsRequest\">\n" +
+	"\x12ListAdminsResponse\x12(\n" +
+	"\x06admins\x18\x01 \x03(\v2\x10.whitelist.AdminR\x06admins\"\xe5\x02\n" +
+	"\fAdminSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12<\n" +
+	"\flast_seen_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSeenAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\x12\x1b\n" +
+	"\tclient_ip\x18\a \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\b \x01(\tR\tuserAgent\"[\n" +
+	"\x18ListAdminSessionsRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12#\n" +
+	"\rinclude_ended\x18\x02 \x01(\bR\fincludeEnded\"P\n" +
+	"\x19ListAdminSessionsResponse\x123\n" +
+	"\bsessions\x18\x01 \x03(\v2\x17.whitelist.AdminSessionR\bsessions\":\n" +
+	"\x19RevokeAdminSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"\x9b\x01\n" +
+	"\x18ExportLicenseFileRequest\x125\n" +
+	"\vlicense_key\x18\x01 \x01(\tB\x14\xfaB\x11r\x0f\x10\x01\x18\x80\x012\b^[!-~]+$R\n" +
+	"licenseKey\x12\x1c\n" +
+	"\x04hwid\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x04hwid\x12*\n" +
+	"\x11valid_for_seconds\x18\x03 \x01(\x03R\x0fvalidForSeconds\"\xb1\x01\n" +
+	"\x19ExportLicenseFileResponse\x12!\n" +
+	"\flicense_file\x18\x01 \x01(\tR\vlicenseFile\x12;\n" +
+	"\vvalid_until\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"validUntil\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x03 \x01(\tR\tpublicKey\x12\x15\n" +
+	"\x06key_id\x18\x04 \x01(\tR\x05keyId\"\xc7\x02\n" +
+	"\x13StartSessionRequest\x125\n" +
+	"\vlicense_key\x18\x01 \x01(\tB\x14\xfaB\x11r\x0f\x10\x01\x18\x80\x012\b^[!-~]+$R\n" +
+	"licenseKey\x12)\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\tproductId\x12\x1c\n" +
+	"\x04hwid\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x04hwid\x12.\n" +
+	"\x0eclient_version\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x18@R\rclientVersion\x12&\n" +
+	"\tchallenge\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\tchallenge\x127\n" +
+	"\x12challenge_response\x18\x06 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x11challengeResponse\x12\x1f\n" +
+	"\x06locale\x18\a \x01(\tB\a\xfaB\x04r\x02\x18#R\x06locale\"\x83\x03\n" +
+	"\x14StartSessionResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\x12expires_in_seconds\x18\x03 \x01(\x03R\x10expiresInSeconds\x12#\n" +
+	"\rsession_token\x18\x04 \x01(\tR\fsessionToken\x12<\n" +
+	"\x1aheartbeat_interval_seconds\x18\x05 \x01(\x03R\x18heartbeatIntervalSeconds\x12)\n" +
+	"\x10required_version\x18\x06 \x01(\tR\x0frequiredVersion\x12%\n" +
+	"\x0esuspend_reason\x18\a \x01(\tR\rsuspendReason\x12-\n" +
+	"\x12challenge_response\x18\b \x01(\tR\x11challengeResponse\x12)\n" +
+	"\x06reason\x18\t \x01(\x0e2\x11.whitelist.ReasonR\x06reason\"C\n" +
+	"\x10HeartbeatRequest\x12/\n" +
+	"\rsession_token\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\fsessionToken\"\xd3\x01\n" +
+	"\x11HeartbeatResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12<\n" +
+	"\x1aheartbeat_interval_seconds\x18\x03 \x01(\x03R\x18heartbeatIntervalSeconds\x12%\n" +
+	"\x0esuspend_reason\x18\x04 \x01(\tR\rsuspendReason\x12)\n" +
+	"\x06reason\x18\x05 \x01(\x0e2\x11.whitelist.ReasonR\x06reason\"D\n" +
+	"\x11EndSessionRequest\x12/\n" +
+	"\rsession_token\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\fsessionToken\"w\n" +
+	"\x13WatchLicenseRequest\x125\n" +
+	"\vlicense_key\x18\x01 \x01(\tB\x14\xfaB\x11r\x0f\x10\x01\x18\x80\x012\b^[!-~]+$R\n" +
+	"licenseKey\x12)\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\tproductId\"\xbe\x02\n" +
+	"\x12LicenseStatusEvent\x120\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x18.whitelist.LicenseStatusR\x06status\x12\x14\n" +
+	"\x05valid\x18\x02 \x01(\bR\x05valid\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"changed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\x12%\n" +
+	"\x0esuspend_reason\x18\x06 \x01(\tR\rsuspendReason\x12)\n" +
+	"\x06reason\x18\a \x01(\x0e2\x11.whitelist.ReasonR\x06reason\"Q\n" +
+	"\x17ValidateLicensesRequest\x126\n" +
+	"\blicenses\x18\x01 \x03(\v2\x1a.whitelist.ValidateRequestR\blicenses\"Q\n" +
+	"\x18ValidateLicensesResponse\x125\n" +
+	"\aresults\x18\x01 \x03(\v2\x1b.whitelist.ValidateResponseR\aresults\"\xb6\x05\n" +
+	"\aProduct\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bdisabled\x18\x04 \x01(\bR\bdisabled\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12#\n" +
+	"\rlicense_count\x18\a \x01(\x05R\flicenseCount\x12\x1f\n" +
+	"\vmin_version\x18\b \x01(\tR\n" +
+	"minVersion\x124\n" +
+	"\x16trial_duration_seconds\x18\t \x01(\x03R\x14trialDurationSeconds\x12+\n" +
+	"\x11allowed_countries\x18\n" +
+	" \x03(\tR\x10allowedCountries\x12+\n" +
+	"\x11blocked_countries\x18\v \x03(\tR\x10blockedCountries\x12'\n" +
+	"\x0fsigned_requests\x18\f \x01(\bR\x0esignedRequests\x12+\n" +
+	"\x11require_challenge\x18\r \x01(\bR\x10requireChallenge\x12\x1d\n" +
+	"\n" +
+	"key_format\x18\x0e \x01(\tR\tkeyFormat\x12<\n" +
+	"\bfeatures\x18\x0f \x03(\v2 .whitelist.Product.FeaturesEntryR\bfeatures\x1a;\n" +
+	"\rFeaturesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"\x93\x04\n" +
+	"\x14CreateProductRequest\x126\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\x17\xfaB\x14r\x12\x10\x01\x18\x80\x012\v^\\S(.*\\S)?$R\tproductId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vmin_version\x18\x04 \x01(\tR\n" +
+	"minVersion\x124\n" +
+	"\x16trial_duration_seconds\x18\x05 \x01(\x03R\x14trialDurationSeconds\x12+\n" +
+	"\x11allowed_countries\x18\x06 \x03(\tR\x10allowedCountries\x12+\n" +
+	"\x11blocked_countries\x18\a \x03(\tR\x10blockedCountries\x12+\n" +
+	"\x11require_challenge\x18\b \x01(\bR\x10requireChallenge\x12'\n" +
+	"\n" +
+	"key_format\x18\t \x01(\tB\b\xfaB\x05r\x03\x18\x80\x01R\tkeyFormat\x12I\n" +
+	"\bfeatures\x18\n" +
+	" \x03(\v2-.whitelist.CreateProductRequest.FeaturesEntryR\bfeatures\x1a;\n" +
+	"\rFeaturesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"\x8c\x05\n" +
+	"\x14UpdateProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1f\n" +
+	"\bdisabled\x18\x04 \x01(\bH\x02R\bdisabled\x88\x01\x01\x12$\n" +
+	"\vmin_version\x18\x05 \x01(\tH\x03R\n" +
+	"minVersion\x88\x01\x01\x129\n" +
+	"\x16trial_duration_seconds\x18\x06 \x01(\x03H\x04R\x14trialDurationSeconds\x88\x01\x01\x12C\n" +
+	"\x11allowed_countries\x18\a \x01(\v2\x16.whitelist.CountryListR\x10allowedCountries\x12C\n" +
+	"\x11blocked_countries\x18\b \x01(\v2\x16.whitelist.CountryListR\x10blockedCountries\x120\n" +
+	"\x11require_challenge\x18\t \x01(\bH\x05R\x10requireChallenge\x88\x01\x01\x12,\n" +
+	"\n" +
+	"key_format\x18\n" +
+	" \x01(\tB\b\xfaB\x05r\x03\x18\x80\x01H\x06R\tkeyFormat\x88\x01\x01\x123\n" +
+	"\bfeatures\x18\v \x01(\v2\x17.whitelist.FeatureFlagsR\bfeaturesB\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\v\n" +
+	"\t_disabledB\x0e\n" +
+	"\f_min_versionB\x19\n" +
+	"\x17_trial_duration_secondsB\x14\n" +
+	"\x12_require_challengeB\r\n" +
+	"\v_key_format\"+\n" +
+	"\vCountryList\x12\x1c\n" +
+	"\tcountries\x18\x01 \x03(\tR\tcountries\"\x82\x01\n" +
+	"\fFeatureFlags\x128\n" +
+	"\x05flags\x18\x01 \x03(\v2\".whitelist.FeatureFlags.FlagsEntryR\x05flags\x1a8\n" +
+	"\n" +
+	"FlagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"@\n" +
+	"\x13ListProductsRequest\x12)\n" +
+	"\x10include_disabled\x18\x01 \x01(\bR\x0fincludeDisabled\"F\n" +
+	"\x14ListProductsResponse\x12.\n" +
+	"\bproducts\x18\x01 \x03(\v2\x12.whitelist.ProductR\bproducts\"5\n" +
+	"\x14DeleteProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"\x9b\x03\n" +
+	"\x04Plan\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vmax_devices\x18\x03 \x01(\x05R\n" +
+	"maxDevices\x12)\n" +
+	"\x10duration_seconds\x18\x04 \x01(\x03R\x0fdurationSeconds\x129\n" +
+	"\bfeatures\x18\x05 \x03(\v2\x1d.whitelist.Plan.FeaturesEntryR\bfeatures\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12#\n" +
+	"\rlicense_count\x18\b \x01(\x05R\flicenseCount\x1a;\n" +
+	"\rFeaturesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"\xb3\x02\n" +
+	"\x11CreatePlanRequest\x12+\n" +
+	"\x04name\x18\x01 \x01(\tB\x17\xfaB\x14r\x12\x10\x01\x18\x80\x012\v^\\S(.*\\S)?$R\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vmax_devices\x18\x03 \x01(\x05R\n" +
+	"maxDevices\x12)\n" +
+	"\x10duration_seconds\x18\x04 \x01(\x03R\x0fdurationSeconds\x12F\n" +
+	"\bfeatures\x18\x05 \x03(\v2*.whitelist.CreatePlanRequest.FeaturesEntryR\bfeatures\x1a;\n" +
+	"\rFeaturesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"\x8e\x02\n" +
+	"\x11UpdatePlanRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01\x12$\n" +
+	"\vmax_devices\x18\x03 \x01(\x05H\x01R\n" +
+	"maxDevices\x88\x01\x01\x12.\n" +
+	"\x10duration_seconds\x18\x04 \x01(\x03H\x02R\x0fdurationSeconds\x88\x01\x01\x123\n" +
+	"\bfeatures\x18\x05 \x01(\v2\x17.whitelist.FeatureFlagsR\bfeaturesB\x0e\n" +
+	"\f_descriptionB\x0e\n" +
+	"\f_max_devicesB\x13\n" +
+	"\x11_duration_seconds\"\x12\n" +
+	"\x10ListPlansRequest\":\n" +
+	"\x11ListPlansResponse\x12%\n" +
+	"\x05plans\x18\x01 \x03(\v2\x0f.whitelist.PlanR\x05plans\"'\n" +
+	"\x11DeletePlanRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xdc\x01\n" +
+	"\aRelease\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12\x1c\n" +
+	"\tchangelog\x18\x04 \x01(\tR\tchangelog\x12!\n" +
+	"\fdownload_url\x18\x05 \x01(\tR\vdownloadUrl\x12=\n" +
+	"\fpublished_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\"\x7f\n" +
+	"\x17GetLatestVersionRequest\x12)\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\tproductId\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12\x1f\n" +
+	"\vlicense_key\x18\x03 \x01(\tR\n" +
+	"licenseKey\"\xc2\x01\n" +
+	"\x15PublishReleaseRequest\x12)\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\tproductId\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12#\n" +
+	"\aversion\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\aversion\x12\x1c\n" +
+	"\tchangelog\x18\x04 \x01(\tR\tchangelog\x12!\n" +
+	"\fdownload_url\x18\x05 \x01(\tR\vdownloadUrl\"U\n" +
+	"\x18SetLicenseChannelRequest\x12\x1f\n" +
+	"\vlicense_key\x18\x01 \x01(\tR\n" +
+	"licenseKey\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\"\xc5\x01\n" +
+	"\bCustomer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"discord_id\x18\x03 \x01(\tR\tdiscordId\x12\x14\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notes\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12#\n" +
+	"\rlicense_count\x18\x06 \x01(\x05R\flicenseCount\"b\n" +
+	"\x15CreateCustomerRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"discord_id\x18\x02 \x01(\tR\tdiscordId\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\"\xc3\x01\n" +
+	"\x14ListCustomersRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"discord_id\x18\x02 \x01(\tR\tdiscordId\x12\x1f\n" +
+	"\vlicense_key\x18\x03 \x01(\tR\n" +
+	"licenseKey\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x06 \x01(\tR\aorderBy\"r\n" +
+	"\x15ListCustomersResponse\x121\n" +
+	"\tcustomers\x18\x01 \x03(\v2\x13.whitelist.CustomerR\tcustomers\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"X\n" +
+	"\x14AttachLicenseRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\x03R\n" +
+	"customerId\x12\x1f\n" +
+	"\vlicense_key\x18\x02 \x01(\tR\n" +
+	"licenseKey\"X\n" +
+	"\x14DetachLicenseRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\x03R\n" +
+	"customerId\x12\x1f\n" +
+	"\vlicense_key\x18\x02 \x01(\tR\n" +
+	"licenseKey\"\x91\x01\n" +
+	"\x1aIssueLicenseToEmailRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1f\n" +
+	"\vlicense_key\x18\x02 \x01(\tR\n" +
+	"licenseKey\x12<\n" +
+	"\alicense\x18\x03 \x01(\v2\".whitelist.GenerateLicensesRequestR\alicense\"\x99\x02\n" +
+	"\x0fLicenseDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vlicense_key\x18\x02 \x01(\tR\n" +
+	"licenseKey\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12!\n" +
+	"\frequested_by\x18\x06 \x01(\tR\vrequestedBy\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x123\n" +
+	"\asent_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x06sentAt\"v\n" +
+	"\x1bIssueLicenseToEmailResponse\x12\x1f\n" +
+	"\vlicense_key\x18\x01 \x01(\tR\n" +
+	"licenseKey\x126\n" +
+	"\bdelivery\x18\x02 \x01(\v2\x1a.whitelist.LicenseDeliveryR\bdelivery\"?\n" +
+	"\x1cListLicenseDeliveriesRequest\x12\x1f\n" +
+	"\vlicense_key\x18\x01 \x01(\tR\n" +
+	"licenseKey\"[\n" +
+	"\x1dListLicenseDeliveriesResponse\x12:\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x1a.whitelist.LicenseDeliveryR\n" +
+	"deliveries\"\x91\x01\n" +
+	"\x19CreateTrialLicenseRequest\x12)\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\tproductId\x12)\n" +
+	"\x10duration_seconds\x18\x02 \x01(\x03R\x0fdurationSeconds\x12\x1e\n" +
+	"\x04hwid\x18\x03 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x02R\x04hwid\"x\n" +
+	"\x1aCreateTrialLicenseResponse\x12\x1f\n" +
+	"\vlicense_key\x18\x01 \x01(\tR\n" +
+	"licenseKey\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"x\n" +
+	"\x14ExtendLicenseRequest\x125\n" +
+	"\vlicense_key\x18\x01 \x01(\tB\x14\xfaB\x11r\x0f\x10\x01\x18\x80\x012\b^[!-~]+$R\n" +
+	"licenseKey\x12)\n" +
+	"\x10duration_seconds\x18\x02 \x01(\x03R\x0fdurationSeconds\"\x87\x01\n" +
+	"\x1dResellerExtendLicenseResponse\x129\n" +
+	"\n" +
+	"expires_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12+\n" +
+	"\x11remaining_credits\x18\x02 \x01(\x03R\x10remainingCredits\"f\n" +
+	"\x15SuspendLicenseRequest\x125\n" +
+	"\vlicense_key\x18\x01 \x01(\tB\x14\xfaB\x11r\x0f\x10\x01\x18\x80\x012\b^[!-~]+$R\n" +
+	"licenseKey\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"P\n" +
+	"\x17UnsuspendLicenseRequest\x125\n" +
+	"\vlicense_key\x18\x01 \x01(\tB\x14\xfaB\x11r\x0f\x10\x01\x18\x80\x012\b^[!-~]+$R\n" +
+	"licenseKey\"\x8d\x01\n" +
+	"\aHwidBan\x12\x12\n" +
+	"\x04hwid\x18\x01 \x01(\tR\x04hwid\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1b\n" +
+	"\tbanned_by\x18\x03 \x01(\tR\bbannedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"H\n" +
+	"\x0eBanHwidRequest\x12\x1e\n" +
+	"\x04hwid\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x02R\x04hwid\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"2\n" +
+	"\x10UnbanHwidRequest\x12\x1e\n" +
+	"\x04hwid\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x02R\x04hwid\"l\n" +
+	"\x13ListHwidBansRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x03 \x01(\tR\aorderBy\"f\n" +
+	"\x14ListHwidBansResponse\x12&\n" +
+	"\x04bans\x18\x01 \x03(\v2\x12.whitelist.HwidBanR\x04bans\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xcc\x01\n" +
+	"\x05IpBan\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1b\n" +
+	"\tbanned_by\x18\x03 \x01(\tR\bbannedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"k\n" +
+	"\fBanIpRequest\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12)\n" +
+	"\x10duration_seconds\x18\x03 \x01(\x03R\x0fdurationSeconds\"*\n" +
+	"\x0eUnbanIpRequest\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\"\x13\n" +
+	"\x11ListIpBansRequest\":\n" +
+	"\x12ListIpBansResponse\x12$\n" +
+	"\x04bans\x18\x01 \x03(\v2\x10.whitelist.IpBanR\x04bans\"\x97\x01\n" +
+	"\x1aSetLicenseCountriesRequest\x12\x1f\n" +
+	"\vlicense_key\x18\x01 \x01(\tR\n" +
+	"licenseKey\x12+\n" +
+	"\x11allowed_countries\x18\x02 \x03(\tR\x10allowedCountries\x12+\n" +
+	"\x11blocked_countries\x18\x03 \x03(\tR\x10blockedCountries\"\x94\x01\n" +
+	"\aLockout\x12\x14\n" +
+	"\x05scope\x18\x01 \x01(\tR\x05scope\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12\x1a\n" +
+	"\bfailures\x18\x03 \x01(\x05R\bfailures\x12=\n" +
+	"\flocked_until\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vlockedUntil\"G\n" +
+	"\x14ClearLockoutsRequest\x12\x1f\n" +
+	"\vlicense_key\x18\x01 \x01(\tR\n" +
+	"licenseKey\x12\x0e\n" +
+	"\x02ip\x18\x02 \x01(\tR\x02ip\"E\n" +
+	"\x15ClearLockoutsResponse\x12,\n" +
+	"\acleared\x18\x01 \x03(\v2\x12.whitelist.LockoutR\acleared\"B\n" +
+	"!RotateProductSigningSecretRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"y\n" +
+	"\"RotateProductSigningSecretResponse\x12,\n" +
+	"\aproduct\x18\x01 \x01(\v2\x12.whitelist.ProductR\aproduct\x12%\n" +
+	"\x0esigning_secret\x18\x02 \x01(\tR\rsigningSecret\"B\n" +
+	"!RemoveProductSigningSecretRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"\x15\n" +
+	"\x13GetChallengeRequest\"b\n" +
+	"\x14GetChallengeResponse\x12\x1c\n" +
+	"\tchallenge\x18\x01 \x01(\tR\tchallenge\x12,\n" +
+	"\x12expires_in_seconds\x18\x02 \x01(\x03R\x10expiresInSeconds\"S\n" +
+	"\x14GetServerTimeRequest\x12;\n" +
+	"\vclient_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"clientTime\"\x82\x01\n" +
+	"\x15GetServerTimeResponse\x12;\n" +
+	"\vserver_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"serverTime\x12,\n" +
+	"\x12clock_skew_seconds\x18\x02 \x01(\x03R\x10clockSkewSeconds\"\x15\n" +
+	"\x13GetPublicKeyRequest\"L\n" +
+	"\x14GetPublicKeyResponse\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x01 \x01(\tR\tpublicKey\x12\x15\n" +
+	"\x06key_id\x18\x02 \x01(\tR\x05keyId\"\x17\n" +
+	"\x15GetSigningKeysRequest\"\x99\x01\n" +
+	"\n" +
+	"SigningKey\x12\x10\n" +
+	"\x03kid\x18\x01 \x01(\tR\x03kid\x12\x10\n" +
+	"\x03kty\x18\x02 \x01(\tR\x03kty\x12\x10\n" +
+	"\x03crv\x18\x03 \x01(\tR\x03crv\x12\f\n" +
+	"\x01x\x18\x04 \x01(\tR\x01x\x12\x10\n" +
+	"\x03alg\x18\x05 \x01(\tR\x03alg\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x06 \x01(\tR\tpublicKey\x12\x16\n" +
+	"\x06active\x18\a \x01(\bR\x06active\"C\n" +
+	"\x16GetSigningKeysResponse\x12)\n" +
+	"\x04keys\x18\x01 \x03(\v2\x15.whitelist.SigningKeyR\x04keys\"A\n" +
+	"\x1aRevokeRefreshTokensRequest\x12#\n" +
+	"\aapi_key\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x02R\x06apiKey\"7\n" +
+	"\x1bRevokeRefreshTokensResponse\x12\x18\n" +
+	"\arevoked\x18\x01 \x01(\x05R\arevoked\"C\n" +
+	"\x18RotateAdminSecretRequest\x12'\n" +
+	"\x0foverlap_seconds\x18\x01 \x01(\x03R\x0eoverlapSeconds\"}\n" +
+	"\x19RotateAdminSecretResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12H\n" +
+	"\x12previous_expire_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x10previousExpireAt\",\n" +
+	"\x16EnrollAdminTotpRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"l\n" +
+	"\x17EnrollAdminTotpResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x1f\n" +
+	"\votpauth_url\x18\x02 \x01(\tR\n" +
+	"otpauthUrl\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\"\xd9\x01\n" +
+	"\x15ExportAuditLogRequest\x12\x14\n" +
+	"\x05actor\x18\x01 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\tR\x06target\x120\n" +
+	"\x05since\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x120\n" +
+	"\x05until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12\x16\n" +
+	"\x06format\x18\x06 \x01(\tR\x06format\"H\n" +
+	"\x0fGetStatsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\"\xb9\x01\n" +
+	"\n" +
+	"DailyStats\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12 \n" +
+	"\vvalidations\x18\x02 \x01(\x03R\vvalidations\x12-\n" +
+	"\x12failed_validations\x18\x03 \x01(\x03R\x11failedValidations\x12!\n" +
+	"\funique_hwids\x18\x04 \x01(\x03R\vuniqueHwids\x12#\n" +
+	"\rtokens_issued\x18\x05 \x01(\x03R\ftokensIssued\"=\n" +
+	"\rFailureReason\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\xcb\x02\n" +
+	"\x10GetStatsResponse\x120\n" +
+	"\x05since\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x12)\n" +
+	"\x04days\x18\x02 \x03(\v2\x15.whitelist.DailyStatsR\x04days\x12 \n" +
+	"\vvalidations\x18\x03 \x01(\x03R\vvalidations\x12-\n" +
+	"\x12failed_validations\x18\x04 \x01(\x03R\x11failedValidations\x12!\n" +
+	"\funique_hwids\x18\x05 \x01(\x03R\vuniqueHwids\x12#\n" +
+	"\rtokens_issued\x18\x06 \x01(\x03R\ftokensIssued\x12A\n" +
+	"\x0ffailure_reasons\x18\a \x03(\v2\x18.whitelist.FailureReasonR\x0efailureReasons\"\x95\x02\n" +
+	"\x0fValidationEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1f\n" +
+	"\vlicense_key\x18\x03 \x01(\tR\n" +
+	"licenseKey\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x04 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04hwid\x18\x05 \x01(\tR\x04hwid\x12\x14\n" +
+	"\x05valid\x18\x06 \x01(\bR\x05valid\x12\x16\n" +
+	"\x06result\x18\a \x01(\tR\x06result\x12\x1b\n" +
+	"\tclient_ip\x18\b \x01(\tR\bclientIp\x12\x18\n" +
+	"\acountry\x18\t \x01(\tR\acountry\"\xd9\x01\n" +
+	"\x1bListValidationEventsRequest\x12\x1f\n" +
+	"\vlicense_key\x18\x01 \x01(\tR\n" +
+	"licenseKey\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x19\n" +
+	"\x05valid\x18\x03 \x01(\bH\x00R\x05valid\x88\x01\x01\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x06 \x01(\tR\aorderByB\b\n" +
+	"\x06_valid\"z\n" +
+	"\x1cListValidationEventsResponse\x122\n" +
+	"\x06events\x18\x01 \x03(\v2\x1a.whitelist.ValidationEventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8b\x02\n" +
+	"\x15SearchLicensesRequest\x12\x1d\n" +
+	"\n" +
+	"key_suffix\x18\x01 \x01(\tR\tkeySuffix\x12\x12\n" +
+	"\x04hwid\x18\x02 \x01(\tR\x04hwid\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x123\n" +
+	"\bmetadata\x18\x04 \x01(\v2\x17.google.protobuf.StructR\bmetadata\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x05 \x01(\tR\tproductId\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\b \x01(\tR\aorderBy\"p\n" +
+	"\x16SearchLicensesResponse\x12.\n" +
+	"\blicenses\x18\x01 \x03(\v2\x12.whitelist.LicenseR\blicenses\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"8\n" +
+	"\x15RestoreLicenseRequest\x12\x1f\n" +
+	"\vlicense_key\x18\x01 \x01(\tR\n" +
+	"licenseKey\"6\n" +
+	"\x13PurgeLicenseRequest\x12\x1f\n" +
+	"\vlicense_key\x18\x01 \x01(\tR\n" +
+	"licenseKey\"\xa8\x01\n" +
+	"\x18GetLicenseHistoryRequest\x125\n" +
+	"\vlicense_key\x18\x01 \x01(\tB\x14\xfaB\x11r\x0f\x10\x01\x18\x80\x012\b^[!-~]+$R\n" +
+	"licenseKey\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\"}\n" +
+	"\x19GetLicenseHistoryResponse\x128\n" +
+	"\trevisions\x18\x01 \x03(\v2\x1a.whitelist.LicenseRevisionR\trevisions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xdf\x01\n" +
+	"\x0fLicenseRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12%\n" +
+	"\x0echanged_fields\x18\x05 \x03(\tR\rchangedFields\x12,\n" +
+	"\alicense\x18\x06 \x01(\v2\x12.whitelist.LicenseR\alicense\"\x83\x01\n" +
+	"\x1dImportExternalLicensesRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\tR\x04data\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tR\tproductId\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"T\n" +
+	"\x1bBulkSuspendByProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\";\n" +
+	"\x1aBulkDeleteByProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"\x8f\x01\n" +
+	"\x1aBulkExtendByProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12)\n" +
+	"\x10duration_seconds\x18\x02 \x01(\x03R\x0fdurationSeconds\x12'\n" +
+	"\x0finclude_expired\x18\x03 \x01(\bR\x0eincludeExpired\"3\n" +
+	"\x15BulkOperationResponse\x12\x1a\n" +
+	"\baffected\x18\x01 \x01(\x05R\baffected\"O\n" +
+	"\x19SetMaintenanceModeRequest\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xc0\x01\n" +
+	"\x0fMaintenanceMode\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x129\n" +
+	"\n" +
+	"started_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12\x1d\n" +
+	"\n" +
+	"started_by\x18\x04 \x01(\tR\tstartedBy\x12\x1f\n" +
+	"\vfrom_config\x18\x05 \x01(\bR\n" +
+	"fromConfig\"\xa9\x01\n" +
+	"\x0eProductMessage\x12F\n" +
+	"\x06locale\x18\x01 \x01(\tB.\xfaB+r)\x10\x02\x18#2#^[A-Za-z]{2,3}(-[A-Za-z0-9]{1,8})*$R\x06locale\x12)\n" +
+	"\x06reason\x18\x02 \x01(\x0e2\x11.whitelist.ReasonR\x06reason\x12$\n" +
+	"\amessage\x18\x03 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xc8\x01R\amessage\"\x88\x01\n" +
+	"\x19SetProductMessagesRequest\x12)\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\tproductId\x12@\n" +
+	"\bmessages\x18\x02 \x03(\v2\x19.whitelist.ProductMessageB\t\xfaB\x06\x92\x01\x03\x10\xf4\x03R\bmessages\"F\n" +
+	"\x19GetProductMessagesRequest\x12)\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\tproductId\"g\n" +
+	"\x0fProductMessages\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x125\n" +
+	"\bmessages\x18\x02 \x03(\v2\x19.whitelist.ProductMessageR\bmessages\"\x96\x01\n" +
+	"\x14ListMyDevicesRequest\x125\n" +
+	"\vlicense_key\x18\x01 \x01(\tB\x14\xfaB\x11r\x0f\x10\x01\x18\x80\x012\b^[!-~]+$R\n" +
+	"licenseKey\x12)\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\tproductId\x12\x1c\n" +
+	"\x04hwid\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x04hwid\"x\n" +
+	"\bMyDevice\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\bdeviceId\x125\n" +
+	"\bbound_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aboundAt\x12\x18\n" +
+	"\acurrent\x18\x03 \x01(\bR\acurrent\"\xb5\x01\n" +
+	"\x15ListMyDevicesResponse\x12-\n" +
+	"\adevices\x18\x01 \x03(\v2\x13.whitelist.MyDeviceR\adevices\x12\x1f\n" +
+	"\vmax_devices\x18\x02 \x01(\x05R\n" +
+	"maxDevices\x12L\n" +
+	"\x14next_deactivation_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x12nextDeactivationAt\"\xa4\x01\n" +
+	"\x17DeactivateDeviceRequest\x125\n" +
+	"\vlicense_key\x18\x01 \x01(\tB\x14\xfaB\x11r\x0f\x10\x01\x18\x80\x012\b^[!-~]+$R\n" +
+	"licenseKey\x12)\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\tproductId\x12'\n" +
+	"\tdevice_id\x18\x03 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x02R\bdeviceId\"t\n" +
+	"\x1eSetLicenseFloatingSeatsRequest\x12\x1f\n" +
+	"\vlicense_key\x18\x01 \x01(\tR\n" +
+	"licenseKey\x121\n" +
+	"\x0efloating_seats\x18\x02 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\x90N(\x00R\rfloatingSeats\"\xcc\x02\n" +
+	"\x16CheckoutLicenseRequest\x125\n" +
+	"\vlicense_key\x18\x01 \x01(\tB\x14\xfaB\x11r\x0f\x10\x01\x18\x80\x012\b^[!-~]+$R\n" +
+	"licenseKey\x12)\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\tproductId\x12\x1e\n" +
+	"\x04hwid\x18\x03 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x02R\x04hwid\x12.\n" +
+	"\x0eclient_version\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x18@R\rclientVersion\x12&\n" +
+	"\tchallenge\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\tchallenge\x127\n" +
+	"\x12challenge_response\x18\x06 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x11challengeResponse\x12\x1f\n" +
+	"\x06locale\x18\a \x01(\tB\a\xfaB\x04r\x02\x18#R\x06locale\"\xad\x03\n" +
+	"\x17CheckoutLicenseResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\x12expires_in_seconds\x18\x03 \x01(\x03R\x10expiresInSeconds\x12\x1f\n" +
+	"\vlease_token\x18\x04 \x01(\tR\n" +
+	"leaseToken\x127\n" +
+	"\x18lease_expires_in_seconds\x18\x05 \x01(\x03R\x15leaseExpiresInSeconds\x12)\n" +
+	"\x10required_version\x18\x06 \x01(\tR\x0frequiredVersion\x12%\n" +
+	"\x0esuspend_reason\x18\a \x01(\tR\rsuspendReason\x12.\n" +
+	"\x13retry_after_seconds\x18\b \x01(\x03R\x11retryAfterSeconds\x12-\n" +
+	"\x12challenge_response\x18\t \x01(\tR\x11challengeResponse\x12)\n" +
+	"\x06reason\x18\n" +
+	" \x01(\x0e2\x11.whitelist.ReasonR\x06reason\"D\n" +
+	"\x15CheckinLicenseRequest\x12+\n" +
+	"\vlease_token\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\n" +
+	"leaseToken\"\x8e\x03\n" +
+	"\x15ConsumeCreditsRequest\x125\n" +
+	"\vlicense_key\x18\x01 \x01(\tB\x14\xfaB\x11r\x0f\x10\x01\x18\x80\x012\b^[!-~]+$R\n" +
+	"licenseKey\x12)\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\tproductId\x12\x1c\n" +
+	"\x04hwid\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x04hwid\x12%\n" +
+	"\x06amount\x18\x04 \x01(\x03B\r\xfaB\n" +
+	"\"\b\x18\x80\x94\xeb\xdc\x03(\x01R\x06amount\x12\x1c\n" +
+	"\x04note\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\xc8\x01R\x04note\x12.\n" +
+	"\x0eclient_version\x18\x06 \x01(\tB\a\xfaB\x04r\x02\x18@R\rclientVersion\x12&\n" +
+	"\tchallenge\x18\a \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\tchallenge\x127\n" +
+	"\x12challenge_response\x18\b \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x11challengeResponse\x12\x1f\n" +
+	"\x06locale\x18\t \x01(\tB\a\xfaB\x04r\x02\x18#R\x06locale\"\xd1\x02\n" +
+	"\x16ConsumeCreditsResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12+\n" +
+	"\x11remaining_credits\x18\x03 \x01(\x03R\x10remainingCredits\x12)\n" +
+	"\x10required_version\x18\x04 \x01(\tR\x0frequiredVersion\x12%\n" +
+	"\x0esuspend_reason\x18\x05 \x01(\tR\rsuspendReason\x12.\n" +
+	"\x13retry_after_seconds\x18\x06 \x01(\x03R\x11retryAfterSeconds\x12-\n" +
+	"\x12challenge_response\x18\a \x01(\tR\x11challengeResponse\x12)\n" +
+	"\x06reason\x18\b \x01(\x0e2\x11.whitelist.ReasonR\x06reason\"k\n" +
+	"\x1aTopUpLicenseCreditsRequest\x12\x1f\n" +
+	"\vlicense_key\x18\x01 \x01(\tR\n" +
+	"licenseKey\x12\x18\n" +
+	"\acredits\x18\x02 \x01(\x03R\acredits\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"\xd0\x01\n" +
+	"\x15LicenseCreditActivity\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05delta\x18\x04 \x01(\x03R\x05delta\x12\x18\n" +
+	"\abalance\x18\x05 \x01(\x03R\abalance\x12\x14\n" +
+	"\x05actor\x18\x06 \x01(\tR\x05actor\x12\x12\n" +
+	"\x04note\x18\a \x01(\tR\x04note\"\x9a\x01\n" +
+	" ListLicenseCreditActivityRequest\x12\x1f\n" +
+	"\vlicense_key\x18\x01 \x01(\tR\n" +
+	"licenseKey\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\"\x89\x01\n" +
+	"!ListLicenseCreditActivityResponse\x12<\n" +
+	"\bactivity\x18\x01 \x03(\v2 .whitelist.LicenseCreditActivityR\bactivity\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xc9\x01\n" +
+	"\x19SetLicenseFeaturesRequest\x12\x1f\n" +
+	"\vlicense_key\x18\x01 \x01(\tR\n" +
+	"licenseKey\x12N\n" +
+	"\bfeatures\x18\x02 \x03(\v22.whitelist.SetLicenseFeaturesRequest.FeaturesEntryR\bfeatures\x1a;\n" +
+	"\rFeaturesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01*\xd6\a\n" +
+	"\x06Reason\x12\x16\n" +
+	"\x12REASON_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tREASON_OK\x10\x01\x12\x1c\n" +
+	"\x18REASON_LICENSE_NOT_FOUND\x10\x02\x12\x1a\n" +
+	"\x16REASON_UNKNOWN_PRODUCT\x10\x03\x12\x1b\n" +
+	"\x17REASON_PRODUCT_DISABLED\x10\x04\x12\x14\n" +
+	"\x10REASON_SUSPENDED\x10\x05\x12\x12\n" +
+	"\x0eREASON_EXPIRED\x10\x06\x12\x18\n" +
+	"\x14REASON_HWID_MISMATCH\x10\a\x12\x1f\n" +
+	"\x1bREASON_DEVICE_LIMIT_REACHED\x10\b\x12\x18\n" +
+	"\x14REASON_DEVICE_BANNED\x10\t\x12\x1d\n" +
+	"\x19REASON_REGION_NOT_ALLOWED\x10\n" +
+	"\x12\x1a\n" +
+	"\x16REASON_CLIENT_OUTDATED\x10\v\x12\x1d\n" +
+	"\x19REASON_CHALLENGE_REQUIRED\x10\f\x12\x1c\n" +
+	"\x18REASON_INVALID_CHALLENGE\x10\r\x12\x15\n" +
+	"\x11REASON_LOCKED_OUT\x10\x0e\x12\x1c\n" +
+	"\x18REASON_TOO_MANY_SESSIONS\x10\x0f\x12\x1a\n" +
+	"\x16REASON_SESSION_EXPIRED\x10\x10\x12\x1a\n" +
+	"\x16REASON_INVALID_REQUEST\x10\x11\x12\x18\n" +
+	"\x14REASON_TOKEN_MISSING\x10\x12\x12\x18\n" +
+	"\x14REASON_TOKEN_INVALID\x10\x13\x12\x1e\n" +
+	"\x1aREASON_TOKEN_WRONG_PRODUCT\x10\x14\x12\x1a\n" +
+	"\x16REASON_API_KEY_INVALID\x10\x15\x12 \n" +
+	"\x1cREASON_REFRESH_TOKEN_INVALID\x10\x16\x12\x1f\n" +
+	"\x1bREASON_RESELLER_KEY_INVALID\x10\x17\x12\x1d\n" +
+	"\x19REASON_SIGNATURE_REQUIRED\x10\x18\x12\x1c\n" +
+	"\x18REASON_SIGNATURE_INVALID\x10\x19\x12\x17\n" +
+	"\x13REASON_NONCE_REUSED\x10\x1a\x12\x17\n" +
+	"\x13REASON_RATE_LIMITED\x10\x1b\x12\x19\n" +
+	"\x15REASON_ADDRESS_BANNED\x10\x1c\x12\x1e\n" +
+	"\x1aREASON_ADDRESS_NOT_ALLOWED\x10\x1d\x12\x16\n" +
+	"\x12REASON_MAINTENANCE\x10\x1e\x12\x1a\n" +
+	"\x16REASON_REBIND_COOLDOWN\x10\x1f\x12\x1a\n" +
+	"\x16REASON_NOT_CHECKED_OUT\x10 \x12\x1c\n" +
+	"\x18REASON_NO_FLOATING_SEATS\x10!\x12\x1f\n" +
+	"\x1bREASON_INSUFFICIENT_CREDITS\x10\"*\xa0\x01\n" +
+	"\rLicenseStatus\x12\x1e\n" +
+	"\x1aLICENSE_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15LICENSE_STATUS_ACTIVE\x10\x01\x12\x1c\n" +
+	"\x18LICENSE_STATUS_SUSPENDED\x10\x02\x12\x1a\n" +
+	"\x16LICENSE_STATUS_EXPIRED\x10\x03\x12\x1a\n" +
+	"\x16LICENSE_STATUS_REVOKED\x10\x042\x93U\n" +
+	"\x10WhitelistService\x12c\n" +
+	"\fGetAuthToken\x12\x1a.whitelist.GetTokenRequest\x1a\x1c.whitelist.AuthTokenResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/token\x12k\n" +
+	"\x0fValidateLicense\x12\x1a.whitelist.ValidateRequest\x1a\x1b.whitelist.ValidateResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/license/validate\x12`\n" +
+	"\rUpdateLicense\x12\x1f.whitelist.UpdateLicenseRequest\x1a\x16.google.protobuf.Empty\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\x1a\v/v1/license\x12k\n" +
+	"\rDeleteLicense\x12\x1f.whitelist.DeleteLicenseRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b*\x19/v1/license/{license_key}\x12a\n" +
+	"\n" +
+	"GetLicense\x12\x1c.whitelist.GetLicenseRequest\x1a\x12.whitelist.License\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/license/{license_key}\x12e\n" +
+	"\fListLicenses\x12\x1e.whitelist.ListLicensesRequest\x1a\x1f.whitelist.ListLicensesResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/licenses\x12q\n" +
+	"\tResetHwid\x12\x1b.whitelist.ResetHwidRequest\x1a\x16.google.protobuf.Empty\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/license/{license_key}/reset-hwid\x12}\n" +
+	"\x10GenerateLicenses\x12\".whitelist.GenerateLicensesRequest\x1a#.whitelist.GenerateLicensesResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/licenses/generate\x12}\n" +
+	"\x13BatchUpsertLicenses\x12%.whitelist.BatchUpsertLicensesRequest\x1a&.whitelist.BatchUpsertLicensesResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\x1a\f/v1/licenses\x12g\n" +
+	"\x0eExportLicenses\x12 .whitelist.ExportLicensesRequest\x1a\x14.google.api.HttpBody\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/licenses/export0\x01\x12u\n" +
+	"\x0eImportLicenses\x12 .whitelist.ImportLicensesRequest\x1a!.whitelist.ImportLicensesResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/licenses/import\x12k\n" +
+	"\x0fListAuditEvents\x12!.whitelist.ListAuditEventsRequest\x1a\".whitelist.ListAuditEventsResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/audit\x12\x9b\x01\n" +
+	"\x17ResellerGenerateLicense\x12).whitelist.ResellerGenerateLicenseRequest\x1a*.whitelist.ResellerGenerateLicenseResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/reseller/licenses/generate\x12o\n" +
+	"\x0eCreateReseller\x12 .whitelist.CreateResellerRequest\x1a!.whitelist.CreateResellerResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/resellers\x12\x83\x01\n" +
+	"\x14TopUpResellerCredits\x12&.whitelist.TopUpResellerCreditsRequest\x1a\x13.whitelist.Reseller\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/resellers/{reseller_id}/credits\x12\x95\x01\n" +
+	"\x14ListResellerActivity\x12&.whitelist.ListResellerActivityRequest\x1a'.whitelist.ListResellerActivityResponse\",\x82\xd3\xe4\x93\x02&\x12$/v1/resellers/{reseller_id}/activity\x12e\n" +
+	"\n" +
+	"AdminLogin\x12\x1c.whitelist.AdminLoginRequest\x1a\x1d.whitelist.AdminLoginResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/admin/login\x12U\n" +
+	"\vCreateAdmin\x12\x1d.whitelist.CreateAdminRequest\x1a\x10.whitelist.Admin\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/admins\x12`\n" +
+	"\vUpdateAdmin\x12\x1d.whitelist.UpdateAdminRequest\x1a\x10.whitelist.Admin\" \x82\xd3\xe4\x93\x02\x1a:\x01*2\x15/v1/admins/{username}\x12]\n" +
+	"\n" +
+	"ListAdmins\x12\x1c.whitelist.ListAdminsRequest\x1a\x1d.whitelist.ListAdminsResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/admins\x12Z\n" +
+	"\vAdminLogout\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/admin/logout\x12z\n" +
+	"\x11ListAdminSessions\x12#.whitelist.ListAdminSessionsRequest\x1a$.whitelist.ListAdminSessionsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/admin/sessions\x12{\n" +
+	"\x12RevokeAdminSession\x12$.whitelist.RevokeAdminSessionRequest\x1a\x16.google.protobuf.Empty\"'\x82\xd3\xe4\x93\x02!*\x1f/v1/admin/sessions/{session_id}\x12\x89\x01\n" +
+	"\x11ExportLicenseFile\x12#.whitelist.ExportLicenseFileRequest\x1a$.whitelist.ExportLicenseFileResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/license/{license_key}/file\x12h\n" +
+	"\fStartSession\x12\x1e.whitelist.StartSessionRequest\x1a\x1f.whitelist.StartSessionResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/sessions\x12i\n" +
+	"\tHeartbeat\x12\x1b.whitelist.HeartbeatRequest\x1a\x1c.whitelist.HeartbeatResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/sessions/heartbeat\x12_\n" +
+	"\n" +
+	"EndSession\x12\x1c.whitelist.EndSessionRequest\x1a\x16.google.protobuf.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/sessions/end\x12x\n" +
+	"\fWatchLicense\x12\x1e.whitelist.WatchLicenseRequest\x1a\x1d.whitelist.LicenseStatusEvent\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/license/{license_key}/watch0\x01\x12\x82\x01\n" +
+	"\x10ValidateLicenses\x12\".whitelist.ValidateLicensesRequest\x1a#.whitelist.ValidateLicensesResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/license/validate-batch\x12]\n" +
+	"\rCreateProduct\x12\x1f.whitelist.CreateProductRequest\x1a\x12.whitelist.Product\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/products\x12j\n" +
+	"\rUpdateProduct\x12\x1f.whitelist.UpdateProductRequest\x1a\x12.whitelist.Product\"$\x82\xd3\xe4\x93\x02\x1e:\x01*2\x19/v1/products/{product_id}\x12e\n" +
+	"\fListProducts\x12\x1e.whitelist.ListProductsRequest\x1a\x1f.whitelist.ListProductsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/products\x12k\n" +
+	"\rDeleteProduct\x12\x1f.whitelist.DeleteProductRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b*\x19/v1/products/{product_id}\x12t\n" +
+	"\x10GetLatestVersion\x12\".whitelist.GetLatestVersionRequest\x1a\x12.whitelist.Release\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/products/{product_id}/latest\x12\x7f\n" +
+	"\x0ePublishRelease\x12 .whitelist.PublishReleaseRequest\x1a\x12.whitelist.Release\"7\x82\xd3\xe4\x93\x021:\x01*\x1a,/v1/products/{product_id}/releases/{channel}\x12z\n" +
+	"\x11SetLicenseChannel\x12#.whitelist.SetLicenseChannelRequest\x1a\x12.whitelist.License\",\x82\xd3\xe4\x93\x02&:\x01*\x1a!/v1/license/{license_key}/channel\x12a\n" +
+	"\x0eCreateCustomer\x12 .whitelist.CreateCustomerRequest\x1a\x13.whitelist.Customer\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/customers\x12i\n" +
+	"\rListCustomers\x12\x1f.whitelist.ListCustomersRequest\x1a .whitelist.ListCustomersResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/customers\x12u\n" +
+	"\rAttachLicense\x12\x1f.whitelist.AttachLicenseRequest\x1a\x12.whitelist.License\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/customers/{customer_id}/licenses\x12\x80\x01\n" +
+	"\rDetachLicense\x12\x1f.whitelist.DetachLicenseRequest\x1a\x12.whitelist.License\":\x82\xd3\xe4\x93\x024*2/v1/customers/{customer_id}/licenses/{license_key}\x12\x83\x01\n" +
+	"\x13IssueLicenseToEmail\x12%.whitelist.IssueLicenseToEmailRequest\x1a&.whitelist.IssueLicenseToEmailResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/licenses/email\x12\x98\x01\n" +
+	"\x15ListLicenseDeliveries\x12'.whitelist.ListLicenseDeliveriesRequest\x1a(.whitelist.ListLicenseDeliveriesResponse\",\x82\xd3\xe4\x93\x02&\x12$/v1/license/{license_key}/deliveries\x12\x8d\x01\n" +
+	"\x12CreateTrialLicense\x12$.whitelist.CreateTrialLicenseRequest\x1a%.whitelist.CreateTrialLicenseResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/products/{product_id}/trial\x12q\n" +
+	"\rExtendLicense\x12\x1f.whitelist.ExtendLicenseRequest\x1a\x12.whitelist.License\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/license/{license_key}/extend\x12\x99\x01\n" +
+	"\x15ResellerExtendLicense\x12\x1f.whitelist.ExtendLicenseRequest\x1a(.whitelist.ResellerExtendLicenseResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/v1/reseller/licenses/{license_key}/extend\x12t\n" +
+	"\x0eSuspendLicense\x12 .whitelist.SuspendLicenseRequest\x1a\x12.whitelist.License\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/license/{license_key}/suspend\x12z\n" +
+	"\x10UnsuspendLicense\x12\".whitelist.UnsuspendLicenseRequest\x1a\x12.whitelist.License\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/license/{license_key}/unsuspend\x12R\n" +
+	"\aBanHwid\x12\x19.whitelist.BanHwidRequest\x1a\x12.whitelist.HwidBan\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/hwid-bans\x12^\n" +
+	"\tUnbanHwid\x12\x1b.whitelist.UnbanHwidRequest\x1a\x16.google.protobuf.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/v1/hwid-bans/{hwid}\x12f\n" +
+	"\fListHwidBans\x12\x1e.whitelist.ListHwidBansRequest\x1a\x1f.whitelist.ListHwidBansResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/hwid-bans\x12J\n" +
+	"\x05BanIp\x12\x17.whitelist.BanIpRequest\x1a\x10.whitelist.IpBan\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/ip-bans\x12Q\n" +
+	"\aUnbanIp\x12\x19.whitelist.UnbanIpRequest\x1a\x16.google.protobuf.Empty\"\x13\x82\xd3\xe4\x93\x02\r*\v/v1/ip-bans\x12^\n" +
+	"\n" +
+	"ListIpBans\x12\x1c.whitelist.ListIpBansRequest\x1a\x1d.whitelist.ListIpBansResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/ip-bans\x12\x80\x01\n" +
+	"\x13SetLicenseCountries\x12%.whitelist.SetLicenseCountriesRequest\x1a\x12.whitelist.License\".\x82\xd3\xe4\x93\x02(:\x01*\x1a#/v1/license/{license_key}/countries\x12h\n" +
+	"\rClearLockouts\x12\x1f.whitelist.ClearLockoutsRequest\x1a .whitelist.ClearLockoutsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e*\f/v1/lockouts\x12\xae\x01\n" +
+	"\x1aRotateProductSigningSecret\x12,.whitelist.RotateProductSigningSecretRequest\x1a-.whitelist.RotateProductSigningSecretResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/products/{product_id}/signing-secret\x12\x90\x01\n" +
+	"\x1aRemoveProductSigningSecret\x12,.whitelist.RemoveProductSigningSecretRequest\x1a\x12.whitelist.Product\"0\x82\xd3\xe4\x93\x02**(/v1/products/{product_id}/signing-secret\x12f\n" +
+	"\fGetChallenge\x12\x1e.whitelist.GetChallengeRequest\x1a\x1f.whitelist.GetChallengeResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/challenge\x12\x8f\x01\n" +
+	"\x13RevokeRefreshTokens\x12%.whitelist.RevokeRefreshTokensRequest\x1a&.whitelist.RevokeRefreshTokensResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/auth/refresh-tokens/revoke\x12\x82\x01\n" +
+	"\x11RotateAdminSecret\x12#.whitelist.RotateAdminSecretRequest\x1a$.whitelist.RotateAdminSecretResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/admin/secret/rotate\x12s\n" +
+	"\x0fEnrollAdminTotp\x12!.whitelist.EnrollAdminTotpRequest\x1a\".whitelist.EnrollAdminTotpResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/admin/totp\x12d\n" +
+	"\x0eExportAuditLog\x12 .whitelist.ExportAuditLogRequest\x1a\x14.google.api.HttpBody\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/audit/export0\x01\x12V\n" +
+	"\bGetStats\x12\x1a.whitelist.GetStatsRequest\x1a\x1b.whitelist.GetStatsResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/stats\x12\x80\x01\n" +
+	"\x14ListValidationEvents\x12&.whitelist.ListValidationEventsRequest\x1a'.whitelist.ListValidationEventsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/validations\x12r\n" +
+	"\x0eSearchLicenses\x12 .whitelist.SearchLicensesRequest\x1a!.whitelist.SearchLicensesResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/licenses/search\x12q\n" +
+	"\x0eRestoreLicense\x12 .whitelist.RestoreLicenseRequest\x1a\x12.whitelist.License\")\x82\xd3\xe4\x93\x02#\"!/v1/license/{license_key}/restore\x12o\n" +
+	"\fPurgeLicense\x12\x1e.whitelist.PurgeLicenseRequest\x1a\x16.google.protobuf.Empty\"'\x82\xd3\xe4\x93\x02!*\x1f/v1/license/{license_key}/purge\x12\x89\x01\n" +
+	"\x11GetLicenseHistory\x12#.whitelist.GetLicenseHistoryRequest\x1a$.whitelist.GetLicenseHistoryResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/license/{license_key}/history\x12\x8e\x01\n" +
+	"\x16ImportExternalLicenses\x12(.whitelist.ImportExternalLicensesRequest\x1a!.whitelist.ImportLicensesResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/licenses/import/external\x12\x97\x01\n" +
+	"\x14BulkSuspendByProduct\x12&.whitelist.BulkSuspendByProductRequest\x1a .whitelist.BulkOperationResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/v1/products/{product_id}/licenses/suspend\x12\x94\x01\n" +
+	"\x13BulkDeleteByProduct\x12%.whitelist.BulkDeleteByProductRequest\x1a .whitelist.BulkOperationResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v1/products/{product_id}/licenses/delete\x12\x94\x01\n" +
+	"\x13BulkExtendByProduct\x12%.whitelist.BulkExtendByProductRequest\x1a .whitelist.BulkOperationResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v1/products/{product_id}/licenses/extend\x12x\n" +
+	"\x12SetMaintenanceMode\x12$.whitelist.SetMaintenanceModeRequest\x1a\x1a.whitelist.MaintenanceMode\" \x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/v1/admin/maintenance\x12g\n" +
+	"\x12GetMaintenanceMode\x12\x16.google.protobuf.Empty\x1a\x1a.whitelist.MaintenanceMode\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/admin/maintenance\x12\x85\x01\n" +
+	"\x12SetProductMessages\x12$.whitelist.SetProductMessagesRequest\x1a\x1a.whitelist.ProductMessages\"-\x82\xd3\xe4\x93\x02':\x01*\x1a\"/v1/products/{product_id}/messages\x12\x82\x01\n" +
+	"\x12GetProductMessages\x12$.whitelist.GetProductMessagesRequest\x1a\x1a.whitelist.ProductMessages\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/products/{product_id}/messages\x12\x80\x01\n" +
+	"\rListMyDevices\x12\x1f.whitelist.ListMyDevicesRequest\x1a .whitelist.ListMyDevicesResponse\",\x82\xd3\xe4\x93\x02&\x12$/v1/license/{license_key}/my-devices\x12\x8a\x01\n" +
+	"\x10DeactivateDevice\x12\".whitelist.DeactivateDeviceRequest\x1a\x16.google.protobuf.Empty\":\x82\xd3\xe4\x93\x024:\x01*\"//v1/license/{license_key}/my-devices/deactivate\x12\x8d\x01\n" +
+	"\x17SetLicenseFloatingSeats\x12).whitelist.SetLicenseFloatingSeatsRequest\x1a\x12.whitelist.License\"3\x82\xd3\xe4\x93\x02-:\x01*\x1a(/v1/license/{license_key}/floating-seats\x12o\n" +
+	"\x0fCheckoutLicense\x12!.whitelist.CheckoutLicenseRequest\x1a\".whitelist.CheckoutLicenseResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/leases\x12i\n" +
+	"\x0eCheckinLicense\x12 .whitelist.CheckinLicenseRequest\x1a\x16.google.protobuf.Empty\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/leases/checkin\x12\x8b\x01\n" +
+	"\x0eConsumeCredits\x12 .whitelist.ConsumeCreditsRequest\x1a!.whitelist.ConsumeCreditsResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v1/license/{license_key}/credits/consume\x12~\n" +
+	"\x13TopUpLicenseCredits\x12%.whitelist.TopUpLicenseCreditsRequest\x1a\x12.whitelist.License\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/license/{license_key}/credits\x12\xaa\x01\n" +
+	"\x19ListLicenseCreditActivity\x12+.whitelist.ListLicenseCreditActivityRequest\x1a,.whitelist.ListLicenseCreditActivityResponse\"2\x82\xd3\xe4\x93\x02,\x12*/v1/license/{license_key}/credits/activity\x12}\n" +
+	"\x12SetLicenseFeatures\x12$.whitelist.SetLicenseFeaturesRequest\x1a\x12.whitelist.License\"-\x82\xd3\xe4\x93\x02':\x01*\x1a\"/v1/license/{license_key}/features\x12Q\n" +
+	"\n" +
+	"CreatePlan\x12\x1c.whitelist.CreatePlanRequest\x1a\x0f.whitelist.Plan\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/plans\x12X\n" +
+	"\n" +
+	"UpdatePlan\x12\x1c.whitelist.UpdatePlanRequest\x1a\x0f.whitelist.Plan\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*2\x10/v1/plans/{name}\x12Y\n" +
+	"\tListPlans\x12\x1b.whitelist.ListPlansRequest\x1a\x1c.whitelist.ListPlansResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/plans\x12\\\n" +
+	"\n" +
+	"DeletePlan\x12\x1c.whitelist.DeletePlanRequest\x1a\x16.google.protobuf.Empty\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/plans/{name}\x12d\n" +
+	"\rGetServerTime\x12\x1f.whitelist.GetServerTimeRequest\x1a .whitelist.GetServerTimeResponse\"\x10\x82\xd3\xe4\x93\x02\n" +
+	"\x12\b/v1/time\x12g\n" +
+	"\fGetPublicKey\x12\x1e.whitelist.GetPublicKeyRequest\x1a\x1f.whitelist.GetPublicKeyResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/public-key\x12o\n" +
+	"\x0eGetSigningKeys\x12 .whitelist.GetSigningKeysRequest\x1a!.whitelist.GetSigningKeysResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/signing-keysB-Z+github.com/mkseven15/whitelist-server/protob\x06proto3"
 
 var (
 	file_proto_whitelist_proto_rawDescOnce sync.Once
@@ -335,29 +12156,487 @@ func file_proto_whitelist_proto_rawDescGZIP() []byte {
 	return file_proto_whitelist_proto_rawDescData
 }
 
-var file_proto_whitelist_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_whitelist_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_whitelist_proto_msgTypes = make([]protoimpl.MessageInfo, 170)
 var file_proto_whitelist_proto_goTypes = []any{
-	(*AuthTokenResponse)(nil),    // 0: whitelist.AuthTokenResponse
-	(*ValidateRequest)(nil),      // 1: whitelist.ValidateRequest
-	(*ValidateResponse)(nil),     // 2: whitelist.ValidateResponse
-	(*UpdateLicenseRequest)(nil), // 3: whitelist.UpdateLicenseRequest
-	(*DeleteLicenseRequest)(nil), // 4: whitelist.DeleteLicenseRequest
-	(*emptypb.Empty)(nil),        // 5: google.protobuf.Empty
+	(Reason)(0),                                // 0: whitelist.Reason
+	(LicenseStatus)(0),                         // 1: whitelist.LicenseStatus
+	(*GetTokenRequest)(nil),                    // 2: whitelist.GetTokenRequest
+	(*AuthTokenResponse)(nil),                  // 3: whitelist.AuthTokenResponse
+	(*ValidateRequest)(nil),                    // 4: whitelist.ValidateRequest
+	(*HwidComponents)(nil),                     // 5: whitelist.HwidComponents
+	(*ValidateResponse)(nil),                   // 6: whitelist.ValidateResponse
+	(*UpdateLicenseRequest)(nil),               // 7: whitelist.UpdateLicenseRequest
+	(*DeleteLicenseRequest)(nil),               // 8: whitelist.DeleteLicenseRequest
+	(*License)(nil),                            // 9: whitelist.License
+	(*GetLicenseRequest)(nil),                  // 10: whitelist.GetLicenseRequest
+	(*ListLicensesRequest)(nil),                // 11: whitelist.ListLicensesRequest
+	(*ListLicensesResponse)(nil),               // 12: whitelist.ListLicensesResponse
+	(*ResetHwidRequest)(nil),                   // 13: whitelist.ResetHwidRequest
+	(*GenerateLicensesRequest)(nil),            // 14: whitelist.GenerateLicensesRequest
+	(*GenerateLicensesResponse)(nil),           // 15: whitelist.GenerateLicensesResponse
+	(*BatchUpsertLicensesRequest)(nil),         // 16: whitelist.BatchUpsertLicensesRequest
+	(*BatchUpsertLicensesResponse)(nil),        // 17: whitelist.BatchUpsertLicensesResponse
+	(*ExportLicensesRequest)(nil),              // 18: whitelist.ExportLicensesRequest
+	(*ImportLicensesRequest)(nil),              // 19: whitelist.ImportLicensesRequest
+	(*ImportLicensesResponse)(nil),             // 20: whitelist.ImportLicensesResponse
+	(*ImportLicenseRow)(nil),                   // 21: whitelist.ImportLicenseRow
+	(*AuditEvent)(nil),                         // 22: whitelist.AuditEvent
+	(*ListAuditEventsRequest)(nil),             // 23: whitelist.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),            // 24: whitelist.ListAuditEventsResponse
+	(*ResellerGenerateLicenseRequest)(nil),     // 25: whitelist.ResellerGenerateLicenseRequest
+	(*ResellerGenerateLicenseResponse)(nil),    // 26: whitelist.ResellerGenerateLicenseResponse
+	(*Reseller)(nil),                           // 27: whitelist.Reseller
+	(*CreateResellerRequest)(nil),              // 28: whitelist.CreateResellerRequest
+	(*CreateResellerResponse)(nil),             // 29: whitelist.CreateResellerResponse
+	(*TopUpResellerCreditsRequest)(nil),        // 30: whitelist.TopUpResellerCreditsRequest
+	(*ResellerActivity)(nil),                   // 31: whitelist.ResellerActivity
+	(*ListResellerActivityRequest)(nil),        // 32: whitelist.ListResellerActivityRequest
+	(*ListResellerActivityResponse)(nil),       // 33: whitelist.ListResellerActivityResponse
+	(*AdminLoginRequest)(nil),                  // 34: whitelist.AdminLoginRequest
+	(*AdminLoginResponse)(nil),                 // 35: whitelist.AdminLoginResponse
+	(*Admin)(nil),                              // 36: whitelist.Admin
+	(*CreateAdminRequest)(nil),                 // 37: whitelist.CreateAdminRequest
+	(*UpdateAdminRequest)(nil),                 // 38: whitelist.UpdateAdminRequest
+	(*ListAdminsRequest)(nil),                  // 39: whitelist.ListAdminsRequest
+	(*ListAdminsResponse)(nil),                 // 40: whitelist.ListAdminsResponse
+	(*AdminSession)(nil),                       // 41: whitelist.AdminSession
+	(*ListAdminSessionsRequest)(nil),           // 42: whitelist.ListAdminSessionsRequest
+	(*ListAdminSessionsResponse)(nil),          // 43: whitelist.ListAdminSessionsResponse
+	(*RevokeAdminSessionRequest)(nil),          // 44: whitelist.RevokeAdminSessionRequest
+	(*ExportLicenseFileRequest)(nil),           // 45: whitelist.ExportLicenseFileRequest
+	(*ExportLicenseFileResponse)(nil),          // 46: whitelist.ExportLicenseFileResponse
+	(*StartSessionRequest)(nil),                // 47: whitelist.StartSessionRequest
+	(*StartSessionResponse)(nil),               // 48: whitelist.StartSessionResponse
+	(*HeartbeatRequest)(nil),                   // 49: whitelist.HeartbeatRequest
+	(*HeartbeatResponse)(nil),                  // 50: whitelist.HeartbeatResponse
+	(*EndSessionRequest)(nil),                  // 51: whitelist.EndSessionRequest
+	(*WatchLicenseRequest)(nil),                // 52: whitelist.WatchLicenseRequest
+	(*LicenseStatusEvent)(nil),                 // 53: whitelist.LicenseStatusEvent
+	(*ValidateLicensesRequest)(nil),            // 54: whitelist.ValidateLicensesRequest
+	(*ValidateLicensesResponse)(nil),           // 55: whitelist.ValidateLicensesResponse
+	(*Product)(nil),                            // 56: whitelist.Product
+	(*CreateProductRequest)(nil),               // 57: whitelist.CreateProductRequest
+	(*UpdateProductRequest)(nil),               // 58: whitelist.UpdateProductRequest
+	(*CountryList)(nil),                        // 59: whitelist.CountryList
+	(*FeatureFlags)(nil),                       // 60: whitelist.FeatureFlags
+	(*ListProductsRequest)(nil),                // 61: whitelist.ListProductsRequest
+	(*ListProductsResponse)(nil),               // 62: whitelist.ListProductsResponse
+	(*DeleteProductRequest)(nil),               // 63: whitelist.DeleteProductRequest
+	(*Plan)(nil),                               // 64: whitelist.Plan
+	(*CreatePlanRequest)(nil),                  // 65: whitelist.CreatePlanRequest
+	(*UpdatePlanRequest)(nil),                  // 66: whitelist.UpdatePlanRequest
+	(*ListPlansRequest)(nil),                   // 67: whitelist.ListPlansRequest
+	(*ListPlansResponse)(nil),                  // 68: whitelist.ListPlansResponse
+	(*DeletePlanRequest)(nil),                  // 69: whitelist.DeletePlanRequest
+	(*Release)(nil),                            // 70: whitelist.Release
+	(*GetLatestVersionRequest)(nil),            // 71: whitelist.GetLatestVersionRequest
+	(*PublishReleaseRequest)(nil),              // 72: whitelist.PublishReleaseRequest
+	(*SetLicenseChannelRequest)(nil),           // 73: whitelist.SetLicenseChannelRequest
+	(*Customer)(nil),                           // 74: whitelist.Customer
+	(*CreateCustomerRequest)(nil),              // 75: whitelist.CreateCustomerRequest
+	(*ListCustomersRequest)(nil),               // 76: whitelist.ListCustomersRequest
+	(*ListCustomersResponse)(nil),              // 77: whitelist.ListCustomersResponse
+	(*AttachLicenseRequest)(nil),               // 78: whitelist.AttachLicenseRequest
+	(*DetachLicenseRequest)(nil),               // 79: whitelist.DetachLicenseRequest
+	(*IssueLicenseToEmailRequest)(nil),         // 80: whitelist.IssueLicenseToEmailRequest
+	(*LicenseDelivery)(nil),                    // 81: whitelist.LicenseDelivery
+	(*IssueLicenseToEmailResponse)(nil),        // 82: whitelist.IssueLicenseToEmailResponse
+	(*ListLicenseDeliveriesRequest)(nil),       // 83: whitelist.ListLicenseDeliveriesRequest
+	(*ListLicenseDeliveriesResponse)(nil),      // 84: whitelist.ListLicenseDeliveriesResponse
+	(*CreateTrialLicenseRequest)(nil),          // 85: whitelist.CreateTrialLicenseRequest
+	(*CreateTrialLicenseResponse)(nil),         // 86: whitelist.CreateTrialLicenseResponse
+	(*ExtendLicenseRequest)(nil),               // 87: whitelist.ExtendLicenseRequest
+	(*ResellerExtendLicenseResponse)(nil),      // 88: whitelist.ResellerExtendLicenseResponse
+	(*SuspendLicenseRequest)(nil),              // 89: whitelist.SuspendLicenseRequest
+	(*UnsuspendLicenseRequest)(nil),            // 90: whitelist.UnsuspendLicenseRequest
+	(*HwidBan)(nil),                            // 91: whitelist.HwidBan
+	(*BanHwidRequest)(nil),                     // 92: whitelist.BanHwidRequest
+	(*UnbanHwidRequest)(nil),                   // 93: whitelist.UnbanHwidRequest
+	(*ListHwidBansRequest)(nil),                // 94: whitelist.ListHwidBansRequest
+	(*ListHwidBansResponse)(nil),               // 95: whitelist.ListHwidBansResponse
+	(*IpBan)(nil),                              // 96: whitelist.IpBan
+	(*BanIpRequest)(nil),                       // 97: whitelist.BanIpRequest
+	(*UnbanIpRequest)(nil),                     // 98: whitelist.UnbanIpRequest
+	(*ListIpBansRequest)(nil),                  // 99: whitelist.ListIpBansRequest
+	(*ListIpBansResponse)(nil),                 // 100: whitelist.ListIpBansResponse
+	(*SetLicenseCountriesRequest)(nil),         // 101: whitelist.SetLicenseCountriesRequest
+	(*Lockout)(nil),                            // 102: whitelist.Lockout
+	(*ClearLockoutsRequest)(nil),               // 103: whitelist.ClearLockoutsRequest
+	(*ClearLockoutsResponse)(nil),              // 104: whitelist.ClearLockoutsResponse
+	(*RotateProductSigningSecretRequest)(nil),  // 105: whitelist.RotateProductSigningSecretRequest
+	(*RotateProductSigningSecretResponse)(nil), // 106: whitelist.RotateProductSigningSecretResponse
+	(*RemoveProductSigningSecretRequest)(nil),  // 107: whitelist.RemoveProductSigningSecretRequest
+	(*GetChallengeRequest)(nil),                // 108: whitelist.GetChallengeRequest
+	(*GetChallengeResponse)(nil),               // 109: whitelist.GetChallengeResponse
+	(*GetServerTimeRequest)(nil),               // 110: whitelist.GetServerTimeRequest
+	(*GetServerTimeResponse)(nil),              // 111: whitelist.GetServerTimeResponse
+	(*GetPublicKeyRequest)(nil),                // 112: whitelist.GetPublicKeyRequest
+	(*GetPublicKeyResponse)(nil),               // 113: whitelist.GetPublicKeyResponse
+	(*GetSigningKeysRequest)(nil),              // 114: whitelist.GetSigningKeysRequest
+	(*SigningKey)(nil),                         // 115: whitelist.SigningKey
+	(*GetSigningKeysResponse)(nil),             // 116: whitelist.GetSigningKeysResponse
+	(*RevokeRefreshTokensRequest)(nil),         // 117: whitelist.RevokeRefreshTokensRequest
+	(*RevokeRefreshTokensResponse)(nil),        // 118: whitelist.RevokeRefreshTokensResponse
+	(*RotateAdminSecretRequest)(nil),           // 119: whitelist.RotateAdminSecretRequest
+	(*RotateAdminSecretResponse)(nil),          // 120: whitelist.RotateAdminSecretResponse
+	(*EnrollAdminTotpRequest)(nil),             // 121: whitelist.EnrollAdminTotpRequest
+	(*EnrollAdminTotpResponse)(nil),            // 122: whitelist.EnrollAdminTotpResponse
+	(*ExportAuditLogRequest)(nil),              // 123: whitelist.ExportAuditLogRequest
+	(*GetStatsRequest)(nil),                    // 124: whitelist.GetStatsRequest
+	(*DailyStats)(nil),                         // 125: whitelist.DailyStats
+	(*FailureReason)(nil),                      // 126: whitelist.FailureReason
+	(*GetStatsResponse)(nil),                   // 127: whitelist.GetStatsResponse
+	(*ValidationEvent)(nil),                    // 128: whitelist.ValidationEvent
+	(*ListValidationEventsRequest)(nil),        // 129: whitelist.ListValidationEventsRequest
+	(*ListValidationEventsResponse)(nil),       // 130: whitelist.ListValidationEventsResponse
+	(*SearchLicensesRequest)(nil),              // 131: whitelist.SearchLicensesRequest
+	(*SearchLicensesResponse)(nil),             // 132: whitelist.SearchLicensesResponse
+	(*RestoreLicenseRequest)(nil),              // 133: whitelist.RestoreLicenseRequest
+	(*PurgeLicenseRequest)(nil),                // 134: whitelist.PurgeLicenseRequest
+	(*GetLicenseHistoryRequest)(nil),           // 135: whitelist.GetLicenseHistoryRequest
+	(*GetLicenseHistoryResponse)(nil),          // 136: whitelist.GetLicenseHistoryResponse
+	(*LicenseRevision)(nil),                    // 137: whitelist.LicenseRevision
+	(*ImportExternalLicensesRequest)(nil),      // 138: whitelist.ImportExternalLicensesRequest
+	(*BulkSuspendByProductRequest)(nil),        // 139: whitelist.BulkSuspendByProductRequest
+	(*BulkDeleteByProductRequest)(nil),         // 140: whitelist.BulkDeleteByProductRequest
+	(*BulkExtendByProductRequest)(nil),         // 141: whitelist.BulkExtendByProductRequest
+	(*BulkOperationResponse)(nil),              // 142: whitelist.BulkOperationResponse
+	(*SetMaintenanceModeRequest)(nil),          // 143: whitelist.SetMaintenanceModeRequest
+	(*MaintenanceMode)(nil),                    // 144: whitelist.MaintenanceMode
+	(*ProductMessage)(nil),                     // 145: whitelist.ProductMessage
+	(*SetProductMessagesRequest)(nil),          // 146: whitelist.SetProductMessagesRequest
+	(*GetProductMessagesRequest)(nil),          // 147: whitelist.GetProductMessagesRequest
+	(*ProductMessages)(nil),                    // 148: whitelist.ProductMessages
+	(*ListMyDevicesRequest)(nil),               // 149: whitelist.ListMyDevicesRequest
+	(*MyDevice)(nil),                           // 150: whitelist.MyDevice
+	(*ListMyDevicesResponse)(nil),              // 151: whitelist.ListMyDevicesResponse
+	(*DeactivateDeviceRequest)(nil),            // 152: whitelist.DeactivateDeviceRequest
+	(*SetLicenseFloatingSeatsRequest)(nil),     // 153: whitelist.SetLicenseFloatingSeatsRequest
+	(*CheckoutLicenseRequest)(nil),             // 154: whitelist.CheckoutLicenseRequest
+	(*CheckoutLicenseResponse)(nil),            // 155: whitelist.CheckoutLicenseResponse
+	(*CheckinLicenseRequest)(nil),              // 156: whitelist.CheckinLicenseRequest
+	(*ConsumeCreditsRequest)(nil),              // 157: whitelist.ConsumeCreditsRequest
+	(*ConsumeCreditsResponse)(nil),             // 158: whitelist.ConsumeCreditsResponse
+	(*TopUpLicenseCreditsRequest)(nil),         // 159: whitelist.TopUpLicenseCreditsRequest
+	(*LicenseCreditActivity)(nil),              // 160: whitelist.LicenseCreditActivity
+	(*ListLicenseCreditActivityRequest)(nil),   // 161: whitelist.ListLicenseCreditActivityRequest
+	(*ListLicenseCreditActivityResponse)(nil),  // 162: whitelist.ListLicenseCreditActivityResponse
+	(*SetLicenseFeaturesRequest)(nil),          // 163: whitelist.SetLicenseFeaturesRequest
+	nil,                                        // 164: whitelist.ValidateResponse.FeaturesEntry
+	nil,                                        // 165: whitelist.License.FeaturesEntry
+	nil,                                        // 166: whitelist.Product.FeaturesEntry
+	nil,                                        // 167: whitelist.CreateProductRequest.FeaturesEntry
+	nil,                                        // 168: whitelist.FeatureFlags.FlagsEntry
+	nil,                                        // 169: whitelist.Plan.FeaturesEntry
+	nil,                                        // 170: whitelist.CreatePlanRequest.FeaturesEntry
+	nil,                                        // 171: whitelist.SetLicenseFeaturesRequest.FeaturesEntry
+	(*timestamppb.Timestamp)(nil),              // 172: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                    // 173: google.protobuf.Struct
+	(*fieldmaskpb.FieldMask)(nil),              // 174: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                      // 175: google.protobuf.Empty
+	(*httpbody.HttpBody)(nil),                  // 176: google.api.HttpBody
 }
 var file_proto_whitelist_proto_depIdxs = []int32{
-	5, // 0: whitelist.WhitelistService.GetAuthToken:input_type -> google.protobuf.Empty
-	1, // 1: whitelist.WhitelistService.ValidateLicense:input_type -> whitelist.ValidateRequest
-	3, // 2: whitelist.WhitelistService.UpdateLicense:input_type -> whitelist.UpdateLicenseRequest
-	4, // 3: whitelist.WhitelistService.DeleteLicense:input_type -> whitelist.DeleteLicenseRequest
-	0, // 4: whitelist.WhitelistService.GetAuthToken:output_type -> whitelist.AuthTokenResponse
-	2, // 5: whitelist.WhitelistService.ValidateLicense:output_type -> whitelist.ValidateResponse
-	5, // 6: whitelist.WhitelistService.UpdateLicense:output_type -> google.protobuf.Empty
-	5, // 7: whitelist.WhitelistService.DeleteLicense:output_type -> google.protobuf.Empty
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	5,   // 0: whitelist.ValidateRequest.hwid_components:type_name -> whitelist.HwidComponents
+	172, // 1: whitelist.ValidateRequest.client_time:type_name -> google.protobuf.Timestamp
+	173, // 2: whitelist.ValidateResponse.metadata:type_name -> google.protobuf.Struct
+	0,   // 3: whitelist.ValidateResponse.reason:type_name -> whitelist.Reason
+	164, // 4: whitelist.ValidateResponse.features:type_name -> whitelist.ValidateResponse.FeaturesEntry
+	172, // 5: whitelist.ValidateResponse.server_time:type_name -> google.protobuf.Timestamp
+	172, // 6: whitelist.UpdateLicenseRequest.expires_at:type_name -> google.protobuf.Timestamp
+	173, // 7: whitelist.UpdateLicenseRequest.metadata:type_name -> google.protobuf.Struct
+	174, // 8: whitelist.UpdateLicenseRequest.update_mask:type_name -> google.protobuf.FieldMask
+	172, // 9: whitelist.License.expires_at:type_name -> google.protobuf.Timestamp
+	172, // 10: whitelist.License.created_at:type_name -> google.protobuf.Timestamp
+	172, // 11: whitelist.License.last_validated_at:type_name -> google.protobuf.Timestamp
+	173, // 12: whitelist.License.metadata:type_name -> google.protobuf.Struct
+	172, // 13: whitelist.License.deleted_at:type_name -> google.protobuf.Timestamp
+	172, // 14: whitelist.License.hwid_rebound_at:type_name -> google.protobuf.Timestamp
+	165, // 15: whitelist.License.features:type_name -> whitelist.License.FeaturesEntry
+	9,   // 16: whitelist.ListLicensesResponse.licenses:type_name -> whitelist.License
+	172, // 17: whitelist.GenerateLicensesRequest.expires_at:type_name -> google.protobuf.Timestamp
+	7,   // 18: whitelist.BatchUpsertLicensesRequest.licenses:type_name -> whitelist.UpdateLicenseRequest
+	21,  // 19: whitelist.ImportLicensesResponse.rows:type_name -> whitelist.ImportLicenseRow
+	172, // 20: whitelist.AuditEvent.created_at:type_name -> google.protobuf.Timestamp
+	173, // 21: whitelist.AuditEvent.old_value:type_name -> google.protobuf.Struct
+	173, // 22: whitelist.AuditEvent.new_value:type_name -> google.protobuf.Struct
+	172, // 23: whitelist.ListAuditEventsRequest.since:type_name -> google.protobuf.Timestamp
+	172, // 24: whitelist.ListAuditEventsRequest.until:type_name -> google.protobuf.Timestamp
+	22,  // 25: whitelist.ListAuditEventsResponse.events:type_name -> whitelist.AuditEvent
+	172, // 26: whitelist.ResellerGenerateLicenseRequest.expires_at:type_name -> google.protobuf.Timestamp
+	172, // 27: whitelist.Reseller.created_at:type_name -> google.protobuf.Timestamp
+	27,  // 28: whitelist.CreateResellerResponse.reseller:type_name -> whitelist.Reseller
+	172, // 29: whitelist.ResellerActivity.created_at:type_name -> google.protobuf.Timestamp
+	31,  // 30: whitelist.ListResellerActivityResponse.activity:type_name -> whitelist.ResellerActivity
+	172, // 31: whitelist.AdminLoginResponse.expires_at:type_name -> google.protobuf.Timestamp
+	172, // 32: whitelist.Admin.created_at:type_name -> google.protobuf.Timestamp
+	172, // 33: whitelist.Admin.last_login_at:type_name -> google.protobuf.Timestamp
+	36,  // 34: whitelist.ListAdminsResponse.admins:type_name -> whitelist.Admin
+	172, // 35: whitelist.AdminSession.created_at:type_name -> google.protobuf.Timestamp
+	172, // 36: whitelist.AdminSession.expires_at:type_name -> google.protobuf.Timestamp
+	172, // 37: whitelist.AdminSession.last_seen_at:type_name -> google.protobuf.Timestamp
+	172, // 38: whitelist.AdminSession.revoked_at:type_name -> google.protobuf.Timestamp
+	41,  // 39: whitelist.ListAdminSessionsResponse.sessions:type_name -> whitelist.AdminSession
+	172, // 40: whitelist.ExportLicenseFileResponse.valid_until:type_name -> google.protobuf.Timestamp
+	0,   // 41: whitelist.StartSessionResponse.reason:type_name -> whitelist.Reason
+	0,   // 42: whitelist.HeartbeatResponse.reason:type_name -> whitelist.Reason
+	1,   // 43: whitelist.LicenseStatusEvent.status:type_name -> whitelist.LicenseStatus
+	172, // 44: whitelist.LicenseStatusEvent.expires_at:type_name -> google.protobuf.Timestamp
+	172, // 45: whitelist.LicenseStatusEvent.changed_at:type_name -> google.protobuf.Timestamp
+	0,   // 46: whitelist.LicenseStatusEvent.reason:type_name -> whitelist.Reason
+	4,   // 47: whitelist.ValidateLicensesRequest.licenses:type_name -> whitelist.ValidateRequest
+	6,   // 48: whitelist.ValidateLicensesResponse.results:type_name -> whitelist.ValidateResponse
+	172, // 49: whitelist.Product.created_at:type_name -> google.protobuf.Timestamp
+	172, // 50: whitelist.Product.updated_at:type_name -> google.protobuf.Timestamp
+	166, // 51: whitelist.Product.features:type_name -> whitelist.Product.FeaturesEntry
+	167, // 52: whitelist.CreateProductRequest.features:type_name -> whitelist.CreateProductRequest.FeaturesEntry
+	59,  // 53: whitelist.UpdateProductRequest.allowed_countries:type_name -> whitelist.CountryList
+	59,  // 54: whitelist.UpdateProductRequest.blocked_countries:type_name -> whitelist.CountryList
+	60,  // 55: whitelist.UpdateProductRequest.features:type_name -> whitelist.FeatureFlags
+	168, // 56: whitelist.FeatureFlags.flags:type_name -> whitelist.FeatureFlags.FlagsEntry
+	56,  // 57: whitelist.ListProductsResponse.products:type_name -> whitelist.Product
+	169, // 58: whitelist.Plan.features:type_name -> whitelist.Plan.FeaturesEntry
+	172, // 59: whitelist.Plan.created_at:type_name -> google.protobuf.Timestamp
+	172, // 60: whitelist.Plan.updated_at:type_name -> google.protobuf.Timestamp
+	170, // 61: whitelist.CreatePlanRequest.features:type_name -> whitelist.CreatePlanRequest.FeaturesEntry
+	60,  // 62: whitelist.UpdatePlanRequest.features:type_name -> whitelist.FeatureFlags
+	64,  // 63: whitelist.ListPlansResponse.plans:type_name -> whitelist.Plan
+	172, // 64: whitelist.Release.published_at:type_name -> google.protobuf.Timestamp
+	172, // 65: whitelist.Customer.created_at:type_name -> google.protobuf.Timestamp
+	74,  // 66: whitelist.ListCustomersResponse.customers:type_name -> whitelist.Customer
+	14,  // 67: whitelist.IssueLicenseToEmailRequest.license:type_name -> whitelist.GenerateLicensesRequest
+	172, // 68: whitelist.LicenseDelivery.created_at:type_name -> google.protobuf.Timestamp
+	172, // 69: whitelist.LicenseDelivery.sent_at:type_name -> google.protobuf.Timestamp
+	81,  // 70: whitelist.IssueLicenseToEmailResponse.delivery:type_name -> whitelist.LicenseDelivery
+	81,  // 71: whitelist.ListLicenseDeliveriesResponse.deliveries:type_name -> whitelist.LicenseDelivery
+	172, // 72: whitelist.CreateTrialLicenseResponse.expires_at:type_name -> google.protobuf.Timestamp
+	172, // 73: whitelist.ResellerExtendLicenseResponse.expires_at:type_name -> google.protobuf.Timestamp
+	172, // 74: whitelist.HwidBan.created_at:type_name -> google.protobuf.Timestamp
+	91,  // 75: whitelist.ListHwidBansResponse.bans:type_name -> whitelist.HwidBan
+	172, // 76: whitelist.IpBan.created_at:type_name -> google.protobuf.Timestamp
+	172, // 77: whitelist.IpBan.expires_at:type_name -> google.protobuf.Timestamp
+	96,  // 78: whitelist.ListIpBansResponse.bans:type_name -> whitelist.IpBan
+	172, // 79: whitelist.Lockout.locked_until:type_name -> google.protobuf.Timestamp
+	102, // 80: whitelist.ClearLockoutsResponse.cleared:type_name -> whitelist.Lockout
+	56,  // 81: whitelist.RotateProductSigningSecretResponse.product:type_name -> whitelist.Product
+	172, // 82: whitelist.GetServerTimeRequest.client_time:type_name -> google.protobuf.Timestamp
+	172, // 83: whitelist.GetServerTimeResponse.server_time:type_name -> google.protobuf.Timestamp
+	115, // 84: whitelist.GetSigningKeysResponse.keys:type_name -> whitelist.SigningKey
+	172, // 85: whitelist.RotateAdminSecretResponse.previous_expire_at:type_name -> google.protobuf.Timestamp
+	172, // 86: whitelist.ExportAuditLogRequest.since:type_name -> google.protobuf.Timestamp
+	172, // 87: whitelist.ExportAuditLogRequest.until:type_name -> google.protobuf.Timestamp
+	172, // 88: whitelist.GetStatsResponse.since:type_name -> google.protobuf.Timestamp
+	125, // 89: whitelist.GetStatsResponse.days:type_name -> whitelist.DailyStats
+	126, // 90: whitelist.GetStatsResponse.failure_reasons:type_name -> whitelist.FailureReason
+	172, // 91: whitelist.ValidationEvent.created_at:type_name -> google.protobuf.Timestamp
+	128, // 92: whitelist.ListValidationEventsResponse.events:type_name -> whitelist.ValidationEvent
+	173, // 93: whitelist.SearchLicensesRequest.metadata:type_name -> google.protobuf.Struct
+	9,   // 94: whitelist.SearchLicensesResponse.licenses:type_name -> whitelist.License
+	137, // 95: whitelist.GetLicenseHistoryResponse.revisions:type_name -> whitelist.LicenseRevision
+	172, // 96: whitelist.LicenseRevision.created_at:type_name -> google.protobuf.Timestamp
+	9,   // 97: whitelist.LicenseRevision.license:type_name -> whitelist.License
+	172, // 98: whitelist.MaintenanceMode.started_at:type_name -> google.protobuf.Timestamp
+	0,   // 99: whitelist.ProductMessage.reason:type_name -> whitelist.Reason
+	145, // 100: whitelist.SetProductMessagesRequest.messages:type_name -> whitelist.ProductMessage
+	145, // 101: whitelist.ProductMessages.messages:type_name -> whitelist.ProductMessage
+	172, // 102: whitelist.MyDevice.bound_at:type_name -> google.protobuf.Timestamp
+	150, // 103: whitelist.ListMyDevicesResponse.devices:type_name -> whitelist.MyDevice
+	172, // 104: whitelist.ListMyDevicesResponse.next_deactivation_at:type_name -> google.protobuf.Timestamp
+	0,   // 105: whitelist.CheckoutLicenseResponse.reason:type_name -> whitelist.Reason
+	0,   // 106: whitelist.ConsumeCreditsResponse.reason:type_name -> whitelist.Reason
+	172, // 107: whitelist.LicenseCreditActivity.created_at:type_name -> google.protobuf.Timestamp
+	160, // 108: whitelist.ListLicenseCreditActivityResponse.activity:type_name -> whitelist.LicenseCreditActivity
+	171, // 109: whitelist.SetLicenseFeaturesRequest.features:type_name -> whitelist.SetLicenseFeaturesRequest.FeaturesEntry
+	2,   // 110: whitelist.WhitelistService.GetAuthToken:input_type -> whitelist.GetTokenRequest
+	4,   // 111: whitelist.WhitelistService.ValidateLicense:input_type -> whitelist.ValidateRequest
+	7,   // 112: whitelist.WhitelistService.UpdateLicense:input_type -> whitelist.UpdateLicenseRequest
+	8,   // 113: whitelist.WhitelistService.DeleteLicense:input_type -> whitelist.DeleteLicenseRequest
+	10,  // 114: whitelist.WhitelistService.GetLicense:input_type -> whitelist.GetLicenseRequest
+	11,  // 115: whitelist.WhitelistService.ListLicenses:input_type -> whitelist.ListLicensesRequest
+	13,  // 116: whitelist.WhitelistService.ResetHwid:input_type -> whitelist.ResetHwidRequest
+	14,  // 117: whitelist.WhitelistService.GenerateLicenses:input_type -> whitelist.GenerateLicensesRequest
+	16,  // 118: whitelist.WhitelistService.BatchUpsertLicenses:input_type -> whitelist.BatchUpsertLicensesRequest
+	18,  // 119: whitelist.WhitelistService.ExportLicenses:input_type -> whitelist.ExportLicensesRequest
+	19,  // 120: whitelist.WhitelistService.ImportLicenses:input_type -> whitelist.ImportLicensesRequest
+	23,  // 121: whitelist.WhitelistService.ListAuditEvents:input_type -> whitelist.ListAuditEventsRequest
+	25,  // 122: whitelist.WhitelistService.ResellerGenerateLicense:input_type -> whitelist.ResellerGenerateLicenseRequest
+	28,  // 123: whitelist.WhitelistService.CreateReseller:input_type -> whitelist.CreateResellerRequest
+	30,  // 124: whitelist.WhitelistService.TopUpResellerCredits:input_type -> whitelist.TopUpResellerCreditsRequest
+	32,  // 125: whitelist.WhitelistService.ListResellerActivity:input_type -> whitelist.ListResellerActivityRequest
+	34,  // 126: whitelist.WhitelistService.AdminLogin:input_type -> whitelist.AdminLoginRequest
+	37,  // 127: whitelist.WhitelistService.CreateAdmin:input_type -> whitelist.CreateAdminRequest
+	38,  // 128: whitelist.WhitelistService.UpdateAdmin:input_type -> whitelist.UpdateAdminRequest
+	39,  // 129: whitelist.WhitelistService.ListAdmins:input_type -> whitelist.ListAdminsRequest
+	175, // 130: whitelist.WhitelistService.AdminLogout:input_type -> google.protobuf.Empty
+	42,  // 131: whitelist.WhitelistService.ListAdminSessions:input_type -> whitelist.ListAdminSessionsRequest
+	44,  // 132: whitelist.WhitelistService.RevokeAdminSession:input_type -> whitelist.RevokeAdminSessionRequest
+	45,  // 133: whitelist.WhitelistService.ExportLicenseFile:input_type -> whitelist.ExportLicenseFileRequest
+	47,  // 134: whitelist.WhitelistService.StartSession:input_type -> whitelist.StartSessionRequest
+	49,  // 135: whitelist.WhitelistService.Heartbeat:input_type -> whitelist.HeartbeatRequest
+	51,  // 136: whitelist.WhitelistService.EndSession:input_type -> whitelist.EndSessionRequest
+	52,  // 137: whitelist.WhitelistService.WatchLicense:input_type -> whitelist.WatchLicenseRequest
+	54,  // 138: whitelist.WhitelistService.ValidateLicenses:input_type -> whitelist.ValidateLicensesRequest
+	57,  // 139: whitelist.WhitelistService.CreateProduct:input_type -> whitelist.CreateProductRequest
+	58,  // 140: whitelist.WhitelistService.UpdateProduct:input_type -> whitelist.UpdateProductRequest
+	61,  // 141: whitelist.WhitelistService.ListProducts:input_type -> whitelist.ListProductsRequest
+	63,  // 142: whitelist.WhitelistService.DeleteProduct:input_type -> whitelist.DeleteProductRequest
+	71,  // 143: whitelist.WhitelistService.GetLatestVersion:input_type -> whitelist.GetLatestVersionRequest
+	72,  // 144: whitelist.WhitelistService.PublishRelease:input_type -> whitelist.PublishReleaseRequest
+	73,  // 145: whitelist.WhitelistService.SetLicenseChannel:input_type -> whitelist.SetLicenseChannelRequest
+	75,  // 146: whitelist.WhitelistService.CreateCustomer:input_type -> whitelist.CreateCustomerRequest
+	76,  // 147: whitelist.WhitelistService.ListCustomers:input_type -> whitelist.ListCustomersRequest
+	78,  // 148: whitelist.WhitelistService.AttachLicense:input_type -> whitelist.AttachLicenseRequest
+	79,  // 149: whitelist.WhitelistService.DetachLicense:input_type -> whitelist.DetachLicenseRequest
+	80,  // 150: whitelist.WhitelistService.IssueLicenseToEmail:input_type -> whitelist.IssueLicenseToEmailRequest
+	83,  // 151: whitelist.WhitelistService.ListLicenseDeliveries:input_type -> whitelist.ListLicenseDeliveriesRequest
+	85,  // 152: whitelist.WhitelistService.CreateTrialLicense:input_type -> whitelist.CreateTrialLicenseRequest
+	87,  // 153: whitelist.WhitelistService.ExtendLicense:input_type -> whitelist.ExtendLicenseRequest
+	87,  // 154: whitelist.WhitelistService.ResellerExtendLicense:input_type -> whitelist.ExtendLicenseRequest
+	89,  // 155: whitelist.WhitelistService.SuspendLicense:input_type -> whitelist.SuspendLicenseRequest
+	90,  // 156: whitelist.WhitelistService.UnsuspendLicense:input_type -> whitelist.UnsuspendLicenseRequest
+	92,  // 157: whitelist.WhitelistService.BanHwid:input_type -> whitelist.BanHwidRequest
+	93,  // 158: whitelist.WhitelistService.UnbanHwid:input_type -> whitelist.UnbanHwidRequest
+	94,  // 159: whitelist.WhitelistService.ListHwidBans:input_type -> whitelist.ListHwidBansRequest
+	97,  // 160: whitelist.WhitelistService.BanIp:input_type -> whitelist.BanIpRequest
+	98,  // 161: whitelist.WhitelistService.UnbanIp:input_type -> whitelist.UnbanIpRequest
+	99,  // 162: whitelist.WhitelistService.ListIpBans:input_type -> whitelist.ListIpBansRequest
+	101, // 163: whitelist.WhitelistService.SetLicenseCountries:input_type -> whitelist.SetLicenseCountriesRequest
+	103, // 164: whitelist.WhitelistService.ClearLockouts:input_type -> whitelist.ClearLockoutsRequest
+	105, // 165: whitelist.WhitelistService.RotateProductSigningSecret:input_type -> whitelist.RotateProductSigningSecretRequest
+	107, // 166: whitelist.WhitelistService.RemoveProductSigningSecret:input_type -> whitelist.RemoveProductSigningSecretRequest
+	108, // 167: whitelist.WhitelistService.GetChallenge:input_type -> whitelist.GetChallengeRequest
+	117, // 168: whitelist.WhitelistService.RevokeRefreshTokens:input_type -> whitelist.RevokeRefreshTokensRequest
+	119, // 169: whitelist.WhitelistService.RotateAdminSecret:input_type -> whitelist.RotateAdminSecretRequest
+	121, // 170: whitelist.WhitelistService.EnrollAdminTotp:input_type -> whitelist.EnrollAdminTotpRequest
+	123, // 171: whitelist.WhitelistService.ExportAuditLog:input_type -> whitelist.ExportAuditLogRequest
+	124, // 172: whitelist.WhitelistService.GetStats:input_type -> whitelist.GetStatsRequest
+	129, // 173: whitelist.WhitelistService.ListValidationEvents:input_type -> whitelist.ListValidationEventsRequest
+	131, // 174: whitelist.WhitelistService.SearchLicenses:input_type -> whitelist.SearchLicensesRequest
+	133, // 175: whitelist.WhitelistService.RestoreLicense:input_type -> whitelist.RestoreLicenseRequest
+	134, // 176: whitelist.WhitelistService.PurgeLicense:input_type -> whitelist.PurgeLicenseRequest
+	135, // 177: whitelist.WhitelistService.GetLicenseHistory:input_type -> whitelist.GetLicenseHistoryRequest
+	138, // 178: whitelist.WhitelistService.ImportExternalLicenses:input_type -> whitelist.ImportExternalLicensesRequest
+	139, // 179: whitelist.WhitelistService.BulkSuspendByProduct:input_type -> whitelist.BulkSuspendByProductRequest
+	140, // 180: whitelist.WhitelistService.BulkDeleteByProduct:input_type -> whitelist.BulkDeleteByProductRequest
+	141, // 181: whitelist.WhitelistService.BulkExtendByProduct:input_type -> whitelist.BulkExtendByProductRequest
+	143, // 182: whitelist.WhitelistService.SetMaintenanceMode:input_type -> whitelist.SetMaintenanceModeRequest
+	175, // 183: whitelist.WhitelistService.GetMaintenanceMode:input_type -> google.protobuf.Empty
+	146, // 184: whitelist.WhitelistService.SetProductMessages:input_type -> whitelist.SetProductMessagesRequest
+	147, // 185: whitelist.WhitelistService.GetProductMessages:input_type -> whitelist.GetProductMessagesRequest
+	149, // 186: whitelist.WhitelistService.ListMyDevices:input_type -> whitelist.ListMyDevicesRequest
+	152, // 187: whitelist.WhitelistService.DeactivateDevice:input_type -> whitelist.DeactivateDeviceRequest
+	153, // 188: whitelist.WhitelistService.SetLicenseFloatingSeats:input_type -> whitelist.SetLicenseFloatingSeatsRequest
+	154, // 189: whitelist.WhitelistService.CheckoutLicense:input_type -> whitelist.CheckoutLicenseRequest
+	156, // 190: whitelist.WhitelistService.CheckinLicense:input_type -> whitelist.CheckinLicenseRequest
+	157, // 191: whitelist.WhitelistService.ConsumeCredits:input_type -> whitelist.ConsumeCreditsRequest
+	159, // 192: whitelist.WhitelistService.TopUpLicenseCredits:input_type -> whitelist.TopUpLicenseCreditsRequest
+	161, // 193: whitelist.WhitelistService.ListLicenseCreditActivity:input_type -> whitelist.ListLicenseCreditActivityRequest
+	163, // 194: whitelist.WhitelistService.SetLicenseFeatures:input_type -> whitelist.SetLicenseFeaturesRequest
+	65,  // 195: whitelist.WhitelistService.CreatePlan:input_type -> whitelist.CreatePlanRequest
+	66,  // 196: whitelist.WhitelistService.UpdatePlan:input_type -> whitelist.UpdatePlanRequest
+	67,  // 197: whitelist.WhitelistService.ListPlans:input_type -> whitelist.ListPlansRequest
+	69,  // 198: whitelist.WhitelistService.DeletePlan:input_type -> whitelist.DeletePlanRequest
+	110, // 199: whitelist.WhitelistService.GetServerTime:input_type -> whitelist.GetServerTimeRequest
+	112, // 200: whitelist.WhitelistService.GetPublicKey:input_type -> whitelist.GetPublicKeyRequest
+	114, // 201: whitelist.WhitelistService.GetSigningKeys:input_type -> whitelist.GetSigningKeysRequest
+	3,   // 202: whitelist.WhitelistService.GetAuthToken:output_type -> whitelist.AuthTokenResponse
+	6,   // 203: whitelist.WhitelistService.ValidateLicense:output_type -> whitelist.ValidateResponse
+	175, // 204: whitelist.WhitelistService.UpdateLicense:output_type -> google.protobuf.Empty
+	175, // 205: whitelist.WhitelistService.DeleteLicense:output_type -> google.protobuf.Empty
+	9,   // 206: whitelist.WhitelistService.GetLicense:output_type -> whitelist.License
+	12,  // 207: whitelist.WhitelistService.ListLicenses:output_type -> whitelist.ListLicensesResponse
+	175, // 208: whitelist.WhitelistService.ResetHwid:output_type -> google.protobuf.Empty
+	15,  // 209: whitelist.WhitelistService.GenerateLicenses:output_type -> whitelist.GenerateLicensesResponse
+	17,  // 210: whitelist.WhitelistService.BatchUpsertLicenses:output_type -> whitelist.BatchUpsertLicensesResponse
+	176, // 211: whitelist.WhitelistService.ExportLicenses:output_type -> google.api.HttpBody
+	20,  // 212: whitelist.WhitelistService.ImportLicenses:output_type -> whitelist.ImportLicensesResponse
+	24,  // 213: whitelist.WhitelistService.ListAuditEvents:output_type -> whitelist.ListAuditEventsResponse
+	26,  // 214: whitelist.WhitelistService.ResellerGenerateLicense:output_type -> whitelist.ResellerGenerateLicenseResponse
+	29,  // 215: whitelist.WhitelistService.CreateReseller:output_type -> whitelist.CreateResellerResponse
+	27,  // 216: whitelist.WhitelistService.TopUpResellerCredits:output_type -> whitelist.Reseller
+	33,  // 217: whitelist.WhitelistService.ListResellerActivity:output_type -> whitelist.ListResellerActivityResponse
+	35,  // 218: whitelist.WhitelistService.AdminLogin:output_type -> whitelist.AdminLoginResponse
+	36,  // 219: whitelist.WhitelistService.CreateAdmin:output_type -> whitelist.Admin
+	36,  // 220: whitelist.WhitelistService.UpdateAdmin:output_type -> whitelist.Admin
+	40,  // 221: whitelist.WhitelistService.ListAdmins:output_type -> whitelist.ListAdminsResponse
+	175, // 222: whitelist.WhitelistService.AdminLogout:output_type -> google.protobuf.Empty
+	43,  // 223: whitelist.WhitelistService.ListAdminSessions:output_type -> whitelist.ListAdminSessionsResponse
+	175, // 224: whitelist.WhitelistService.RevokeAdminSession:output_type -> google.protobuf.Empty
+	46,  // 225: whitelist.WhitelistService.ExportLicenseFile:output_type -> whitelist.ExportLicenseFileResponse
+	48,  // 226: whitelist.WhitelistService.StartSession:output_type -> whitelist.StartSessionResponse
+	50,  // 227: whitelist.WhitelistService.Heartbeat:output_type -> whitelist.HeartbeatResponse
+	175, // 228: whitelist.WhitelistService.EndSession:output_type -> google.protobuf.Empty
+	53,  // 229: whitelist.WhitelistService.WatchLicense:output_type -> whitelist.LicenseStatusEvent
+	55,  // 230: whitelist.WhitelistService.ValidateLicenses:output_type -> whitelist.ValidateLicensesResponse
+	56,  // 231: whitelist.WhitelistService.CreateProduct:output_type -> whitelist.Product
+	56,  // 232: whitelist.WhitelistService.UpdateProduct:output_type -> whitelist.Product
+	62,  // 233: whitelist.WhitelistService.ListProducts:output_type -> whitelist.ListProductsResponse
+	175, // 234: whitelist.WhitelistService.DeleteProduct:output_type -> google.protobuf.Empty
+	70,  // 235: whitelist.WhitelistService.GetLatestVersion:output_type -> whitelist.Release
+	70,  // 236: whitelist.WhitelistService.PublishRelease:output_type -> whitelist.Release
+	9,   // 237: whitelist.WhitelistService.SetLicenseChannel:output_type -> whitelist.License
+	74,  // 238: whitelist.WhitelistService.CreateCustomer:output_type -> whitelist.Customer
+	77,  // 239: whitelist.WhitelistService.ListCustomers:output_type -> whitelist.ListCustomersResponse
+	9,   // 240: whitelist.WhitelistService.AttachLicense:output_type -> whitelist.License
+	9,   // 241: whitelist.WhitelistService.DetachLicense:output_type -> whitelist.License
+	82,  // 242: whitelist.WhitelistService.IssueLicenseToEmail:output_type -> whitelist.IssueLicenseToEmailResponse
+	84,  // 243: whitelist.WhitelistService.ListLicenseDeliveries:output_type -> whitelist.ListLicenseDeliveriesResponse
+	86,  // 244: whitelist.WhitelistService.CreateTrialLicense:output_type -> whitelist.CreateTrialLicenseResponse
+	9,   // 245: whitelist.WhitelistService.ExtendLicense:output_type -> whitelist.License
+	88,  // 246: whitelist.WhitelistService.ResellerExtendLicense:output_type -> whitelist.ResellerExtendLicenseResponse
+	9,   // 247: whitelist.WhitelistService.SuspendLicense:output_type -> whitelist.License
+	9,   // 248: whitelist.WhitelistService.UnsuspendLicense:output_type -> whitelist.License
+	91,  // 249: whitelist.WhitelistService.BanHwid:output_type -> whitelist.HwidBan
+	175, // 250: whitelist.WhitelistService.UnbanHwid:output_type -> google.protobuf.Empty
+	95,  // 251: whitelist.WhitelistService.ListHwidBans:output_type -> whitelist.ListHwidBansResponse
+	96,  // 252: whitelist.WhitelistService.BanIp:output_type -> whitelist.IpBan
+	175, // 253: whitelist.WhitelistService.UnbanIp:output_type -> google.protobuf.Empty
+	100, // 254: whitelist.WhitelistService.ListIpBans:output_type -> whitelist.ListIpBansResponse
+	9,   // 255: whitelist.WhitelistService.SetLicenseCountries:output_type -> whitelist.License
+	104, // 256: whitelist.WhitelistService.ClearLockouts:output_type -> whitelist.ClearLockoutsResponse
+	106, // 257: whitelist.WhitelistService.RotateProductSigningSecret:output_type -> whitelist.RotateProductSigningSecretResponse
+	56,  // 258: whitelist.WhitelistService.RemoveProductSigningSecret:output_type -> whitelist.Product
+	109, // 259: whitelist.WhitelistService.GetChallenge:output_type -> whitelist.GetChallengeResponse
+	118, // 260: whitelist.WhitelistService.RevokeRefreshTokens:output_type -> whitelist.RevokeRefreshTokensResponse
+	120, // 261: whitelist.WhitelistService.RotateAdminSecret:output_type -> whitelist.RotateAdminSecretResponse
+	122, // 262: whitelist.WhitelistService.EnrollAdminTotp:output_type -> whitelist.EnrollAdminTotpResponse
+	176, // 263: whitelist.WhitelistService.ExportAuditLog:output_type -> google.api.HttpBody
+	127, // 264: whitelist.WhitelistService.GetStats:output_type -> whitelist.GetStatsResponse
+	130, // 265: whitelist.WhitelistService.ListValidationEvents:output_type -> whitelist.ListValidationEventsResponse
+	132, // 266: whitelist.WhitelistService.SearchLicenses:output_type -> whitelist.SearchLicensesResponse
+	9,   // 267: whitelist.WhitelistService.RestoreLicense:output_type -> whitelist.License
+	175, // 268: whitelist.WhitelistService.PurgeLicense:output_type -> google.protobuf.Empty
+	136, // 269: whitelist.WhitelistService.GetLicenseHistory:output_type -> whitelist.GetLicenseHistoryResponse
+	20,  // 270: whitelist.WhitelistService.ImportExternalLicenses:output_type -> whitelist.ImportLicensesResponse
+	142, // 271: whitelist.WhitelistService.BulkSuspendByProduct:output_type -> whitelist.BulkOperationResponse
+	142, // 272: whitelist.WhitelistService.BulkDeleteByProduct:output_type -> whitelist.BulkOperationResponse
+	142, // 273: whitelist.WhitelistService.BulkExtendByProduct:output_type -> whitelist.BulkOperationResponse
+	144, // 274: whitelist.WhitelistService.SetMaintenanceMode:output_type -> whitelist.MaintenanceMode
+	144, // 275: whitelist.WhitelistService.GetMaintenanceMode:output_type -> whitelist.MaintenanceMode
+	148, // 276: whitelist.WhitelistService.SetProductMessages:output_type -> whitelist.ProductMessages
+	148, // 277: whitelist.WhitelistService.GetProductMessages:output_type -> whitelist.ProductMessages
+	151, // 278: whitelist.WhitelistService.ListMyDevices:output_type -> whitelist.ListMyDevicesResponse
+	175, // 279: whitelist.WhitelistService.DeactivateDevice:output_type -> google.protobuf.Empty
+	9,   // 280: whitelist.WhitelistService.SetLicenseFloatingSeats:output_type -> whitelist.License
+	155, // 281: whitelist.WhitelistService.CheckoutLicense:output_type -> whitelist.CheckoutLicenseResponse
+	175, // 282: whitelist.WhitelistService.CheckinLicense:output_type -> google.protobuf.Empty
+	158, // 283: whitelist.WhitelistService.ConsumeCredits:output_type -> whitelist.ConsumeCreditsResponse
+	9,   // 284: whitelist.WhitelistService.TopUpLicenseCredits:output_type -> whitelist.License
+	162, // 285: whitelist.WhitelistService.ListLicenseCreditActivity:output_type -> whitelist.ListLicenseCreditActivityResponse
+	9,   // 286: whitelist.WhitelistService.SetLicenseFeatures:output_type -> whitelist.License
+	64,  // 287: whitelist.WhitelistService.CreatePlan:output_type -> whitelist.Plan
+	64,  // 288: whitelist.WhitelistService.UpdatePlan:output_type -> whitelist.Plan
+	68,  // 289: whitelist.WhitelistService.ListPlans:output_type -> whitelist.ListPlansResponse
+	175, // 290: whitelist.WhitelistService.DeletePlan:output_type -> google.protobuf.Empty
+	111, // 291: whitelist.WhitelistService.GetServerTime:output_type -> whitelist.GetServerTimeResponse
+	113, // 292: whitelist.WhitelistService.GetPublicKey:output_type -> whitelist.GetPublicKeyResponse
+	116, // 293: whitelist.WhitelistService.GetSigningKeys:output_type -> whitelist.GetSigningKeysResponse
+	202, // [202:294] is the sub-list for method output_type
+	110, // [110:202] is the sub-list for method input_type
+	110, // [110:110] is the sub-list for extension type_name
+	110, // [110:110] is the sub-list for extension extendee
+	0,   // [0:110] is the sub-list for field type_name
 }
 
 func init() { file_proto_whitelist_proto_init() }
@@ -365,18 +12644,25 @@ func file_proto_whitelist_proto_init() {
 	if File_proto_whitelist_proto != nil {
 		return
 	}
+	file_proto_whitelist_proto_msgTypes[5].OneofWrappers = []any{}
+	file_proto_whitelist_proto_msgTypes[9].OneofWrappers = []any{}
+	file_proto_whitelist_proto_msgTypes[36].OneofWrappers = []any{}
+	file_proto_whitelist_proto_msgTypes[56].OneofWrappers = []any{}
+	file_proto_whitelist_proto_msgTypes[64].OneofWrappers = []any{}
+	file_proto_whitelist_proto_msgTypes[127].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_whitelist_proto_rawDesc), len(file_proto_whitelist_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      2,
+			NumMessages:   170,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_whitelist_proto_goTypes,
 		DependencyIndexes: file_proto_whitelist_proto_depIdxs,
+		EnumInfos:         file_proto_whitelist_proto_enumTypes,
 		MessageInfos:      file_proto_whitelist_proto_msgTypes,
 	}.Build()
 	File_proto_whitelist_proto = out.File
@@ -8,6 +8,7 @@ package proto
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -20,26 +21,290 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	WhitelistService_GetAuthToken_FullMethodName    = "/whitelist.WhitelistService/GetAuthToken"
-	WhitelistService_ValidateLicense_FullMethodName = "/whitelist.WhitelistService/ValidateLicense"
-	WhitelistService_UpdateLicense_FullMethodName   = "/whitelist.WhitelistService/UpdateLicense"
-	WhitelistService_DeleteLicense_FullMethodName   = "/whitelist.WhitelistService/DeleteLicense"
+	WhitelistService_GetAuthToken_FullMethodName               = "/whitelist.WhitelistService/GetAuthToken"
+	WhitelistService_ValidateLicense_FullMethodName            = "/whitelist.WhitelistService/ValidateLicense"
+	WhitelistService_UpdateLicense_FullMethodName              = "/whitelist.WhitelistService/UpdateLicense"
+	WhitelistService_DeleteLicense_FullMethodName              = "/whitelist.WhitelistService/DeleteLicense"
+	WhitelistService_GetLicense_FullMethodName                 = "/whitelist.WhitelistService/GetLicense"
+	WhitelistService_ListLicenses_FullMethodName               = "/whitelist.WhitelistService/ListLicenses"
+	WhitelistService_ResetHwid_FullMethodName                  = "/whitelist.WhitelistService/ResetHwid"
+	WhitelistService_GenerateLicenses_FullMethodName           = "/whitelist.WhitelistService/GenerateLicenses"
+	WhitelistService_BatchUpsertLicenses_FullMethodName        = "/whitelist.WhitelistService/BatchUpsertLicenses"
+	WhitelistService_ExportLicenses_FullMethodName             = "/whitelist.WhitelistService/ExportLicenses"
+	WhitelistService_ImportLicenses_FullMethodName             = "/whitelist.WhitelistService/ImportLicenses"
+	WhitelistService_ListAuditEvents_FullMethodName            = "/whitelist.WhitelistService/ListAuditEvents"
+	WhitelistService_ResellerGenerateLicense_FullMethodName    = "/whitelist.WhitelistService/ResellerGenerateLicense"
+	WhitelistService_CreateReseller_FullMethodName             = "/whitelist.WhitelistService/CreateReseller"
+	WhitelistService_TopUpResellerCredits_FullMethodName       = "/whitelist.WhitelistService/TopUpResellerCredits"
+	WhitelistService_ListResellerActivity_FullMethodName       = "/whitelist.WhitelistService/ListResellerActivity"
+	WhitelistService_AdminLogin_FullMethodName                 = "/whitelist.WhitelistService/AdminLogin"
+	WhitelistService_CreateAdmin_FullMethodName                = "/whitelist.WhitelistService/CreateAdmin"
+	WhitelistService_UpdateAdmin_FullMethodName                = "/whitelist.WhitelistService/UpdateAdmin"
+	WhitelistService_ListAdmins_FullMethodName                 = "/whitelist.WhitelistService/ListAdmins"
+	WhitelistService_AdminLogout_FullMethodName                = "/whitelist.WhitelistService/AdminLogout"
+	WhitelistService_ListAdminSessions_FullMethodName          = "/whitelist.WhitelistService/ListAdminSessions"
+	WhitelistService_RevokeAdminSession_FullMethodName         = "/whitelist.WhitelistService/RevokeAdminSession"
+	WhitelistService_ExportLicenseFile_FullMethodName          = "/whitelist.WhitelistService/ExportLicenseFile"
+	WhitelistService_StartSession_FullMethodName               = "/whitelist.WhitelistService/StartSession"
+	WhitelistService_Heartbeat_FullMethodName                  = "/whitelist.WhitelistService/Heartbeat"
+	WhitelistService_EndSession_FullMethodName                 = "/whitelist.WhitelistService/EndSession"
+	WhitelistService_WatchLicense_FullMethodName               = "/whitelist.WhitelistService/WatchLicense"
+	WhitelistService_ValidateLicenses_FullMethodName           = "/whitelist.WhitelistService/ValidateLicenses"
+	WhitelistService_CreateProduct_FullMethodName              = "/whitelist.WhitelistService/CreateProduct"
+	WhitelistService_UpdateProduct_FullMethodName              = "/whitelist.WhitelistService/UpdateProduct"
+	WhitelistService_ListProducts_FullMethodName               = "/whitelist.WhitelistService/ListProducts"
+	WhitelistService_DeleteProduct_FullMethodName              = "/whitelist.WhitelistService/DeleteProduct"
+	WhitelistService_GetLatestVersion_FullMethodName           = "/whitelist.WhitelistService/GetLatestVersion"
+	WhitelistService_PublishRelease_FullMethodName             = "/whitelist.WhitelistService/PublishRelease"
+	WhitelistService_SetLicenseChannel_FullMethodName          = "/whitelist.WhitelistService/SetLicenseChannel"
+	WhitelistService_CreateCustomer_FullMethodName             = "/whitelist.WhitelistService/CreateCustomer"
+	WhitelistService_ListCustomers_FullMethodName              = "/whitelist.WhitelistService/ListCustomers"
+	WhitelistService_AttachLicense_FullMethodName              = "/whitelist.WhitelistService/AttachLicense"
+	WhitelistService_DetachLicense_FullMethodName              = "/whitelist.WhitelistService/DetachLicense"
+	WhitelistService_IssueLicenseToEmail_FullMethodName        = "/whitelist.WhitelistService/IssueLicenseToEmail"
+	WhitelistService_ListLicenseDeliveries_FullMethodName      = "/whitelist.WhitelistService/ListLicenseDeliveries"
+	WhitelistService_CreateTrialLicense_FullMethodName         = "/whitelist.WhitelistService/CreateTrialLicense"
+	WhitelistService_ExtendLicense_FullMethodName              = "/whitelist.WhitelistService/ExtendLicense"
+	WhitelistService_ResellerExtendLicense_FullMethodName      = "/whitelist.WhitelistService/ResellerExtendLicense"
+	WhitelistService_SuspendLicense_FullMethodName             = "/whitelist.WhitelistService/SuspendLicense"
+	WhitelistService_UnsuspendLicense_FullMethodName           = "/whitelist.WhitelistService/UnsuspendLicense"
+	WhitelistService_BanHwid_FullMethodName                    = "/whitelist.WhitelistService/BanHwid"
+	WhitelistService_UnbanHwid_FullMethodName                  = "/whitelist.WhitelistService/UnbanHwid"
+	WhitelistService_ListHwidBans_FullMethodName               = "/whitelist.WhitelistService/ListHwidBans"
+	WhitelistService_BanIp_FullMethodName                      = "/whitelist.WhitelistService/BanIp"
+	WhitelistService_UnbanIp_FullMethodName                    = "/whitelist.WhitelistService/UnbanIp"
+	WhitelistService_ListIpBans_FullMethodName                 = "/whitelist.WhitelistService/ListIpBans"
+	WhitelistService_SetLicenseCountries_FullMethodName        = "/whitelist.WhitelistService/SetLicenseCountries"
+	WhitelistService_ClearLockouts_FullMethodName              = "/whitelist.WhitelistService/ClearLockouts"
+	WhitelistService_RotateProductSigningSecret_FullMethodName = "/whitelist.WhitelistService/RotateProductSigningSecret"
+	WhitelistService_RemoveProductSigningSecret_FullMethodName = "/whitelist.WhitelistService/RemoveProductSigningSecret"
+	WhitelistService_GetChallenge_FullMethodName               = "/whitelist.WhitelistService/GetChallenge"
+	WhitelistService_RevokeRefreshTokens_FullMethodName        = "/whitelist.WhitelistService/RevokeRefreshTokens"
+	WhitelistService_RotateAdminSecret_FullMethodName          = "/whitelist.WhitelistService/RotateAdminSecret"
+	WhitelistService_EnrollAdminTotp_FullMethodName            = "/whitelist.WhitelistService/EnrollAdminTotp"
+	WhitelistService_ExportAuditLog_FullMethodName             = "/whitelist.WhitelistService/ExportAuditLog"
+	WhitelistService_GetStats_FullMethodName                   = "/whitelist.WhitelistService/GetStats"
+	WhitelistService_ListValidationEvents_FullMethodName       = "/whitelist.WhitelistService/ListValidationEvents"
+	WhitelistService_SearchLicenses_FullMethodName             = "/whitelist.WhitelistService/SearchLicenses"
+	WhitelistService_RestoreLicense_FullMethodName             = "/whitelist.WhitelistService/RestoreLicense"
+	WhitelistService_PurgeLicense_FullMethodName               = "/whitelist.WhitelistService/PurgeLicense"
+	WhitelistService_GetLicenseHistory_FullMethodName          = "/whitelist.WhitelistService/GetLicenseHistory"
+	WhitelistService_ImportExternalLicenses_FullMethodName     = "/whitelist.WhitelistService/ImportExternalLicenses"
+	WhitelistService_BulkSuspendByProduct_FullMethodName       = "/whitelist.WhitelistService/BulkSuspendByProduct"
+	WhitelistService_BulkDeleteByProduct_FullMethodName        = "/whitelist.WhitelistService/BulkDeleteByProduct"
+	WhitelistService_BulkExtendByProduct_FullMethodName        = "/whitelist.WhitelistService/BulkExtendByProduct"
+	WhitelistService_SetMaintenanceMode_FullMethodName         = "/whitelist.WhitelistService/SetMaintenanceMode"
+	WhitelistService_GetMaintenanceMode_FullMethodName         = "/whitelist.WhitelistService/GetMaintenanceMode"
+	WhitelistService_SetProductMessages_FullMethodName         = "/whitelist.WhitelistService/SetProductMessages"
+	WhitelistService_GetProductMessages_FullMethodName         = "/whitelist.WhitelistService/GetProductMessages"
+	WhitelistService_ListMyDevices_FullMethodName              = "/whitelist.WhitelistService/ListMyDevices"
+	WhitelistService_DeactivateDevice_FullMethodName           = "/whitelist.WhitelistService/DeactivateDevice"
+	WhitelistService_SetLicenseFloatingSeats_FullMethodName    = "/whitelist.WhitelistService/SetLicenseFloatingSeats"
+	WhitelistService_CheckoutLicense_FullMethodName            = "/whitelist.WhitelistService/CheckoutLicense"
+	WhitelistService_CheckinLicense_FullMethodName             = "/whitelist.WhitelistService/CheckinLicense"
+	WhitelistService_ConsumeCredits_FullMethodName             = "/whitelist.WhitelistService/ConsumeCredits"
+	WhitelistService_TopUpLicenseCredits_FullMethodName        = "/whitelist.WhitelistService/TopUpLicenseCredits"
+	WhitelistService_ListLicenseCreditActivity_FullMethodName  = "/whitelist.WhitelistService/ListLicenseCreditActivity"
+	WhitelistService_SetLicenseFeatures_FullMethodName         = "/whitelist.WhitelistService/SetLicenseFeatures"
+	WhitelistService_CreatePlan_FullMethodName                 = "/whitelist.WhitelistService/CreatePlan"
+	WhitelistService_UpdatePlan_FullMethodName                 = "/whitelist.WhitelistService/UpdatePlan"
+	WhitelistService_ListPlans_FullMethodName                  = "/whitelist.WhitelistService/ListPlans"
+	WhitelistService_DeletePlan_FullMethodName                 = "/whitelist.WhitelistService/DeletePlan"
+	WhitelistService_GetServerTime_FullMethodName              = "/whitelist.WhitelistService/GetServerTime"
+	WhitelistService_GetPublicKey_FullMethodName               = "/whitelist.WhitelistService/GetPublicKey"
+	WhitelistService_GetSigningKeys_FullMethodName             = "/whitelist.WhitelistService/GetSigningKeys"
 )
 
 // WhitelistServiceClient is the client API for WhitelistService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-//
-// The Whitelist Service Definition
 type WhitelistServiceClient interface {
-	// 1. Get a One-Time Access Token (Public)
-	GetAuthToken(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AuthTokenResponse, error)
-	// 2. Validate a License Key (Requires OTAT)
+	// 1. Get Token (Now requires API Key)
+	GetAuthToken(ctx context.Context, in *GetTokenRequest, opts ...grpc.CallOption) (*AuthTokenResponse, error)
+	// 2. Validate License
 	ValidateLicense(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error)
-	// 3. Create/Update License (Admin Only)
+	// 3. Create/Update License (Admin)
 	UpdateLicense(ctx context.Context, in *UpdateLicenseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// 4. Delete License (Admin Only)
+	// 4. Delete License (Admin). Restorable until purged.
 	DeleteLicense(ctx context.Context, in *DeleteLicenseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 5. Get License (Admin)
+	GetLicense(ctx context.Context, in *GetLicenseRequest, opts ...grpc.CallOption) (*License, error)
+	// 6. List Licenses (Admin)
+	ListLicenses(ctx context.Context, in *ListLicensesRequest, opts ...grpc.CallOption) (*ListLicensesResponse, error)
+	// 7. Reset HWID bindings (Admin)
+	ResetHwid(ctx context.Context, in *ResetHwidRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 8. Generate License Keys (Admin)
+	GenerateLicenses(ctx context.Context, in *GenerateLicensesRequest, opts ...grpc.CallOption) (*GenerateLicensesResponse, error)
+	// 9. Create/Update many licenses in one transaction (Admin)
+	BatchUpsertLicenses(ctx context.Context, in *BatchUpsertLicensesRequest, opts ...grpc.CallOption) (*BatchUpsertLicensesResponse, error)
+	// 10. Export licenses as CSV (Admin)
+	ExportLicenses(ctx context.Context, in *ExportLicensesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error)
+	// 11. Import licenses from CSV (Admin)
+	ImportLicenses(ctx context.Context, in *ImportLicensesRequest, opts ...grpc.CallOption) (*ImportLicensesResponse, error)
+	// 12. List Audit Log (Admin)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	// 13. Generate License Keys against a credit balance (Reseller, x-reseller-key header)
+	ResellerGenerateLicense(ctx context.Context, in *ResellerGenerateLicenseRequest, opts ...grpc.CallOption) (*ResellerGenerateLicenseResponse, error)
+	// 14. Create Reseller (Admin)
+	CreateReseller(ctx context.Context, in *CreateResellerRequest, opts ...grpc.CallOption) (*CreateResellerResponse, error)
+	// 15. Add (or remove) Reseller Credits (Admin)
+	TopUpResellerCredits(ctx context.Context, in *TopUpResellerCreditsRequest, opts ...grpc.CallOption) (*Reseller, error)
+	// 16. List Reseller Credit Activity (Admin)
+	ListResellerActivity(ctx context.Context, in *ListResellerActivityRequest, opts ...grpc.CallOption) (*ListResellerActivityResponse, error)
+	// 17. Admin Login, returns a bearer token for the Authorization header
+	AdminLogin(ctx context.Context, in *AdminLoginRequest, opts ...grpc.CallOption) (*AdminLoginResponse, error)
+	// 18. Create Admin Account (Owner)
+	CreateAdmin(ctx context.Context, in *CreateAdminRequest, opts ...grpc.CallOption) (*Admin, error)
+	// 19. Change role, password or disabled flag of an Admin Account (Owner)
+	UpdateAdmin(ctx context.Context, in *UpdateAdminRequest, opts ...grpc.CallOption) (*Admin, error)
+	// 20. List Admin Accounts (Owner)
+	ListAdmins(ctx context.Context, in *ListAdminsRequest, opts ...grpc.CallOption) (*ListAdminsResponse, error)
+	// 21. Admin Logout, ends the session of the calling token
+	AdminLogout(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 22. List Admin Sessions (own sessions, or anyone's for owners)
+	ListAdminSessions(ctx context.Context, in *ListAdminSessionsRequest, opts ...grpc.CallOption) (*ListAdminSessionsResponse, error)
+	// 23. Revoke an Admin Session (own sessions, or anyone's for owners)
+	RevokeAdminSession(ctx context.Context, in *RevokeAdminSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 24. Export a signed license file for offline validation (Support)
+	ExportLicenseFile(ctx context.Context, in *ExportLicenseFileRequest, opts ...grpc.CallOption) (*ExportLicenseFileResponse, error)
+	// 25. Start a Session, validating the license and taking a concurrent-use slot
+	StartSession(ctx context.Context, in *StartSessionRequest, opts ...grpc.CallOption) (*StartSessionResponse, error)
+	// 26. Heartbeat keeps a Session alive
+	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
+	// 27. End a Session, freeing its slot
+	EndSession(ctx context.Context, in *EndSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 28. Watch a License, streaming its status whenever it changes
+	WatchLicense(ctx context.Context, in *WatchLicenseRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LicenseStatusEvent], error)
+	// 29. Validate several Licenses with one access token
+	ValidateLicenses(ctx context.Context, in *ValidateLicensesRequest, opts ...grpc.CallOption) (*ValidateLicensesResponse, error)
+	// 30. Create a Product (Owner)
+	CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*Product, error)
+	// 31. Update a Product's name, description or disabled flag (Owner)
+	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*Product, error)
+	// 32. List Products (Admin)
+	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
+	// 33. Delete a Product without licenses (Owner)
+	DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 34. Get the latest Release of a Product for self-updating clients
+	GetLatestVersion(ctx context.Context, in *GetLatestVersionRequest, opts ...grpc.CallOption) (*Release, error)
+	// 35. Publish a Release on one of a Product's channels (Owner)
+	PublishRelease(ctx context.Context, in *PublishReleaseRequest, opts ...grpc.CallOption) (*Release, error)
+	// 36. Pin a License to an update channel (Admin)
+	SetLicenseChannel(ctx context.Context, in *SetLicenseChannelRequest, opts ...grpc.CallOption) (*License, error)
+	// 37. Create a Customer (Admin)
+	CreateCustomer(ctx context.Context, in *CreateCustomerRequest, opts ...grpc.CallOption) (*Customer, error)
+	// 38. List or look up Customers (Admin)
+	ListCustomers(ctx context.Context, in *ListCustomersRequest, opts ...grpc.CallOption) (*ListCustomersResponse, error)
+	// 39. Attach a License to a Customer (Admin)
+	AttachLicense(ctx context.Context, in *AttachLicenseRequest, opts ...grpc.CallOption) (*License, error)
+	// 40. Detach a License from its Customer (Admin)
+	DetachLicense(ctx context.Context, in *DetachLicenseRequest, opts ...grpc.CallOption) (*License, error)
+	// 41. Email a new or existing License key to a customer (Admin)
+	IssueLicenseToEmail(ctx context.Context, in *IssueLicenseToEmailRequest, opts ...grpc.CallOption) (*IssueLicenseToEmailResponse, error)
+	// 42. List the emails sent for a License (Admin)
+	ListLicenseDeliveries(ctx context.Context, in *ListLicenseDeliveriesRequest, opts ...grpc.CallOption) (*ListLicenseDeliveriesResponse, error)
+	// 43. Start a self-service trial of a Product on this device
+	CreateTrialLicense(ctx context.Context, in *CreateTrialLicenseRequest, opts ...grpc.CallOption) (*CreateTrialLicenseResponse, error)
+	// 44. Push a License's expiry forward (Admin)
+	ExtendLicense(ctx context.Context, in *ExtendLicenseRequest, opts ...grpc.CallOption) (*License, error)
+	// 45. Extend a License the reseller generated, for one credit (Reseller, x-reseller-key header)
+	ResellerExtendLicense(ctx context.Context, in *ExtendLicenseRequest, opts ...grpc.CallOption) (*ResellerExtendLicenseResponse, error)
+	// 46. Suspend a License, with a reason shown to its users (Admin)
+	SuspendLicense(ctx context.Context, in *SuspendLicenseRequest, opts ...grpc.CallOption) (*License, error)
+	// 47. Reactivate a suspended License (Admin)
+	UnsuspendLicense(ctx context.Context, in *UnsuspendLicenseRequest, opts ...grpc.CallOption) (*License, error)
+	// 48. Ban a HWID from validating any License (Admin)
+	BanHwid(ctx context.Context, in *BanHwidRequest, opts ...grpc.CallOption) (*HwidBan, error)
+	// 49. Lift a HWID ban (Admin)
+	UnbanHwid(ctx context.Context, in *UnbanHwidRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 50. List banned HWIDs (Admin)
+	ListHwidBans(ctx context.Context, in *ListHwidBansRequest, opts ...grpc.CallOption) (*ListHwidBansResponse, error)
+	// 51. Ban an IP address or CIDR range from the public endpoints (Admin)
+	BanIp(ctx context.Context, in *BanIpRequest, opts ...grpc.CallOption) (*IpBan, error)
+	// 52. Lift an IP ban (Admin)
+	UnbanIp(ctx context.Context, in *UnbanIpRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 53. List IP bans in force (Admin)
+	ListIpBans(ctx context.Context, in *ListIpBansRequest, opts ...grpc.CallOption) (*ListIpBansResponse, error)
+	// 54. Set the countries a License may be used from (Admin)
+	SetLicenseCountries(ctx context.Context, in *SetLicenseCountriesRequest, opts ...grpc.CallOption) (*License, error)
+	// 55. Lift validation lockouts of a License key and/or a client IP (Admin)
+	ClearLockouts(ctx context.Context, in *ClearLockoutsRequest, opts ...grpc.CallOption) (*ClearLockoutsResponse, error)
+	// 56. Turn on signed requests for a Product, or replace its secret (Admin)
+	RotateProductSigningSecret(ctx context.Context, in *RotateProductSigningSecretRequest, opts ...grpc.CallOption) (*RotateProductSigningSecretResponse, error)
+	// 57. Turn off signed requests for a Product (Admin)
+	RemoveProductSigningSecret(ctx context.Context, in *RemoveProductSigningSecretRequest, opts ...grpc.CallOption) (*Product, error)
+	// 58. Get a single-use challenge to send with ValidateLicense (Public)
+	GetChallenge(ctx context.Context, in *GetChallengeRequest, opts ...grpc.CallOption) (*GetChallengeResponse, error)
+	// 59. Revoke every refresh token issued for an API key (Admin)
+	RevokeRefreshTokens(ctx context.Context, in *RevokeRefreshTokensRequest, opts ...grpc.CallOption) (*RevokeRefreshTokensResponse, error)
+	// 60. Issue a new shared admin secret and expire the old ones (Admin)
+	RotateAdminSecret(ctx context.Context, in *RotateAdminSecretRequest, opts ...grpc.CallOption) (*RotateAdminSecretResponse, error)
+	// 61. Set up a TOTP second factor for the caller (Read-only)
+	EnrollAdminTotp(ctx context.Context, in *EnrollAdminTotpRequest, opts ...grpc.CallOption) (*EnrollAdminTotpResponse, error)
+	// 62. Export the audit log as NDJSON or CSV (Admin)
+	ExportAuditLog(ctx context.Context, in *ExportAuditLogRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error)
+	// 63. Validation and token statistics per day (Admin)
+	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error)
+	// 64. List ValidateLicense attempts (Admin)
+	ListValidationEvents(ctx context.Context, in *ListValidationEventsRequest, opts ...grpc.CallOption) (*ListValidationEventsResponse, error)
+	// 65. Find licenses from partial details (Admin)
+	SearchLicenses(ctx context.Context, in *SearchLicensesRequest, opts ...grpc.CallOption) (*SearchLicensesResponse, error)
+	// 66. Undo DeleteLicense (Admin)
+	RestoreLicense(ctx context.Context, in *RestoreLicenseRequest, opts ...grpc.CallOption) (*License, error)
+	// 67. Remove a deleted license for good (Admin)
+	PurgeLicense(ctx context.Context, in *PurgeLicenseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 68. Changes to a license, newest first (Admin)
+	GetLicenseHistory(ctx context.Context, in *GetLicenseHistoryRequest, opts ...grpc.CallOption) (*GetLicenseHistoryResponse, error)
+	// 69. Import licenses, devices and customers from another license system (Admin)
+	ImportExternalLicenses(ctx context.Context, in *ImportExternalLicensesRequest, opts ...grpc.CallOption) (*ImportLicensesResponse, error)
+	// 70. Suspend every active license of a product (Admin)
+	BulkSuspendByProduct(ctx context.Context, in *BulkSuspendByProductRequest, opts ...grpc.CallOption) (*BulkOperationResponse, error)
+	// 71. Delete every license of a product (Admin). Restorable until purged.
+	BulkDeleteByProduct(ctx context.Context, in *BulkDeleteByProductRequest, opts ...grpc.CallOption) (*BulkOperationResponse, error)
+	// 72. Extend every expiring license of a product (Admin)
+	BulkExtendByProduct(ctx context.Context, in *BulkExtendByProductRequest, opts ...grpc.CallOption) (*BulkOperationResponse, error)
+	// 73. Turn maintenance mode on or off (Admin)
+	SetMaintenanceMode(ctx context.Context, in *SetMaintenanceModeRequest, opts ...grpc.CallOption) (*MaintenanceMode, error)
+	// 74. Show whether maintenance mode is on (Admin)
+	GetMaintenanceMode(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MaintenanceMode, error)
+	// 75. Replace a Product's wording of validation messages (Owner)
+	SetProductMessages(ctx context.Context, in *SetProductMessagesRequest, opts ...grpc.CallOption) (*ProductMessages, error)
+	// 76. Show a Product's wording of validation messages (Admin)
+	GetProductMessages(ctx context.Context, in *GetProductMessagesRequest, opts ...grpc.CallOption) (*ProductMessages, error)
+	// 77. List the devices bound to a License, for its owner (Public)
+	ListMyDevices(ctx context.Context, in *ListMyDevicesRequest, opts ...grpc.CallOption) (*ListMyDevicesResponse, error)
+	// 78. Unbind one of a License's devices, freeing its seat, for its owner
+	// (Public)
+	DeactivateDevice(ctx context.Context, in *DeactivateDeviceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 79. Set the size of a License's floating pool (Admin)
+	SetLicenseFloatingSeats(ctx context.Context, in *SetLicenseFloatingSeatsRequest, opts ...grpc.CallOption) (*License, error)
+	// 80. Check out a seat of a floating License for this machine, validating
+	// the license
+	CheckoutLicense(ctx context.Context, in *CheckoutLicenseRequest, opts ...grpc.CallOption) (*CheckoutLicenseResponse, error)
+	// 81. Check a floating seat back in, freeing it for another machine
+	CheckinLicense(ctx context.Context, in *CheckinLicenseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 82. Spend credits of a pay-per-use License, validating it
+	ConsumeCredits(ctx context.Context, in *ConsumeCreditsRequest, opts ...grpc.CallOption) (*ConsumeCreditsResponse, error)
+	// 83. Add credits to a License, or take them back (Admin)
+	TopUpLicenseCredits(ctx context.Context, in *TopUpLicenseCreditsRequest, opts ...grpc.CallOption) (*License, error)
+	// 84. List License Credit Activity (Admin)
+	ListLicenseCreditActivity(ctx context.Context, in *ListLicenseCreditActivityRequest, opts ...grpc.CallOption) (*ListLicenseCreditActivityResponse, error)
+	// 85. Set the feature flags of a License, on top of its Product's (Admin)
+	SetLicenseFeatures(ctx context.Context, in *SetLicenseFeaturesRequest, opts ...grpc.CallOption) (*License, error)
+	// 86. Create a Plan licenses can be put on (Owner)
+	CreatePlan(ctx context.Context, in *CreatePlanRequest, opts ...grpc.CallOption) (*Plan, error)
+	// 87. Update a Plan (Owner)
+	UpdatePlan(ctx context.Context, in *UpdatePlanRequest, opts ...grpc.CallOption) (*Plan, error)
+	// 88. List Plans (Admin)
+	ListPlans(ctx context.Context, in *ListPlansRequest, opts ...grpc.CallOption) (*ListPlansResponse, error)
+	// 89. Delete a Plan without licenses (Owner)
+	DeletePlan(ctx context.Context, in *DeletePlanRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 90. Get the server's clock, e.g. to correct signed request timestamps (Public)
+	GetServerTime(ctx context.Context, in *GetServerTimeRequest, opts ...grpc.CallOption) (*GetServerTimeResponse, error)
+	// 91. Get the public key that validation answers and license files are signed with (Public)
+	GetPublicKey(ctx context.Context, in *GetPublicKeyRequest, opts ...grpc.CallOption) (*GetPublicKeyResponse, error)
+	// 92. List the public keys clients should accept, current and retired, JWKS-style (Public)
+	GetSigningKeys(ctx context.Context, in *GetSigningKeysRequest, opts ...grpc.CallOption) (*GetSigningKeysResponse, error)
 }
 
 type whitelistServiceClient struct {
@@ -50,7 +315,7 @@ func NewWhitelistServiceClient(cc grpc.ClientConnInterface) WhitelistServiceClie
 	return &whitelistServiceClient{cc}
 }
 
-func (c *whitelistServiceClient) GetAuthToken(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AuthTokenResponse, error) {
+func (c *whitelistServiceClient) GetAuthToken(ctx context.Context, in *GetTokenRequest, opts ...grpc.CallOption) (*AuthTokenResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AuthTokenResponse)
 	err := c.cc.Invoke(ctx, WhitelistService_GetAuthToken_FullMethodName, in, out, cOpts...)
@@ -90,159 +355,3425 @@ func (c *whitelistServiceClient) DeleteLicense(ctx context.Context, in *DeleteLi
 	return out, nil
 }
 
-// WhitelistServiceServer is the server API for WhitelistService service.
-// All implementations must embed UnimplementedWhitelistServiceServer
-// for forward compatibility.
-//
-// The Whitelist Service Definition
-type WhitelistServiceServer interface {
-	// 1. Get a One-Time Access Token (Public)
-	GetAuthToken(context.Context, *emptypb.Empty) (*AuthTokenResponse, error)
-	// 2. Validate a License Key (Requires OTAT)
-	ValidateLicense(context.Context, *ValidateRequest) (*ValidateResponse, error)
-	// 3. Create/Update License (Admin Only)
-	UpdateLicense(context.Context, *UpdateLicenseRequest) (*emptypb.Empty, error)
-	// 4. Delete License (Admin Only)
-	DeleteLicense(context.Context, *DeleteLicenseRequest) (*emptypb.Empty, error)
-	mustEmbedUnimplementedWhitelistServiceServer()
+func (c *whitelistServiceClient) GetLicense(ctx context.Context, in *GetLicenseRequest, opts ...grpc.CallOption) (*License, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(License)
+	err := c.cc.Invoke(ctx, WhitelistService_GetLicense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedWhitelistServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedWhitelistServiceServer struct{}
+func (c *whitelistServiceClient) ListLicenses(ctx context.Context, in *ListLicensesRequest, opts ...grpc.CallOption) (*ListLicensesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLicensesResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_ListLicenses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedWhitelistServiceServer) GetAuthToken(context.Context, *emptypb.Empty) (*AuthTokenResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetAuthToken not implemented")
+func (c *whitelistServiceClient) ResetHwid(ctx context.Context, in *ResetHwidRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WhitelistService_ResetHwid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedWhitelistServiceServer) ValidateLicense(context.Context, *ValidateRequest) (*ValidateResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ValidateLicense not implemented")
+
+func (c *whitelistServiceClient) GenerateLicenses(ctx context.Context, in *GenerateLicensesRequest, opts ...grpc.CallOption) (*GenerateLicensesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateLicensesResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_GenerateLicenses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedWhitelistServiceServer) UpdateLicense(context.Context, *UpdateLicenseRequest) (*emptypb.Empty, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateLicense not implemented")
+
+func (c *whitelistServiceClient) BatchUpsertLicenses(ctx context.Context, in *BatchUpsertLicensesRequest, opts ...grpc.CallOption) (*BatchUpsertLicensesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUpsertLicensesResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_BatchUpsertLicenses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedWhitelistServiceServer) DeleteLicense(context.Context, *DeleteLicenseRequest) (*emptypb.Empty, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteLicense not implemented")
+
+func (c *whitelistServiceClient) ExportLicenses(ctx context.Context, in *ExportLicensesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WhitelistService_ServiceDesc.Streams[0], WhitelistService_ExportLicenses_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportLicensesRequest, httpbody.HttpBody]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedWhitelistServiceServer) mustEmbedUnimplementedWhitelistServiceServer() {}
-func (UnimplementedWhitelistServiceServer) testEmbeddedByValue()                          {}
 
-// UnsafeWhitelistServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to WhitelistServiceServer will
-// result in compilation errors.
-type UnsafeWhitelistServiceServer interface {
-	mustEmbedUnimplementedWhitelistServiceServer()
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WhitelistService_ExportLicensesClient = grpc.ServerStreamingClient[httpbody.HttpBody]
+
+func (c *whitelistServiceClient) ImportLicenses(ctx context.Context, in *ImportLicensesRequest, opts ...grpc.CallOption) (*ImportLicensesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportLicensesResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_ImportLicenses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterWhitelistServiceServer(s grpc.ServiceRegistrar, srv WhitelistServiceServer) {
-	// If the following call panics, it indicates UnimplementedWhitelistServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func (c *whitelistServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	s.RegisterService(&WhitelistService_ServiceDesc, srv)
+	return out, nil
 }
 
-func _WhitelistService_GetAuthToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
-	if err := dec(in); err != nil {
+func (c *whitelistServiceClient) ResellerGenerateLicense(ctx context.Context, in *ResellerGenerateLicenseRequest, opts ...grpc.CallOption) (*ResellerGenerateLicenseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResellerGenerateLicenseResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_ResellerGenerateLicense_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(WhitelistServiceServer).GetAuthToken(ctx, in)
+	return out, nil
+}
+
+func (c *whitelistServiceClient) CreateReseller(ctx context.Context, in *CreateResellerRequest, opts ...grpc.CallOption) (*CreateResellerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateResellerResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_CreateReseller_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: WhitelistService_GetAuthToken_FullMethodName,
+	return out, nil
+}
+
+func (c *whitelistServiceClient) TopUpResellerCredits(ctx context.Context, in *TopUpResellerCreditsRequest, opts ...grpc.CallOption) (*Reseller, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reseller)
+	err := c.cc.Invoke(ctx, WhitelistService_TopUpResellerCredits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WhitelistServiceServer).GetAuthToken(ctx, req.(*emptypb.Empty))
+	return out, nil
+}
+
+func (c *whitelistServiceClient) ListResellerActivity(ctx context.Context, in *ListResellerActivityRequest, opts ...grpc.CallOption) (*ListResellerActivityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResellerActivityResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_ListResellerActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _WhitelistService_ValidateLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidateRequest)
-	if err := dec(in); err != nil {
+func (c *whitelistServiceClient) AdminLogin(ctx context.Context, in *AdminLoginRequest, opts ...grpc.CallOption) (*AdminLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminLoginResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_AdminLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(WhitelistServiceServer).ValidateLicense(ctx, in)
+	return out, nil
+}
+
+func (c *whitelistServiceClient) CreateAdmin(ctx context.Context, in *CreateAdminRequest, opts ...grpc.CallOption) (*Admin, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Admin)
+	err := c.cc.Invoke(ctx, WhitelistService_CreateAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: WhitelistService_ValidateLicense_FullMethodName,
+	return out, nil
+}
+
+func (c *whitelistServiceClient) UpdateAdmin(ctx context.Context, in *UpdateAdminRequest, opts ...grpc.CallOption) (*Admin, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Admin)
+	err := c.cc.Invoke(ctx, WhitelistService_UpdateAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WhitelistServiceServer).ValidateLicense(ctx, req.(*ValidateRequest))
+	return out, nil
+}
+
+func (c *whitelistServiceClient) ListAdmins(ctx context.Context, in *ListAdminsRequest, opts ...grpc.CallOption) (*ListAdminsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAdminsResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_ListAdmins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _WhitelistService_UpdateLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateLicenseRequest)
-	if err := dec(in); err != nil {
+func (c *whitelistServiceClient) AdminLogout(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WhitelistService_AdminLogout_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(WhitelistServiceServer).UpdateLicense(ctx, in)
+	return out, nil
+}
+
+func (c *whitelistServiceClient) ListAdminSessions(ctx context.Context, in *ListAdminSessionsRequest, opts ...grpc.CallOption) (*ListAdminSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAdminSessionsResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_ListAdminSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: WhitelistService_UpdateLicense_FullMethodName,
+	return out, nil
+}
+
+func (c *whitelistServiceClient) RevokeAdminSession(ctx context.Context, in *RevokeAdminSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WhitelistService_RevokeAdminSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WhitelistServiceServer).UpdateLicense(ctx, req.(*UpdateLicenseRequest))
+	return out, nil
+}
+
+func (c *whitelistServiceClient) ExportLicenseFile(ctx context.Context, in *ExportLicenseFileRequest, opts ...grpc.CallOption) (*ExportLicenseFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportLicenseFileResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_ExportLicenseFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _WhitelistService_DeleteLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteLicenseRequest)
-	if err := dec(in); err != nil {
+func (c *whitelistServiceClient) StartSession(ctx context.Context, in *StartSessionRequest, opts ...grpc.CallOption) (*StartSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartSessionResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_StartSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(WhitelistServiceServer).DeleteLicense(ctx, in)
+	return out, nil
+}
+
+func (c *whitelistServiceClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HeartbeatResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_Heartbeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: WhitelistService_DeleteLicense_FullMethodName,
+	return out, nil
+}
+
+func (c *whitelistServiceClient) EndSession(ctx context.Context, in *EndSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WhitelistService_EndSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WhitelistServiceServer).DeleteLicense(ctx, req.(*DeleteLicenseRequest))
+	return out, nil
+}
+
+func (c *whitelistServiceClient) WatchLicense(ctx context.Context, in *WatchLicenseRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LicenseStatusEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WhitelistService_ServiceDesc.Streams[1], WhitelistService_WatchLicense_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	x := &grpc.GenericClientStream[WatchLicenseRequest, LicenseStatusEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-// WhitelistService_ServiceDesc is the grpc.ServiceDesc for WhitelistService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var WhitelistService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "whitelist.WhitelistService",
-	HandlerType: (*WhitelistServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetAuthToken",
-			Handler:    _WhitelistService_GetAuthToken_Handler,
-		},
-		{
-			MethodName: "ValidateLicense",
-			Handler:    _WhitelistService_ValidateLicense_Handler,
-		},
-		{
-			MethodName: "UpdateLicense",
-			Handler:    _WhitelistService_UpdateLicense_Handler,
-		},
-		{
-			MethodName: "DeleteLicense",
-			Handler:    _WhitelistService_DeleteLicense_Handler,
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WhitelistService_WatchLicenseClient = grpc.ServerStreamingClient[LicenseStatusEvent]
+
+func (c *whitelistServiceClient) ValidateLicenses(ctx context.Context, in *ValidateLicensesRequest, opts ...grpc.CallOption) (*ValidateLicensesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateLicensesResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_ValidateLicenses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*Product, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Product)
+	err := c.cc.Invoke(ctx, WhitelistService_CreateProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*Product, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Product)
+	err := c.cc.Invoke(ctx, WhitelistService_UpdateProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductsResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_ListProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WhitelistService_DeleteProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) GetLatestVersion(ctx context.Context, in *GetLatestVersionRequest, opts ...grpc.CallOption) (*Release, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Release)
+	err := c.cc.Invoke(ctx, WhitelistService_GetLatestVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) PublishRelease(ctx context.Context, in *PublishReleaseRequest, opts ...grpc.CallOption) (*Release, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Release)
+	err := c.cc.Invoke(ctx, WhitelistService_PublishRelease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) SetLicenseChannel(ctx context.Context, in *SetLicenseChannelRequest, opts ...grpc.CallOption) (*License, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(License)
+	err := c.cc.Invoke(ctx, WhitelistService_SetLicenseChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) CreateCustomer(ctx context.Context, in *CreateCustomerRequest, opts ...grpc.CallOption) (*Customer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Customer)
+	err := c.cc.Invoke(ctx, WhitelistService_CreateCustomer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) ListCustomers(ctx context.Context, in *ListCustomersRequest, opts ...grpc.CallOption) (*ListCustomersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCustomersResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_ListCustomers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) AttachLicense(ctx context.Context, in *AttachLicenseRequest, opts ...grpc.CallOption) (*License, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(License)
+	err := c.cc.Invoke(ctx, WhitelistService_AttachLicense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) DetachLicense(ctx context.Context, in *DetachLicenseRequest, opts ...grpc.CallOption) (*License, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(License)
+	err := c.cc.Invoke(ctx, WhitelistService_DetachLicense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) IssueLicenseToEmail(ctx context.Context, in *IssueLicenseToEmailRequest, opts ...grpc.CallOption) (*IssueLicenseToEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueLicenseToEmailResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_IssueLicenseToEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) ListLicenseDeliveries(ctx context.Context, in *ListLicenseDeliveriesRequest, opts ...grpc.CallOption) (*ListLicenseDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLicenseDeliveriesResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_ListLicenseDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) CreateTrialLicense(ctx context.Context, in *CreateTrialLicenseRequest, opts ...grpc.CallOption) (*CreateTrialLicenseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTrialLicenseResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_CreateTrialLicense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) ExtendLicense(ctx context.Context, in *ExtendLicenseRequest, opts ...grpc.CallOption) (*License, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(License)
+	err := c.cc.Invoke(ctx, WhitelistService_ExtendLicense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) ResellerExtendLicense(ctx context.Context, in *ExtendLicenseRequest, opts ...grpc.CallOption) (*ResellerExtendLicenseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResellerExtendLicenseResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_ResellerExtendLicense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) SuspendLicense(ctx context.Context, in *SuspendLicenseRequest, opts ...grpc.CallOption) (*License, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(License)
+	err := c.cc.Invoke(ctx, WhitelistService_SuspendLicense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) UnsuspendLicense(ctx context.Context, in *UnsuspendLicenseRequest, opts ...grpc.CallOption) (*License, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(License)
+	err := c.cc.Invoke(ctx, WhitelistService_UnsuspendLicense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) BanHwid(ctx context.Context, in *BanHwidRequest, opts ...grpc.CallOption) (*HwidBan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HwidBan)
+	err := c.cc.Invoke(ctx, WhitelistService_BanHwid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) UnbanHwid(ctx context.Context, in *UnbanHwidRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WhitelistService_UnbanHwid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) ListHwidBans(ctx context.Context, in *ListHwidBansRequest, opts ...grpc.CallOption) (*ListHwidBansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHwidBansResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_ListHwidBans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) BanIp(ctx context.Context, in *BanIpRequest, opts ...grpc.CallOption) (*IpBan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IpBan)
+	err := c.cc.Invoke(ctx, WhitelistService_BanIp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) UnbanIp(ctx context.Context, in *UnbanIpRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WhitelistService_UnbanIp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) ListIpBans(ctx context.Context, in *ListIpBansRequest, opts ...grpc.CallOption) (*ListIpBansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIpBansResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_ListIpBans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) SetLicenseCountries(ctx context.Context, in *SetLicenseCountriesRequest, opts ...grpc.CallOption) (*License, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(License)
+	err := c.cc.Invoke(ctx, WhitelistService_SetLicenseCountries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) ClearLockouts(ctx context.Context, in *ClearLockoutsRequest, opts ...grpc.CallOption) (*ClearLockoutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearLockoutsResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_ClearLockouts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) RotateProductSigningSecret(ctx context.Context, in *RotateProductSigningSecretRequest, opts ...grpc.CallOption) (*RotateProductSigningSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateProductSigningSecretResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_RotateProductSigningSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) RemoveProductSigningSecret(ctx context.Context, in *RemoveProductSigningSecretRequest, opts ...grpc.CallOption) (*Product, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Product)
+	err := c.cc.Invoke(ctx, WhitelistService_RemoveProductSigningSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) GetChallenge(ctx context.Context, in *GetChallengeRequest, opts ...grpc.CallOption) (*GetChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChallengeResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_GetChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) RevokeRefreshTokens(ctx context.Context, in *RevokeRefreshTokensRequest, opts ...grpc.CallOption) (*RevokeRefreshTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeRefreshTokensResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_RevokeRefreshTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) RotateAdminSecret(ctx context.Context, in *RotateAdminSecretRequest, opts ...grpc.CallOption) (*RotateAdminSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateAdminSecretResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_RotateAdminSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) EnrollAdminTotp(ctx context.Context, in *EnrollAdminTotpRequest, opts ...grpc.CallOption) (*EnrollAdminTotpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollAdminTotpResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_EnrollAdminTotp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) ExportAuditLog(ctx context.Context, in *ExportAuditLogRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WhitelistService_ServiceDesc.Streams[2], WhitelistService_ExportAuditLog_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportAuditLogRequest, httpbody.HttpBody]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WhitelistService_ExportAuditLogClient = grpc.ServerStreamingClient[httpbody.HttpBody]
+
+func (c *whitelistServiceClient) GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStatsResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_GetStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) ListValidationEvents(ctx context.Context, in *ListValidationEventsRequest, opts ...grpc.CallOption) (*ListValidationEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListValidationEventsResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_ListValidationEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) SearchLicenses(ctx context.Context, in *SearchLicensesRequest, opts ...grpc.CallOption) (*SearchLicensesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchLicensesResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_SearchLicenses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) RestoreLicense(ctx context.Context, in *RestoreLicenseRequest, opts ...grpc.CallOption) (*License, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(License)
+	err := c.cc.Invoke(ctx, WhitelistService_RestoreLicense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) PurgeLicense(ctx context.Context, in *PurgeLicenseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WhitelistService_PurgeLicense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) GetLicenseHistory(ctx context.Context, in *GetLicenseHistoryRequest, opts ...grpc.CallOption) (*GetLicenseHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLicenseHistoryResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_GetLicenseHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) ImportExternalLicenses(ctx context.Context, in *ImportExternalLicensesRequest, opts ...grpc.CallOption) (*ImportLicensesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportLicensesResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_ImportExternalLicenses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) BulkSuspendByProduct(ctx context.Context, in *BulkSuspendByProductRequest, opts ...grpc.CallOption) (*BulkOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkOperationResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_BulkSuspendByProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) BulkDeleteByProduct(ctx context.Context, in *BulkDeleteByProductRequest, opts ...grpc.CallOption) (*BulkOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkOperationResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_BulkDeleteByProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) BulkExtendByProduct(ctx context.Context, in *BulkExtendByProductRequest, opts ...grpc.CallOption) (*BulkOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkOperationResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_BulkExtendByProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) SetMaintenanceMode(ctx context.Context, in *SetMaintenanceModeRequest, opts ...grpc.CallOption) (*MaintenanceMode, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MaintenanceMode)
+	err := c.cc.Invoke(ctx, WhitelistService_SetMaintenanceMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) GetMaintenanceMode(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MaintenanceMode, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MaintenanceMode)
+	err := c.cc.Invoke(ctx, WhitelistService_GetMaintenanceMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) SetProductMessages(ctx context.Context, in *SetProductMessagesRequest, opts ...grpc.CallOption) (*ProductMessages, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductMessages)
+	err := c.cc.Invoke(ctx, WhitelistService_SetProductMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) GetProductMessages(ctx context.Context, in *GetProductMessagesRequest, opts ...grpc.CallOption) (*ProductMessages, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductMessages)
+	err := c.cc.Invoke(ctx, WhitelistService_GetProductMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) ListMyDevices(ctx context.Context, in *ListMyDevicesRequest, opts ...grpc.CallOption) (*ListMyDevicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyDevicesResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_ListMyDevices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) DeactivateDevice(ctx context.Context, in *DeactivateDeviceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WhitelistService_DeactivateDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) SetLicenseFloatingSeats(ctx context.Context, in *SetLicenseFloatingSeatsRequest, opts ...grpc.CallOption) (*License, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(License)
+	err := c.cc.Invoke(ctx, WhitelistService_SetLicenseFloatingSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) CheckoutLicense(ctx context.Context, in *CheckoutLicenseRequest, opts ...grpc.CallOption) (*CheckoutLicenseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckoutLicenseResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_CheckoutLicense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) CheckinLicense(ctx context.Context, in *CheckinLicenseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WhitelistService_CheckinLicense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) ConsumeCredits(ctx context.Context, in *ConsumeCreditsRequest, opts ...grpc.CallOption) (*ConsumeCreditsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConsumeCreditsResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_ConsumeCredits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) TopUpLicenseCredits(ctx context.Context, in *TopUpLicenseCreditsRequest, opts ...grpc.CallOption) (*License, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(License)
+	err := c.cc.Invoke(ctx, WhitelistService_TopUpLicenseCredits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) ListLicenseCreditActivity(ctx context.Context, in *ListLicenseCreditActivityRequest, opts ...grpc.CallOption) (*ListLicenseCreditActivityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLicenseCreditActivityResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_ListLicenseCreditActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) SetLicenseFeatures(ctx context.Context, in *SetLicenseFeaturesRequest, opts ...grpc.CallOption) (*License, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(License)
+	err := c.cc.Invoke(ctx, WhitelistService_SetLicenseFeatures_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) CreatePlan(ctx context.Context, in *CreatePlanRequest, opts ...grpc.CallOption) (*Plan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Plan)
+	err := c.cc.Invoke(ctx, WhitelistService_CreatePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) UpdatePlan(ctx context.Context, in *UpdatePlanRequest, opts ...grpc.CallOption) (*Plan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Plan)
+	err := c.cc.Invoke(ctx, WhitelistService_UpdatePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) ListPlans(ctx context.Context, in *ListPlansRequest, opts ...grpc.CallOption) (*ListPlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPlansResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_ListPlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) DeletePlan(ctx context.Context, in *DeletePlanRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WhitelistService_DeletePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) GetServerTime(ctx context.Context, in *GetServerTimeRequest, opts ...grpc.CallOption) (*GetServerTimeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetServerTimeResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_GetServerTime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) GetPublicKey(ctx context.Context, in *GetPublicKeyRequest, opts ...grpc.CallOption) (*GetPublicKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublicKeyResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_GetPublicKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *whitelistServiceClient) GetSigningKeys(ctx context.Context, in *GetSigningKeysRequest, opts ...grpc.CallOption) (*GetSigningKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSigningKeysResponse)
+	err := c.cc.Invoke(ctx, WhitelistService_GetSigningKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WhitelistServiceServer is the server API for WhitelistService service.
+// All implementations must embed UnimplementedWhitelistServiceServer
+// for forward compatibility.
+type WhitelistServiceServer interface {
+	// 1. Get Token (Now requires API Key)
+	GetAuthToken(context.Context, *GetTokenRequest) (*AuthTokenResponse, error)
+	// 2. Validate License
+	ValidateLicense(context.Context, *ValidateRequest) (*ValidateResponse, error)
+	// 3. Create/Update License (Admin)
+	UpdateLicense(context.Context, *UpdateLicenseRequest) (*emptypb.Empty, error)
+	// 4. Delete License (Admin). Restorable until purged.
+	DeleteLicense(context.Context, *DeleteLicenseRequest) (*emptypb.Empty, error)
+	// 5. Get License (Admin)
+	GetLicense(context.Context, *GetLicenseRequest) (*License, error)
+	// 6. List Licenses (Admin)
+	ListLicenses(context.Context, *ListLicensesRequest) (*ListLicensesResponse, error)
+	// 7. Reset HWID bindings (Admin)
+	ResetHwid(context.Context, *ResetHwidRequest) (*emptypb.Empty, error)
+	// 8. Generate License Keys (Admin)
+	GenerateLicenses(context.Context, *GenerateLicensesRequest) (*GenerateLicensesResponse, error)
+	// 9. Create/Update many licenses in one transaction (Admin)
+	BatchUpsertLicenses(context.Context, *BatchUpsertLicensesRequest) (*BatchUpsertLicensesResponse, error)
+	// 10. Export licenses as CSV (Admin)
+	ExportLicenses(*ExportLicensesRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error
+	// 11. Import licenses from CSV (Admin)
+	ImportLicenses(context.Context, *ImportLicensesRequest) (*ImportLicensesResponse, error)
+	// 12. List Audit Log (Admin)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	// 13. Generate License Keys against a credit balance (Reseller, x-reseller-key header)
+	ResellerGenerateLicense(context.Context, *ResellerGenerateLicenseRequest) (*ResellerGenerateLicenseResponse, error)
+	// 14. Create Reseller (Admin)
+	CreateReseller(context.Context, *CreateResellerRequest) (*CreateResellerResponse, error)
+	// 15. Add (or remove) Reseller Credits (Admin)
+	TopUpResellerCredits(context.Context, *TopUpResellerCreditsRequest) (*Reseller, error)
+	// 16. List Reseller Credit Activity (Admin)
+	ListResellerActivity(context.Context, *ListResellerActivityRequest) (*ListResellerActivityResponse, error)
+	// 17. Admin Login, returns a bearer token for the Authorization header
+	AdminLogin(context.Context, *AdminLoginRequest) (*AdminLoginResponse, error)
+	// 18. Create Admin Account (Owner)
+	CreateAdmin(context.Context, *CreateAdminRequest) (*Admin, error)
+	// 19. Change role, password or disabled flag of an Admin Account (Owner)
+	UpdateAdmin(context.Context, *UpdateAdminRequest) (*Admin, error)
+	// 20. List Admin Accounts (Owner)
+	ListAdmins(context.Context, *ListAdminsRequest) (*ListAdminsResponse, error)
+	// 21. Admin Logout, ends the session of the calling token
+	AdminLogout(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// 22. List Admin Sessions (own sessions, or anyone's for owners)
+	ListAdminSessions(context.Context, *ListAdminSessionsRequest) (*ListAdminSessionsResponse, error)
+	// 23. Revoke an Admin Session (own sessions, or anyone's for owners)
+	RevokeAdminSession(context.Context, *RevokeAdminSessionRequest) (*emptypb.Empty, error)
+	// 24. Export a signed license file for offline validation (Support)
+	ExportLicenseFile(context.Context, *ExportLicenseFileRequest) (*ExportLicenseFileResponse, error)
+	// 25. Start a Session, validating the license and taking a concurrent-use slot
+	StartSession(context.Context, *StartSessionRequest) (*StartSessionResponse, error)
+	// 26. Heartbeat keeps a Session alive
+	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
+	// 27. End a Session, freeing its slot
+	EndSession(context.Context, *EndSessionRequest) (*emptypb.Empty, error)
+	// 28. Watch a License, streaming its status whenever it changes
+	WatchLicense(*WatchLicenseRequest, grpc.ServerStreamingServer[LicenseStatusEvent]) error
+	// 29. Validate several Licenses with one access token
+	ValidateLicenses(context.Context, *ValidateLicensesRequest) (*ValidateLicensesResponse, error)
+	// 30. Create a Product (Owner)
+	CreateProduct(context.Context, *CreateProductRequest) (*Product, error)
+	// 31. Update a Product's name, description or disabled flag (Owner)
+	UpdateProduct(context.Context, *UpdateProductRequest) (*Product, error)
+	// 32. List Products (Admin)
+	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
+	// 33. Delete a Product without licenses (Owner)
+	DeleteProduct(context.Context, *DeleteProductRequest) (*emptypb.Empty, error)
+	// 34. Get the latest Release of a Product for self-updating clients
+	GetLatestVersion(context.Context, *GetLatestVersionRequest) (*Release, error)
+	// 35. Publish a Release on one of a Product's channels (Owner)
+	PublishRelease(context.Context, *PublishReleaseRequest) (*Release, error)
+	// 36. Pin a License to an update channel (Admin)
+	SetLicenseChannel(context.Context, *SetLicenseChannelRequest) (*License, error)
+	// 37. Create a Customer (Admin)
+	CreateCustomer(context.Context, *CreateCustomerRequest) (*Customer, error)
+	// 38. List or look up Customers (Admin)
+	ListCustomers(context.Context, *ListCustomersRequest) (*ListCustomersResponse, error)
+	// 39. Attach a License to a Customer (Admin)
+	AttachLicense(context.Context, *AttachLicenseRequest) (*License, error)
+	// 40. Detach a License from its Customer (Admin)
+	DetachLicense(context.Context, *DetachLicenseRequest) (*License, error)
+	// 41. Email a new or existing License key to a customer (Admin)
+	IssueLicenseToEmail(context.Context, *IssueLicenseToEmailRequest) (*IssueLicenseToEmailResponse, error)
+	// 42. List the emails sent for a License (Admin)
+	ListLicenseDeliveries(context.Context, *ListLicenseDeliveriesRequest) (*ListLicenseDeliveriesResponse, error)
+	// 43. Start a self-service trial of a Product on this device
+	CreateTrialLicense(context.Context, *CreateTrialLicenseRequest) (*CreateTrialLicenseResponse, error)
+	// 44. Push a License's expiry forward (Admin)
+	ExtendLicense(context.Context, *ExtendLicenseRequest) (*License, error)
+	// 45. Extend a License the reseller generated, for one credit (Reseller, x-reseller-key header)
+	ResellerExtendLicense(context.Context, *ExtendLicenseRequest) (*ResellerExtendLicenseResponse, error)
+	// 46. Suspend a License, with a reason shown to its users (Admin)
+	SuspendLicense(context.Context, *SuspendLicenseRequest) (*License, error)
+	// 47. Reactivate a suspended License (Admin)
+	UnsuspendLicense(context.Context, *UnsuspendLicenseRequest) (*License, error)
+	// 48. Ban a HWID from validating any License (Admin)
+	BanHwid(context.Context, *BanHwidRequest) (*HwidBan, error)
+	// 49. Lift a HWID ban (Admin)
+	UnbanHwid(context.Context, *UnbanHwidRequest) (*emptypb.Empty, error)
+	// 50. List banned HWIDs (Admin)
+	ListHwidBans(context.Context, *ListHwidBansRequest) (*ListHwidBansResponse, error)
+	// 51. Ban an IP address or CIDR range from the public endpoints (Admin)
+	BanIp(context.Context, *BanIpRequest) (*IpBan, error)
+	// 52. Lift an IP ban (Admin)
+	UnbanIp(context.Context, *UnbanIpRequest) (*emptypb.Empty, error)
+	// 53. List IP bans in force (Admin)
+	ListIpBans(context.Context, *ListIpBansRequest) (*ListIpBansResponse, error)
+	// 54. Set the countries a License may be used from (Admin)
+	SetLicenseCountries(context.Context, *SetLicenseCountriesRequest) (*License, error)
+	// 55. Lift validation lockouts of a License key and/or a client IP (Admin)
+	ClearLockouts(context.Context, *ClearLockoutsRequest) (*ClearLockoutsResponse, error)
+	// 56. Turn on signed requests for a Product, or replace its secret (Admin)
+	RotateProductSigningSecret(context.Context, *RotateProductSigningSecretRequest) (*RotateProductSigningSecretResponse, error)
+	// 57. Turn off signed requests for a Product (Admin)
+	RemoveProductSigningSecret(context.Context, *RemoveProductSigningSecretRequest) (*Product, error)
+	// 58. Get a single-use challenge to send with ValidateLicense (Public)
+	GetChallenge(context.Context, *GetChallengeRequest) (*GetChallengeResponse, error)
+	// 59. Revoke every refresh token issued for an API key (Admin)
+	RevokeRefreshTokens(context.Context, *RevokeRefreshTokensRequest) (*RevokeRefreshTokensResponse, error)
+	// 60. Issue a new shared admin secret and expire the old ones (Admin)
+	RotateAdminSecret(context.Context, *RotateAdminSecretRequest) (*RotateAdminSecretResponse, error)
+	// 61. Set up a TOTP second factor for the caller (Read-only)
+	EnrollAdminTotp(context.Context, *EnrollAdminTotpRequest) (*EnrollAdminTotpResponse, error)
+	// 62. Export the audit log as NDJSON or CSV (Admin)
+	ExportAuditLog(*ExportAuditLogRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error
+	// 63. Validation and token statistics per day (Admin)
+	GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error)
+	// 64. List ValidateLicense attempts (Admin)
+	ListValidationEvents(context.Context, *ListValidationEventsRequest) (*ListValidationEventsResponse, error)
+	// 65. Find licenses from partial details (Admin)
+	SearchLicenses(context.Context, *SearchLicensesRequest) (*SearchLicensesResponse, error)
+	// 66. Undo DeleteLicense (Admin)
+	RestoreLicense(context.Context, *RestoreLicenseRequest) (*License, error)
+	// 67. Remove a deleted license for good (Admin)
+	PurgeLicense(context.Context, *PurgeLicenseRequest) (*emptypb.Empty, error)
+	// 68. Changes to a license, newest first (Admin)
+	GetLicenseHistory(context.Context, *GetLicenseHistoryRequest) (*GetLicenseHistoryResponse, error)
+	// 69. Import licenses, devices and customers from another license system (Admin)
+	ImportExternalLicenses(context.Context, *ImportExternalLicensesRequest) (*ImportLicensesResponse, error)
+	// 70. Suspend every active license of a product (Admin)
+	BulkSuspendByProduct(context.Context, *BulkSuspendByProductRequest) (*BulkOperationResponse, error)
+	// 71. Delete every license of a product (Admin). Restorable until purged.
+	BulkDeleteByProduct(context.Context, *BulkDeleteByProductRequest) (*BulkOperationResponse, error)
+	// 72. Extend every expiring license of a product (Admin)
+	BulkExtendByProduct(context.Context, *BulkExtendByProductRequest) (*BulkOperationResponse, error)
+	// 73. Turn maintenance mode on or off (Admin)
+	SetMaintenanceMode(context.Context, *SetMaintenanceModeRequest) (*MaintenanceMode, error)
+	// 74. Show whether maintenance mode is on (Admin)
+	GetMaintenanceMode(context.Context, *emptypb.Empty) (*MaintenanceMode, error)
+	// 75. Replace a Product's wording of validation messages (Owner)
+	SetProductMessages(context.Context, *SetProductMessagesRequest) (*ProductMessages, error)
+	// 76. Show a Product's wording of validation messages (Admin)
+	GetProductMessages(context.Context, *GetProductMessagesRequest) (*ProductMessages, error)
+	// 77. List the devices bound to a License, for its owner (Public)
+	ListMyDevices(context.Context, *ListMyDevicesRequest) (*ListMyDevicesResponse, error)
+	// 78. Unbind one of a License's devices, freeing its seat, for its owner
+	// (Public)
+	DeactivateDevice(context.Context, *DeactivateDeviceRequest) (*emptypb.Empty, error)
+	// 79. Set the size of a License's floating pool (Admin)
+	SetLicenseFloatingSeats(context.Context, *SetLicenseFloatingSeatsRequest) (*License, error)
+	// 80. Check out a seat of a floating License for this machine, validating
+	// the license
+	CheckoutLicense(context.Context, *CheckoutLicenseRequest) (*CheckoutLicenseResponse, error)
+	// 81. Check a floating seat back in, freeing it for another machine
+	CheckinLicense(context.Context, *CheckinLicenseRequest) (*emptypb.Empty, error)
+	// 82. Spend credits of a pay-per-use License, validating it
+	ConsumeCredits(context.Context, *ConsumeCreditsRequest) (*ConsumeCreditsResponse, error)
+	// 83. Add credits to a License, or take them back (Admin)
+	TopUpLicenseCredits(context.Context, *TopUpLicenseCreditsRequest) (*License, error)
+	// 84. List License Credit Activity (Admin)
+	ListLicenseCreditActivity(context.Context, *ListLicenseCreditActivityRequest) (*ListLicenseCreditActivityResponse, error)
+	// 85. Set the feature flags of a License, on top of its Product's (Admin)
+	SetLicenseFeatures(context.Context, *SetLicenseFeaturesRequest) (*License, error)
+	// 86. Create a Plan licenses can be put on (Owner)
+	CreatePlan(context.Context, *CreatePlanRequest) (*Plan, error)
+	// 87. Update a Plan (Owner)
+	UpdatePlan(context.Context, *UpdatePlanRequest) (*Plan, error)
+	// 88. List Plans (Admin)
+	ListPlans(context.Context, *ListPlansRequest) (*ListPlansResponse, error)
+	// 89. Delete a Plan without licenses (Owner)
+	DeletePlan(context.Context, *DeletePlanRequest) (*emptypb.Empty, error)
+	// 90. Get the server's clock, e.g. to correct signed request timestamps (Public)
+	GetServerTime(context.Context, *GetServerTimeRequest) (*GetServerTimeResponse, error)
+	// 91. Get the public key that validation answers and license files are signed with (Public)
+	GetPublicKey(context.Context, *GetPublicKeyRequest) (*GetPublicKeyResponse, error)
+	// 92. List the public keys clients should accept, current and retired, JWKS-style (Public)
+	GetSigningKeys(context.Context, *GetSigningKeysRequest) (*GetSigningKeysResponse, error)
+	mustEmbedUnimplementedWhitelistServiceServer()
+}
+
+// UnimplementedWhitelistServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWhitelistServiceServer struct{}
+
+func (UnimplementedWhitelistServiceServer) GetAuthToken(context.Context, *GetTokenRequest) (*AuthTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAuthToken not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ValidateLicense(context.Context, *ValidateRequest) (*ValidateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidateLicense not implemented")
+}
+func (UnimplementedWhitelistServiceServer) UpdateLicense(context.Context, *UpdateLicenseRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateLicense not implemented")
+}
+func (UnimplementedWhitelistServiceServer) DeleteLicense(context.Context, *DeleteLicenseRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteLicense not implemented")
+}
+func (UnimplementedWhitelistServiceServer) GetLicense(context.Context, *GetLicenseRequest) (*License, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLicense not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ListLicenses(context.Context, *ListLicensesRequest) (*ListLicensesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLicenses not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ResetHwid(context.Context, *ResetHwidRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetHwid not implemented")
+}
+func (UnimplementedWhitelistServiceServer) GenerateLicenses(context.Context, *GenerateLicensesRequest) (*GenerateLicensesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateLicenses not implemented")
+}
+func (UnimplementedWhitelistServiceServer) BatchUpsertLicenses(context.Context, *BatchUpsertLicensesRequest) (*BatchUpsertLicensesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchUpsertLicenses not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ExportLicenses(*ExportLicensesRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	return status.Error(codes.Unimplemented, "method ExportLicenses not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ImportLicenses(context.Context, *ImportLicensesRequest) (*ImportLicensesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportLicenses not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ResellerGenerateLicense(context.Context, *ResellerGenerateLicenseRequest) (*ResellerGenerateLicenseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResellerGenerateLicense not implemented")
+}
+func (UnimplementedWhitelistServiceServer) CreateReseller(context.Context, *CreateResellerRequest) (*CreateResellerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateReseller not implemented")
+}
+func (UnimplementedWhitelistServiceServer) TopUpResellerCredits(context.Context, *TopUpResellerCreditsRequest) (*Reseller, error) {
+	return nil, status.Error(codes.Unimplemented, "method TopUpResellerCredits not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ListResellerActivity(context.Context, *ListResellerActivityRequest) (*ListResellerActivityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListResellerActivity not implemented")
+}
+func (UnimplementedWhitelistServiceServer) AdminLogin(context.Context, *AdminLoginRequest) (*AdminLoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminLogin not implemented")
+}
+func (UnimplementedWhitelistServiceServer) CreateAdmin(context.Context, *CreateAdminRequest) (*Admin, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAdmin not implemented")
+}
+func (UnimplementedWhitelistServiceServer) UpdateAdmin(context.Context, *UpdateAdminRequest) (*Admin, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAdmin not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ListAdmins(context.Context, *ListAdminsRequest) (*ListAdminsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAdmins not implemented")
+}
+func (UnimplementedWhitelistServiceServer) AdminLogout(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminLogout not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ListAdminSessions(context.Context, *ListAdminSessionsRequest) (*ListAdminSessionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAdminSessions not implemented")
+}
+func (UnimplementedWhitelistServiceServer) RevokeAdminSession(context.Context, *RevokeAdminSessionRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeAdminSession not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ExportLicenseFile(context.Context, *ExportLicenseFileRequest) (*ExportLicenseFileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportLicenseFile not implemented")
+}
+func (UnimplementedWhitelistServiceServer) StartSession(context.Context, *StartSessionRequest) (*StartSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartSession not implemented")
+}
+func (UnimplementedWhitelistServiceServer) Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedWhitelistServiceServer) EndSession(context.Context, *EndSessionRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method EndSession not implemented")
+}
+func (UnimplementedWhitelistServiceServer) WatchLicense(*WatchLicenseRequest, grpc.ServerStreamingServer[LicenseStatusEvent]) error {
+	return status.Error(codes.Unimplemented, "method WatchLicense not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ValidateLicenses(context.Context, *ValidateLicensesRequest) (*ValidateLicensesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidateLicenses not implemented")
+}
+func (UnimplementedWhitelistServiceServer) CreateProduct(context.Context, *CreateProductRequest) (*Product, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateProduct not implemented")
+}
+func (UnimplementedWhitelistServiceServer) UpdateProduct(context.Context, *UpdateProductRequest) (*Product, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateProduct not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListProducts not implemented")
+}
+func (UnimplementedWhitelistServiceServer) DeleteProduct(context.Context, *DeleteProductRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteProduct not implemented")
+}
+func (UnimplementedWhitelistServiceServer) GetLatestVersion(context.Context, *GetLatestVersionRequest) (*Release, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLatestVersion not implemented")
+}
+func (UnimplementedWhitelistServiceServer) PublishRelease(context.Context, *PublishReleaseRequest) (*Release, error) {
+	return nil, status.Error(codes.Unimplemented, "method PublishRelease not implemented")
+}
+func (UnimplementedWhitelistServiceServer) SetLicenseChannel(context.Context, *SetLicenseChannelRequest) (*License, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetLicenseChannel not implemented")
+}
+func (UnimplementedWhitelistServiceServer) CreateCustomer(context.Context, *CreateCustomerRequest) (*Customer, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCustomer not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ListCustomers(context.Context, *ListCustomersRequest) (*ListCustomersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCustomers not implemented")
+}
+func (UnimplementedWhitelistServiceServer) AttachLicense(context.Context, *AttachLicenseRequest) (*License, error) {
+	return nil, status.Error(codes.Unimplemented, "method AttachLicense not implemented")
+}
+func (UnimplementedWhitelistServiceServer) DetachLicense(context.Context, *DetachLicenseRequest) (*License, error) {
+	return nil, status.Error(codes.Unimplemented, "method DetachLicense not implemented")
+}
+func (UnimplementedWhitelistServiceServer) IssueLicenseToEmail(context.Context, *IssueLicenseToEmailRequest) (*IssueLicenseToEmailResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method IssueLicenseToEmail not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ListLicenseDeliveries(context.Context, *ListLicenseDeliveriesRequest) (*ListLicenseDeliveriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLicenseDeliveries not implemented")
+}
+func (UnimplementedWhitelistServiceServer) CreateTrialLicense(context.Context, *CreateTrialLicenseRequest) (*CreateTrialLicenseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTrialLicense not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ExtendLicense(context.Context, *ExtendLicenseRequest) (*License, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExtendLicense not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ResellerExtendLicense(context.Context, *ExtendLicenseRequest) (*ResellerExtendLicenseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResellerExtendLicense not implemented")
+}
+func (UnimplementedWhitelistServiceServer) SuspendLicense(context.Context, *SuspendLicenseRequest) (*License, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuspendLicense not implemented")
+}
+func (UnimplementedWhitelistServiceServer) UnsuspendLicense(context.Context, *UnsuspendLicenseRequest) (*License, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnsuspendLicense not implemented")
+}
+func (UnimplementedWhitelistServiceServer) BanHwid(context.Context, *BanHwidRequest) (*HwidBan, error) {
+	return nil, status.Error(codes.Unimplemented, "method BanHwid not implemented")
+}
+func (UnimplementedWhitelistServiceServer) UnbanHwid(context.Context, *UnbanHwidRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnbanHwid not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ListHwidBans(context.Context, *ListHwidBansRequest) (*ListHwidBansResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListHwidBans not implemented")
+}
+func (UnimplementedWhitelistServiceServer) BanIp(context.Context, *BanIpRequest) (*IpBan, error) {
+	return nil, status.Error(codes.Unimplemented, "method BanIp not implemented")
+}
+func (UnimplementedWhitelistServiceServer) UnbanIp(context.Context, *UnbanIpRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnbanIp not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ListIpBans(context.Context, *ListIpBansRequest) (*ListIpBansResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListIpBans not implemented")
+}
+func (UnimplementedWhitelistServiceServer) SetLicenseCountries(context.Context, *SetLicenseCountriesRequest) (*License, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetLicenseCountries not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ClearLockouts(context.Context, *ClearLockoutsRequest) (*ClearLockoutsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClearLockouts not implemented")
+}
+func (UnimplementedWhitelistServiceServer) RotateProductSigningSecret(context.Context, *RotateProductSigningSecretRequest) (*RotateProductSigningSecretResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateProductSigningSecret not implemented")
+}
+func (UnimplementedWhitelistServiceServer) RemoveProductSigningSecret(context.Context, *RemoveProductSigningSecretRequest) (*Product, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveProductSigningSecret not implemented")
+}
+func (UnimplementedWhitelistServiceServer) GetChallenge(context.Context, *GetChallengeRequest) (*GetChallengeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetChallenge not implemented")
+}
+func (UnimplementedWhitelistServiceServer) RevokeRefreshTokens(context.Context, *RevokeRefreshTokensRequest) (*RevokeRefreshTokensResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeRefreshTokens not implemented")
+}
+func (UnimplementedWhitelistServiceServer) RotateAdminSecret(context.Context, *RotateAdminSecretRequest) (*RotateAdminSecretResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateAdminSecret not implemented")
+}
+func (UnimplementedWhitelistServiceServer) EnrollAdminTotp(context.Context, *EnrollAdminTotpRequest) (*EnrollAdminTotpResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EnrollAdminTotp not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ExportAuditLog(*ExportAuditLogRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	return status.Error(codes.Unimplemented, "method ExportAuditLog not implemented")
+}
+func (UnimplementedWhitelistServiceServer) GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStats not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ListValidationEvents(context.Context, *ListValidationEventsRequest) (*ListValidationEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListValidationEvents not implemented")
+}
+func (UnimplementedWhitelistServiceServer) SearchLicenses(context.Context, *SearchLicensesRequest) (*SearchLicensesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchLicenses not implemented")
+}
+func (UnimplementedWhitelistServiceServer) RestoreLicense(context.Context, *RestoreLicenseRequest) (*License, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreLicense not implemented")
+}
+func (UnimplementedWhitelistServiceServer) PurgeLicense(context.Context, *PurgeLicenseRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeLicense not implemented")
+}
+func (UnimplementedWhitelistServiceServer) GetLicenseHistory(context.Context, *GetLicenseHistoryRequest) (*GetLicenseHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLicenseHistory not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ImportExternalLicenses(context.Context, *ImportExternalLicensesRequest) (*ImportLicensesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportExternalLicenses not implemented")
+}
+func (UnimplementedWhitelistServiceServer) BulkSuspendByProduct(context.Context, *BulkSuspendByProductRequest) (*BulkOperationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BulkSuspendByProduct not implemented")
+}
+func (UnimplementedWhitelistServiceServer) BulkDeleteByProduct(context.Context, *BulkDeleteByProductRequest) (*BulkOperationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BulkDeleteByProduct not implemented")
+}
+func (UnimplementedWhitelistServiceServer) BulkExtendByProduct(context.Context, *BulkExtendByProductRequest) (*BulkOperationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BulkExtendByProduct not implemented")
+}
+func (UnimplementedWhitelistServiceServer) SetMaintenanceMode(context.Context, *SetMaintenanceModeRequest) (*MaintenanceMode, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMaintenanceMode not implemented")
+}
+func (UnimplementedWhitelistServiceServer) GetMaintenanceMode(context.Context, *emptypb.Empty) (*MaintenanceMode, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMaintenanceMode not implemented")
+}
+func (UnimplementedWhitelistServiceServer) SetProductMessages(context.Context, *SetProductMessagesRequest) (*ProductMessages, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetProductMessages not implemented")
+}
+func (UnimplementedWhitelistServiceServer) GetProductMessages(context.Context, *GetProductMessagesRequest) (*ProductMessages, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProductMessages not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ListMyDevices(context.Context, *ListMyDevicesRequest) (*ListMyDevicesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMyDevices not implemented")
+}
+func (UnimplementedWhitelistServiceServer) DeactivateDevice(context.Context, *DeactivateDeviceRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeactivateDevice not implemented")
+}
+func (UnimplementedWhitelistServiceServer) SetLicenseFloatingSeats(context.Context, *SetLicenseFloatingSeatsRequest) (*License, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetLicenseFloatingSeats not implemented")
+}
+func (UnimplementedWhitelistServiceServer) CheckoutLicense(context.Context, *CheckoutLicenseRequest) (*CheckoutLicenseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckoutLicense not implemented")
+}
+func (UnimplementedWhitelistServiceServer) CheckinLicense(context.Context, *CheckinLicenseRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckinLicense not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ConsumeCredits(context.Context, *ConsumeCreditsRequest) (*ConsumeCreditsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConsumeCredits not implemented")
+}
+func (UnimplementedWhitelistServiceServer) TopUpLicenseCredits(context.Context, *TopUpLicenseCreditsRequest) (*License, error) {
+	return nil, status.Error(codes.Unimplemented, "method TopUpLicenseCredits not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ListLicenseCreditActivity(context.Context, *ListLicenseCreditActivityRequest) (*ListLicenseCreditActivityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLicenseCreditActivity not implemented")
+}
+func (UnimplementedWhitelistServiceServer) SetLicenseFeatures(context.Context, *SetLicenseFeaturesRequest) (*License, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetLicenseFeatures not implemented")
+}
+func (UnimplementedWhitelistServiceServer) CreatePlan(context.Context, *CreatePlanRequest) (*Plan, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePlan not implemented")
+}
+func (UnimplementedWhitelistServiceServer) UpdatePlan(context.Context, *UpdatePlanRequest) (*Plan, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePlan not implemented")
+}
+func (UnimplementedWhitelistServiceServer) ListPlans(context.Context, *ListPlansRequest) (*ListPlansResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPlans not implemented")
+}
+func (UnimplementedWhitelistServiceServer) DeletePlan(context.Context, *DeletePlanRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeletePlan not implemented")
+}
+func (UnimplementedWhitelistServiceServer) GetServerTime(context.Context, *GetServerTimeRequest) (*GetServerTimeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetServerTime not implemented")
+}
+func (UnimplementedWhitelistServiceServer) GetPublicKey(context.Context, *GetPublicKeyRequest) (*GetPublicKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPublicKey not implemented")
+}
+func (UnimplementedWhitelistServiceServer) GetSigningKeys(context.Context, *GetSigningKeysRequest) (*GetSigningKeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSigningKeys not implemented")
+}
+func (UnimplementedWhitelistServiceServer) mustEmbedUnimplementedWhitelistServiceServer() {}
+func (UnimplementedWhitelistServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeWhitelistServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WhitelistServiceServer will
+// result in compilation errors.
+type UnsafeWhitelistServiceServer interface {
+	mustEmbedUnimplementedWhitelistServiceServer()
+}
+
+func RegisterWhitelistServiceServer(s grpc.ServiceRegistrar, srv WhitelistServiceServer) {
+	// If the following call panics, it indicates UnimplementedWhitelistServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WhitelistService_ServiceDesc, srv)
+}
+
+func _WhitelistService_GetAuthToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).GetAuthToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_GetAuthToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).GetAuthToken(ctx, req.(*GetTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_ValidateLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).ValidateLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_ValidateLicense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).ValidateLicense(ctx, req.(*ValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_UpdateLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLicenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).UpdateLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_UpdateLicense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).UpdateLicense(ctx, req.(*UpdateLicenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_DeleteLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLicenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).DeleteLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_DeleteLicense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).DeleteLicense(ctx, req.(*DeleteLicenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_GetLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLicenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).GetLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_GetLicense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).GetLicense(ctx, req.(*GetLicenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_ListLicenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLicensesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).ListLicenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_ListLicenses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).ListLicenses(ctx, req.(*ListLicensesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_ResetHwid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetHwidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).ResetHwid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_ResetHwid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).ResetHwid(ctx, req.(*ResetHwidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_GenerateLicenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateLicensesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).GenerateLicenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_GenerateLicenses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).GenerateLicenses(ctx, req.(*GenerateLicensesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_BatchUpsertLicenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpsertLicensesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).BatchUpsertLicenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_BatchUpsertLicenses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).BatchUpsertLicenses(ctx, req.(*BatchUpsertLicensesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_ExportLicenses_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportLicensesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WhitelistServiceServer).ExportLicenses(m, &grpc.GenericServerStream[ExportLicensesRequest, httpbody.HttpBody]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WhitelistService_ExportLicensesServer = grpc.ServerStreamingServer[httpbody.HttpBody]
+
+func _WhitelistService_ImportLicenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportLicensesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).ImportLicenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_ImportLicenses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).ImportLicenses(ctx, req.(*ImportLicensesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_ResellerGenerateLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResellerGenerateLicenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).ResellerGenerateLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_ResellerGenerateLicense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).ResellerGenerateLicense(ctx, req.(*ResellerGenerateLicenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_CreateReseller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateResellerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).CreateReseller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_CreateReseller_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).CreateReseller(ctx, req.(*CreateResellerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_TopUpResellerCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopUpResellerCreditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).TopUpResellerCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_TopUpResellerCredits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).TopUpResellerCredits(ctx, req.(*TopUpResellerCreditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_ListResellerActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResellerActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).ListResellerActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_ListResellerActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).ListResellerActivity(ctx, req.(*ListResellerActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_AdminLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).AdminLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_AdminLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).AdminLogin(ctx, req.(*AdminLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_CreateAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).CreateAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_CreateAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).CreateAdmin(ctx, req.(*CreateAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_UpdateAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).UpdateAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_UpdateAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).UpdateAdmin(ctx, req.(*UpdateAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_ListAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAdminsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).ListAdmins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_ListAdmins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).ListAdmins(ctx, req.(*ListAdminsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_AdminLogout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).AdminLogout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_AdminLogout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).AdminLogout(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_ListAdminSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAdminSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).ListAdminSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_ListAdminSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).ListAdminSessions(ctx, req.(*ListAdminSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_RevokeAdminSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAdminSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).RevokeAdminSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_RevokeAdminSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).RevokeAdminSession(ctx, req.(*RevokeAdminSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_ExportLicenseFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportLicenseFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).ExportLicenseFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_ExportLicenseFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).ExportLicenseFile(ctx, req.(*ExportLicenseFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_StartSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).StartSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_StartSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).StartSession(ctx, req.(*StartSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_Heartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).Heartbeat(ctx, req.(*HeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_EndSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).EndSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_EndSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).EndSession(ctx, req.(*EndSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_WatchLicense_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchLicenseRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WhitelistServiceServer).WatchLicense(m, &grpc.GenericServerStream[WatchLicenseRequest, LicenseStatusEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WhitelistService_WatchLicenseServer = grpc.ServerStreamingServer[LicenseStatusEvent]
+
+func _WhitelistService_ValidateLicenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateLicensesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).ValidateLicenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_ValidateLicenses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).ValidateLicenses(ctx, req.(*ValidateLicensesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).CreateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_CreateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).CreateProduct(ctx, req.(*CreateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_UpdateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).ListProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_ListProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).ListProducts(ctx, req.(*ListProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).DeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_DeleteProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_GetLatestVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLatestVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).GetLatestVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_GetLatestVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).GetLatestVersion(ctx, req.(*GetLatestVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_PublishRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).PublishRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_PublishRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).PublishRelease(ctx, req.(*PublishReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_SetLicenseChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLicenseChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).SetLicenseChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_SetLicenseChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).SetLicenseChannel(ctx, req.(*SetLicenseChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_CreateCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).CreateCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_CreateCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).CreateCustomer(ctx, req.(*CreateCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_ListCustomers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCustomersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).ListCustomers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_ListCustomers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).ListCustomers(ctx, req.(*ListCustomersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_AttachLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachLicenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).AttachLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_AttachLicense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).AttachLicense(ctx, req.(*AttachLicenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_DetachLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetachLicenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).DetachLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_DetachLicense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).DetachLicense(ctx, req.(*DetachLicenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_IssueLicenseToEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueLicenseToEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).IssueLicenseToEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_IssueLicenseToEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).IssueLicenseToEmail(ctx, req.(*IssueLicenseToEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_ListLicenseDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLicenseDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).ListLicenseDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_ListLicenseDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).ListLicenseDeliveries(ctx, req.(*ListLicenseDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_CreateTrialLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTrialLicenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).CreateTrialLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_CreateTrialLicense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).CreateTrialLicense(ctx, req.(*CreateTrialLicenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_ExtendLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendLicenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).ExtendLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_ExtendLicense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).ExtendLicense(ctx, req.(*ExtendLicenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_ResellerExtendLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendLicenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).ResellerExtendLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_ResellerExtendLicense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).ResellerExtendLicense(ctx, req.(*ExtendLicenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_SuspendLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendLicenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).SuspendLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_SuspendLicense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).SuspendLicense(ctx, req.(*SuspendLicenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_UnsuspendLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsuspendLicenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).UnsuspendLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_UnsuspendLicense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).UnsuspendLicense(ctx, req.(*UnsuspendLicenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_BanHwid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanHwidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).BanHwid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_BanHwid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).BanHwid(ctx, req.(*BanHwidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_UnbanHwid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanHwidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).UnbanHwid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_UnbanHwid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).UnbanHwid(ctx, req.(*UnbanHwidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_ListHwidBans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHwidBansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).ListHwidBans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_ListHwidBans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).ListHwidBans(ctx, req.(*ListHwidBansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_BanIp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanIpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).BanIp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_BanIp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).BanIp(ctx, req.(*BanIpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_UnbanIp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanIpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).UnbanIp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_UnbanIp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).UnbanIp(ctx, req.(*UnbanIpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_ListIpBans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIpBansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).ListIpBans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_ListIpBans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).ListIpBans(ctx, req.(*ListIpBansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_SetLicenseCountries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLicenseCountriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).SetLicenseCountries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_SetLicenseCountries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).SetLicenseCountries(ctx, req.(*SetLicenseCountriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_ClearLockouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearLockoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).ClearLockouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_ClearLockouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).ClearLockouts(ctx, req.(*ClearLockoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_RotateProductSigningSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateProductSigningSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).RotateProductSigningSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_RotateProductSigningSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).RotateProductSigningSecret(ctx, req.(*RotateProductSigningSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_RemoveProductSigningSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveProductSigningSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).RemoveProductSigningSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_RemoveProductSigningSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).RemoveProductSigningSecret(ctx, req.(*RemoveProductSigningSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_GetChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).GetChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_GetChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).GetChallenge(ctx, req.(*GetChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_RevokeRefreshTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeRefreshTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).RevokeRefreshTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_RevokeRefreshTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).RevokeRefreshTokens(ctx, req.(*RevokeRefreshTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_RotateAdminSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateAdminSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).RotateAdminSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_RotateAdminSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).RotateAdminSecret(ctx, req.(*RotateAdminSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_EnrollAdminTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollAdminTotpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).EnrollAdminTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_EnrollAdminTotp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).EnrollAdminTotp(ctx, req.(*EnrollAdminTotpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_ExportAuditLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportAuditLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WhitelistServiceServer).ExportAuditLog(m, &grpc.GenericServerStream[ExportAuditLogRequest, httpbody.HttpBody]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WhitelistService_ExportAuditLogServer = grpc.ServerStreamingServer[httpbody.HttpBody]
+
+func _WhitelistService_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_GetStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).GetStats(ctx, req.(*GetStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_ListValidationEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListValidationEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).ListValidationEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_ListValidationEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).ListValidationEvents(ctx, req.(*ListValidationEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_SearchLicenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchLicensesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).SearchLicenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_SearchLicenses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).SearchLicenses(ctx, req.(*SearchLicensesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_RestoreLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreLicenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).RestoreLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_RestoreLicense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).RestoreLicense(ctx, req.(*RestoreLicenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_PurgeLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeLicenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).PurgeLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_PurgeLicense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).PurgeLicense(ctx, req.(*PurgeLicenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_GetLicenseHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLicenseHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).GetLicenseHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_GetLicenseHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).GetLicenseHistory(ctx, req.(*GetLicenseHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_ImportExternalLicenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportExternalLicensesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).ImportExternalLicenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_ImportExternalLicenses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).ImportExternalLicenses(ctx, req.(*ImportExternalLicensesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_BulkSuspendByProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkSuspendByProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).BulkSuspendByProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_BulkSuspendByProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).BulkSuspendByProduct(ctx, req.(*BulkSuspendByProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_BulkDeleteByProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkDeleteByProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).BulkDeleteByProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_BulkDeleteByProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).BulkDeleteByProduct(ctx, req.(*BulkDeleteByProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_BulkExtendByProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkExtendByProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).BulkExtendByProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_BulkExtendByProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).BulkExtendByProduct(ctx, req.(*BulkExtendByProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_SetMaintenanceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMaintenanceModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).SetMaintenanceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_SetMaintenanceMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).SetMaintenanceMode(ctx, req.(*SetMaintenanceModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_GetMaintenanceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).GetMaintenanceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_GetMaintenanceMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).GetMaintenanceMode(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_SetProductMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProductMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).SetProductMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_SetProductMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).SetProductMessages(ctx, req.(*SetProductMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_GetProductMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).GetProductMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_GetProductMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).GetProductMessages(ctx, req.(*GetProductMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_ListMyDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).ListMyDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_ListMyDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).ListMyDevices(ctx, req.(*ListMyDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_DeactivateDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).DeactivateDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_DeactivateDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).DeactivateDevice(ctx, req.(*DeactivateDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_SetLicenseFloatingSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLicenseFloatingSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).SetLicenseFloatingSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_SetLicenseFloatingSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).SetLicenseFloatingSeats(ctx, req.(*SetLicenseFloatingSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_CheckoutLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckoutLicenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).CheckoutLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_CheckoutLicense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).CheckoutLicense(ctx, req.(*CheckoutLicenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_CheckinLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckinLicenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).CheckinLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_CheckinLicense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).CheckinLicense(ctx, req.(*CheckinLicenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_ConsumeCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumeCreditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).ConsumeCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_ConsumeCredits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).ConsumeCredits(ctx, req.(*ConsumeCreditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_TopUpLicenseCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopUpLicenseCreditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).TopUpLicenseCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_TopUpLicenseCredits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).TopUpLicenseCredits(ctx, req.(*TopUpLicenseCreditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_ListLicenseCreditActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLicenseCreditActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).ListLicenseCreditActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_ListLicenseCreditActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).ListLicenseCreditActivity(ctx, req.(*ListLicenseCreditActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_SetLicenseFeatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLicenseFeaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).SetLicenseFeatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_SetLicenseFeatures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).SetLicenseFeatures(ctx, req.(*SetLicenseFeaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_CreatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).CreatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_CreatePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).CreatePlan(ctx, req.(*CreatePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_UpdatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).UpdatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_UpdatePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).UpdatePlan(ctx, req.(*UpdatePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_ListPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).ListPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_ListPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).ListPlans(ctx, req.(*ListPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_DeletePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).DeletePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_DeletePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).DeletePlan(ctx, req.(*DeletePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_GetServerTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServerTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).GetServerTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_GetServerTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).GetServerTime(ctx, req.(*GetServerTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_GetPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).GetPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_GetPublicKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).GetPublicKey(ctx, req.(*GetPublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WhitelistService_GetSigningKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSigningKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WhitelistServiceServer).GetSigningKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WhitelistService_GetSigningKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WhitelistServiceServer).GetSigningKeys(ctx, req.(*GetSigningKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WhitelistService_ServiceDesc is the grpc.ServiceDesc for WhitelistService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WhitelistService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "whitelist.WhitelistService",
+	HandlerType: (*WhitelistServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAuthToken",
+			Handler:    _WhitelistService_GetAuthToken_Handler,
+		},
+		{
+			MethodName: "ValidateLicense",
+			Handler:    _WhitelistService_ValidateLicense_Handler,
+		},
+		{
+			MethodName: "UpdateLicense",
+			Handler:    _WhitelistService_UpdateLicense_Handler,
+		},
+		{
+			MethodName: "DeleteLicense",
+			Handler:    _WhitelistService_DeleteLicense_Handler,
+		},
+		{
+			MethodName: "GetLicense",
+			Handler:    _WhitelistService_GetLicense_Handler,
+		},
+		{
+			MethodName: "ListLicenses",
+			Handler:    _WhitelistService_ListLicenses_Handler,
+		},
+		{
+			MethodName: "ResetHwid",
+			Handler:    _WhitelistService_ResetHwid_Handler,
+		},
+		{
+			MethodName: "GenerateLicenses",
+			Handler:    _WhitelistService_GenerateLicenses_Handler,
+		},
+		{
+			MethodName: "BatchUpsertLicenses",
+			Handler:    _WhitelistService_BatchUpsertLicenses_Handler,
+		},
+		{
+			MethodName: "ImportLicenses",
+			Handler:    _WhitelistService_ImportLicenses_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _WhitelistService_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "ResellerGenerateLicense",
+			Handler:    _WhitelistService_ResellerGenerateLicense_Handler,
+		},
+		{
+			MethodName: "CreateReseller",
+			Handler:    _WhitelistService_CreateReseller_Handler,
+		},
+		{
+			MethodName: "TopUpResellerCredits",
+			Handler:    _WhitelistService_TopUpResellerCredits_Handler,
+		},
+		{
+			MethodName: "ListResellerActivity",
+			Handler:    _WhitelistService_ListResellerActivity_Handler,
+		},
+		{
+			MethodName: "AdminLogin",
+			Handler:    _WhitelistService_AdminLogin_Handler,
+		},
+		{
+			MethodName: "CreateAdmin",
+			Handler:    _WhitelistService_CreateAdmin_Handler,
+		},
+		{
+			MethodName: "UpdateAdmin",
+			Handler:    _WhitelistService_UpdateAdmin_Handler,
+		},
+		{
+			MethodName: "ListAdmins",
+			Handler:    _WhitelistService_ListAdmins_Handler,
+		},
+		{
+			MethodName: "AdminLogout",
+			Handler:    _WhitelistService_AdminLogout_Handler,
+		},
+		{
+			MethodName: "ListAdminSessions",
+			Handler:    _WhitelistService_ListAdminSessions_Handler,
+		},
+		{
+			MethodName: "RevokeAdminSession",
+			Handler:    _WhitelistService_RevokeAdminSession_Handler,
+		},
+		{
+			MethodName: "ExportLicenseFile",
+			Handler:    _WhitelistService_ExportLicenseFile_Handler,
+		},
+		{
+			MethodName: "StartSession",
+			Handler:    _WhitelistService_StartSession_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _WhitelistService_Heartbeat_Handler,
+		},
+		{
+			MethodName: "EndSession",
+			Handler:    _WhitelistService_EndSession_Handler,
+		},
+		{
+			MethodName: "ValidateLicenses",
+			Handler:    _WhitelistService_ValidateLicenses_Handler,
+		},
+		{
+			MethodName: "CreateProduct",
+			Handler:    _WhitelistService_CreateProduct_Handler,
+		},
+		{
+			MethodName: "UpdateProduct",
+			Handler:    _WhitelistService_UpdateProduct_Handler,
+		},
+		{
+			MethodName: "ListProducts",
+			Handler:    _WhitelistService_ListProducts_Handler,
+		},
+		{
+			MethodName: "DeleteProduct",
+			Handler:    _WhitelistService_DeleteProduct_Handler,
+		},
+		{
+			MethodName: "GetLatestVersion",
+			Handler:    _WhitelistService_GetLatestVersion_Handler,
+		},
+		{
+			MethodName: "PublishRelease",
+			Handler:    _WhitelistService_PublishRelease_Handler,
+		},
+		{
+			MethodName: "SetLicenseChannel",
+			Handler:    _WhitelistService_SetLicenseChannel_Handler,
+		},
+		{
+			MethodName: "CreateCustomer",
+			Handler:    _WhitelistService_CreateCustomer_Handler,
+		},
+		{
+			MethodName: "ListCustomers",
+			Handler:    _WhitelistService_ListCustomers_Handler,
+		},
+		{
+			MethodName: "AttachLicense",
+			Handler:    _WhitelistService_AttachLicense_Handler,
+		},
+		{
+			MethodName: "DetachLicense",
+			Handler:    _WhitelistService_DetachLicense_Handler,
+		},
+		{
+			MethodName: "IssueLicenseToEmail",
+			Handler:    _WhitelistService_IssueLicenseToEmail_Handler,
+		},
+		{
+			MethodName: "ListLicenseDeliveries",
+			Handler:    _WhitelistService_ListLicenseDeliveries_Handler,
+		},
+		{
+			MethodName: "CreateTrialLicense",
+			Handler:    _WhitelistService_CreateTrialLicense_Handler,
+		},
+		{
+			MethodName: "ExtendLicense",
+			Handler:    _WhitelistService_ExtendLicense_Handler,
+		},
+		{
+			MethodName: "ResellerExtendLicense",
+			Handler:    _WhitelistService_ResellerExtendLicense_Handler,
+		},
+		{
+			MethodName: "SuspendLicense",
+			Handler:    _WhitelistService_SuspendLicense_Handler,
+		},
+		{
+			MethodName: "UnsuspendLicense",
+			Handler:    _WhitelistService_UnsuspendLicense_Handler,
+		},
+		{
+			MethodName: "BanHwid",
+			Handler:    _WhitelistService_BanHwid_Handler,
+		},
+		{
+			MethodName: "UnbanHwid",
+			Handler:    _WhitelistService_UnbanHwid_Handler,
+		},
+		{
+			MethodName: "ListHwidBans",
+			Handler:    _WhitelistService_ListHwidBans_Handler,
+		},
+		{
+			MethodName: "BanIp",
+			Handler:    _WhitelistService_BanIp_Handler,
+		},
+		{
+			MethodName: "UnbanIp",
+			Handler:    _WhitelistService_UnbanIp_Handler,
+		},
+		{
+			MethodName: "ListIpBans",
+			Handler:    _WhitelistService_ListIpBans_Handler,
+		},
+		{
+			MethodName: "SetLicenseCountries",
+			Handler:    _WhitelistService_SetLicenseCountries_Handler,
+		},
+		{
+			MethodName: "ClearLockouts",
+			Handler:    _WhitelistService_ClearLockouts_Handler,
+		},
+		{
+			MethodName: "RotateProductSigningSecret",
+			Handler:    _WhitelistService_RotateProductSigningSecret_Handler,
+		},
+		{
+			MethodName: "RemoveProductSigningSecret",
+			Handler:    _WhitelistService_RemoveProductSigningSecret_Handler,
+		},
+		{
+			MethodName: "GetChallenge",
+			Handler:    _WhitelistService_GetChallenge_Handler,
+		},
+		{
+			MethodName: "RevokeRefreshTokens",
+			Handler:    _WhitelistService_RevokeRefreshTokens_Handler,
+		},
+		{
+			MethodName: "RotateAdminSecret",
+			Handler:    _WhitelistService_RotateAdminSecret_Handler,
+		},
+		{
+			MethodName: "EnrollAdminTotp",
+			Handler:    _WhitelistService_EnrollAdminTotp_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _WhitelistService_GetStats_Handler,
+		},
+		{
+			MethodName: "ListValidationEvents",
+			Handler:    _WhitelistService_ListValidationEvents_Handler,
+		},
+		{
+			MethodName: "SearchLicenses",
+			Handler:    _WhitelistService_SearchLicenses_Handler,
+		},
+		{
+			MethodName: "RestoreLicense",
+			Handler:    _WhitelistService_RestoreLicense_Handler,
+		},
+		{
+			MethodName: "PurgeLicense",
+			Handler:    _WhitelistService_PurgeLicense_Handler,
+		},
+		{
+			MethodName: "GetLicenseHistory",
+			Handler:    _WhitelistService_GetLicenseHistory_Handler,
+		},
+		{
+			MethodName: "ImportExternalLicenses",
+			Handler:    _WhitelistService_ImportExternalLicenses_Handler,
+		},
+		{
+			MethodName: "BulkSuspendByProduct",
+			Handler:    _WhitelistService_BulkSuspendByProduct_Handler,
+		},
+		{
+			MethodName: "BulkDeleteByProduct",
+			Handler:    _WhitelistService_BulkDeleteByProduct_Handler,
+		},
+		{
+			MethodName: "BulkExtendByProduct",
+			Handler:    _WhitelistService_BulkExtendByProduct_Handler,
+		},
+		{
+			MethodName: "SetMaintenanceMode",
+			Handler:    _WhitelistService_SetMaintenanceMode_Handler,
+		},
+		{
+			MethodName: "GetMaintenanceMode",
+			Handler:    _WhitelistService_GetMaintenanceMode_Handler,
+		},
+		{
+			MethodName: "SetProductMessages",
+			Handler:    _WhitelistService_SetProductMessages_Handler,
+		},
+		{
+			MethodName: "GetProductMessages",
+			Handler:    _WhitelistService_GetProductMessages_Handler,
+		},
+		{
+			MethodName: "ListMyDevices",
+			Handler:    _WhitelistService_ListMyDevices_Handler,
+		},
+		{
+			MethodName: "DeactivateDevice",
+			Handler:    _WhitelistService_DeactivateDevice_Handler,
+		},
+		{
+			MethodName: "SetLicenseFloatingSeats",
+			Handler:    _WhitelistService_SetLicenseFloatingSeats_Handler,
+		},
+		{
+			MethodName: "CheckoutLicense",
+			Handler:    _WhitelistService_CheckoutLicense_Handler,
+		},
+		{
+			MethodName: "CheckinLicense",
+			Handler:    _WhitelistService_CheckinLicense_Handler,
+		},
+		{
+			MethodName: "ConsumeCredits",
+			Handler:    _WhitelistService_ConsumeCredits_Handler,
+		},
+		{
+			MethodName: "TopUpLicenseCredits",
+			Handler:    _WhitelistService_TopUpLicenseCredits_Handler,
+		},
+		{
+			MethodName: "ListLicenseCreditActivity",
+			Handler:    _WhitelistService_ListLicenseCreditActivity_Handler,
+		},
+		{
+			MethodName: "SetLicenseFeatures",
+			Handler:    _WhitelistService_SetLicenseFeatures_Handler,
+		},
+		{
+			MethodName: "CreatePlan",
+			Handler:    _WhitelistService_CreatePlan_Handler,
+		},
+		{
+			MethodName: "UpdatePlan",
+			Handler:    _WhitelistService_UpdatePlan_Handler,
+		},
+		{
+			MethodName: "ListPlans",
+			Handler:    _WhitelistService_ListPlans_Handler,
+		},
+		{
+			MethodName: "DeletePlan",
+			Handler:    _WhitelistService_DeletePlan_Handler,
+		},
+		{
+			MethodName: "GetServerTime",
+			Handler:    _WhitelistService_GetServerTime_Handler,
+		},
+		{
+			MethodName: "GetPublicKey",
+			Handler:    _WhitelistService_GetPublicKey_Handler,
+		},
+		{
+			MethodName: "GetSigningKeys",
+			Handler:    _WhitelistService_GetSigningKeys_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportLicenses",
+			Handler:       _WhitelistService_ExportLicenses_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchLicense",
+			Handler:       _WhitelistService_WatchLicense_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportAuditLog",
+			Handler:       _WhitelistService_ExportAuditLog_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/whitelist.proto",
 }